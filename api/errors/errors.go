@@ -58,6 +58,9 @@ var ErrTxGenerationFailed = errors.New("transaction generation failed")
 // ErrValidationEmptyTxHash signals that an empty tx hash was provided
 var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 
+// ErrValidationInvalidTxHash signals that a tx hash that is not hex encoded was provided
+var ErrValidationInvalidTxHash = errors.New("TxHash is not a valid hex string")
+
 // ErrInvalidBlockNonce signals that an invalid block nonce was provided
 var ErrInvalidBlockNonce = errors.New("invalid block nonce")
 
@@ -73,9 +76,15 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrGetTransactionStateChanges signals an error happening when trying to fetch the state changes of a transaction
+var ErrGetTransactionStateChanges = errors.New("getting transaction state changes failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrGetHyperblock signals an error happening when trying to fetch a hyperblock
+var ErrGetHyperblock = errors.New("getting hyperblock failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -141,3 +150,42 @@ var ErrFetchingNonceGapsCannotIncludeFields = errors.New("fetching nonce gaps ca
 
 // ErrInvalidFields signals that invalid fields were provided
 var ErrInvalidFields = errors.New("invalid fields")
+
+// ErrGetPoolHistory signals that an error occurred while trying to fetch the pool history of a transaction
+var ErrGetPoolHistory = errors.New("getting transaction pool history failed")
+
+// ErrGetPoolEvictions signals that an error occurred while trying to fetch the latest pool evictions
+var ErrGetPoolEvictions = errors.New("getting transactions pool evictions failed")
+
+// ErrInvalidLimit signals that an invalid limit parameter was provided
+var ErrInvalidLimit = errors.New("invalid limit parameter")
+
+// ErrGetGasPriceSuggestion signals that an error occurred while trying to compute the gas price suggestion
+var ErrGetGasPriceSuggestion = errors.New("getting gas price suggestion failed")
+
+// ErrInvalidBlocksParameter signals that an invalid blocks parameter was provided
+var ErrInvalidBlocksParameter = errors.New("invalid blocks parameter")
+
+// ErrGetAddressTransactions signals that an error occurred while trying to fetch the transactions of an address
+var ErrGetAddressTransactions = errors.New("getting address transactions failed")
+
+// ErrGetAccountHistory signals that an error occurred while trying to fetch the history of an account
+var ErrGetAccountHistory = errors.New("getting account history failed")
+
+// ErrGetEvents signals that an error occurred while trying to fetch the logged events
+var ErrGetEvents = errors.New("getting events failed")
+
+// ErrInvalidPageSize signals that an invalid page size parameter was provided
+var ErrInvalidPageSize = errors.New("invalid page size parameter")
+
+// ErrInvalidSimulationBatchSize signals that an empty or too large batch of transactions has been provided for simulation
+var ErrInvalidSimulationBatchSize = errors.New("invalid number of transactions in the simulation batch")
+
+// ErrNilRouteGroups signals that nil route groups were provided
+var ErrNilRouteGroups = errors.New("nil route groups")
+
+// ErrGetConsensusRounds signals that an error occurred while trying to fetch the consensus timeline of rounds
+var ErrGetConsensusRounds = errors.New("getting consensus rounds failed")
+
+// ErrGetConsensusSchedule signals that an error occurred while trying to compute the consensus schedule
+var ErrGetConsensusSchedule = errors.New("getting consensus schedule failed")
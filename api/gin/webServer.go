@@ -91,7 +91,7 @@ func (ws *webServer) StartHttpServer() error {
 		gin.SetMode(gin.ReleaseMode)
 	}
 	engine = gin.Default()
-	engine.Use(cors.Default())
+	engine.Use(cors.New(createCorsConfig()))
 
 	processors, err := ws.createMiddlewareLimiters()
 	if err != nil {
@@ -157,6 +157,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["internal"] = internalBlockGroup
 
+	hyperblockGroup, err := groups.NewHyperblockGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["hyperblock"] = hyperblockGroup
+
+	logsGroup, err := groups.NewLogsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["logs"] = logsGroup
+
 	hardforkGroup, err := groups.NewHardforkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -199,6 +211,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["vm-values"] = vmValuesGroup
 
+	openApiGroup, err := groups.NewOpenApiGroup(groupsMap, ws.apiConfig)
+	if err != nil {
+		return err
+	}
+	groupsMap["openapi"] = openApiGroup
+
 	ws.groups = groupsMap
 
 	return nil
@@ -221,6 +239,15 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 	}
 }
 
+// createCorsConfig allows all the origins, as the default config does, together with the authentication headers
+func createCorsConfig() cors.Config {
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AllowAllOrigins = true
+	corsConfig.AddAllowHeaders(middleware.ApiKeyHeader, middleware.ApiTimestampHeader, middleware.ApiSignatureHeader)
+
+	return corsConfig
+}
+
 func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, error) {
 	middlewares := make([]shared.MiddlewareProcessor, 0)
 
@@ -241,6 +268,15 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 
 	middlewares = append(middlewares, sourceLimiter)
 
+	if ws.apiConfig.Authentication.Enabled {
+		authenticator, errAuth := middleware.NewAuthenticator(ws.apiConfig.Authentication)
+		if errAuth != nil {
+			return nil, errAuth
+		}
+
+		middlewares = append(middlewares, authenticator)
+	}
+
 	globalLimiter, err := middleware.NewGlobalThrottler(ws.antiFloodConfig.SimultaneousRequests)
 	if err != nil {
 		return nil, err
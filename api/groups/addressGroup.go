@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -11,8 +12,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,12 +31,30 @@ const (
 	getESDTsRolesPath         = "/:address/esdts/roles"
 	getRegisteredNFTsPath     = "/:address/registered-nfts"
 	getESDTNFTDataPath        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getTransactionsPath       = "/:address/transactions"
+	getHistoryPath            = "/:address/history"
+	getESDTHistoryPath        = "/:address/esdt/:tokenIdentifier/history"
 	urlParamOnFinalBlock      = "onFinalBlock"
 	urlParamOnStartOfEpoch    = "onStartOfEpoch"
 	urlParamBlockNonce        = "blockNonce"
 	urlParamBlockHash         = "blockHash"
 	urlParamBlockRootHash     = "blockRootHash"
 	urlParamHintEpoch         = "hintEpoch"
+	urlParamFrom              = "from"
+	urlParamSize              = "size"
+	urlParamFromNonce         = "fromNonce"
+	urlParamToNonce           = "toNonce"
+	urlParamStep              = "step"
+	urlParamTokens            = "tokens"
+	urlParamStartKey          = "startKey"
+	urlParamLimit             = "limit"
+	urlParamStream            = "stream"
+	defaultTransactionsSize   = 20
+	maxTransactionsSize       = 100
+	maxAccountHistoryPoints   = 1000
+	maxAccountHistoryTokens   = 20
+	defaultStoragePageSize    = 1000
+	maxStoragePageSize        = 10000
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -48,6 +69,12 @@ type addressFacadeHandler interface {
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error)
+	IterateKeyValuePairs(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error)
+	GetESDTTokensPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error)
+	IterateESDTTokens(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error)
+	GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -92,56 +119,141 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Path:    getAccountPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAccount,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the account of an address",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"account": api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getBalance,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the balance of an address",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"balance": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getUsernamePath,
 			Method:  http.MethodGet,
 			Handler: ag.getUsername,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the username of an address",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"username": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeyPath,
 			Method:  http.MethodGet,
 			Handler: ag.getValueForKey,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the hex encoded value stored under a key of an address",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"value": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeysPath,
 			Method:  http.MethodGet,
 			Handler: ag.getKeyValuePairs,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the hex encoded key-value pairs stored by an address",
+				QueryParameters: accountStorageQueryParameters,
+				ResponseData:    shared.ResponseFields{"pairs": map[string]string{}, "nextKey": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTBalance,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the balance of an ESDT token of an address",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"tokenData": esdtTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTNFTDataPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTNFTData,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the data of an NFT or SFT of an address",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"tokenData": esdtNFTTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAllESDTData,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns all the ESDT tokens of an address",
+				QueryParameters: accountStorageQueryParameters,
+				ResponseData:    shared.ResponseFields{"esdts": map[string]*esdtNFTTokenData{}, "nextKey": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getRegisteredNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ag.getNFTTokenIDsRegisteredByAddress,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the identifiers of the NFT collections registered by an address",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensWithRolePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTTokensWithRole,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the identifiers of the ESDT tokens for which an address has a role",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTsRolesPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the ESDT roles of an address, by token identifier",
+				QueryParameters: accountQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"roles": map[string][]string{}, "blockInfo": api.BlockInfo{}},
+			},
+		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns, newest first, the transactions an address took part in",
+				QueryParameters: []string{urlParamFrom, urlParamSize},
+				ResponseData:    shared.ResponseFields{"transactions": []*transaction.ApiTransactionResult{}, "total": uint64(0)},
+			},
+		},
+		{
+			Path:    getHistoryPath,
+			Method:  http.MethodGet,
+			Handler: ag.getHistory,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the balance, the nonce and the token balances of an address at each of the requested blocks",
+				QueryParameters: accountHistoryQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"history": []*common.AccountHistoryPoint{}},
+			},
+		},
+		{
+			Path:    getESDTHistoryPath,
+			Method:  http.MethodGet,
+			Handler: ag.getESDTHistory,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the balance, the nonce and the balance of a token of an address at each of the requested blocks",
+				QueryParameters: accountHistoryQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"history": []*common.AccountHistoryPoint{}},
+			},
 		},
 	}
 	ag.endpoints = endpoints
@@ -248,7 +360,8 @@ func (ag *addressGroup) getValueForKey(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"value": value, "blockInfo": blockInfo})
 }
 
-// addressGroup returns all the key-value pairs for the given address
+// addressGroup returns the key-value pairs for the given address: all of them, a page of them or, in streamed mode, one
+// per line, as newline delimited JSON objects followed by the next key and the block info
 func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 	addr := c.Param("address")
 	if addr == "" {
@@ -262,6 +375,28 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
+	storageOptions, err := extractAccountStorageQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	if storageOptions.isStreamed {
+		ag.streamKeyValuePairs(c, addr, storageOptions.pageOptions, options)
+		return
+	}
+
+	if storageOptions.isPaginated {
+		page, blockInfo, errPage := ag.getFacade().GetKeyValuePairsPage(addr, storageOptions.pageOptions, options)
+		if errPage != nil {
+			shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, errPage)
+			return
+		}
+
+		shared.RespondWithSuccess(c, gin.H{"pairs": page.Pairs, "nextKey": page.NextKey, "blockInfo": blockInfo})
+		return
+	}
+
 	value, blockInfo, err := ag.getFacade().GetKeyValuePairs(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
@@ -442,12 +577,38 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 		return
 	}
 
+	storageOptions, err := extractAccountStorageQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTNFTData, err)
+		return
+	}
+
+	if storageOptions.isStreamed {
+		ag.streamESDTTokens(c, addr, storageOptions.pageOptions, options)
+		return
+	}
+
+	if storageOptions.isPaginated {
+		page, blockInfo, errPage := ag.getFacade().GetESDTTokensPage(addr, storageOptions.pageOptions, options)
+		if errPage != nil {
+			shared.RespondWithInternalError(c, errors.ErrGetESDTNFTData, errPage)
+			return
+		}
+
+		shared.RespondWithSuccess(c, gin.H{"esdts": formatTokensData(page.Tokens), "nextKey": page.NextKey, "blockInfo": blockInfo})
+		return
+	}
+
 	tokens, blockInfo, err := ag.getFacade().GetAllESDTTokens(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTNFTData, err)
 		return
 	}
 
+	shared.RespondWithSuccess(c, gin.H{"esdts": formatTokensData(tokens), "blockInfo": blockInfo})
+}
+
+func formatTokensData(tokens map[string]*esdt.ESDigitalToken) map[string]*esdtNFTTokenData {
 	formattedTokens := make(map[string]*esdtNFTTokenData)
 	for tokenID, esdtData := range tokens {
 		tokenData := buildTokenDataApiResponse(tokenID, esdtData)
@@ -455,7 +616,131 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 		formattedTokens[tokenID] = tokenData
 	}
 
-	shared.RespondWithSuccess(c, gin.H{"esdts": formattedTokens, "blockInfo": blockInfo})
+	return formattedTokens
+}
+
+// streamKeyValuePairs writes the key-value pairs of the given address as they are read from the data trie, so that
+// accounts with a large storage can be read without holding it in memory
+func (ag *addressGroup) streamKeyValuePairs(
+	c *gin.Context,
+	address string,
+	pageOptions common.AccountStoragePageOptions,
+	options api.AccountQueryOptions,
+) {
+	stream := shared.NewNDJSONStream(c)
+	nextKey, blockInfo, err := ag.getFacade().IterateKeyValuePairs(address, pageOptions, options, c.Request.Context(), func(key string, value string) error {
+		return stream.Write(gin.H{"key": key, "value": value})
+	})
+	if err != nil {
+		stream.Fail(errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	stream.Close(gin.H{"nextKey": nextKey, "blockInfo": blockInfo})
+}
+
+// streamESDTTokens writes the ESDT tokens of the given address as they are read from the data trie, each one along with
+// its data trie key, which can be used as start key to resume the stream
+func (ag *addressGroup) streamESDTTokens(
+	c *gin.Context,
+	address string,
+	pageOptions common.AccountStoragePageOptions,
+	options api.AccountQueryOptions,
+) {
+	stream := shared.NewNDJSONStream(c)
+	handler := func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error {
+		return stream.Write(gin.H{"key": key, "tokenData": buildTokenDataApiResponse(tokenIdentifier, token)})
+	}
+	nextKey, blockInfo, err := ag.getFacade().IterateESDTTokens(address, pageOptions, options, c.Request.Context(), handler)
+	if err != nil {
+		stream.Fail(errors.ErrGetESDTNFTData, err)
+		return
+	}
+
+	stream.Close(gin.H{"nextKey": nextKey, "blockInfo": blockInfo})
+}
+
+// getTransactions returns, newest first, the transactions the given address took part in
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, errors.ErrEmptyAddress)
+		return
+	}
+
+	from, err := parseUint64UrlParam(c, urlParamFrom)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err))
+		return
+	}
+
+	size, err := parseUint64UrlParam(c, urlParamSize)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err))
+		return
+	}
+	if !size.HasValue {
+		size.Value = defaultTransactionsSize
+	}
+	if size.Value == 0 || size.Value > maxTransactionsSize {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, errors.ErrInvalidPageSize)
+		return
+	}
+
+	transactions, err := ag.getFacade().GetTransactionsByAddress(addr, from.Value, size.Value)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAddressTransactions, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"transactions": transactions.Transactions, "total": transactions.Total})
+}
+
+// getHistory returns the balance, the nonce and, optionally, the balances of the given tokens of an account
+// at each of the requested blocks
+func (ag *addressGroup) getHistory(c *gin.Context) {
+	options, err := extractAccountHistoryQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountHistory, err)
+		return
+	}
+
+	ag.respondWithAccountHistory(c, options)
+}
+
+// getESDTHistory returns the balance, the nonce and the balance of the given token of an account at each of the
+// requested blocks
+func (ag *addressGroup) getESDTHistory(c *gin.Context) {
+	options, err := extractAccountHistoryQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountHistory, err)
+		return
+	}
+
+	tokenIdentifier := c.Param("tokenIdentifier")
+	if tokenIdentifier == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountHistory, errors.ErrEmptyTokenIdentifier)
+		return
+	}
+	options.Tokens = []string{tokenIdentifier}
+
+	ag.respondWithAccountHistory(c, options)
+}
+
+func (ag *addressGroup) respondWithAccountHistory(c *gin.Context, options common.AccountHistoryQueryOptions) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountHistory, errors.ErrEmptyAddress)
+		return
+	}
+
+	history, err := ag.getFacade().GetAccountHistory(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountHistory, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"history": history.History})
 }
 
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *esdtNFTTokenData {
@@ -1,11 +1,15 @@
 package groups
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	customErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
@@ -93,3 +97,154 @@ func checkAccountQueryOptions(options api.AccountQueryOptions) error {
 
 	return nil
 }
+
+func extractAccountHistoryQueryOptions(c *gin.Context) (common.AccountHistoryQueryOptions, error) {
+	options, err := parseAccountHistoryQueryOptions(c)
+	if err != nil {
+		return common.AccountHistoryQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	err = checkAccountHistoryQueryOptions(options)
+	if err != nil {
+		return common.AccountHistoryQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return options, nil
+}
+
+func parseAccountHistoryQueryOptions(c *gin.Context) (common.AccountHistoryQueryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.AccountHistoryQueryOptions{}, err
+	}
+	if !fromNonce.HasValue {
+		return common.AccountHistoryQueryOptions{}, fmt.Errorf("missing %s", urlParamFromNonce)
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.AccountHistoryQueryOptions{}, err
+	}
+	if !toNonce.HasValue {
+		return common.AccountHistoryQueryOptions{}, fmt.Errorf("missing %s", urlParamToNonce)
+	}
+
+	step, err := parseUint64UrlParam(c, urlParamStep)
+	if err != nil {
+		return common.AccountHistoryQueryOptions{}, err
+	}
+	if !step.HasValue {
+		step.Value = 1
+	}
+
+	tokens := make([]string, 0)
+	tokensParam := c.Request.URL.Query().Get(urlParamTokens)
+	for _, token := range strings.Split(tokensParam, ",") {
+		if len(token) > 0 {
+			tokens = append(tokens, token)
+		}
+	}
+
+	options := common.AccountHistoryQueryOptions{
+		FromNonce: fromNonce.Value,
+		ToNonce:   toNonce.Value,
+		Step:      step.Value,
+		Tokens:    tokens,
+	}
+	return options, nil
+}
+
+func checkAccountHistoryQueryOptions(options common.AccountHistoryQueryOptions) error {
+	if options.Step == 0 {
+		return errors.New("step must be greater than 0")
+	}
+	if options.FromNonce > options.ToNonce {
+		return errors.New("fromNonce must not be greater than toNonce")
+	}
+
+	if (options.ToNonce-options.FromNonce)/options.Step >= maxAccountHistoryPoints {
+		return fmt.Errorf("too many blocks requested, at most %d are allowed", maxAccountHistoryPoints)
+	}
+	if len(options.Tokens) > maxAccountHistoryTokens {
+		return fmt.Errorf("too many tokens requested, at most %d are allowed", maxAccountHistoryTokens)
+	}
+
+	return nil
+}
+
+// accountStorageQueryOptions holds the page and the response mode of a request for the storage entries of an account.
+// A request without pagination parameters returns all the entries
+type accountStorageQueryOptions struct {
+	pageOptions common.AccountStoragePageOptions
+	isPaginated bool
+	isStreamed  bool
+}
+
+func extractAccountStorageQueryOptions(c *gin.Context) (accountStorageQueryOptions, error) {
+	options, err := parseAccountStorageQueryOptions(c)
+	if err != nil {
+		return accountStorageQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return options, nil
+}
+
+func parseAccountStorageQueryOptions(c *gin.Context) (accountStorageQueryOptions, error) {
+	isStreamed, err := parseBoolUrlParam(c, urlParamStream)
+	if err != nil {
+		return accountStorageQueryOptions{}, err
+	}
+
+	startKey, err := parseHexBytesUrlParam(c, urlParamStartKey)
+	if err != nil {
+		return accountStorageQueryOptions{}, err
+	}
+
+	limit, err := parseUint64UrlParam(c, urlParamLimit)
+	if err != nil {
+		return accountStorageQueryOptions{}, err
+	}
+
+	// a streamed response is not limited by default, as it does not hold the entries in memory
+	isPaginated := !isStreamed && (len(startKey) > 0 || limit.HasValue)
+	if isPaginated && !limit.HasValue {
+		limit.Value = defaultStoragePageSize
+	}
+	if isPaginated && (limit.Value == 0 || limit.Value > maxStoragePageSize) {
+		return accountStorageQueryOptions{}, fmt.Errorf("%s must be between 1 and %d", urlParamLimit, maxStoragePageSize)
+	}
+	if limit.Value > math.MaxInt32 {
+		return accountStorageQueryOptions{}, fmt.Errorf("%s must not be greater than %d", urlParamLimit, math.MaxInt32)
+	}
+
+	options := accountStorageQueryOptions{
+		pageOptions: common.AccountStoragePageOptions{
+			StartKey: hex.EncodeToString(startKey),
+			Limit:    int(limit.Value),
+		},
+		isPaginated: isPaginated,
+		isStreamed:  isStreamed,
+	}
+	return options, nil
+}
+
+// accountQueryOptionsParameters holds the URL parameters parsed by extractAccountQueryOptions
+var accountQueryOptionsParameters = []string{
+	urlParamOnFinalBlock,
+	urlParamOnStartOfEpoch,
+	urlParamBlockNonce,
+	urlParamBlockHash,
+	urlParamBlockRootHash,
+	urlParamHintEpoch,
+}
+
+// accountHistoryQueryOptionsParameters holds the URL parameters parsed by extractAccountHistoryQueryOptions
+var accountHistoryQueryOptionsParameters = []string{
+	urlParamFromNonce,
+	urlParamToNonce,
+	urlParamStep,
+	urlParamTokens,
+}
+
+// accountStorageQueryParameters holds the URL parameters of the requests for the storage entries of an account
+var accountStorageQueryParameters = append([]string{urlParamStartKey, urlParamLimit, urlParamStream}, accountQueryOptionsParameters...)
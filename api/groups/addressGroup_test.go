@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,10 +13,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -110,7 +113,8 @@ type esdtTokensCompleteResponse struct {
 }
 
 type keyValuePairsResponseData struct {
-	Pairs map[string]string `json:"pairs"`
+	Pairs   map[string]string `json:"pairs"`
+	NextKey string            `json:"nextKey"`
 }
 
 type keyValuePairsResponse struct {
@@ -900,6 +904,167 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	assert.Equal(t, pairs, response.Data.Pairs)
 }
 
+func TestGetKeyValuePairs_InvalidPageParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	addrGroup, err := groups.NewAddressGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	for _, query := range []string{"limit=0", "limit=10001", "limit=abc", "startKey=xyz", "stream=maybe"} {
+		req, _ := http.NewRequest("GET", "/address/address/keys?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+	}
+}
+
+func TestGetKeyValuePairs_PaginatedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pairs := map[string]string{
+		"aa": "v1",
+		"bb": "v2",
+	}
+	var receivedPageOptions common.AccountStoragePageOptions
+	facade := mock.FacadeStub{
+		GetKeyValuePairsPageCalled: func(_ string, pageOptions common.AccountStoragePageOptions, _ api.AccountQueryOptions) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error) {
+			receivedPageOptions = pageOptions
+			return &common.KeyValuePairsPageApiResponse{Pairs: pairs, NextKey: "cc"}, api.BlockInfo{}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?startKey=AA&limit=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, common.AccountStoragePageOptions{StartKey: "aa", Limit: 2}, receivedPageOptions)
+	assert.Equal(t, pairs, response.Data.Pairs)
+	assert.Equal(t, "cc", response.Data.NextKey)
+
+	// only the start key given should use the default page size
+	req, _ = http.NewRequest("GET", "/address/address/keys?startKey=aa", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, common.AccountStoragePageOptions{StartKey: "aa", Limit: 1000}, receivedPageOptions)
+}
+
+func TestGetKeyValuePairs_StreamedShouldWriteNDJSON(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		IterateKeyValuePairsCalled: func(_ string, pageOptions common.AccountStoragePageOptions, _ api.AccountQueryOptions, _ context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error) {
+			assert.Equal(t, common.AccountStoragePageOptions{}, pageOptions)
+			_ = handler("aa", "v1")
+			_ = handler("bb", "v2")
+			return "", api.BlockInfo{Nonce: 37}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?stream=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, shared.NDJSONContentType, resp.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"key":"aa","value":"v1"}`, lines[0])
+	assert.JSONEq(t, `{"key":"bb","value":"v2"}`, lines[1])
+
+	trailer := struct {
+		NextKey   string        `json:"nextKey"`
+		BlockInfo api.BlockInfo `json:"blockInfo"`
+	}{}
+	require.Nil(t, json.Unmarshal([]byte(lines[2]), &trailer))
+	assert.Empty(t, trailer.NextKey)
+	assert.Equal(t, uint64(37), trailer.BlockInfo.Nonce)
+}
+
+func TestGetKeyValuePairs_StreamedFailureShouldEndTheStream(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		IterateKeyValuePairsCalled: func(_ string, _ common.AccountStoragePageOptions, _ api.AccountQueryOptions, _ context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error) {
+			_ = handler("aa", "v1")
+			return "", api.BlockInfo{}, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?stream=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+	require.Len(t, lines, 2)
+
+	response := shared.GenericAPIResponse{}
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &response))
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetFullESDTTokens_PaginatedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetESDTTokensPageCalled: func(_ string, pageOptions common.AccountStoragePageOptions, _ api.AccountQueryOptions) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error) {
+			assert.Equal(t, 1, pageOptions.Limit)
+			tokens := map[string]*esdt.ESDigitalToken{
+				"TKN-001": {Value: big.NewInt(10)},
+			}
+			return &common.ESDTTokensPageApiResponse{Tokens: tokens, NextKey: "dd"}, api.BlockInfo{}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt?limit=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Tokens  map[string]esdtNFTTokenData `json:"esdts"`
+			NextKey string                      `json:"nextKey"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Tokens, 1)
+	assert.Equal(t, "10", response.Data.Tokens["TKN-001"].Balance)
+	assert.Equal(t, "dd", response.Data.NextKey)
+}
+
 func TestGetESDTsRoles_WithEmptyAddressShouldReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.FacadeStub{}
@@ -979,6 +1144,240 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	assert.Equal(t, roles, response.Data.Roles)
 }
 
+type addressTransactionsResponseData struct {
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+	Total        uint64                              `json:"total"`
+}
+
+type addressTransactionsResponse struct {
+	Data  addressTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestGetTransactions_InvalidSizeShouldError(t *testing.T) {
+	t.Parallel()
+
+	addrGroup, err := groups.NewAddressGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	for _, size := range []string{"0", "101", "abc"} {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/address/transactions?size=%s", size), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAddressTransactions.Error()))
+	}
+}
+
+func TestGetTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(_ string, _ uint64, _ uint64) (*common.AddressTransactionsApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, uint64(5), from)
+			assert.Equal(t, uint64(20), size)
+
+			return &common.AddressTransactionsApiResponse{
+				Address: address,
+				Transactions: []*transaction.ApiTransactionResult{
+					{Hash: "hash1", Nonce: 2},
+					{Hash: "hash0", Nonce: 1},
+				},
+				Total: 7,
+			}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?from=5", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(7), response.Data.Total)
+	require.Equal(t, 2, len(response.Data.Transactions))
+	assert.Equal(t, "hash1", response.Data.Transactions[0].Hash)
+	assert.Equal(t, "hash0", response.Data.Transactions[1].Hash)
+}
+
+type accountHistoryResponseData struct {
+	History []*common.AccountHistoryPoint `json:"history"`
+}
+
+type accountHistoryResponse struct {
+	Data  accountHistoryResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func TestGetHistory_InvalidParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	addrGroup, err := groups.NewAddressGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	invalidQueries := []string{
+		"",
+		"?fromNonce=1",
+		"?toNonce=1",
+		"?fromNonce=abc&toNonce=10",
+		"?fromNonce=10&toNonce=1",
+		"?fromNonce=1&toNonce=10&step=0",
+		"?fromNonce=0&toNonce=1000",
+		"?fromNonce=0&toNonce=10&tokens=" + strings.Repeat("TKN,", 21),
+	}
+	for _, query := range invalidQueries {
+		req, _ := http.NewRequest("GET", "/address/address/history"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAccountHistory.Error()))
+	}
+}
+
+func TestGetHistory_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetAccountHistoryCalled: func(_ string, _ common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/history?fromNonce=1&toNonce=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	facade := mock.FacadeStub{
+		GetAccountHistoryCalled: func(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, common.AccountHistoryQueryOptions{
+				FromNonce: 10,
+				ToNonce:   30,
+				Step:      10,
+				Tokens:    []string{"TKN-abcdef", "OTHER-123456"},
+			}, options)
+
+			return &common.AccountHistoryApiResponse{
+				Address: address,
+				History: []*common.AccountHistoryPoint{
+					{BlockNonce: 10, Balance: "100", Nonce: 1},
+					{BlockNonce: 20, Balance: "200", Nonce: 2, ESDTBalances: map[string]string{"TKN-abcdef": "5"}},
+				},
+			}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	url := fmt.Sprintf("/address/%s/history?fromNonce=10&toNonce=30&step=10&tokens=TKN-abcdef,OTHER-123456", testAddress)
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 2, len(response.Data.History))
+	assert.Equal(t, "100", response.Data.History[0].Balance)
+	assert.Equal(t, "5", response.Data.History[1].ESDTBalances["TKN-abcdef"])
+}
+
+func TestGetESDTHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetAccountHistoryCalled: func(_ string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error) {
+			assert.Equal(t, uint64(1), options.Step)
+			assert.Equal(t, []string{"TKN-abcdef"}, options.Tokens)
+
+			return &common.AccountHistoryApiResponse{
+				History: []*common.AccountHistoryPoint{
+					{BlockNonce: 5, Balance: "0", ESDTBalances: map[string]string{"TKN-abcdef": "7"}},
+				},
+			}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt/TKN-abcdef/history?fromNonce=5&toNonce=5&tokens=IGNORED", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 1, len(response.Data.History))
+	assert.Equal(t, "7", response.Data.History[0].ESDTBalances["TKN-abcdef"])
+}
+
 func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	t.Parallel()
 
@@ -1042,6 +1441,9 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/history", Open: true},
+					{Name: "/:address/esdt/:tokenIdentifier/history", Open: true},
 				},
 			},
 		},
@@ -73,22 +73,7 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.Ap
 	splitPath := strings.Split(basePath, "/")
 	basePath = splitPath[len(splitPath)-1]
 
-	group, ok := apiConfig.APIPackages[basePath]
-	if !ok {
-		return endpointProperties{
-			isOpen: false,
-		}
-	}
-
-	for _, route := range group.Routes {
-		if route.Name == path {
-			return endpointProperties{
-				isOpen: route.Open,
-			}
-		}
-	}
-
 	return endpointProperties{
-		isOpen: false,
+		isOpen: shared.IsEndpointOpen(apiConfig, basePath, path),
 	}
 }
@@ -23,6 +23,9 @@ const (
 	urlParamWithLogs    = "withLogs"
 )
 
+// blockQueryOptionsParameters holds the URL parameters parsed by parseBlockQueryOptions
+var blockQueryOptionsParameters = []string{urlParamWithTxs, urlParamWithLogs}
+
 // blockFacadeHandler defines the methods to be implemented by a facade for handling block requests
 type blockFacadeHandler interface {
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
@@ -53,16 +56,31 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 			Path:    getBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByNonce,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the block with the given nonce",
+				QueryParameters: blockQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByHash,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the block with the given hash",
+				QueryParameters: blockQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByRound,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the block proposed in the given round",
+				QueryParameters: blockQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"block": api.Block{}},
+			},
 		},
 	}
 	bg.endpoints = endpoints
@@ -46,6 +46,11 @@ func NewHardforkGroup(facade hardforkFacadeHandler) (*hardforkGroup, error) {
 			Path:    triggerPath,
 			Method:  http.MethodPost,
 			Handler: hg.triggerHandler,
+			Spec: &shared.EndpointSpec{
+				Summary:      "triggers a hardfork",
+				RequestBody:  HardforkRequest{},
+				ResponseData: shared.ResponseFields{"status": ""},
+			},
 		},
 	}
 	hg.endpoints = endpoints
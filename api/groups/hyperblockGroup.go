@@ -0,0 +1,149 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/shared/logging"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getHyperblockByNoncePath = "/by-nonce/:nonce"
+	getHyperblockByHashPath  = "/by-hash/:hash"
+)
+
+// hyperblockFacadeHandler defines the methods to be implemented by a facade for handling hyperblock requests
+type hyperblockFacadeHandler interface {
+	GetHyperblockByHash(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+type hyperblockGroup struct {
+	*baseGroup
+	facade    hyperblockFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewHyperblockGroup returns a new instance of hyperblockGroup
+func NewHyperblockGroup(facade hyperblockFacadeHandler) (*hyperblockGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for hyperblock group", errors.ErrNilFacadeHandler)
+	}
+
+	hg := &hyperblockGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getHyperblockByNoncePath,
+			Method:  http.MethodGet,
+			Handler: hg.getHyperblockByNonce,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the hyperblock of the metachain block with the given nonce",
+				QueryParameters: blockQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"hyperblock": common.HyperblockApiResponse{}},
+			},
+		},
+		{
+			Path:    getHyperblockByHashPath,
+			Method:  http.MethodGet,
+			Handler: hg.getHyperblockByHash,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the hyperblock of the metachain block with the given hash",
+				QueryParameters: blockQueryOptionsParameters,
+				ResponseData:    shared.ResponseFields{"hyperblock": common.HyperblockApiResponse{}},
+			},
+		},
+	}
+	hg.endpoints = endpoints
+
+	return hg, nil
+}
+
+func (hg *hyperblockGroup) getHyperblockByNonce(c *gin.Context) {
+	nonce, err := getQueryParamNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetHyperblock, errors.ErrInvalidBlockNonce)
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetHyperblock, errors.ErrBadUrlParams)
+		return
+	}
+
+	start := time.Now()
+	hyperblock, err := hg.getFacade().GetHyperblockByNonce(nonce, options)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetHyperblockByNonce")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetHyperblock, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"hyperblock": hyperblock})
+}
+
+func (hg *hyperblockGroup) getHyperblockByHash(c *gin.Context) {
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetHyperblock, errors.ErrValidationEmptyBlockHash)
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetHyperblock, errors.ErrBadUrlParams)
+		return
+	}
+
+	start := time.Now()
+	hyperblock, err := hg.getFacade().GetHyperblockByHash(hash, options)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetHyperblockByHash")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetHyperblock, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"hyperblock": hyperblock})
+}
+
+func (hg *hyperblockGroup) getFacade() hyperblockFacadeHandler {
+	hg.mutFacade.RLock()
+	defer hg.mutFacade.RUnlock()
+
+	return hg.facade
+}
+
+// UpdateFacade will update the facade
+func (hg *hyperblockGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(hyperblockFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for hyperblock group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	hg.mutFacade.Lock()
+	hg.facade = castFacade
+	hg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hg *hyperblockGroup) IsInterfaceNil() bool {
+	return hg == nil
+}
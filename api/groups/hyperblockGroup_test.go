@@ -0,0 +1,162 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type hyperblockResponseData struct {
+	Hyperblock common.HyperblockApiResponse `json:"hyperblock"`
+}
+
+type hyperblockResponse struct {
+	Data  hyperblockResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+func TestNewHyperblockGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		hg, err := groups.NewHyperblockGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.True(t, check.IfNil(hg))
+	})
+	t.Run("should work", func(t *testing.T) {
+		hg, err := groups.NewHyperblockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.False(t, check.IfNil(hg))
+	})
+}
+
+func TestGetHyperblockByNonce_InvalidParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	hg, err := groups.NewHyperblockGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(hg, "hyperblock", getHyperblockRoutesConfig())
+
+	urls := []string{
+		"/hyperblock/by-nonce/invalid",
+		"/hyperblock/by-nonce/10?withTxs=invalid",
+		"/hyperblock/by-hash/abcd?withLogs=invalid",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetHyperblock.Error()))
+	}
+}
+
+func TestGetHyperblock_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetHyperblockByNonceCalled: func(_ uint64, _ api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+			return nil, expectedErr
+		},
+		GetHyperblockByHashCalled: func(_ string, _ api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	hg, err := groups.NewHyperblockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(hg, "hyperblock", getHyperblockRoutesConfig())
+
+	for _, url := range []string{"/hyperblock/by-nonce/10", "/hyperblock/by-hash/abcd"} {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	}
+}
+
+func TestGetHyperblock_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := &common.HyperblockApiResponse{
+		Nonce:   37,
+		Hash:    "abcd",
+		IsFinal: true,
+		ShardBlocks: []*common.HyperblockShardBlockHeader{
+			{
+				Shard: 1,
+				MiniBlocks: []*common.HyperblockMiniBlock{
+					{Hash: "mb", Finality: "final-at-source"},
+				},
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetHyperblockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+			assert.Equal(t, uint64(37), nonce)
+			assert.Equal(t, api.BlockQueryOptions{WithTransactions: true}, options)
+			return expectedHyperblock, nil
+		},
+		GetHyperblockByHashCalled: func(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+			assert.Equal(t, "abcd", hash)
+			assert.Equal(t, api.BlockQueryOptions{WithTransactions: true, WithLogs: true}, options)
+			return expectedHyperblock, nil
+		},
+	}
+
+	hg, err := groups.NewHyperblockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(hg, "hyperblock", getHyperblockRoutesConfig())
+
+	for _, url := range []string{"/hyperblock/by-nonce/37?withTxs=true", "/hyperblock/by-hash/abcd?withTxs=true&withLogs=true"} {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := hyperblockResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint64(37), response.Data.Hyperblock.Nonce)
+		assert.True(t, response.Data.Hyperblock.IsFinal)
+		require.Len(t, response.Data.Hyperblock.ShardBlocks, 1)
+		assert.Equal(t, "final-at-source", response.Data.Hyperblock.ShardBlocks[0].MiniBlocks[0].Finality)
+	}
+}
+
+func getHyperblockRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"hyperblock": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: true},
+				},
+			},
+		},
+	}
+}
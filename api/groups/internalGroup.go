@@ -69,81 +69,145 @@ func NewInternalBlockGroup(facade internalBlockFacadeHandler) (*internalBlockGro
 			Path:    getRawMetaBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByNonce,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the protobuf marshalled metachain block with the given nonce",
+				ResponseData: shared.ResponseFields{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawMetaBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByHash,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the protobuf marshalled metachain block with the given hash",
+				ResponseData: shared.ResponseFields{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawMetaBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByRound,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the protobuf marshalled metachain block proposed in the given round",
+				ResponseData: shared.ResponseFields{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawStartOfEpochMetaBlockPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawStartOfEpochMetaBlock,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the protobuf marshalled start of epoch metachain block of an epoch",
+				ResponseData: shared.ResponseFields{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByNonce,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the protobuf marshalled shard block with the given nonce",
+				ResponseData: shared.ResponseFields{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByHash,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the protobuf marshalled shard block with the given hash",
+				ResponseData: shared.ResponseFields{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByRound,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the protobuf marshalled shard block proposed in the given round",
+				ResponseData: shared.ResponseFields{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByNonce,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the metachain block with the given nonce",
+				ResponseData: shared.ResponseFields{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByHash,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the metachain block with the given hash",
+				ResponseData: shared.ResponseFields{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByRound,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the metachain block proposed in the given round",
+				ResponseData: shared.ResponseFields{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONStartOfEpochMetaBlockPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONStartOfEpochMetaBlock,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the start of epoch metachain block of an epoch",
+				ResponseData: shared.ResponseFields{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByNonce,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the shard block with the given nonce",
+				ResponseData: shared.ResponseFields{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByHash,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the shard block with the given hash",
+				ResponseData: shared.ResponseFields{"block": nil},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByRound,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the shard block proposed in the given round",
+				ResponseData: shared.ResponseFields{"block": nil},
+			},
 		},
 		{
 			Path:    getRawMiniBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMiniBlockByHash,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the protobuf marshalled miniblock with the given hash",
+				ResponseData: shared.ResponseFields{"miniblock": []byte{}},
+			},
 		},
 		{
 			Path:    getJSONMiniBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMiniBlockByHash,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the miniblock with the given hash",
+				ResponseData: shared.ResponseFields{"miniblock": nil},
+			},
 		},
 	}
 	ib.endpoints = endpoints
@@ -0,0 +1,225 @@
+package groups
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	customErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getEventsPath = ""
+
+	urlParamAddress    = "address"
+	urlParamIdentifier = "identifier"
+	urlParamTopics     = "topics"
+
+	defaultEventsSize         = 20
+	maxEventsSize             = 100
+	maxEventsTopics           = 4
+	maxNotIndexedEventsNonces = 1000
+)
+
+// logsFacadeHandler defines the methods to be implemented by a facade for handling logs requests
+type logsFacadeHandler interface {
+	GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+type logsGroup struct {
+	*baseGroup
+	facade    logsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewLogsGroup returns a new instance of logsGroup
+func NewLogsGroup(facade logsFacadeHandler) (*logsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for logs group", customErrors.ErrNilFacadeHandler)
+	}
+
+	lg := &logsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getEventsPath,
+			Method:  http.MethodGet,
+			Handler: lg.getEvents,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns, oldest first, the events logged in a blocks range",
+				QueryParameters: []string{urlParamFromNonce, urlParamToNonce, urlParamAddress, urlParamIdentifier, urlParamTopics, urlParamFrom, urlParamSize},
+				ResponseData:    shared.ResponseFields{"events": []*common.EventApiResponse{}, "hasMore": false},
+			},
+		},
+	}
+	lg.endpoints = endpoints
+
+	return lg, nil
+}
+
+// getEvents returns, oldest first, the events logged in the requested blocks range and matching the optional
+// address, identifier and topics filters
+func (lg *logsGroup) getEvents(c *gin.Context) {
+	options, err := extractEventsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, customErrors.ErrGetEvents, err)
+		return
+	}
+
+	err = lg.checkEventsFilters(options)
+	if err != nil {
+		shared.RespondWithValidationError(c, customErrors.ErrGetEvents, err)
+		return
+	}
+
+	response, err := lg.getFacade().GetEvents(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, customErrors.ErrGetEvents, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"events": response.Events, "hasMore": response.HasMore})
+}
+
+func extractEventsQueryOptions(c *gin.Context) (common.EventsQueryOptions, error) {
+	options, err := parseEventsQueryOptions(c)
+	if err != nil {
+		return common.EventsQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	err = checkEventsQueryOptions(options)
+	if err != nil {
+		return common.EventsQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return options, nil
+}
+
+func parseEventsQueryOptions(c *gin.Context) (common.EventsQueryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+	if !fromNonce.HasValue {
+		return common.EventsQueryOptions{}, fmt.Errorf("missing %s", urlParamFromNonce)
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+	if !toNonce.HasValue {
+		return common.EventsQueryOptions{}, fmt.Errorf("missing %s", urlParamToNonce)
+	}
+
+	from, err := parseUint64UrlParam(c, urlParamFrom)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+
+	size, err := parseUint64UrlParam(c, urlParamSize)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+	if !size.HasValue {
+		size.Value = defaultEventsSize
+	}
+
+	query := c.Request.URL.Query()
+	topics := make([]string, 0)
+	topicsParam := query.Get(urlParamTopics)
+	if len(topicsParam) > 0 {
+		// empty topics are kept, as they match any value at their position
+		topics = strings.Split(topicsParam, ",")
+	}
+
+	options := common.EventsQueryOptions{
+		FromNonce:  fromNonce.Value,
+		ToNonce:    toNonce.Value,
+		Address:    query.Get(urlParamAddress),
+		Identifier: query.Get(urlParamIdentifier),
+		Topics:     topics,
+		From:       from.Value,
+		Size:       size.Value,
+	}
+	return options, nil
+}
+
+func checkEventsQueryOptions(options common.EventsQueryOptions) error {
+	if options.FromNonce > options.ToNonce {
+		return errors.New("fromNonce must not be greater than toNonce")
+	}
+	if options.Size == 0 || options.Size > maxEventsSize {
+		return fmt.Errorf("%w, at most %d events are allowed", customErrors.ErrInvalidPageSize, maxEventsSize)
+	}
+	if len(options.Topics) > maxEventsTopics {
+		return fmt.Errorf("too many topics requested, at most %d are allowed", maxEventsTopics)
+	}
+
+	isIndexedQuery := len(options.Address) > 0 || len(options.Identifier) > 0
+	if !isIndexedQuery && options.ToNonce-options.FromNonce >= maxNotIndexedEventsNonces {
+		return fmt.Errorf("too many blocks requested without an address or identifier filter, at most %d are allowed",
+			maxNotIndexedEventsNonces)
+	}
+
+	return nil
+}
+
+func (lg *logsGroup) checkEventsFilters(options common.EventsQueryOptions) error {
+	if len(options.Address) > 0 {
+		_, err := lg.getFacade().DecodeAddressPubkey(options.Address)
+		if err != nil {
+			return fmt.Errorf("%w: '%s' is not a valid address: %v", customErrors.ErrBadUrlParams, options.Address, err)
+		}
+	}
+
+	for _, topic := range options.Topics {
+		_, err := hex.DecodeString(topic)
+		if err != nil {
+			return fmt.Errorf("%w: '%s' is not a valid hex topic: %v", customErrors.ErrBadUrlParams, topic, err)
+		}
+	}
+
+	return nil
+}
+
+func (lg *logsGroup) getFacade() logsFacadeHandler {
+	lg.mutFacade.RLock()
+	defer lg.mutFacade.RUnlock()
+
+	return lg.facade
+}
+
+// UpdateFacade will update the facade
+func (lg *logsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return customErrors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(logsFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for logs group", customErrors.ErrFacadeWrongTypeAssertion)
+	}
+
+	lg.mutFacade.Lock()
+	lg.facade = castFacade
+	lg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lg *logsGroup) IsInterfaceNil() bool {
+	return lg == nil
+}
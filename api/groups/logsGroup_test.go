@@ -0,0 +1,158 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type eventsResponseData struct {
+	Events  []*common.EventApiResponse `json:"events"`
+	HasMore bool                       `json:"hasMore"`
+}
+
+type eventsResponse struct {
+	Data  eventsResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestNewLogsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.True(t, check.IfNil(lg))
+	})
+	t.Run("should work", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.False(t, check.IfNil(lg))
+	})
+}
+
+func TestGetEvents_InvalidParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+
+	queries := []string{
+		"",
+		"?fromNonce=10",
+		"?fromNonce=abc&toNonce=10",
+		"?fromNonce=10&toNonce=9",
+		"?fromNonce=1&toNonce=10&size=0",
+		"?fromNonce=1&toNonce=10&size=101",
+		"?fromNonce=1&toNonce=10&identifier=transfer&topics=a,b,c,d,e",
+		"?fromNonce=1&toNonce=1001",
+		"?fromNonce=1&toNonce=10&address=not-an-address",
+		"?fromNonce=1&toNonce=10&identifier=transfer&topics=01,zz",
+	}
+	for _, query := range queries {
+		req, _ := http.NewRequest("GET", "/logs"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetEvents.Error()))
+	}
+}
+
+func TestGetEvents_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetEventsCalled: func(_ common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	lg, err := groups.NewLogsGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/logs?fromNonce=1&toNonce=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetEvents_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedOptions := common.EventsQueryOptions{
+		FromNonce:  1,
+		ToNonce:    5000,
+		Address:    "abcd01",
+		Identifier: "ESDTTransfer",
+		Topics:     []string{"544f4b454e", "", "01"},
+		From:       5,
+		Size:       20,
+	}
+	facade := mock.FacadeStub{
+		GetEventsCalled: func(options common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+			assert.Equal(t, expectedOptions, options)
+
+			return &common.EventsApiResponse{
+				Events: []*common.EventApiResponse{
+					{TxHash: "hash0", BlockNonce: 2, Identifier: "ESDTTransfer"},
+					{TxHash: "hash1", BlockNonce: 4, Identifier: "ESDTTransfer"},
+				},
+				HasMore: true,
+			}, nil
+		},
+	}
+
+	lg, err := groups.NewLogsGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/logs?fromNonce=1&toNonce=5000&address=abcd01&identifier=ESDTTransfer&topics=544f4b454e,,01&from=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, response.Data.HasMore)
+	require.Equal(t, 2, len(response.Data.Events))
+	assert.Equal(t, "hash0", response.Data.Events[0].TxHash)
+	assert.Equal(t, uint64(4), response.Data.Events[1].BlockNonce)
+}
+
+func getLogsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
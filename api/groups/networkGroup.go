@@ -83,76 +83,135 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Path:    getConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getNetworkConfig,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the configuration metrics of the network",
+				ResponseData: shared.ResponseFields{"config": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    getStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.getNetworkStatus,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the status metrics of the network",
+				ResponseData: shared.ResponseFields{"status": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    economicsPath,
 			Method:  http.MethodGet,
 			Handler: ng.economicsMetrics,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the economics metrics of the network",
+				ResponseData: shared.ResponseFields{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    enableEpochsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getEnableEpochs,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the activation epochs of the protocol features",
+				ResponseData: shared.ResponseFields{"enableEpochs": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    getESDTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(""),
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the identifiers of all the issued ESDT tokens",
+				ResponseData: shared.ResponseFields{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getFFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.FungibleESDT),
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the identifiers of the issued fungible tokens",
+				ResponseData: shared.ResponseFields{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getSFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.SemiFungibleESDT),
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the identifiers of the issued semi-fungible tokens",
+				ResponseData: shared.ResponseFields{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.NonFungibleESDT),
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the identifiers of the issued non-fungible tokens",
+				ResponseData: shared.ResponseFields{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    directStakedInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.directStakedInfo,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the values staked directly by each staker",
+				ResponseData: shared.ResponseFields{"list": []*api.DirectStakedValue{}},
+			},
 		},
 		{
 			Path:    delegatedInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.delegatedInfo,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the values delegated by each delegator",
+				ResponseData: shared.ResponseFields{"list": []*api.Delegator{}},
+			},
 		},
 		{
 			Path:    getESDTSupplyPath,
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
+			Spec: &shared.EndpointSpec{
+				Summary: "returns the supply of an ESDT token",
+			},
 		},
 		{
 			Path:    ratingsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getRatingsConfig,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the ratings configuration of the network",
+				ResponseData: shared.ResponseFields{"config": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    genesisNodesConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisNodesConfig,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the public keys of the genesis nodes, by shard",
+				ResponseData: shared.ResponseFields{"nodes": GenesisNodesConfig{}},
+			},
 		},
 		{
 			Path:    genesisBalances,
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisBalances,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the genesis balances",
+				ResponseData: shared.ResponseFields{"balances": []*common.InitialAccountAPI{}},
+			},
 		},
 		{
 			Path:    gasConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getGasConfig,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the gas schedule",
+				ResponseData: shared.ResponseFields{"gasConfigs": map[string]map[string]uint64{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
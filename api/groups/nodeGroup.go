@@ -1,7 +1,9 @@
 package groups
 
 import (
+	goErrors "errors"
 	"fmt"
+	"math"
 	"net/http"
 	"sync"
 
@@ -18,6 +20,8 @@ import (
 
 const (
 	pidQueryParam          = "pid"
+	startRoundQueryParam   = "startRound"
+	endRoundQueryParam     = "endRound"
 	debugPath              = "/debug"
 	heartbeatStatusPath    = "/heartbeatstatus"
 	metricsPath            = "/metrics"
@@ -25,6 +29,8 @@ const (
 	peerInfoPath           = "/peerinfo"
 	statusPath             = "/status"
 	epochStartDataForEpoch = "/epoch-start/:epoch"
+	consensusRoundsPath    = "/consensus/rounds"
+	consensusRoundPath     = "/consensus/rounds/:round"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -34,6 +40,8 @@ type nodeFacadeHandler interface {
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRound(round int64) (*common.ConsensusRoundTrace, error)
+	GetConsensusRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error)
 	IsInterfaceNil() bool
 }
 
@@ -65,36 +73,84 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Path:    heartbeatStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.heartbeatStatus,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the heartbeat status of the known nodes",
+				ResponseData: shared.ResponseFields{"heartbeats": []data.PubKeyHeartbeat{}},
+			},
 		},
 		{
 			Path:    statusPath,
 			Method:  http.MethodGet,
 			Handler: ng.statusMetrics,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the status metrics of the node",
+				ResponseData: shared.ResponseFields{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    p2pStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.p2pStatusMetrics,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the p2p status metrics of the node",
+				ResponseData: shared.ResponseFields{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    metricsPath,
 			Method:  http.MethodGet,
 			Handler: ng.prometheusMetrics,
+			Spec: &shared.EndpointSpec{
+				Summary: "returns the status metrics of the node, in the Prometheus text format",
+			},
 		},
 		{
 			Path:    debugPath,
 			Method:  http.MethodPost,
 			Handler: ng.queryDebug,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the debug information of a query handler",
+				RequestBody:  QueryDebugRequest{},
+				ResponseData: shared.ResponseFields{"result": []string{}},
+			},
 		},
 		{
 			Path:    peerInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the p2p information of a peer",
+				QueryParameters: []string{pidQueryParam},
+				ResponseData:    shared.ResponseFields{"info": []core.QueryP2PPeerInfo{}},
+			},
 		},
 		{
 			Path:    epochStartDataForEpoch,
 			Method:  http.MethodGet,
 			Handler: ng.epochStartDataForEpoch,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the start of epoch data of an epoch",
+				ResponseData: shared.ResponseFields{"epochStart": common.EpochStartDataAPI{}},
+			},
+		},
+		{
+			Path:    consensusRoundsPath,
+			Method:  http.MethodGet,
+			Handler: ng.consensusRounds,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the consensus timelines recorded by the node for the rounds within a range",
+				QueryParameters: []string{startRoundQueryParam, endRoundQueryParam},
+				ResponseData:    shared.ResponseFields{"rounds": []common.ConsensusRoundTrace{}},
+			},
+		},
+		{
+			Path:    consensusRoundPath,
+			Method:  http.MethodGet,
+			Handler: ng.consensusRound,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the consensus timeline recorded by the node for a round",
+				ResponseData: shared.ResponseFields{"round": common.ConsensusRoundTrace{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
@@ -266,6 +322,80 @@ func (ng *nodeGroup) epochStartDataForEpoch(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochStart": epochStartData})
 }
 
+// consensusRound returns the consensus timeline recorded by the node for the provided round
+func (ng *nodeGroup) consensusRound(c *gin.Context) {
+	round, err := getQueryParamRound(c)
+	if err != nil || round > math.MaxInt64 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	trace, err := ng.getFacade().GetConsensusRound(int64(round))
+	if err != nil {
+		respondWithConsensusRoundsError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"round": trace})
+}
+
+// consensusRounds returns the consensus timelines recorded by the node for the rounds within the provided range.
+// Missing ends of the range default to the first and the last traced rounds
+func (ng *nodeGroup) consensusRounds(c *gin.Context) {
+	startRound, err := parseRoundUrlParam(c, startRoundQueryParam, 0)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+	endRound, err := parseRoundUrlParam(c, endRoundQueryParam, math.MaxInt64)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	traces, err := ng.getFacade().GetConsensusRounds(startRound, endRound)
+	if err != nil {
+		respondWithConsensusRoundsError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"rounds": traces})
+}
+
+// respondWithConsensusRoundsError responds with not found for the rounds not traced, with bad request if the tracing is
+// disabled or the range is invalid and with internal error otherwise
+func respondWithConsensusRoundsError(c *gin.Context, err error) {
+	switch {
+	case goErrors.Is(err, common.ErrRoundNotTraced):
+		shared.RespondWith(
+			c,
+			http.StatusNotFound,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetConsensusRounds.Error(), err.Error()),
+			shared.ReturnCodeRequestError,
+		)
+	case goErrors.Is(err, common.ErrRoundTracingDisabled), goErrors.Is(err, common.ErrInvalidRoundsRange):
+		shared.RespondWithValidationError(c, errors.ErrGetConsensusRounds, err)
+	default:
+		shared.RespondWithInternalError(c, errors.ErrGetConsensusRounds, err)
+	}
+}
+
+func parseRoundUrlParam(c *gin.Context, name string, defaultValue int64) (int64, error) {
+	round, err := parseUint64UrlParam(c, name)
+	if err != nil {
+		return 0, err
+	}
+	if !round.HasValue {
+		return defaultValue, nil
+	}
+	if round.Value > math.MaxInt64 {
+		return 0, errors.ErrBadUrlParams
+	}
+
+	return int64(round.Value), nil
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics, err := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
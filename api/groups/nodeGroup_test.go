@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -68,6 +69,20 @@ type epochStartResponse struct {
 	generalResponse
 }
 
+type consensusRoundResponse struct {
+	Data struct {
+		Round common.ConsensusRoundTrace `json:"round"`
+	} `json:"data"`
+	generalResponse
+}
+
+type consensusRoundsResponse struct {
+	Data struct {
+		Rounds []common.ConsensusRoundTrace `json:"rounds"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -455,6 +470,181 @@ func TestEpochStartData_ShouldWork(t *testing.T) {
 	require.Equal(t, *expectedEpochStartData, response.Data.EpochStartDataAPI)
 }
 
+func TestConsensusRound_InvalidRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+}
+
+func TestConsensusRound_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetConsensusRoundCalled: func(round int64) (*common.ConsensusRoundTrace, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds/10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestConsensusRound_NotTracedOrDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		err          error
+		expectedCode int
+	}{
+		{err: fmt.Errorf("%w: %d", common.ErrRoundNotTraced, 10), expectedCode: http.StatusNotFound},
+		{err: common.ErrRoundTracingDisabled, expectedCode: http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		providedErr := tc.err
+		facade := mock.FacadeStub{
+			GetConsensusRoundCalled: func(round int64) (*common.ConsensusRoundTrace, error) {
+				return nil, providedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds/10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, tc.expectedCode, resp.Code)
+		assert.True(t, strings.Contains(response.Error, providedErr.Error()))
+	}
+}
+
+func TestConsensusRound_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTrace := &common.ConsensusRoundTrace{
+		Round:              10,
+		Leader:             "leader",
+		ConsensusGroupSize: 7,
+		Subrounds: []*common.ConsensusSubroundTrace{
+			{Name: "(BLOCK)", StartOffsetMs: 5, EndOffsetMs: 800, Status: "finished"},
+		},
+		Messages: map[string][]*common.ConsensusMessageTrace{
+			"leader": {{Type: "(BLOCK_BODY_AND_HEADER)", OriginatorPid: "pid", OffsetMs: 500}},
+		},
+		Outcome: "block committed",
+	}
+	facade := mock.FacadeStub{
+		GetConsensusRoundCalled: func(round int64) (*common.ConsensusRoundTrace, error) {
+			assert.Equal(t, int64(10), round)
+			return expectedTrace, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds/10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &consensusRoundResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, *expectedTrace, response.Data.Round)
+}
+
+func TestConsensusRounds_InvalidRangeParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	for _, url := range []string{
+		"/node/consensus/rounds?startRound=invalid",
+		"/node/consensus/rounds?endRound=-1",
+		"/node/consensus/rounds?endRound=18446744073709551615",
+	} {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), url)
+	}
+}
+
+func TestConsensusRounds_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var providedStartRound, providedEndRound int64
+	facade := mock.FacadeStub{
+		GetConsensusRoundsCalled: func(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error) {
+			providedStartRound, providedEndRound = startRound, endRound
+			return []*common.ConsensusRoundTrace{{Round: 10}, {Round: 11}}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds?startRound=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &consensusRoundsResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, int64(10), providedStartRound)
+	assert.Equal(t, int64(math.MaxInt64), providedEndRound)
+	require.Equal(t, 2, len(response.Data.Rounds))
+	assert.Equal(t, int64(11), response.Data.Rounds[1].Round)
+}
+
 func TestPrometheusMetrics_ShouldReturnErrorIfFacadeReturnsError(t *testing.T) {
 	expectedErr := errors.New("i am an error")
 
@@ -535,6 +725,8 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
 					{Name: "/epoch-start/:epoch", Open: true},
+					{Name: "/consensus/rounds", Open: true},
+					{Name: "/consensus/rounds/:round", Open: true},
 				},
 			},
 		},
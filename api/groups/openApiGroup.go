@@ -0,0 +1,74 @@
+package groups
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const specPath = "/spec"
+
+type openApiGroup struct {
+	*baseGroup
+	groups       map[string]shared.GroupHandler
+	apiConfig    config.ApiRoutesConfig
+	document     *openapi.Document
+	documentOnce sync.Once
+}
+
+// NewOpenApiGroup returns a new instance of openApiGroup, which serves the OpenAPI document describing the open
+// endpoints of the provided route groups. The groups map may be completed after the creation of the group, as the
+// document is built on the first request
+func NewOpenApiGroup(groups map[string]shared.GroupHandler, apiConfig config.ApiRoutesConfig) (*openApiGroup, error) {
+	if groups == nil {
+		return nil, errors.ErrNilRouteGroups
+	}
+
+	og := &openApiGroup{
+		groups:    groups,
+		apiConfig: apiConfig,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    specPath,
+			Method:  http.MethodGet,
+			Handler: og.getSpec,
+		},
+	}
+	og.endpoints = endpoints
+
+	return og, nil
+}
+
+// getSpec returns the OpenAPI document itself, not wrapped in a generic API response, so that it can be used as is by
+// the client generators
+func (og *openApiGroup) getSpec(c *gin.Context) {
+	og.documentOnce.Do(func() {
+		og.document = openapi.BuildDocument(og.groups, og.apiConfig)
+	})
+
+	c.JSON(http.StatusOK, og.document)
+}
+
+// GetEndpoints returns no endpoints, as the document endpoint does not return a generic API response and is not
+// described in the document it serves
+func (og *openApiGroup) GetEndpoints() []*shared.EndpointHandlerData {
+	return make([]*shared.EndpointHandlerData, 0)
+}
+
+// UpdateFacade does nothing, as the group does not use a facade
+func (og *openApiGroup) UpdateFacade(_ interface{}) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (og *openApiGroup) IsInterfaceNil() bool {
+	return og == nil
+}
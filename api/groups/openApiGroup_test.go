@@ -0,0 +1,84 @@
+package groups_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOpenApiGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil groups", func(t *testing.T) {
+		og, err := groups.NewOpenApiGroup(nil, config.ApiRoutesConfig{})
+		require.Equal(t, apiErrors.ErrNilRouteGroups, err)
+		require.Nil(t, og)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		og, err := groups.NewOpenApiGroup(make(map[string]shared.GroupHandler), config.ApiRoutesConfig{})
+		require.NoError(t, err)
+		require.NotNil(t, og)
+		require.Empty(t, og.GetEndpoints())
+	})
+}
+
+func TestOpenApiGroup_GetSpecShouldDescribeTheOpenEndpoints(t *testing.T) {
+	t.Parallel()
+
+	validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	apiConfig := getOpenApiRoutesConfig()
+	routeGroups := map[string]shared.GroupHandler{
+		"validator": validatorGroup,
+	}
+	openApiGroup, err := groups.NewOpenApiGroup(routeGroups, apiConfig)
+	require.NoError(t, err)
+	// the groups map is completed after the creation of the group, as the web server does
+	routeGroups["openapi"] = openApiGroup
+
+	ws := startWebServer(openApiGroup, "openapi", apiConfig)
+
+	req, _ := http.NewRequest("GET", "/openapi/spec", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	document := openapi.Document{}
+	loadResponse(resp.Body, &document)
+
+	assert.Equal(t, "3.0.3", document.OpenAPI)
+	require.Len(t, document.Paths, 1)
+	operation := document.Paths["/validator/statistics"]["get"]
+	require.NotNil(t, operation)
+	assert.Equal(t, "getValidatorStatistics", operation.OperationID)
+	assert.Equal(t, []string{"validator"}, operation.Tags)
+	assert.Contains(t, document.Components.Schemas, "state.ValidatorApiResponse")
+}
+
+func getOpenApiRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"validator": {
+				Routes: []config.RouteConfig{
+					{Name: "/statistics", Open: true},
+				},
+			},
+			"openapi": {
+				Routes: []config.RouteConfig{
+					{Name: "/spec", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -58,6 +58,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofPath,
 			Method:  http.MethodGet,
 			Handler: pg.getProof,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the Merkle proof of an account in the state trie with the given root hash",
+				ResponseData: shared.ResponseFields{"proof": []string{}, "value": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofEndpoint, facade),
@@ -69,6 +73,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofDataTriePath,
 			Method:  http.MethodGet,
 			Handler: pg.getProofDataTrie,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the Merkle proofs of a key stored by an account, in the state trie and in the data trie",
+				ResponseData: shared.ResponseFields{"proofs": map[string][]string{}, "value": "", "dataTrieRootHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofDataTrieEndpoint, facade),
@@ -80,6 +88,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofCurrentRootHashPath,
 			Method:  http.MethodGet,
 			Handler: pg.getProofCurrentRootHash,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the Merkle proof of an account in the current state trie",
+				ResponseData: shared.ResponseFields{"proof": []string{}, "value": "", "rootHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofCurrentRootHashEndpoint, facade),
@@ -91,6 +103,11 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    verifyProofPath,
 			Method:  http.MethodPost,
 			Handler: pg.verifyProof,
+			Spec: &shared.EndpointSpec{
+				Summary:      "verifies a Merkle proof of an account",
+				RequestBody:  VerifyProofRequest{},
+				ResponseData: shared.ResponseFields{"ok": false},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyProofEndpoint, facade),
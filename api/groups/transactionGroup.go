@@ -22,23 +22,35 @@ import (
 )
 
 const (
-	sendTransactionEndpoint          = "/transaction/send"
-	simulateTransactionEndpoint      = "/transaction/simulate"
-	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
-	getTransactionEndpoint           = "/transaction/:hash"
-	sendTransactionPath              = "/send"
-	simulateTransactionPath          = "/simulate"
-	costPath                         = "/cost"
-	sendMultiplePath                 = "/send-multiple"
-	getTransactionPath               = "/:txhash"
-	getTransactionsPool              = "/pool"
-
-	queryParamWithResults    = "withResults"
-	queryParamCheckSignature = "checkSignature"
-	queryParamSender         = "by-sender"
-	queryParamFields         = "fields"
-	queryParamLastNonce      = "last-nonce"
-	queryParamNonceGaps      = "nonce-gaps"
+	sendTransactionEndpoint           = "/transaction/send"
+	simulateTransactionEndpoint       = "/transaction/simulate"
+	simulateTransactionsBatchEndpoint = "/transaction/simulate-batch"
+	sendMultipleTransactionsEndpoint  = "/transaction/send-multiple"
+	getTransactionEndpoint            = "/transaction/:hash"
+	sendTransactionPath               = "/send"
+	simulateTransactionPath           = "/simulate"
+	simulateTransactionsBatchPath     = "/simulate-batch"
+	costPath                          = "/cost"
+	sendMultiplePath                  = "/send-multiple"
+	getTransactionPath                = "/:txhash"
+	getTransactionsPool               = "/pool"
+	getTransactionsPoolEvictions      = "/pool/evictions"
+	getTransactionPoolHistoryPath     = "/:txhash/pool-history"
+	getGasPriceSuggestionPath         = "/gas-price-suggestion"
+
+	queryParamWithResults      = "withResults"
+	queryParamWithStateChanges = "withStateChanges"
+	queryParamCheckSignature   = "checkSignature"
+	queryParamSender           = "by-sender"
+	queryParamFields           = "fields"
+	queryParamLastNonce        = "last-nonce"
+	queryParamNonceGaps        = "nonce-gaps"
+	queryParamLimit            = "limit"
+	queryParamBlocks           = "blocks"
+
+	defaultPoolEvictionsLimit = 100
+	maxPoolEvictionsLimit     = 1000
+	maxSimulationBatchSize    = 50
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
@@ -49,11 +61,17 @@ type transactionFacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBatchExecution(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error)
+	GetTransactionFinality(tx *transaction.ApiTransactionResult) string
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error)
+	GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -82,6 +100,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    sendTransactionPath,
 			Method:  http.MethodPost,
 			Handler: tg.sendTransaction,
+			Spec: &shared.EndpointSpec{
+				Summary:      "sends a signed transaction",
+				RequestBody:  SendTxRequest{},
+				ResponseData: shared.ResponseFields{"txHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendTransactionEndpoint, facade),
@@ -93,6 +116,12 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    simulateTransactionPath,
 			Method:  http.MethodPost,
 			Handler: tg.simulateTransaction,
+			Spec: &shared.EndpointSpec{
+				Summary:         "simulates the execution of a transaction",
+				QueryParameters: []string{queryParamCheckSignature},
+				RequestBody:     SendTxRequest{},
+				ResponseData:    shared.ResponseFields{"result": txSimData.SimulationResults{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateTransactionEndpoint, facade),
@@ -100,15 +129,41 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    simulateTransactionsBatchPath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateTransactionsBatch,
+			Spec: &shared.EndpointSpec{
+				Summary:         "simulates, on a shared state, the execution of an ordered list of transactions",
+				QueryParameters: []string{queryParamCheckSignature},
+				RequestBody:     []SendTxRequest{},
+				ResponseData:    shared.ResponseFields{"results": []*txSimData.SimulationResults{}},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateTransactionsBatchEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
 			Handler: tg.computeTransactionGasLimit,
+			Spec: &shared.EndpointSpec{
+				Summary:     "returns the gas units consumed by a transaction",
+				RequestBody: SendTxRequest{},
+			},
 		},
 		{
 			Path:    getTransactionsPool,
 			Method:  http.MethodGet,
 			Handler: tg.getTransactionsPool,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the transactions in pool, or the pool nonce information of a sender",
+				QueryParameters: []string{queryParamSender, queryParamFields, queryParamLastNonce, queryParamNonceGaps},
+				ResponseData:    shared.ResponseFields{"txPool": nil, "nonce": uint64(0), "nonceGaps": common.TransactionsPoolNonceGapsForSenderApiResponse{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionPath, facade),
@@ -116,10 +171,41 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getTransactionsPoolEvictions,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolEvictions,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns, newest first, the transactions evicted from the pool",
+				QueryParameters: []string{queryParamLimit},
+				ResponseData:    shared.ResponseFields{"evictions": common.TransactionsPoolEvictionsApiResponse{}},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionPath, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getGasPriceSuggestionPath,
+			Method:  http.MethodGet,
+			Handler: tg.getGasPriceSuggestion,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the gas price suggestion computed over the latest committed blocks",
+				QueryParameters: []string{queryParamBlocks},
+				ResponseData:    shared.ResponseFields{"suggestion": common.GasPriceSuggestionApiResponse{}},
+			},
+		},
 		{
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
 			Handler: tg.sendMultipleTransactions,
+			Spec: &shared.EndpointSpec{
+				Summary:      "sends a list of signed transactions",
+				RequestBody:  []SendTxRequest{},
+				ResponseData: shared.ResponseFields{"txsSent": uint64(0), "txsHashes": map[int]string{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendMultipleTransactionsEndpoint, facade),
@@ -131,6 +217,26 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    getTransactionPath,
 			Method:  http.MethodGet,
 			Handler: tg.getTransaction,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns a transaction",
+				QueryParameters: []string{queryParamWithResults, queryParamWithStateChanges},
+				ResponseData:    shared.ResponseFields{"transaction": transaction.ApiTransactionResult{}, "stateChanges": []*common.AccountStateChange{}},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getTransactionPoolHistoryPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionPoolHistory,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the pool history of a transaction",
+				ResponseData: shared.ResponseFields{"history": common.TransactionPoolHistoryApiResponse{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionEndpoint, facade),
@@ -285,6 +391,116 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateTransactionsBatch will receive an ordered list of transactions from the client and will simulate their
+// execution on a shared state, each transaction seeing the changes done by the previous ones
+func (tg *transactionGroup) simulateTransactionsBatch(c *gin.Context) {
+	var gtxs []SendTxRequest
+	err := c.ShouldBindJSON(&gtxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(gtxs) == 0 || len(gtxs) > maxSimulationBatchSize {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: maximum %d transactions are allowed", errors.ErrInvalidSimulationBatchSize.Error(), maxSimulationBatchSize),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(gtxs))
+	txsHashes := make([]string, 0, len(gtxs))
+	for idx, gtx := range gtxs {
+		start := time.Now()
+		tx, txHash, errCreate := tg.getFacade().CreateTransaction(
+			gtx.Nonce,
+			gtx.Value,
+			gtx.Receiver,
+			gtx.ReceiverUsername,
+			gtx.Sender,
+			gtx.SenderUsername,
+			gtx.GasPrice,
+			gtx.GasLimit,
+			gtx.Data,
+			gtx.Signature,
+			gtx.ChainID,
+			gtx.Version,
+			gtx.Options,
+		)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+		if errCreate == nil {
+			start = time.Now()
+			errCreate = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+			logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
+		}
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for transaction %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	start := time.Now()
+	executionResults, err := tg.getFacade().SimulateTransactionsBatchExecution(txs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionsBatchExecution")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, executionResult := range executionResults {
+		executionResult.Hash = txsHashes[idx]
+	}
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"results": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var gtx = SendTxRequest{}
@@ -482,6 +698,19 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
+	withStateChanges, err := getQueryParamWithStateChanges(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start := time.Now()
 	tx, err := tg.getFacade().GetTransaction(txhash, withResults)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransaction")
@@ -497,10 +726,32 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
+	txWithFinality := &common.ApiTransactionResultWithFinality{
+		ApiTransactionResult: tx,
+		Finality:             tg.getFacade().GetTransactionFinality(tx),
+	}
+	response := gin.H{"transaction": txWithFinality}
+	if withStateChanges {
+		stateChanges, errStateChanges := tg.getFacade().GetTransactionStateChanges(txhash)
+		if errStateChanges != nil {
+			c.JSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionStateChanges.Error(), errStateChanges.Error()),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		response["stateChanges"] = stateChanges
+	}
+
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"transaction": tx},
+			Data:  response,
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -749,6 +1000,131 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 	)
 }
 
+// getTransactionPoolHistory returns the recorded pool removals and rejections for a given txhash
+func (tg *transactionGroup) getTransactionPoolHistory(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	_, err := hex.DecodeString(txhash)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetPoolHistory, fmt.Errorf("%w: %v", errors.ErrValidationInvalidTxHash, err))
+		return
+	}
+
+	start := time.Now()
+	history, err := tg.getFacade().GetTransactionPoolHistory(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionPoolHistory")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetPoolHistory.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"history": history},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolEvictions returns the latest transactions evicted from (or rejected by) the pool
+func (tg *transactionGroup) getTransactionsPoolEvictions(c *gin.Context) {
+	limit, err := getQueryParameterLimit(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	evictions, err := tg.getFacade().GetTransactionsPoolEvictions(limit)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolEvictions")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetPoolEvictions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"evictions": evictions},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getGasPriceSuggestion returns the gas price suggestion computed over the latest committed blocks
+func (tg *transactionGroup) getGasPriceSuggestion(c *gin.Context) {
+	numBlocks, err := getQueryParameterBlocks(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	suggestion, err := tg.getFacade().GetGasPriceSuggestion(numBlocks)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetGasPriceSuggestion")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGasPriceSuggestion.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"suggestion": suggestion},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
 	if fields != "" && lastNonce {
 		return errors.ErrFetchingLatestNonceCannotIncludeFields
@@ -798,6 +1174,15 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamWithStateChanges(c *gin.Context) (bool, error) {
+	withStateChangesStr := c.Request.URL.Query().Get(queryParamWithStateChanges)
+	if withStateChangesStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withStateChangesStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
@@ -835,6 +1220,36 @@ func getQueryParameterNonceGaps(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(nonceGapsStr)
 }
 
+func getQueryParameterLimit(c *gin.Context) (int, error) {
+	limitStr := c.Request.URL.Query().Get(queryParamLimit)
+	if limitStr == "" {
+		return defaultPoolEvictionsLimit, nil
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 || limit > maxPoolEvictionsLimit {
+		return 0, errors.ErrInvalidLimit
+	}
+
+	return limit, nil
+}
+
+// getQueryParameterBlocks returns the number of blocks to compute the gas price suggestion over. A missing
+// parameter selects the whole configured window
+func getQueryParameterBlocks(c *gin.Context) (int, error) {
+	blocksStr := c.Request.URL.Query().Get(queryParamBlocks)
+	if blocksStr == "" {
+		return 0, nil
+	}
+
+	numBlocks, err := strconv.Atoi(blocksStr)
+	if err != nil || numBlocks <= 0 {
+		return 0, errors.ErrInvalidBlocksParameter
+	}
+
+	return numBlocks, nil
+}
+
 func (tg *transactionGroup) getFacade() transactionFacadeHandler {
 	tg.mutFacade.RLock()
 	defer tg.mutFacade.RUnlock()
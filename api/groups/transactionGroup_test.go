@@ -42,7 +42,8 @@ func TestNewTransactionGroup(t *testing.T) {
 }
 
 type transactionResponseData struct {
-	TxResp *groups.TxResponse `json:"transaction,omitempty"`
+	TxResp       *groups.TxResponse           `json:"transaction,omitempty"`
+	StateChanges []*common.AccountStateChange `json:"stateChanges,omitempty"`
 }
 
 type transactionResponse struct {
@@ -122,6 +123,36 @@ type txPoolNonceGapsForSenderResponseData struct {
 	NonceGaps common.TransactionsPoolNonceGapsForSenderApiResponse `json:"nonceGaps"`
 }
 
+type txPoolHistoryResponseData struct {
+	History common.TransactionPoolHistoryApiResponse `json:"history"`
+}
+
+type txPoolHistoryResponse struct {
+	Data  txPoolHistoryResponseData `json:"data"`
+	Error string                    `json:"error"`
+	Code  string                    `json:"code"`
+}
+
+type txPoolEvictionsResponseData struct {
+	Evictions common.TransactionsPoolEvictionsApiResponse `json:"evictions"`
+}
+
+type txPoolEvictionsResponse struct {
+	Data  txPoolEvictionsResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+type gasPriceSuggestionResponseData struct {
+	Suggestion common.GasPriceSuggestionApiResponse `json:"suggestion"`
+}
+
+type gasPriceSuggestionResponse struct {
+	Data  gasPriceSuggestionResponseData `json:"data"`
+	Error string                         `json:"error"`
+	Code  string                         `json:"code"`
+}
+
 type txPoolNonceGapsForSenderResponse struct {
 	Data  txPoolNonceGapsForSenderResponseData `json:"data"`
 	Error string                               `json:"error"`
@@ -165,6 +196,46 @@ func TestGetTransaction_WithCorrectHashShouldReturnTransaction(t *testing.T) {
 	assert.Equal(t, txData, txResp.Data)
 }
 
+func TestGetTransaction_ShouldReturnTheTransactionFinality(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	expectedTx := &dataTx.ApiTransactionResult{
+		Sender:                            "sender",
+		NotarizedAtSourceInMetaNonce:      10,
+		NotarizedAtDestinationInMetaNonce: 12,
+	}
+	facade := mock.FacadeStub{
+		GetTransactionHandler: func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+			return expectedTx, nil
+		},
+		GetTransactionFinalityCalled: func(tx *dataTx.ApiTransactionResult) string {
+			require.Equal(t, expectedTx, tx)
+			return "final-at-source"
+		},
+	}
+	transactionGroup, _ := groups.NewTransactionGroup(&facade)
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/"+hash, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Transaction struct {
+				Sender   string `json:"sender"`
+				Finality string `json:"finality"`
+			} `json:"transaction"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, "sender", response.Data.Transaction.Sender)
+	require.Equal(t, "final-at-source", response.Data.Transaction.Finality)
+}
+
 func TestGetTransaction_WithUnknownHashShouldReturnNil(t *testing.T) {
 	sender := "sender"
 	receiver := "receiver"
@@ -201,6 +272,85 @@ func TestGetTransaction_WithUnknownHashShouldReturnNil(t *testing.T) {
 	assert.Empty(t, txResp.Data)
 }
 
+func TestGetTransaction_WithStateChanges(t *testing.T) {
+	t.Parallel()
+
+	hash := "hash"
+	expectedStateChanges := []*common.AccountStateChange{
+		{
+			Address:       "address",
+			BalanceBefore: "10",
+			BalanceAfter:  "5",
+			NonceBefore:   1,
+			NonceAfter:    2,
+		},
+	}
+
+	t.Run("invalid query param should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{}
+		transactionGroup, _ := groups.NewTransactionGroup(&facade)
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"?withStateChanges=not-a-bool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+				return &dataTx.ApiTransactionResult{}, nil
+			},
+			GetTransactionStateChangesCalled: func(txHash string) ([]*common.AccountStateChange, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionGroup, _ := groups.NewTransactionGroup(&facade)
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"?withStateChanges=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionStateChanges.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+				return &dataTx.ApiTransactionResult{Sender: "sender"}, nil
+			},
+			GetTransactionStateChangesCalled: func(txHash string) ([]*common.AccountStateChange, error) {
+				assert.Equal(t, hash, txHash)
+				return expectedStateChanges, nil
+			},
+		}
+		transactionGroup, _ := groups.NewTransactionGroup(&facade)
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"?withStateChanges=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "sender", response.Data.TxResp.Sender)
+		assert.Equal(t, expectedStateChanges, response.Data.StateChanges)
+	})
+}
+
 func TestGetTransaction_ErrorWithExceededNumGoRoutines(t *testing.T) {
 	t.Parallel()
 
@@ -864,6 +1014,117 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransactionsBatch_InvalidBatchSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, apiErrors.ErrInvalidSimulationBatchSize.Error())
+}
+
+func TestSimulateTransactionsBatch_ValidationErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			if tx.Nonce == 1 {
+				return expectedErr
+			}
+			return nil
+		},
+		SimulateTransactionsBatchExecutionCalled: func(txs []*dataTx.Transaction) ([]*txSimData.SimulationResults, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 0}, {Nonce: 1}})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, "transaction 1")
+	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+}
+
+func TestSimulateTransactionsBatch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce}, []byte{byte(nonce)}, nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+		SimulateTransactionsBatchExecutionCalled: func(txs []*dataTx.Transaction) ([]*txSimData.SimulationResults, error) {
+			results := make([]*txSimData.SimulationResults, 0, len(txs))
+			for _, tx := range txs {
+				results = append(results, &txSimData.SimulationResults{
+					Status:  "success",
+					GasUsed: 50000 + tx.Nonce,
+				})
+			}
+			return results, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 1}, {Nonce: 2}})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type simulateBatchResponse struct {
+		Data struct {
+			Results []*txSimData.SimulationResults `json:"results"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+	simulateResponse := simulateBatchResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 2, len(simulateResponse.Data.Results))
+	assert.Equal(t, "01", simulateResponse.Data.Results[0].Hash)
+	assert.Equal(t, uint64(50001), simulateResponse.Data.Results[0].GasUsed)
+	assert.Equal(t, "02", simulateResponse.Data.Results[1].Hash)
+	assert.Equal(t, uint64(50002), simulateResponse.Data.Results[1].GasUsed)
+}
+
 func TestGetTransactionsPoolShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -1166,6 +1427,281 @@ func testTxPoolWithInvalidQuery(query string, expectedErr error) func(t *testing
 	}
 }
 
+func TestGetTransactionPoolHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hex hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTransactionPoolHistoryCalled: func(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+				assert.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/not-hex/pool-history", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		historyResp := generalResponse{}
+		loadResponse(resp.Body, &historyResp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(historyResp.Error, apiErrors.ErrValidationInvalidTxHash.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTransactionPoolHistoryCalled: func(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aaaa/pool-history", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		historyResp := generalResponse{}
+		loadResponse(resp.Body, &historyResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(historyResp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedHistory := &common.TransactionPoolHistoryApiResponse{
+			TxHash: "aaaa",
+			Entries: []common.PoolJournalEntryApiResponse{
+				{
+					TxHash:   "aaaa",
+					Sender:   "erd1alice",
+					Nonce:    7,
+					CacheID:  "0",
+					Reason:   "evicted",
+					PoolSize: 100,
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetTransactionPoolHistoryCalled: func(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+				assert.Equal(t, "aaaa", txHash)
+				return expectedHistory, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aaaa/pool-history", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		historyResp := txPoolHistoryResponse{}
+		loadResponse(resp.Body, &historyResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, historyResp.Error)
+		assert.Equal(t, *expectedHistory, historyResp.Data.History)
+	})
+}
+
+func TestGetTransactionsPoolEvictions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		for _, limit := range []string{"abc", "0", "-1", "1001"} {
+			req, _ := http.NewRequest("GET", "/transaction/pool/evictions?limit="+limit, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			evictionsResp := generalResponse{}
+			loadResponse(resp.Body, &evictionsResp)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(evictionsResp.Error, apiErrors.ErrInvalidLimit.Error()))
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTransactionsPoolEvictionsCalled: func(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/evictions", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		evictionsResp := generalResponse{}
+		loadResponse(resp.Body, &evictionsResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(evictionsResp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvictions := &common.TransactionsPoolEvictionsApiResponse{
+			Evictions: []common.PoolJournalEntryApiResponse{
+				{
+					TxHash:  "aaaa",
+					Reason:  "rejected",
+					Details: "insufficient balance",
+				},
+			},
+		}
+		providedLimits := make([]int, 0)
+		facade := mock.FacadeStub{
+			GetTransactionsPoolEvictionsCalled: func(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+				providedLimits = append(providedLimits, maxNumEvictions)
+				return expectedEvictions, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		for _, url := range []string{"/transaction/pool/evictions", "/transaction/pool/evictions?limit=10"} {
+			req, _ := http.NewRequest("GET", url, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			evictionsResp := txPoolEvictionsResponse{}
+			loadResponse(resp.Body, &evictionsResp)
+
+			assert.Equal(t, http.StatusOK, resp.Code)
+			assert.Empty(t, evictionsResp.Error)
+			assert.Equal(t, *expectedEvictions, evictionsResp.Data.Evictions)
+		}
+		assert.Equal(t, []int{100, 10}, providedLimits)
+	})
+}
+
+func TestGetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		for _, blocks := range []string{"abc", "0", "-1"} {
+			req, _ := http.NewRequest("GET", "/transaction/gas-price-suggestion?blocks="+blocks, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			suggestionResp := generalResponse{}
+			loadResponse(resp.Body, &suggestionResp)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(suggestionResp.Error, apiErrors.ErrInvalidBlocksParameter.Error()))
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGasPriceSuggestionCalled: func(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/gas-price-suggestion", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		suggestionResp := generalResponse{}
+		loadResponse(resp.Body, &suggestionResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(suggestionResp.Error, apiErrors.ErrGetGasPriceSuggestion.Error()))
+		assert.True(t, strings.Contains(suggestionResp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSuggestion := &common.GasPriceSuggestionApiResponse{
+			ShardID:              1,
+			NumBlocks:            10,
+			LastBlockNonce:       37,
+			NumTransactions:      120,
+			MinGasPrice:          1000000000,
+			SuggestedGasPrice:    1500000000,
+			GasPricePercentiles:  map[string]uint64{"50": 1000000000, "75": 1500000000},
+			AverageBlockFullness: 85.5,
+			LastBlockFullness:    90,
+		}
+		providedNumBlocks := make([]int, 0)
+		facade := mock.FacadeStub{
+			GetGasPriceSuggestionCalled: func(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+				providedNumBlocks = append(providedNumBlocks, numBlocks)
+				return expectedSuggestion, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		for _, url := range []string{"/transaction/gas-price-suggestion", "/transaction/gas-price-suggestion?blocks=10"} {
+			req, _ := http.NewRequest("GET", url, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			suggestionResp := gasPriceSuggestionResponse{}
+			loadResponse(resp.Body, &suggestionResp)
+
+			assert.Equal(t, http.StatusOK, resp.Code)
+			assert.Empty(t, suggestionResp.Error)
+			assert.Equal(t, *expectedSuggestion, suggestionResp.Data.Suggestion)
+		}
+		assert.Equal(t, []int{0, 10}, providedNumBlocks)
+	})
+}
+
 func getTransactionRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -1175,9 +1711,13 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/send-multiple", Open: true},
 					{Name: "/cost", Open: true},
 					{Name: "/pool", Open: true},
+					{Name: "/pool/evictions", Open: true},
+					{Name: "/gas-price-suggestion", Open: true},
+					{Name: "/:txhash/pool-history", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-batch", Open: true},
 				},
 			},
 		},
@@ -8,15 +8,27 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath     = "/statistics"
+	equivocationsPath  = "/equivocations"
+	schedulePath       = "/schedule"
+	leaderSchedulePath = "/schedule/:publicKey"
+
+	queryParamPublicKey = "publicKey"
+	publicKeyPathParam  = "publicKey"
+)
 
 // validatorFacadeHandler defines the methods to be implemented by a facade for validator requests
 type validatorFacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetEquivocations(publicKey string) ([]*common.EquivocationEvidence, error)
+	GetConsensusSchedule(startRound int64, endRound int64) (*common.ConsensusSchedule, error)
+	GetValidatorLeaderSchedule(publicKey string) (*common.ValidatorLeaderSchedule, error)
 	IsInterfaceNil() bool
 }
 
@@ -42,6 +54,39 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Path:    statisticsPath,
 			Method:  http.MethodGet,
 			Handler: ng.statistics,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the statistics of the validators, by public key",
+				ResponseData: shared.ResponseFields{"statistics": map[string]*state.ValidatorApiResponse{}},
+			},
+		},
+		{
+			Path:    equivocationsPath,
+			Method:  http.MethodGet,
+			Handler: ng.equivocations,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the equivocation evidences recorded by the node, optionally filtered by the validator's public key",
+				QueryParameters: []string{queryParamPublicKey},
+				ResponseData:    shared.ResponseFields{"equivocations": []*common.EquivocationEvidence{}},
+			},
+		},
+		{
+			Path:    schedulePath,
+			Method:  http.MethodGet,
+			Handler: ng.schedule,
+			Spec: &shared.EndpointSpec{
+				Summary:         "returns the leader and the consensus group of each upcoming round within a range, for each shard, the other shards' ones being estimated from the received headers",
+				QueryParameters: []string{startRoundQueryParam, endRoundQueryParam},
+				ResponseData:    shared.ResponseFields{"schedule": common.ConsensusSchedule{}},
+			},
+		},
+		{
+			Path:    leaderSchedulePath,
+			Method:  http.MethodGet,
+			Handler: ng.leaderSchedule,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the next knowable round in which a validator is the consensus leader, estimated from the received headers for the other shards",
+				ResponseData: shared.ResponseFields{"leaderSchedule": common.ValidatorLeaderSchedule{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
@@ -74,6 +119,67 @@ func (vg *validatorGroup) statistics(c *gin.Context) {
 	)
 }
 
+// equivocations will return the equivocation evidences recorded by the node
+func (vg *validatorGroup) equivocations(c *gin.Context) {
+	publicKey := c.Request.URL.Query().Get(queryParamPublicKey)
+	evidences, err := vg.getFacade().GetEquivocations(publicKey)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"equivocations": evidences},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// schedule will return the consensus groups of the upcoming rounds within the provided range, for each shard. Missing
+// ends of the range are chosen by the node
+func (vg *validatorGroup) schedule(c *gin.Context) {
+	startRound, err := parseRoundUrlParam(c, startRoundQueryParam, 0)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+	endRound, err := parseRoundUrlParam(c, endRoundQueryParam, 0)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	schedule, err := vg.getFacade().GetConsensusSchedule(startRound, endRound)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetConsensusSchedule, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"schedule": schedule})
+}
+
+// leaderSchedule will return the next knowable round in which the provided validator is the consensus leader
+func (vg *validatorGroup) leaderSchedule(c *gin.Context) {
+	publicKey := c.Param(publicKeyPathParam)
+	leaderSchedule, err := vg.getFacade().GetValidatorLeaderSchedule(publicKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetConsensusSchedule, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"leaderSchedule": leaderSchedule})
+}
+
 func (vg *validatorGroup) getFacade() validatorFacadeHandler {
 	vg.mutFacade.RLock()
 	defer vg.mutFacade.RUnlock()
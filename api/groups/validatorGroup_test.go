@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/stretchr/testify/assert"
@@ -106,12 +107,259 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+type validatorEquivocationsResponseData struct {
+	Equivocations []*common.EquivocationEvidence `json:"equivocations"`
+}
+
+type validatorEquivocationsResponse struct {
+	Data  validatorEquivocationsResponseData `json:"data"`
+	Error string                             `json:"error"`
+	Code  string                             `json:"code"`
+}
+
+func TestValidatorEquivocations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetEquivocationsCalled: func(publicKey string) ([]*common.EquivocationEvidence, error) {
+				return nil, errors.New("equivocation detection is disabled")
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/equivocations", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorEquivocationsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, "equivocation detection is disabled", response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		evidences := []*common.EquivocationEvidence{
+			{
+				Type:      "signature",
+				PublicKey: "abcd",
+				Round:     7,
+				First:     &common.EquivocationMessage{BlockHeaderHash: "aa"},
+				Second:    &common.EquivocationMessage{BlockHeaderHash: "bb"},
+			},
+		}
+		providedPublicKey := ""
+		facade := &mock.FacadeStub{
+			GetEquivocationsCalled: func(publicKey string) ([]*common.EquivocationEvidence, error) {
+				providedPublicKey = publicKey
+				return evidences, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/equivocations?publicKey=abcd", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorEquivocationsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "abcd", providedPublicKey)
+		assert.Equal(t, evidences, response.Data.Equivocations)
+	})
+}
+
+type validatorScheduleResponseData struct {
+	Schedule *common.ConsensusSchedule `json:"schedule"`
+}
+
+type validatorScheduleResponse struct {
+	Data  validatorScheduleResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string                        `json:"code"`
+}
+
+type validatorLeaderScheduleResponseData struct {
+	LeaderSchedule *common.ValidatorLeaderSchedule `json:"leaderSchedule"`
+}
+
+type validatorLeaderScheduleResponse struct {
+	Data  validatorLeaderScheduleResponseData `json:"data"`
+	Error string                              `json:"error"`
+	Code  string                              `json:"code"`
+}
+
+func TestValidatorSchedule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid round should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/schedule?startRound=invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorScheduleResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetConsensusScheduleCalled: func(startRound int64, endRound int64) (*common.ConsensusSchedule, error) {
+				return nil, errors.New("invalid rounds range")
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/schedule?startRound=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorScheduleResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetConsensusSchedule.Error())
+		assert.Contains(t, response.Error, "invalid rounds range")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		schedule := &common.ConsensusSchedule{
+			Epoch:        3,
+			CurrentRound: 100,
+			Shards: []*common.ShardConsensusSchedule{
+				{
+					ShardID:               0,
+					RandomnessSource:      "blockchain",
+					RandomnessSourceRound: 99,
+					Rounds: []*common.ConsensusScheduleRound{
+						{Round: 101, IsKnowable: true, Leader: "aa", ConsensusGroup: []string{"aa", "bb"}},
+						{Round: 102},
+					},
+				},
+			},
+		}
+		var providedStartRound, providedEndRound int64
+		facade := &mock.FacadeStub{
+			GetConsensusScheduleCalled: func(startRound int64, endRound int64) (*common.ConsensusSchedule, error) {
+				providedStartRound, providedEndRound = startRound, endRound
+				return schedule, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/schedule?endRound=102", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorScheduleResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, int64(0), providedStartRound)
+		assert.Equal(t, int64(102), providedEndRound)
+		assert.Equal(t, schedule, response.Data.Schedule)
+	})
+}
+
+func TestValidatorLeaderSchedule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetValidatorLeaderScheduleCalled: func(publicKey string) (*common.ValidatorLeaderSchedule, error) {
+				return nil, errors.New("validator is not eligible")
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/schedule/abcd", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorLeaderScheduleResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, "validator is not eligible")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		leaderSchedule := &common.ValidatorLeaderSchedule{
+			PublicKey:                        "abcd",
+			Epoch:                            3,
+			CurrentRound:                     100,
+			LastKnowableRound:                101,
+			NextLeaderRound:                  101,
+			ExpectedRoundsBetweenLeaderships: 400,
+		}
+		providedPublicKey := ""
+		facade := &mock.FacadeStub{
+			GetValidatorLeaderScheduleCalled: func(publicKey string) (*common.ValidatorLeaderSchedule, error) {
+				providedPublicKey = publicKey
+				return leaderSchedule, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/schedule/abcd", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorLeaderScheduleResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "abcd", providedPublicKey)
+		assert.Equal(t, leaderSchedule, response.Data.LeaderSchedule)
+	})
+}
+
 func getValidatorRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
 			"validator": {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/equivocations", Open: true},
+					{Name: "/schedule", Open: true},
+					{Name: "/schedule/:publicKey", Open: true},
 				},
 			},
 		},
@@ -52,21 +52,41 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 			Path:    hexPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getHex,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the first value returned by a smart contract query, hex encoded",
+				RequestBody:  VMValueRequest{},
+				ResponseData: shared.ResponseFields{"data": ""},
+			},
 		},
 		{
 			Path:    stringPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getString,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the first value returned by a smart contract query, as string",
+				RequestBody:  VMValueRequest{},
+				ResponseData: shared.ResponseFields{"data": ""},
+			},
 		},
 		{
 			Path:    intPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getInt,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the first value returned by a smart contract query, as a base 10 integer",
+				RequestBody:  VMValueRequest{},
+				ResponseData: shared.ResponseFields{"data": ""},
+			},
 		},
 		{
 			Path:    queryPath,
 			Method:  http.MethodPost,
 			Handler: vvg.executeQuery,
+			Spec: &shared.EndpointSpec{
+				Summary:      "returns the output of a smart contract query",
+				RequestBody:  VMValueRequest{},
+				ResponseData: shared.ResponseFields{"data": vm.VMOutputApi{}},
+			},
 		},
 	}
 	vvg.endpoints = endpoints
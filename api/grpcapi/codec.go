@@ -0,0 +1,38 @@
+package grpcapi
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+const codecName = "proto"
+
+// gogoCodec marshals the gRPC messages with the gogo protobuf library, so that the elrond-go-core types can be
+// used directly in the requests and the responses
+type gogoCodec struct{}
+
+// Marshal returns the protobuf encoding of the provided message
+func (gc *gogoCodec) Marshal(v interface{}) ([]byte, error) {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNotAProtoMessage, v)
+	}
+
+	return proto.Marshal(message)
+}
+
+// Unmarshal decodes the provided bytes into the provided message
+func (gc *gogoCodec) Unmarshal(data []byte, v interface{}) error {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrNotAProtoMessage, v)
+	}
+
+	return proto.Unmarshal(data, message)
+}
+
+// Name returns the name of the codec, which is also the content subtype of the gRPC requests
+func (gc *gogoCodec) Name() string {
+	return codecName
+}
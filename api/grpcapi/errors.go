@@ -0,0 +1,18 @@
+package grpcapi
+
+import "errors"
+
+// ErrNotAProtoMessage signals that a value which is not a protobuf message was provided to the codec
+var ErrNotAProtoMessage = errors.New("value is not a protobuf message")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyInterface signals that an empty listening interface was provided
+var ErrEmptyInterface = errors.New("empty gRPC interface")
+
+// ErrUnexpectedBlockType signals that the facade returned a block of an unexpected type
+var ErrUnexpectedBlockType = errors.New("unexpected block type")
@@ -0,0 +1,8 @@
+package grpcapi
+
+import "net"
+
+// Serve -
+func (s *nodeApiServer) Serve(listener net.Listener) {
+	s.serve(listener)
+}
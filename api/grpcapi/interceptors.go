@@ -0,0 +1,131 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// routeGroups maps the methods of the NodeApi service to the route groups of the REST API exposing the same
+// operations, so that an API key reaches the same operations on both servers
+var routeGroups = map[string]string{
+	"GetAccount":       "address",
+	"GetKeyValuePairs": "address",
+	"GetAllESDTTokens": "address",
+	"GetShardBlock":    "block",
+	"GetMetaBlock":     "block",
+	"GetTransaction":   "transaction",
+	"SendTransactions": "transaction",
+	"QueryVmValue":     "vm-values",
+	"GetProof":         "proof",
+	"GetNetworkConfig": "network",
+}
+
+// interceptUnary throttles and authenticates the unary calls before handling them
+func (s *nodeApiServer) interceptUnary(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !s.throttler.StartProcessing(info.FullMethod) {
+		return nil, status.Error(codes.ResourceExhausted, middleware.ErrTooManyRequests.Error())
+	}
+	defer s.throttler.EndProcessing(info.FullMethod)
+
+	err := s.authenticateCall(ctx, info.FullMethod, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, request)
+}
+
+// interceptStream throttles the streams for their whole duration and authenticates them on their request message,
+// before it reaches the handler
+func (s *nodeApiServer) interceptStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !s.throttler.StartProcessing(info.FullMethod) {
+		return status.Error(codes.ResourceExhausted, middleware.ErrTooManyRequests.Error())
+	}
+	defer s.throttler.EndProcessing(info.FullMethod)
+
+	authenticatedStream := &authenticatedServerStream{
+		ServerStream: stream,
+		authenticate: func(request interface{}) error {
+			return s.authenticateCall(stream.Context(), info.FullMethod, request)
+		},
+	}
+
+	return handler(srv, authenticatedStream)
+}
+
+// authenticateCall authenticates a call by the API key, timestamp and signature metadata, the same way the REST API
+// authenticates a request by its headers. A signed call is signed as a POST request to the full method name, having
+// the protobuf encoding of the request message as body
+func (s *nodeApiServer) authenticateCall(ctx context.Context, fullMethod string, request interface{}) error {
+	if check.IfNil(s.authenticator) {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	apiRequest := middleware.ApiRequest{
+		Group:      routeGroups[path.Base(fullMethod)],
+		Method:     http.MethodPost,
+		RequestURI: fullMethod,
+		ApiKey:     getMetadataValue(md, middleware.ApiKeyHeader),
+		Timestamp:  getMetadataValue(md, middleware.ApiTimestampHeader),
+		Signature:  getMetadataValue(md, middleware.ApiSignatureHeader),
+		ReadBody: func() ([]byte, error) {
+			codec := &gogoCodec{}
+			return codec.Marshal(request)
+		},
+	}
+
+	err := s.authenticator.AuthenticateRequest(apiRequest)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, middleware.ErrRouteGroupNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, middleware.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+}
+
+func getMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// authenticatedServerStream authenticates the call when its request message is received
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	authenticate    func(request interface{}) error
+	isAuthenticated bool
+}
+
+// RecvMsg receives a message from the client, authenticating the call on the first message
+func (stream *authenticatedServerStream) RecvMsg(m interface{}) error {
+	err := stream.ServerStream.RecvMsg(m)
+	if err != nil || stream.isAuthenticated {
+		return err
+	}
+
+	err = stream.authenticate(m)
+	if err != nil {
+		return err
+	}
+
+	stream.isAuthenticated = true
+
+	return nil
+}
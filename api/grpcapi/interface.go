@@ -0,0 +1,16 @@
+package grpcapi
+
+import "github.com/ElrondNetwork/elrond-go/api/middleware"
+
+// requestAuthenticator defines the authenticator of the gRPC calls, shared with the REST API middleware
+type requestAuthenticator interface {
+	AuthenticateRequest(request middleware.ApiRequest) error
+	IsInterfaceNil() bool
+}
+
+// requestThrottler defines the limiter of the simultaneous gRPC calls, shared with the REST API middleware
+type requestThrottler interface {
+	StartProcessing(path string) bool
+	EndProcessing(path string)
+	IsInterfaceNil() bool
+}
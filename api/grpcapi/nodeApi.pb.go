@@ -0,0 +1,8748 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nodeApi.proto
+
+package grpcapi
+
+import (
+	bytes "bytes"
+	context "context"
+	fmt "fmt"
+	block "github.com/ElrondNetwork/elrond-go-core/data/block"
+	esdt "github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	transaction "github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OptionalUint32 holds a value which may be missing from a request
+type OptionalUint32 struct {
+	Value uint32 `protobuf:"varint,1,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *OptionalUint32) Reset()      { *m = OptionalUint32{} }
+func (*OptionalUint32) ProtoMessage() {}
+func (*OptionalUint32) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{0}
+}
+func (m *OptionalUint32) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionalUint32) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptionalUint32.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptionalUint32) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionalUint32.Merge(m, src)
+}
+func (m *OptionalUint32) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionalUint32) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionalUint32.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionalUint32 proto.InternalMessageInfo
+
+func (m *OptionalUint32) GetValue() uint32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// OptionalUint64 holds a value which may be missing from a request
+type OptionalUint64 struct {
+	Value uint64 `protobuf:"varint,1,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *OptionalUint64) Reset()      { *m = OptionalUint64{} }
+func (*OptionalUint64) ProtoMessage() {}
+func (*OptionalUint64) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{1}
+}
+func (m *OptionalUint64) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionalUint64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptionalUint64.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptionalUint64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionalUint64.Merge(m, src)
+}
+func (m *OptionalUint64) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionalUint64) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionalUint64.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionalUint64 proto.InternalMessageInfo
+
+func (m *OptionalUint64) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// AccountQueryOptions holds the coordinates of the block on which an account is queried
+type AccountQueryOptions struct {
+	OnFinalBlock   bool            `protobuf:"varint,1,opt,name=OnFinalBlock,proto3" json:"OnFinalBlock,omitempty"`
+	OnStartOfEpoch *OptionalUint32 `protobuf:"bytes,2,opt,name=OnStartOfEpoch,proto3" json:"OnStartOfEpoch,omitempty"`
+	BlockNonce     *OptionalUint64 `protobuf:"bytes,3,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash      []byte          `protobuf:"bytes,4,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	BlockRootHash  []byte          `protobuf:"bytes,5,opt,name=BlockRootHash,proto3" json:"BlockRootHash,omitempty"`
+	HintEpoch      *OptionalUint32 `protobuf:"bytes,6,opt,name=HintEpoch,proto3" json:"HintEpoch,omitempty"`
+}
+
+func (m *AccountQueryOptions) Reset()      { *m = AccountQueryOptions{} }
+func (*AccountQueryOptions) ProtoMessage() {}
+func (*AccountQueryOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{2}
+}
+func (m *AccountQueryOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountQueryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountQueryOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountQueryOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountQueryOptions.Merge(m, src)
+}
+func (m *AccountQueryOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountQueryOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountQueryOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountQueryOptions proto.InternalMessageInfo
+
+func (m *AccountQueryOptions) GetOnFinalBlock() bool {
+	if m != nil {
+		return m.OnFinalBlock
+	}
+	return false
+}
+
+func (m *AccountQueryOptions) GetOnStartOfEpoch() *OptionalUint32 {
+	if m != nil {
+		return m.OnStartOfEpoch
+	}
+	return nil
+}
+
+func (m *AccountQueryOptions) GetBlockNonce() *OptionalUint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return nil
+}
+
+func (m *AccountQueryOptions) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountQueryOptions) GetBlockRootHash() []byte {
+	if m != nil {
+		return m.BlockRootHash
+	}
+	return nil
+}
+
+func (m *AccountQueryOptions) GetHintEpoch() *OptionalUint32 {
+	if m != nil {
+		return m.HintEpoch
+	}
+	return nil
+}
+
+// AccountRequest holds the bech32 address of the queried account
+type AccountRequest struct {
+	Address string               `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Options *AccountQueryOptions `protobuf:"bytes,2,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *AccountRequest) Reset()      { *m = AccountRequest{} }
+func (*AccountRequest) ProtoMessage() {}
+func (*AccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{3}
+}
+func (m *AccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountRequest.Merge(m, src)
+}
+func (m *AccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountRequest proto.InternalMessageInfo
+
+func (m *AccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountRequest) GetOptions() *AccountQueryOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// BlockInfo holds the coordinates of the block on which an account query was resolved
+type BlockInfo struct {
+	Nonce    uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Hash     string `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	RootHash string `protobuf:"bytes,3,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+}
+
+func (m *BlockInfo) Reset()      { *m = BlockInfo{} }
+func (*BlockInfo) ProtoMessage() {}
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{4}
+}
+func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfo.Merge(m, src)
+}
+func (m *BlockInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+
+func (m *BlockInfo) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockInfo) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+// AccountResponse holds the account data
+type AccountResponse struct {
+	Address         string     `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Nonce           uint64     `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Balance         string     `protobuf:"bytes,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Username        string     `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	Code            string     `protobuf:"bytes,5,opt,name=Code,proto3" json:"Code,omitempty"`
+	CodeHash        []byte     `protobuf:"bytes,6,opt,name=CodeHash,proto3" json:"CodeHash,omitempty"`
+	RootHash        []byte     `protobuf:"bytes,7,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	CodeMetadata    []byte     `protobuf:"bytes,8,opt,name=CodeMetadata,proto3" json:"CodeMetadata,omitempty"`
+	DeveloperReward string     `protobuf:"bytes,9,opt,name=DeveloperReward,proto3" json:"DeveloperReward,omitempty"`
+	OwnerAddress    string     `protobuf:"bytes,10,opt,name=OwnerAddress,proto3" json:"OwnerAddress,omitempty"`
+	BlockInfo       *BlockInfo `protobuf:"bytes,11,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *AccountResponse) Reset()      { *m = AccountResponse{} }
+func (*AccountResponse) ProtoMessage() {}
+func (*AccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{5}
+}
+func (m *AccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountResponse.Merge(m, src)
+}
+func (m *AccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountResponse proto.InternalMessageInfo
+
+func (m *AccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AccountResponse) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AccountResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AccountResponse) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *AccountResponse) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *AccountResponse) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *AccountResponse) GetCodeMetadata() []byte {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return nil
+}
+
+func (m *AccountResponse) GetDeveloperReward() string {
+	if m != nil {
+		return m.DeveloperReward
+	}
+	return ""
+}
+
+func (m *AccountResponse) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *AccountResponse) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// KeyValuePair holds a key and its value from the storage of an account
+type KeyValuePair struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *KeyValuePair) Reset()      { *m = KeyValuePair{} }
+func (*KeyValuePair) ProtoMessage() {}
+func (*KeyValuePair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{6}
+}
+func (m *KeyValuePair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyValuePair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyValuePair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyValuePair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValuePair.Merge(m, src)
+}
+func (m *KeyValuePair) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyValuePair) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValuePair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValuePair proto.InternalMessageInfo
+
+func (m *KeyValuePair) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *KeyValuePair) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// KeyValuePairsChunk holds a part of the key-value pairs of an account, in the data trie order
+type KeyValuePairsChunk struct {
+	Pairs     []*KeyValuePair `protobuf:"bytes,1,rep,name=Pairs,proto3" json:"Pairs,omitempty"`
+	BlockInfo *BlockInfo      `protobuf:"bytes,2,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *KeyValuePairsChunk) Reset()      { *m = KeyValuePairsChunk{} }
+func (*KeyValuePairsChunk) ProtoMessage() {}
+func (*KeyValuePairsChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{7}
+}
+func (m *KeyValuePairsChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyValuePairsChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyValuePairsChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyValuePairsChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValuePairsChunk.Merge(m, src)
+}
+func (m *KeyValuePairsChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyValuePairsChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValuePairsChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValuePairsChunk proto.InternalMessageInfo
+
+func (m *KeyValuePairsChunk) GetPairs() []*KeyValuePair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *KeyValuePairsChunk) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// ESDTToken holds an ESDT token of an account
+type ESDTToken struct {
+	Identifier string               `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Token      *esdt.ESDigitalToken `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (m *ESDTToken) Reset()      { *m = ESDTToken{} }
+func (*ESDTToken) ProtoMessage() {}
+func (*ESDTToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{8}
+}
+func (m *ESDTToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ESDTToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ESDTToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTToken.Merge(m, src)
+}
+func (m *ESDTToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTToken proto.InternalMessageInfo
+
+func (m *ESDTToken) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetToken() *esdt.ESDigitalToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+// ESDTTokensChunk holds a part of the ESDT tokens of an account, in the data trie order
+type ESDTTokensChunk struct {
+	Tokens    []*ESDTToken `protobuf:"bytes,1,rep,name=Tokens,proto3" json:"Tokens,omitempty"`
+	BlockInfo *BlockInfo   `protobuf:"bytes,2,opt,name=BlockInfo,proto3" json:"BlockInfo,omitempty"`
+}
+
+func (m *ESDTTokensChunk) Reset()      { *m = ESDTTokensChunk{} }
+func (*ESDTTokensChunk) ProtoMessage() {}
+func (*ESDTTokensChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{9}
+}
+func (m *ESDTTokensChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTTokensChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ESDTTokensChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ESDTTokensChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTTokensChunk.Merge(m, src)
+}
+func (m *ESDTTokensChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTTokensChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTTokensChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTTokensChunk proto.InternalMessageInfo
+
+func (m *ESDTTokensChunk) GetTokens() []*ESDTToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ESDTTokensChunk) GetBlockInfo() *BlockInfo {
+	if m != nil {
+		return m.BlockInfo
+	}
+	return nil
+}
+
+// BlockRequest holds the coordinates of a block. The hash, if set, takes precedence over the nonce
+type BlockRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Hash  string `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (m *BlockRequest) Reset()      { *m = BlockRequest{} }
+func (*BlockRequest) ProtoMessage() {}
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{10}
+}
+func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRequest.Merge(m, src)
+}
+func (m *BlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRequest proto.InternalMessageInfo
+
+func (m *BlockRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// ShardBlockResponse holds a shard block, either as a Header or as a HeaderV2
+type ShardBlockResponse struct {
+	Header   *block.Header   `protobuf:"bytes,1,opt,name=Header,proto3" json:"Header,omitempty"`
+	HeaderV2 *block.HeaderV2 `protobuf:"bytes,2,opt,name=HeaderV2,proto3" json:"HeaderV2,omitempty"`
+}
+
+func (m *ShardBlockResponse) Reset()      { *m = ShardBlockResponse{} }
+func (*ShardBlockResponse) ProtoMessage() {}
+func (*ShardBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{11}
+}
+func (m *ShardBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardBlockResponse.Merge(m, src)
+}
+func (m *ShardBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardBlockResponse proto.InternalMessageInfo
+
+func (m *ShardBlockResponse) GetHeader() *block.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ShardBlockResponse) GetHeaderV2() *block.HeaderV2 {
+	if m != nil {
+		return m.HeaderV2
+	}
+	return nil
+}
+
+// TransactionRequest holds the hash of the requested transaction
+type TransactionRequest struct {
+	Hash        string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	WithResults bool   `protobuf:"varint,2,opt,name=WithResults,proto3" json:"WithResults,omitempty"`
+}
+
+func (m *TransactionRequest) Reset()      { *m = TransactionRequest{} }
+func (*TransactionRequest) ProtoMessage() {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{12}
+}
+func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionRequest.Merge(m, src)
+}
+func (m *TransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionRequest proto.InternalMessageInfo
+
+func (m *TransactionRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetWithResults() bool {
+	if m != nil {
+		return m.WithResults
+	}
+	return false
+}
+
+// TransactionResponse holds the transaction data and its processing status
+type TransactionResponse struct {
+	Hash                              string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Type                              string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Nonce                             uint64 `protobuf:"varint,3,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round                             uint64 `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch                             uint32 `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Value                             string `protobuf:"bytes,6,opt,name=Value,proto3" json:"Value,omitempty"`
+	Receiver                          string `protobuf:"bytes,7,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Sender                            string `protobuf:"bytes,8,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	GasPrice                          uint64 `protobuf:"varint,9,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	GasLimit                          uint64 `protobuf:"varint,10,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	Data                              []byte `protobuf:"bytes,11,opt,name=Data,proto3" json:"Data,omitempty"`
+	Signature                         string `protobuf:"bytes,12,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	SourceShard                       uint32 `protobuf:"varint,13,opt,name=SourceShard,proto3" json:"SourceShard,omitempty"`
+	DestinationShard                  uint32 `protobuf:"varint,14,opt,name=DestinationShard,proto3" json:"DestinationShard,omitempty"`
+	BlockNonce                        uint64 `protobuf:"varint,15,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash                         string `protobuf:"bytes,16,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	MiniBlockType                     string `protobuf:"bytes,17,opt,name=MiniBlockType,proto3" json:"MiniBlockType,omitempty"`
+	MiniBlockHash                     string `protobuf:"bytes,18,opt,name=MiniBlockHash,proto3" json:"MiniBlockHash,omitempty"`
+	NotarizedAtSourceInMetaNonce      uint64 `protobuf:"varint,19,opt,name=NotarizedAtSourceInMetaNonce,proto3" json:"NotarizedAtSourceInMetaNonce,omitempty"`
+	NotarizedAtDestinationInMetaNonce uint64 `protobuf:"varint,20,opt,name=NotarizedAtDestinationInMetaNonce,proto3" json:"NotarizedAtDestinationInMetaNonce,omitempty"`
+	Timestamp                         int64  `protobuf:"varint,21,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Status                            string `protobuf:"bytes,22,opt,name=Status,proto3" json:"Status,omitempty"`
+	InitiallyPaidFee                  string `protobuf:"bytes,23,opt,name=InitiallyPaidFee,proto3" json:"InitiallyPaidFee,omitempty"`
+}
+
+func (m *TransactionResponse) Reset()      { *m = TransactionResponse{} }
+func (*TransactionResponse) ProtoMessage() {}
+func (*TransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{13}
+}
+func (m *TransactionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionResponse.Merge(m, src)
+}
+func (m *TransactionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionResponse proto.InternalMessageInfo
+
+func (m *TransactionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TransactionResponse) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetSourceShard() uint32 {
+	if m != nil {
+		return m.SourceShard
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetDestinationShard() uint32 {
+	if m != nil {
+		return m.DestinationShard
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetMiniBlockType() string {
+	if m != nil {
+		return m.MiniBlockType
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetMiniBlockHash() string {
+	if m != nil {
+		return m.MiniBlockHash
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetNotarizedAtSourceInMetaNonce() uint64 {
+	if m != nil {
+		return m.NotarizedAtSourceInMetaNonce
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetNotarizedAtDestinationInMetaNonce() uint64 {
+	if m != nil {
+		return m.NotarizedAtDestinationInMetaNonce
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetInitiallyPaidFee() string {
+	if m != nil {
+		return m.InitiallyPaidFee
+	}
+	return ""
+}
+
+// SendTransactionsRequest holds the signed transactions to be sent
+type SendTransactionsRequest struct {
+	Transactions []*transaction.Transaction `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *SendTransactionsRequest) Reset()      { *m = SendTransactionsRequest{} }
+func (*SendTransactionsRequest) ProtoMessage() {}
+func (*SendTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{14}
+}
+func (m *SendTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendTransactionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionsRequest.Merge(m, src)
+}
+func (m *SendTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTransactionsRequest proto.InternalMessageInfo
+
+func (m *SendTransactionsRequest) GetTransactions() []*transaction.Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// SendTransactionsResponse holds the number of sent transactions and their hashes, in the order of the request
+type SendTransactionsResponse struct {
+	NumSent  uint64   `protobuf:"varint,1,opt,name=NumSent,proto3" json:"NumSent,omitempty"`
+	TxHashes [][]byte `protobuf:"bytes,2,rep,name=TxHashes,proto3" json:"TxHashes,omitempty"`
+}
+
+func (m *SendTransactionsResponse) Reset()      { *m = SendTransactionsResponse{} }
+func (*SendTransactionsResponse) ProtoMessage() {}
+func (*SendTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{15}
+}
+func (m *SendTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendTransactionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionsResponse.Merge(m, src)
+}
+func (m *SendTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTransactionsResponse proto.InternalMessageInfo
+
+func (m *SendTransactionsResponse) GetNumSent() uint64 {
+	if m != nil {
+		return m.NumSent
+	}
+	return 0
+}
+
+func (m *SendTransactionsResponse) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+// VmValueRequest holds a smart contract query
+type VmValueRequest struct {
+	ScAddress   string   `protobuf:"bytes,1,opt,name=ScAddress,proto3" json:"ScAddress,omitempty"`
+	FuncName    string   `protobuf:"bytes,2,opt,name=FuncName,proto3" json:"FuncName,omitempty"`
+	CallerAddr  string   `protobuf:"bytes,3,opt,name=CallerAddr,proto3" json:"CallerAddr,omitempty"`
+	CallValue   string   `protobuf:"bytes,4,opt,name=CallValue,proto3" json:"CallValue,omitempty"`
+	Args        [][]byte `protobuf:"bytes,5,rep,name=Args,proto3" json:"Args,omitempty"`
+	SameScState bool     `protobuf:"varint,6,opt,name=SameScState,proto3" json:"SameScState,omitempty"`
+}
+
+func (m *VmValueRequest) Reset()      { *m = VmValueRequest{} }
+func (*VmValueRequest) ProtoMessage() {}
+func (*VmValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{16}
+}
+func (m *VmValueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VmValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VmValueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VmValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VmValueRequest.Merge(m, src)
+}
+func (m *VmValueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VmValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VmValueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VmValueRequest proto.InternalMessageInfo
+
+func (m *VmValueRequest) GetScAddress() string {
+	if m != nil {
+		return m.ScAddress
+	}
+	return ""
+}
+
+func (m *VmValueRequest) GetFuncName() string {
+	if m != nil {
+		return m.FuncName
+	}
+	return ""
+}
+
+func (m *VmValueRequest) GetCallerAddr() string {
+	if m != nil {
+		return m.CallerAddr
+	}
+	return ""
+}
+
+func (m *VmValueRequest) GetCallValue() string {
+	if m != nil {
+		return m.CallValue
+	}
+	return ""
+}
+
+func (m *VmValueRequest) GetArgs() [][]byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *VmValueRequest) GetSameScState() bool {
+	if m != nil {
+		return m.SameScState
+	}
+	return false
+}
+
+// VmValueResponse holds the output of a smart contract query
+type VmValueResponse struct {
+	ReturnData    [][]byte `protobuf:"bytes,1,rep,name=ReturnData,proto3" json:"ReturnData,omitempty"`
+	ReturnCode    string   `protobuf:"bytes,2,opt,name=ReturnCode,proto3" json:"ReturnCode,omitempty"`
+	ReturnMessage string   `protobuf:"bytes,3,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+	GasRemaining  uint64   `protobuf:"varint,4,opt,name=GasRemaining,proto3" json:"GasRemaining,omitempty"`
+}
+
+func (m *VmValueResponse) Reset()      { *m = VmValueResponse{} }
+func (*VmValueResponse) ProtoMessage() {}
+func (*VmValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{17}
+}
+func (m *VmValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VmValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VmValueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VmValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VmValueResponse.Merge(m, src)
+}
+func (m *VmValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VmValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VmValueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VmValueResponse proto.InternalMessageInfo
+
+func (m *VmValueResponse) GetReturnData() [][]byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
+}
+
+func (m *VmValueResponse) GetReturnCode() string {
+	if m != nil {
+		return m.ReturnCode
+	}
+	return ""
+}
+
+func (m *VmValueResponse) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+func (m *VmValueResponse) GetGasRemaining() uint64 {
+	if m != nil {
+		return m.GasRemaining
+	}
+	return 0
+}
+
+// ProofRequest holds the hex encoded root hash and the bech32 address of the proven account
+type ProofRequest struct {
+	RootHash string `protobuf:"bytes,1,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+}
+
+func (m *ProofRequest) Reset()      { *m = ProofRequest{} }
+func (*ProofRequest) ProtoMessage() {}
+func (*ProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{18}
+}
+func (m *ProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofRequest.Merge(m, src)
+}
+func (m *ProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofRequest proto.InternalMessageInfo
+
+func (m *ProofRequest) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+func (m *ProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ProofResponse holds the Merkle proof of an account
+type ProofResponse struct {
+	Proof    [][]byte `protobuf:"bytes,1,rep,name=Proof,proto3" json:"Proof,omitempty"`
+	Value    []byte   `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	RootHash string   `protobuf:"bytes,3,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+}
+
+func (m *ProofResponse) Reset()      { *m = ProofResponse{} }
+func (*ProofResponse) ProtoMessage() {}
+func (*ProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{19}
+}
+func (m *ProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofResponse.Merge(m, src)
+}
+func (m *ProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofResponse proto.InternalMessageInfo
+
+func (m *ProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *ProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ProofResponse) GetRootHash() string {
+	if m != nil {
+		return m.RootHash
+	}
+	return ""
+}
+
+// NetworkConfigRequest is the empty request of the network config
+type NetworkConfigRequest struct {
+}
+
+func (m *NetworkConfigRequest) Reset()      { *m = NetworkConfigRequest{} }
+func (*NetworkConfigRequest) ProtoMessage() {}
+func (*NetworkConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{20}
+}
+func (m *NetworkConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkConfigRequest.Merge(m, src)
+}
+func (m *NetworkConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkConfigRequest proto.InternalMessageInfo
+
+// NetworkConfigResponse holds the network config metrics
+type NetworkConfigResponse struct {
+	Metrics map[string]string `protobuf:"bytes,1,rep,name=Metrics,proto3" json:"Metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *NetworkConfigResponse) Reset()      { *m = NetworkConfigResponse{} }
+func (*NetworkConfigResponse) ProtoMessage() {}
+func (*NetworkConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aeeda6fa2765447, []int{21}
+}
+func (m *NetworkConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkConfigResponse.Merge(m, src)
+}
+func (m *NetworkConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkConfigResponse proto.InternalMessageInfo
+
+func (m *NetworkConfigResponse) GetMetrics() map[string]string {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*OptionalUint32)(nil), "proto.OptionalUint32")
+	proto.RegisterType((*OptionalUint64)(nil), "proto.OptionalUint64")
+	proto.RegisterType((*AccountQueryOptions)(nil), "proto.AccountQueryOptions")
+	proto.RegisterType((*AccountRequest)(nil), "proto.AccountRequest")
+	proto.RegisterType((*BlockInfo)(nil), "proto.BlockInfo")
+	proto.RegisterType((*AccountResponse)(nil), "proto.AccountResponse")
+	proto.RegisterType((*KeyValuePair)(nil), "proto.KeyValuePair")
+	proto.RegisterType((*KeyValuePairsChunk)(nil), "proto.KeyValuePairsChunk")
+	proto.RegisterType((*ESDTToken)(nil), "proto.ESDTToken")
+	proto.RegisterType((*ESDTTokensChunk)(nil), "proto.ESDTTokensChunk")
+	proto.RegisterType((*BlockRequest)(nil), "proto.BlockRequest")
+	proto.RegisterType((*ShardBlockResponse)(nil), "proto.ShardBlockResponse")
+	proto.RegisterType((*TransactionRequest)(nil), "proto.TransactionRequest")
+	proto.RegisterType((*TransactionResponse)(nil), "proto.TransactionResponse")
+	proto.RegisterType((*SendTransactionsRequest)(nil), "proto.SendTransactionsRequest")
+	proto.RegisterType((*SendTransactionsResponse)(nil), "proto.SendTransactionsResponse")
+	proto.RegisterType((*VmValueRequest)(nil), "proto.VmValueRequest")
+	proto.RegisterType((*VmValueResponse)(nil), "proto.VmValueResponse")
+	proto.RegisterType((*ProofRequest)(nil), "proto.ProofRequest")
+	proto.RegisterType((*ProofResponse)(nil), "proto.ProofResponse")
+	proto.RegisterType((*NetworkConfigRequest)(nil), "proto.NetworkConfigRequest")
+	proto.RegisterType((*NetworkConfigResponse)(nil), "proto.NetworkConfigResponse")
+	proto.RegisterMapType((map[string]string)(nil), "proto.NetworkConfigResponse.MetricsEntry")
+}
+
+func init() { proto.RegisterFile("nodeApi.proto", fileDescriptor_6aeeda6fa2765447) }
+
+var fileDescriptor_6aeeda6fa2765447 = []byte{
+	// 1599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x73, 0x1b, 0xb9,
+	0x11, 0xd6, 0x50, 0xa2, 0x48, 0xb6, 0xa8, 0x47, 0xa0, 0x87, 0xc7, 0x8c, 0x6b, 0xa2, 0x4c, 0x25,
+	0x29, 0x39, 0x2e, 0x4b, 0x2e, 0xda, 0x56, 0xb9, 0x9c, 0x38, 0x89, 0x9e, 0xb4, 0x6c, 0xeb, 0x61,
+	0x50, 0x96, 0x53, 0x39, 0x05, 0x1a, 0x42, 0xd4, 0x94, 0x48, 0x0c, 0x33, 0x03, 0xda, 0x51, 0x4e,
+	0xf9, 0x09, 0x39, 0xa6, 0x2a, 0x7f, 0xc0, 0xa7, 0xfc, 0x84, 0x3d, 0xef, 0xd1, 0x47, 0x1f, 0xd7,
+	0x72, 0x6d, 0xd5, 0x1e, 0xfd, 0x0f, 0x76, 0x0b, 0x0d, 0xcc, 0x8b, 0xa6, 0xe4, 0xb5, 0x2f, 0x24,
+	0xfa, 0xc3, 0x87, 0x46, 0x77, 0x03, 0x8d, 0xee, 0x81, 0x49, 0x11, 0xb4, 0xf8, 0x5a, 0xcf, 0x5f,
+	0xee, 0x85, 0x81, 0x0c, 0x48, 0x11, 0xff, 0x6a, 0xb7, 0xdb, 0xbe, 0x3c, 0xed, 0x1f, 0x2f, 0x7b,
+	0x41, 0x77, 0xa5, 0x1d, 0xb4, 0x83, 0x15, 0x84, 0x8f, 0xfb, 0x27, 0x28, 0xa1, 0x80, 0x23, 0xbd,
+	0xaa, 0xf6, 0xa7, 0x0c, 0x7d, 0xab, 0x13, 0x06, 0xa2, 0xb5, 0xc7, 0xe5, 0xeb, 0x20, 0x3c, 0x5b,
+	0xe1, 0x28, 0xdd, 0x6e, 0x07, 0xb7, 0xbd, 0x20, 0xe4, 0x2b, 0x2d, 0x26, 0xd9, 0xca, 0x71, 0x27,
+	0xf0, 0xce, 0xf4, 0xaf, 0x59, 0xff, 0x97, 0xaf, 0x5d, 0x7f, 0x54, 0x37, 0x1a, 0xd6, 0xbf, 0x42,
+	0x43, 0x97, 0x4b, 0xb6, 0x9e, 0xb1, 0xe2, 0x8f, 0x5f, 0xa4, 0x83, 0x47, 0x2d, 0x89, 0x3f, 0x66,
+	0xf5, 0x93, 0x2f, 0x5a, 0x2d, 0x43, 0x26, 0x22, 0xe6, 0x49, 0x3f, 0x10, 0xd9, 0xb1, 0xd6, 0xe5,
+	0xfe, 0x0e, 0xa6, 0xf6, 0x7b, 0x4a, 0x66, 0x9d, 0x17, 0xbe, 0x90, 0x77, 0xeb, 0x64, 0x0e, 0x8a,
+	0x47, 0xac, 0xd3, 0xe7, 0xb6, 0xb5, 0x68, 0x2d, 0x4d, 0x52, 0x2d, 0x0c, 0xf2, 0x56, 0xef, 0xe5,
+	0x79, 0x63, 0x31, 0xef, 0x4d, 0x01, 0x66, 0xd7, 0x3c, 0x2f, 0xe8, 0x0b, 0xf9, 0xbc, 0xcf, 0xc3,
+	0x73, 0xbd, 0x28, 0x22, 0x2e, 0x54, 0xf7, 0xc5, 0xb6, 0x2f, 0x58, 0x07, 0xe3, 0x80, 0x8b, 0xca,
+	0x34, 0x87, 0x91, 0x47, 0x30, 0xb5, 0x2f, 0x9a, 0x92, 0x85, 0x72, 0xff, 0x64, 0xab, 0x17, 0x78,
+	0xa7, 0x76, 0x61, 0xd1, 0x5a, 0x9a, 0xa8, 0xcf, 0x6b, 0x5b, 0x97, 0xf3, 0x86, 0xd2, 0x01, 0x32,
+	0xb9, 0x0f, 0x80, 0x7a, 0xf6, 0x02, 0xe1, 0x71, 0x7b, 0xf4, 0xd2, 0xa5, 0xab, 0xf7, 0x68, 0x86,
+	0x48, 0x6e, 0x40, 0x05, 0xa5, 0xc7, 0x2c, 0x3a, 0xb5, 0xc7, 0x16, 0xad, 0xa5, 0x2a, 0x4d, 0x01,
+	0xf2, 0x1b, 0x98, 0x44, 0x81, 0x06, 0x81, 0x44, 0x46, 0x11, 0x19, 0x79, 0x90, 0xdc, 0x85, 0xca,
+	0x63, 0x5f, 0x48, 0x6d, 0xf4, 0xf8, 0x55, 0x46, 0xa7, 0x3c, 0xf7, 0xef, 0x30, 0x65, 0x22, 0x45,
+	0xf9, 0x3f, 0xfa, 0x3c, 0x92, 0xc4, 0x86, 0xd2, 0x5a, 0xab, 0x15, 0xf2, 0x28, 0xc2, 0xf8, 0x54,
+	0x68, 0x2c, 0x92, 0x7b, 0x50, 0x32, 0x91, 0x34, 0x31, 0xa9, 0x19, 0xf5, 0x43, 0x62, 0x4d, 0x63,
+	0xaa, 0xfb, 0xdc, 0xb8, 0xb6, 0x23, 0x4e, 0x02, 0x75, 0x5e, 0x3a, 0x32, 0xe6, 0xbc, 0xb4, 0xf7,
+	0x04, 0xc6, 0xd0, 0xad, 0x02, 0xee, 0x87, 0x63, 0x52, 0x83, 0x72, 0xe2, 0xee, 0x28, 0xe2, 0x89,
+	0xec, 0x7e, 0x5f, 0x80, 0xe9, 0xc4, 0xea, 0xa8, 0x17, 0x88, 0x88, 0x5f, 0x61, 0x76, 0xb2, 0x67,
+	0x21, 0xbb, 0xa7, 0x0d, 0xa5, 0x75, 0xd6, 0x61, 0xf1, 0x29, 0x55, 0x68, 0x2c, 0xaa, 0x9d, 0x5f,
+	0x44, 0x3c, 0x14, 0xac, 0xcb, 0xf1, 0x28, 0x2a, 0x34, 0x91, 0x95, 0xa5, 0x1b, 0x41, 0x8b, 0xe3,
+	0x01, 0x54, 0x28, 0x8e, 0x15, 0x5f, 0xfd, 0xa3, 0xa5, 0xe3, 0x78, 0x30, 0x89, 0x9c, 0xf3, 0xa2,
+	0xa4, 0xe7, 0x92, 0xf3, 0x72, 0xa1, 0xaa, 0x78, 0xbb, 0x5c, 0x32, 0x95, 0x23, 0x76, 0x19, 0xe7,
+	0x73, 0x18, 0x59, 0x82, 0xe9, 0x4d, 0xfe, 0x8a, 0x77, 0x82, 0x1e, 0x0f, 0x29, 0x7f, 0xcd, 0xc2,
+	0x96, 0x5d, 0xc1, 0xad, 0x07, 0x61, 0xbc, 0xdb, 0xaf, 0x05, 0x0f, 0xe3, 0x20, 0x00, 0xd2, 0x72,
+	0x18, 0x59, 0xce, 0x1c, 0x85, 0x3d, 0x81, 0x47, 0x38, 0x63, 0x8e, 0x30, 0xc1, 0x69, 0x4a, 0x71,
+	0x57, 0xa1, 0xfa, 0x94, 0x9f, 0x63, 0x4e, 0x1d, 0x30, 0x3f, 0x24, 0x33, 0x30, 0xfa, 0x94, 0x9f,
+	0x63, 0x7c, 0xab, 0x54, 0x0d, 0xd3, 0xfc, 0x2b, 0x20, 0x66, 0xf2, 0x2f, 0x00, 0x92, 0x5d, 0x17,
+	0x6d, 0x9c, 0xf6, 0xc5, 0x19, 0xb9, 0x09, 0x45, 0x94, 0x6c, 0x6b, 0x71, 0x74, 0x69, 0xa2, 0x3e,
+	0x6b, 0x76, 0xce, 0x32, 0xa9, 0x66, 0xe4, 0x0d, 0x2d, 0x7c, 0xde, 0xd0, 0xbf, 0x42, 0x65, 0xab,
+	0xb9, 0x79, 0x78, 0x18, 0x9c, 0x71, 0x41, 0x1c, 0x80, 0x9d, 0x16, 0x17, 0xd2, 0x3f, 0xf1, 0x79,
+	0x68, 0x2e, 0x43, 0x06, 0x21, 0xb7, 0xa0, 0x88, 0xc4, 0x81, 0xc4, 0xde, 0x6a, 0x6e, 0xfa, 0x6d,
+	0x5f, 0xb2, 0x0e, 0x4e, 0x52, 0xcd, 0x71, 0xcf, 0x60, 0x3a, 0xd1, 0x6c, 0xfc, 0x58, 0x82, 0x71,
+	0x2d, 0x1a, 0x47, 0x66, 0x52, 0x05, 0x9a, 0x47, 0xcd, 0xfc, 0x17, 0xbb, 0xf1, 0x00, 0xaa, 0x28,
+	0xc4, 0xa9, 0xf8, 0xb3, 0xb3, 0xc5, 0x3d, 0x05, 0xd2, 0x3c, 0x65, 0x61, 0xcb, 0x2c, 0x37, 0x39,
+	0xf1, 0x5b, 0x18, 0x7f, 0xcc, 0x59, 0xcb, 0x44, 0x61, 0xa2, 0x3e, 0x69, 0x36, 0xd7, 0x20, 0x35,
+	0x93, 0xe4, 0x16, 0x94, 0xf5, 0xe8, 0xa8, 0x6e, 0xac, 0x9c, 0xce, 0x11, 0x8f, 0xea, 0x34, 0x21,
+	0xb8, 0x4f, 0x80, 0x1c, 0xa6, 0x0f, 0x78, 0x6c, 0x69, 0x6c, 0x93, 0x95, 0xc9, 0xe0, 0x45, 0x98,
+	0x78, 0xe9, 0xcb, 0x53, 0xca, 0xa3, 0x7e, 0x47, 0xea, 0x27, 0xa3, 0x4c, 0xb3, 0x90, 0xfb, 0x63,
+	0x11, 0x66, 0x73, 0xca, 0x8c, 0xdd, 0xc3, 0xb4, 0x11, 0x18, 0x3b, 0x3c, 0xef, 0xf1, 0xd8, 0x6b,
+	0x35, 0x4e, 0xe3, 0x33, 0x9a, 0x8d, 0xcf, 0x1c, 0x14, 0x69, 0xd0, 0x17, 0x2d, 0x4c, 0xde, 0x31,
+	0xaa, 0x05, 0x85, 0xea, 0x97, 0xb1, 0xa8, 0x2b, 0x0a, 0x0a, 0xe9, 0xfd, 0x1d, 0x47, 0xb5, 0x5a,
+	0xc0, 0xac, 0xe5, 0x1e, 0xf7, 0x5f, 0xf1, 0xd0, 0x2e, 0x99, 0xb7, 0xc7, 0xc8, 0x64, 0x01, 0xc6,
+	0x9b, 0x5c, 0xa8, 0x98, 0x96, 0x71, 0xc6, 0x48, 0x6a, 0x4d, 0x83, 0x45, 0x07, 0xa1, 0xef, 0x71,
+	0x4c, 0xd1, 0x31, 0x9a, 0xc8, 0x66, 0xee, 0x99, 0xdf, 0xf5, 0xa5, 0x0d, 0xc9, 0x1c, 0xca, 0xca,
+	0xaf, 0x4d, 0x95, 0xfd, 0x13, 0x98, 0x40, 0x38, 0x56, 0xd5, 0xa0, 0xe9, 0xb7, 0x05, 0x93, 0xfd,
+	0x90, 0xdb, 0x55, 0xdc, 0x26, 0x05, 0x54, 0x5c, 0x9b, 0x41, 0x3f, 0xf4, 0x38, 0x9e, 0xb8, 0x3d,
+	0x89, 0xfe, 0x64, 0x21, 0xf2, 0x7b, 0x98, 0xd9, 0xe4, 0x91, 0xf4, 0x05, 0x53, 0x61, 0xd5, 0xb4,
+	0x29, 0xa4, 0x7d, 0x82, 0xab, 0x6c, 0xc9, 0x14, 0xac, 0x69, 0xb4, 0xee, 0xd2, 0xca, 0x34, 0xa3,
+	0x6d, 0xc9, 0x55, 0xa6, 0x5d, 0x5f, 0xf8, 0x08, 0xe0, 0xf1, 0xfc, 0x02, 0x19, 0x79, 0x30, 0xc7,
+	0x42, 0x3d, 0x64, 0x80, 0x85, 0xba, 0xd6, 0xe1, 0xc6, 0x5e, 0x20, 0x59, 0xe8, 0xff, 0x8b, 0xb7,
+	0xd6, 0xa4, 0xf6, 0x67, 0x47, 0xa8, 0xa7, 0x50, 0xdb, 0x36, 0x8b, 0xb6, 0x5d, 0xc9, 0x21, 0xcf,
+	0xe0, 0xd7, 0x99, 0xf9, 0x8c, 0xb3, 0x59, 0x45, 0x73, 0xa8, 0xe8, 0xf3, 0x44, 0xe5, 0xfb, 0xa1,
+	0xdf, 0xe5, 0x91, 0x64, 0xdd, 0x9e, 0x3d, 0xbf, 0x68, 0x2d, 0x8d, 0xd2, 0x14, 0xc0, 0x9b, 0x20,
+	0x99, 0xec, 0x47, 0xf6, 0x82, 0xb9, 0x09, 0x28, 0xa9, 0xe8, 0xef, 0x08, 0x5f, 0xfa, 0xac, 0xd3,
+	0x39, 0x3f, 0x60, 0x7e, 0x6b, 0x9b, 0x73, 0xfb, 0x1a, 0x32, 0x3e, 0xc1, 0xdd, 0xe7, 0x70, 0x4d,
+	0xdd, 0x9f, 0x4c, 0x12, 0x44, 0x71, 0x4a, 0xad, 0x42, 0x35, 0x0b, 0x9b, 0xc7, 0x86, 0x98, 0xcc,
+	0xcc, 0x4c, 0xd1, 0x1c, 0xcf, 0x3d, 0x00, 0xfb, 0x53, 0x95, 0x69, 0x91, 0xdc, 0xeb, 0x77, 0x9b,
+	0x5c, 0x48, 0xf3, 0xa4, 0xc4, 0xa2, 0xba, 0xa2, 0x87, 0xff, 0x54, 0xc7, 0xc0, 0x55, 0xa6, 0x8e,
+	0xaa, 0x42, 0x15, 0xcb, 0xee, 0x37, 0x16, 0x4c, 0x1d, 0x75, 0x31, 0x35, 0x62, 0xe3, 0xd4, 0x0d,
+	0xf5, 0xf2, 0xf5, 0x36, 0x05, 0x94, 0xb2, 0xed, 0xbe, 0xf0, 0xf6, 0x58, 0x37, 0xce, 0xd7, 0x44,
+	0x56, 0xf7, 0x6d, 0x83, 0x75, 0x3a, 0xba, 0x28, 0x99, 0xd2, 0x9b, 0x41, 0x94, 0x66, 0x25, 0xe9,
+	0xac, 0xd4, 0xe5, 0x37, 0x05, 0x54, 0xb6, 0xac, 0x85, 0xed, 0xc8, 0x2e, 0xa2, 0x89, 0x38, 0xc6,
+	0x7c, 0x60, 0x5d, 0xde, 0xf4, 0x54, 0xfc, 0x75, 0x26, 0x97, 0x69, 0x16, 0x72, 0xff, 0x67, 0xc1,
+	0x74, 0xe2, 0x80, 0x09, 0x85, 0x03, 0x40, 0xb9, 0xec, 0x87, 0x02, 0xb3, 0xcf, 0x42, 0x7d, 0x19,
+	0x24, 0x9d, 0xc7, 0x7a, 0xaf, 0xbd, 0xc8, 0x20, 0xea, 0x4e, 0x6b, 0x69, 0x97, 0x47, 0x11, 0x6b,
+	0xc7, 0x5d, 0x44, 0x1e, 0x54, 0x55, 0xb9, 0xc1, 0x22, 0xca, 0xbb, 0xcc, 0x17, 0xbe, 0x68, 0x9b,
+	0x27, 0x29, 0x87, 0xb9, 0x9b, 0x50, 0x3d, 0x08, 0x83, 0xe0, 0x24, 0x8e, 0x6d, 0xb6, 0x67, 0xb0,
+	0xf2, 0x9d, 0x4f, 0xb6, 0xcb, 0x29, 0xe4, 0xba, 0x1c, 0xf7, 0x25, 0x4c, 0x1a, 0x2d, 0xc6, 0xc1,
+	0x39, 0x28, 0x22, 0x60, 0x7c, 0xd3, 0xc2, 0xf0, 0x82, 0x7d, 0x65, 0xb3, 0xb5, 0x00, 0x73, 0xa6,
+	0xb3, 0xdf, 0x08, 0xc4, 0x89, 0xdf, 0x36, 0x66, 0xba, 0xff, 0xb5, 0x60, 0x7e, 0x60, 0xc2, 0xec,
+	0xbc, 0x01, 0xa5, 0x5d, 0x2e, 0x43, 0xdf, 0x8b, 0x2f, 0xed, 0x4d, 0x73, 0x69, 0x87, 0xd2, 0x97,
+	0x0d, 0x77, 0x4b, 0xc8, 0xf0, 0x9c, 0xc6, 0x2b, 0x6b, 0x0f, 0xa1, 0x9a, 0x9d, 0x50, 0xbd, 0xc7,
+	0x99, 0xe9, 0x3d, 0x2a, 0x54, 0x0d, 0x95, 0x2b, 0xaf, 0x12, 0x57, 0x2a, 0x54, 0x0b, 0x0f, 0x0b,
+	0x0f, 0xac, 0xfa, 0xff, 0x8b, 0x50, 0xda, 0xd3, 0xdf, 0x79, 0xe4, 0x0f, 0x00, 0x0d, 0x2e, 0x4d,
+	0xb7, 0x48, 0xe6, 0xf3, 0x1d, 0xab, 0xf1, 0xa5, 0xb6, 0x30, 0x08, 0x1b, 0x4f, 0xb6, 0x61, 0xa6,
+	0xc1, 0x65, 0xae, 0x97, 0xb9, 0x4c, 0xc5, 0xf5, 0x21, 0xed, 0x8c, 0x6e, 0x18, 0xee, 0x58, 0x64,
+	0x03, 0xf5, 0xac, 0x75, 0x3a, 0x69, 0x2f, 0xf1, 0x39, 0x53, 0x06, 0xba, 0x8e, 0x3b, 0x16, 0xf9,
+	0x33, 0x4c, 0x36, 0xb8, 0x4c, 0xcb, 0x3c, 0x99, 0xcd, 0xf6, 0x12, 0x83, 0x76, 0x0c, 0x69, 0x07,
+	0xee, 0x43, 0xb5, 0xc1, 0xe5, 0x6e, 0xfc, 0x19, 0x38, 0x7c, 0x7d, 0xdc, 0xa0, 0xa4, 0xb4, 0x06,
+	0x4c, 0x35, 0xb8, 0xcc, 0xbc, 0x27, 0xe4, 0xfa, 0x90, 0x47, 0xc8, 0x2c, 0xaf, 0x0d, 0x9b, 0x32,
+	0xfb, 0x37, 0x61, 0x66, 0xf0, 0x65, 0x22, 0x4e, 0x6c, 0xee, 0xf0, 0x57, 0xb0, 0xf6, 0xab, 0x4b,
+	0xe7, 0x8d, 0xd2, 0x47, 0x50, 0xc5, 0xef, 0x0e, 0x93, 0xdf, 0x49, 0x58, 0xf3, 0x0f, 0x56, 0x6d,
+	0x61, 0x10, 0x4e, 0x62, 0x52, 0x6e, 0x70, 0xa9, 0x73, 0x23, 0x8e, 0x47, 0x36, 0x1b, 0x6b, 0x73,
+	0x79, 0xd0, 0x2c, 0xdb, 0xc5, 0x03, 0xcd, 0xdd, 0x67, 0xf2, 0xcb, 0xe1, 0xb7, 0x5c, 0xab, 0xb9,
+	0x71, 0x55, 0x0a, 0xac, 0x3f, 0x7a, 0xfb, 0xde, 0x19, 0x79, 0xf7, 0xde, 0x19, 0xf9, 0xf8, 0xde,
+	0xb1, 0xfe, 0x7d, 0xe1, 0x58, 0x6f, 0x2e, 0x1c, 0xeb, 0xdb, 0x0b, 0xc7, 0x7a, 0x7b, 0xe1, 0x58,
+	0xdf, 0x5d, 0x38, 0xd6, 0x0f, 0x17, 0xce, 0xc8, 0xc7, 0x0b, 0xc7, 0xfa, 0xcf, 0x07, 0x67, 0xe4,
+	0xed, 0x07, 0x67, 0xe4, 0xdd, 0x07, 0x67, 0xe4, 0x6f, 0xa5, 0x76, 0xd8, 0xf3, 0x58, 0xcf, 0x3f,
+	0x1e, 0x47, 0xdd, 0x77, 0x7f, 0x1a, 0x00, 0xae, 0xba, 0xdc, 0xa7, 0xdd, 0x10, 0x00, 0x00,
+}
+
+func (this *OptionalUint32) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OptionalUint32)
+	if !ok {
+		that2, ok := that.(OptionalUint32)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *OptionalUint64) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OptionalUint64)
+	if !ok {
+		that2, ok := that.(OptionalUint64)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *AccountQueryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountQueryOptions)
+	if !ok {
+		that2, ok := that.(AccountQueryOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OnFinalBlock != that1.OnFinalBlock {
+		return false
+	}
+	if !this.OnStartOfEpoch.Equal(that1.OnStartOfEpoch) {
+		return false
+	}
+	if !this.BlockNonce.Equal(that1.BlockNonce) {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if !bytes.Equal(this.BlockRootHash, that1.BlockRootHash) {
+		return false
+	}
+	if !this.HintEpoch.Equal(that1.HintEpoch) {
+		return false
+	}
+	return true
+}
+func (this *AccountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountRequest)
+	if !ok {
+		that2, ok := that.(AccountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *BlockInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockInfo)
+	if !ok {
+		that2, ok := that.(BlockInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.RootHash != that1.RootHash {
+		return false
+	}
+	return true
+}
+func (this *AccountResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountResponse)
+	if !ok {
+		that2, ok := that.(AccountResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if !bytes.Equal(this.CodeMetadata, that1.CodeMetadata) {
+		return false
+	}
+	if this.DeveloperReward != that1.DeveloperReward {
+		return false
+	}
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *KeyValuePair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyValuePair)
+	if !ok {
+		that2, ok := that.(KeyValuePair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	return true
+}
+func (this *KeyValuePairsChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyValuePairsChunk)
+	if !ok {
+		that2, ok := that.(KeyValuePairsChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Pairs) != len(that1.Pairs) {
+		return false
+	}
+	for i := range this.Pairs {
+		if !this.Pairs[i].Equal(that1.Pairs[i]) {
+			return false
+		}
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *ESDTToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTToken)
+	if !ok {
+		that2, ok := that.(ESDTToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Identifier != that1.Identifier {
+		return false
+	}
+	if !this.Token.Equal(that1.Token) {
+		return false
+	}
+	return true
+}
+func (this *ESDTTokensChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTTokensChunk)
+	if !ok {
+		that2, ok := that.(ESDTTokensChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if !this.BlockInfo.Equal(that1.BlockInfo) {
+		return false
+	}
+	return true
+}
+func (this *BlockRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockRequest)
+	if !ok {
+		that2, ok := that.(BlockRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	return true
+}
+func (this *ShardBlockResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardBlockResponse)
+	if !ok {
+		that2, ok := that.(ShardBlockResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
+	if !this.HeaderV2.Equal(that1.HeaderV2) {
+		return false
+	}
+	return true
+}
+func (this *TransactionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionRequest)
+	if !ok {
+		that2, ok := that.(TransactionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.WithResults != that1.WithResults {
+		return false
+	}
+	return true
+}
+func (this *TransactionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionResponse)
+	if !ok {
+		that2, ok := that.(TransactionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	if this.SourceShard != that1.SourceShard {
+		return false
+	}
+	if this.DestinationShard != that1.DestinationShard {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.BlockHash != that1.BlockHash {
+		return false
+	}
+	if this.MiniBlockType != that1.MiniBlockType {
+		return false
+	}
+	if this.MiniBlockHash != that1.MiniBlockHash {
+		return false
+	}
+	if this.NotarizedAtSourceInMetaNonce != that1.NotarizedAtSourceInMetaNonce {
+		return false
+	}
+	if this.NotarizedAtDestinationInMetaNonce != that1.NotarizedAtDestinationInMetaNonce {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.InitiallyPaidFee != that1.InitiallyPaidFee {
+		return false
+	}
+	return true
+}
+func (this *SendTransactionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendTransactionsRequest)
+	if !ok {
+		that2, ok := that.(SendTransactionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SendTransactionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendTransactionsResponse)
+	if !ok {
+		that2, ok := that.(SendTransactionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumSent != that1.NumSent {
+		return false
+	}
+	if len(this.TxHashes) != len(that1.TxHashes) {
+		return false
+	}
+	for i := range this.TxHashes {
+		if !bytes.Equal(this.TxHashes[i], that1.TxHashes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VmValueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VmValueRequest)
+	if !ok {
+		that2, ok := that.(VmValueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScAddress != that1.ScAddress {
+		return false
+	}
+	if this.FuncName != that1.FuncName {
+		return false
+	}
+	if this.CallerAddr != that1.CallerAddr {
+		return false
+	}
+	if this.CallValue != that1.CallValue {
+		return false
+	}
+	if len(this.Args) != len(that1.Args) {
+		return false
+	}
+	for i := range this.Args {
+		if !bytes.Equal(this.Args[i], that1.Args[i]) {
+			return false
+		}
+	}
+	if this.SameScState != that1.SameScState {
+		return false
+	}
+	return true
+}
+func (this *VmValueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VmValueResponse)
+	if !ok {
+		that2, ok := that.(VmValueResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ReturnData) != len(that1.ReturnData) {
+		return false
+	}
+	for i := range this.ReturnData {
+		if !bytes.Equal(this.ReturnData[i], that1.ReturnData[i]) {
+			return false
+		}
+	}
+	if this.ReturnCode != that1.ReturnCode {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	if this.GasRemaining != that1.GasRemaining {
+		return false
+	}
+	return true
+}
+func (this *ProofRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProofRequest)
+	if !ok {
+		that2, ok := that.(ProofRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RootHash != that1.RootHash {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *ProofResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProofResponse)
+	if !ok {
+		that2, ok := that.(ProofResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	if this.RootHash != that1.RootHash {
+		return false
+	}
+	return true
+}
+func (this *NetworkConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NetworkConfigRequest)
+	if !ok {
+		that2, ok := that.(NetworkConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *NetworkConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NetworkConfigResponse)
+	if !ok {
+		that2, ok := that.(NetworkConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Metrics) != len(that1.Metrics) {
+		return false
+	}
+	for i := range this.Metrics {
+		if this.Metrics[i] != that1.Metrics[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *OptionalUint32) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.OptionalUint32{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OptionalUint64) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.OptionalUint64{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountQueryOptions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&grpcapi.AccountQueryOptions{")
+	s = append(s, "OnFinalBlock: "+fmt.Sprintf("%#v", this.OnFinalBlock)+",\n")
+	if this.OnStartOfEpoch != nil {
+		s = append(s, "OnStartOfEpoch: "+fmt.Sprintf("%#v", this.OnStartOfEpoch)+",\n")
+	}
+	if this.BlockNonce != nil {
+		s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	}
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "BlockRootHash: "+fmt.Sprintf("%#v", this.BlockRootHash)+",\n")
+	if this.HintEpoch != nil {
+		s = append(s, "HintEpoch: "+fmt.Sprintf("%#v", this.HintEpoch)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.AccountRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.BlockInfo{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&grpcapi.AccountResponse{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Username: "+fmt.Sprintf("%#v", this.Username)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "CodeHash: "+fmt.Sprintf("%#v", this.CodeHash)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
+	s = append(s, "DeveloperReward: "+fmt.Sprintf("%#v", this.DeveloperReward)+",\n")
+	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyValuePair) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.KeyValuePair{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyValuePairsChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.KeyValuePairsChunk{")
+	if this.Pairs != nil {
+		s = append(s, "Pairs: "+fmt.Sprintf("%#v", this.Pairs)+",\n")
+	}
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTToken) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.ESDTToken{")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	if this.Token != nil {
+		s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTTokensChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.ESDTTokensChunk{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	if this.BlockInfo != nil {
+		s = append(s, "BlockInfo: "+fmt.Sprintf("%#v", this.BlockInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.BlockRequest{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardBlockResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.ShardBlockResponse{")
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	if this.HeaderV2 != nil {
+		s = append(s, "HeaderV2: "+fmt.Sprintf("%#v", this.HeaderV2)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.TransactionRequest{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "WithResults: "+fmt.Sprintf("%#v", this.WithResults)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 27)
+	s = append(s, "&grpcapi.TransactionResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "SourceShard: "+fmt.Sprintf("%#v", this.SourceShard)+",\n")
+	s = append(s, "DestinationShard: "+fmt.Sprintf("%#v", this.DestinationShard)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "MiniBlockType: "+fmt.Sprintf("%#v", this.MiniBlockType)+",\n")
+	s = append(s, "MiniBlockHash: "+fmt.Sprintf("%#v", this.MiniBlockHash)+",\n")
+	s = append(s, "NotarizedAtSourceInMetaNonce: "+fmt.Sprintf("%#v", this.NotarizedAtSourceInMetaNonce)+",\n")
+	s = append(s, "NotarizedAtDestinationInMetaNonce: "+fmt.Sprintf("%#v", this.NotarizedAtDestinationInMetaNonce)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "InitiallyPaidFee: "+fmt.Sprintf("%#v", this.InitiallyPaidFee)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SendTransactionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.SendTransactionsRequest{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SendTransactionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.SendTransactionsResponse{")
+	s = append(s, "NumSent: "+fmt.Sprintf("%#v", this.NumSent)+",\n")
+	s = append(s, "TxHashes: "+fmt.Sprintf("%#v", this.TxHashes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VmValueRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&grpcapi.VmValueRequest{")
+	s = append(s, "ScAddress: "+fmt.Sprintf("%#v", this.ScAddress)+",\n")
+	s = append(s, "FuncName: "+fmt.Sprintf("%#v", this.FuncName)+",\n")
+	s = append(s, "CallerAddr: "+fmt.Sprintf("%#v", this.CallerAddr)+",\n")
+	s = append(s, "CallValue: "+fmt.Sprintf("%#v", this.CallValue)+",\n")
+	s = append(s, "Args: "+fmt.Sprintf("%#v", this.Args)+",\n")
+	s = append(s, "SameScState: "+fmt.Sprintf("%#v", this.SameScState)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VmValueResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpcapi.VmValueResponse{")
+	s = append(s, "ReturnData: "+fmt.Sprintf("%#v", this.ReturnData)+",\n")
+	s = append(s, "ReturnCode: "+fmt.Sprintf("%#v", this.ReturnCode)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "GasRemaining: "+fmt.Sprintf("%#v", this.GasRemaining)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProofRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.ProofRequest{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProofResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.ProofResponse{")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NetworkConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&grpcapi.NetworkConfigRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NetworkConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.NetworkConfigResponse{")
+	keysForMetrics := make([]string, 0, len(this.Metrics))
+	for k, _ := range this.Metrics {
+		keysForMetrics = append(keysForMetrics, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetrics)
+	mapStringForMetrics := "map[string]string{"
+	for _, k := range keysForMetrics {
+		mapStringForMetrics += fmt.Sprintf("%#v: %#v,", k, this.Metrics[k])
+	}
+	mapStringForMetrics += "}"
+	if this.Metrics != nil {
+		s = append(s, "Metrics: "+mapStringForMetrics+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringNodeApi(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeApiClient is the client API for NodeApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeApiClient interface {
+	GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	GetKeyValuePairs(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (NodeApi_GetKeyValuePairsClient, error)
+	GetAllESDTTokens(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (NodeApi_GetAllESDTTokensClient, error)
+	GetShardBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*ShardBlockResponse, error)
+	GetMetaBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*block.MetaBlock, error)
+	GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	SendTransactions(ctx context.Context, in *SendTransactionsRequest, opts ...grpc.CallOption) (*SendTransactionsResponse, error)
+	QueryVmValue(ctx context.Context, in *VmValueRequest, opts ...grpc.CallOption) (*VmValueResponse, error)
+	GetProof(ctx context.Context, in *ProofRequest, opts ...grpc.CallOption) (*ProofResponse, error)
+	GetNetworkConfig(ctx context.Context, in *NetworkConfigRequest, opts ...grpc.CallOption) (*NetworkConfigResponse, error)
+}
+
+type nodeApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeApiClient(cc *grpc.ClientConn) NodeApiClient {
+	return &nodeApiClient{cc}
+}
+
+func (c *nodeApiClient) GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/proto.NodeApi/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeApiClient) GetKeyValuePairs(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (NodeApi_GetKeyValuePairsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NodeApi_serviceDesc.Streams[0], "/proto.NodeApi/GetKeyValuePairs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeApiGetKeyValuePairsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeApi_GetKeyValuePairsClient interface {
+	Recv() (*KeyValuePairsChunk, error)
+	grpc.ClientStream
+}
+
+type nodeApiGetKeyValuePairsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeApiGetKeyValuePairsClient) Recv() (*KeyValuePairsChunk, error) {
+	m := new(KeyValuePairsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeApiClient) GetAllESDTTokens(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (NodeApi_GetAllESDTTokensClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NodeApi_serviceDesc.Streams[1], "/proto.NodeApi/GetAllESDTTokens", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeApiGetAllESDTTokensClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeApi_GetAllESDTTokensClient interface {
+	Recv() (*ESDTTokensChunk, error)
+	grpc.ClientStream
+}
+
+type nodeApiGetAllESDTTokensClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeApiGetAllESDTTokensClient) Recv() (*ESDTTokensChunk, error) {
+	m := new(ESDTTokensChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeApiClient) GetShardBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*ShardBlockResponse, error) {
+	out := new(ShardBlockResponse)
+	err := c.cc.Invoke(ctx, "/proto.NodeApi/GetShardBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeApiClient) GetMetaBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*block.MetaBlock, error) {
+	out := new(block.MetaBlock)
+	err := c.cc.Invoke(ctx, "/proto.NodeApi/GetMetaBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeApiClient) GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, "/proto.NodeApi/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeApiClient) SendTransactions(ctx context.Context, in *SendTransactionsRequest, opts ...grpc.CallOption) (*SendTransactionsResponse, error) {
+	out := new(SendTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.NodeApi/SendTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeApiClient) QueryVmValue(ctx context.Context, in *VmValueRequest, opts ...grpc.CallOption) (*VmValueResponse, error) {
+	out := new(VmValueResponse)
+	err := c.cc.Invoke(ctx, "/proto.NodeApi/QueryVmValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeApiClient) GetProof(ctx context.Context, in *ProofRequest, opts ...grpc.CallOption) (*ProofResponse, error) {
+	out := new(ProofResponse)
+	err := c.cc.Invoke(ctx, "/proto.NodeApi/GetProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeApiClient) GetNetworkConfig(ctx context.Context, in *NetworkConfigRequest, opts ...grpc.CallOption) (*NetworkConfigResponse, error) {
+	out := new(NetworkConfigResponse)
+	err := c.cc.Invoke(ctx, "/proto.NodeApi/GetNetworkConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeApiServer is the server API for NodeApi service.
+type NodeApiServer interface {
+	GetAccount(context.Context, *AccountRequest) (*AccountResponse, error)
+	GetKeyValuePairs(*AccountRequest, NodeApi_GetKeyValuePairsServer) error
+	GetAllESDTTokens(*AccountRequest, NodeApi_GetAllESDTTokensServer) error
+	GetShardBlock(context.Context, *BlockRequest) (*ShardBlockResponse, error)
+	GetMetaBlock(context.Context, *BlockRequest) (*block.MetaBlock, error)
+	GetTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error)
+	SendTransactions(context.Context, *SendTransactionsRequest) (*SendTransactionsResponse, error)
+	QueryVmValue(context.Context, *VmValueRequest) (*VmValueResponse, error)
+	GetProof(context.Context, *ProofRequest) (*ProofResponse, error)
+	GetNetworkConfig(context.Context, *NetworkConfigRequest) (*NetworkConfigResponse, error)
+}
+
+// UnimplementedNodeApiServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeApiServer struct {
+}
+
+func (*UnimplementedNodeApiServer) GetAccount(ctx context.Context, req *AccountRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (*UnimplementedNodeApiServer) GetKeyValuePairs(req *AccountRequest, srv NodeApi_GetKeyValuePairsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetKeyValuePairs not implemented")
+}
+func (*UnimplementedNodeApiServer) GetAllESDTTokens(req *AccountRequest, srv NodeApi_GetAllESDTTokensServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAllESDTTokens not implemented")
+}
+func (*UnimplementedNodeApiServer) GetShardBlock(ctx context.Context, req *BlockRequest) (*ShardBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardBlock not implemented")
+}
+func (*UnimplementedNodeApiServer) GetMetaBlock(ctx context.Context, req *BlockRequest) (*block.MetaBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetaBlock not implemented")
+}
+func (*UnimplementedNodeApiServer) GetTransaction(ctx context.Context, req *TransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (*UnimplementedNodeApiServer) SendTransactions(ctx context.Context, req *SendTransactionsRequest) (*SendTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransactions not implemented")
+}
+func (*UnimplementedNodeApiServer) QueryVmValue(ctx context.Context, req *VmValueRequest) (*VmValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVmValue not implemented")
+}
+func (*UnimplementedNodeApiServer) GetProof(ctx context.Context, req *ProofRequest) (*ProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
+}
+func (*UnimplementedNodeApiServer) GetNetworkConfig(ctx context.Context, req *NetworkConfigRequest) (*NetworkConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkConfig not implemented")
+}
+
+func RegisterNodeApiServer(s *grpc.Server, srv NodeApiServer) {
+	s.RegisterService(&_NodeApi_serviceDesc, srv)
+}
+
+func _NodeApi_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeApiServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeApi/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeApiServer).GetAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeApi_GetKeyValuePairs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeApiServer).GetKeyValuePairs(m, &nodeApiGetKeyValuePairsServer{stream})
+}
+
+type NodeApi_GetKeyValuePairsServer interface {
+	Send(*KeyValuePairsChunk) error
+	grpc.ServerStream
+}
+
+type nodeApiGetKeyValuePairsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeApiGetKeyValuePairsServer) Send(m *KeyValuePairsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodeApi_GetAllESDTTokens_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeApiServer).GetAllESDTTokens(m, &nodeApiGetAllESDTTokensServer{stream})
+}
+
+type NodeApi_GetAllESDTTokensServer interface {
+	Send(*ESDTTokensChunk) error
+	grpc.ServerStream
+}
+
+type nodeApiGetAllESDTTokensServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeApiGetAllESDTTokensServer) Send(m *ESDTTokensChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodeApi_GetShardBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeApiServer).GetShardBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeApi/GetShardBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeApiServer).GetShardBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeApi_GetMetaBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeApiServer).GetMetaBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeApi/GetMetaBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeApiServer).GetMetaBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeApi_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeApiServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeApi/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeApiServer).GetTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeApi_SendTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeApiServer).SendTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeApi/SendTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeApiServer).SendTransactions(ctx, req.(*SendTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeApi_QueryVmValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeApiServer).QueryVmValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeApi/QueryVmValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeApiServer).QueryVmValue(ctx, req.(*VmValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeApi_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeApiServer).GetProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeApi/GetProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeApiServer).GetProof(ctx, req.(*ProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeApi_GetNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeApiServer).GetNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.NodeApi/GetNetworkConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeApiServer).GetNetworkConfig(ctx, req.(*NetworkConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.NodeApi",
+	HandlerType: (*NodeApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _NodeApi_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetShardBlock",
+			Handler:    _NodeApi_GetShardBlock_Handler,
+		},
+		{
+			MethodName: "GetMetaBlock",
+			Handler:    _NodeApi_GetMetaBlock_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _NodeApi_GetTransaction_Handler,
+		},
+		{
+			MethodName: "SendTransactions",
+			Handler:    _NodeApi_SendTransactions_Handler,
+		},
+		{
+			MethodName: "QueryVmValue",
+			Handler:    _NodeApi_QueryVmValue_Handler,
+		},
+		{
+			MethodName: "GetProof",
+			Handler:    _NodeApi_GetProof_Handler,
+		},
+		{
+			MethodName: "GetNetworkConfig",
+			Handler:    _NodeApi_GetNetworkConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetKeyValuePairs",
+			Handler:       _NodeApi_GetKeyValuePairs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAllESDTTokens",
+			Handler:       _NodeApi_GetAllESDTTokens_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nodeApi.proto",
+}
+
+func (m *OptionalUint32) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionalUint32) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalUint32) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptionalUint64) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionalUint64) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountQueryOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountQueryOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountQueryOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HintEpoch != nil {
+		{
+			size, err := m.HintEpoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockRootHash) > 0 {
+		i -= len(m.BlockRootHash)
+		copy(dAtA[i:], m.BlockRootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockRootHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockNonce != nil {
+		{
+			size, err := m.BlockNonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OnStartOfEpoch != nil {
+		{
+			size, err := m.OnStartOfEpoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OnFinalBlock {
+		i--
+		if m.OnFinalBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DeveloperReward) > 0 {
+		i -= len(m.DeveloperReward)
+		copy(dAtA[i:], m.DeveloperReward)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.DeveloperReward)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CodeMetadata) > 0 {
+		i -= len(m.CodeMetadata)
+		copy(dAtA[i:], m.CodeMetadata)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CodeMetadata)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyValuePair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyValuePair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyValuePair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyValuePairsChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyValuePairsChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyValuePairsChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTTokensChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTTokensChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTTokensChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo != nil {
+		{
+			size, err := m.BlockInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeaderV2 != nil {
+		{
+			size, err := m.HeaderV2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNodeApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithResults {
+		i--
+		if m.WithResults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InitiallyPaidFee) > 0 {
+		i -= len(m.InitiallyPaidFee)
+		copy(dAtA[i:], m.InitiallyPaidFee)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.InitiallyPaidFee)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.NotarizedAtDestinationInMetaNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NotarizedAtDestinationInMetaNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.NotarizedAtSourceInMetaNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NotarizedAtSourceInMetaNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.MiniBlockHash) > 0 {
+		i -= len(m.MiniBlockHash)
+		copy(dAtA[i:], m.MiniBlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.MiniBlockHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.MiniBlockType) > 0 {
+		i -= len(m.MiniBlockType)
+		copy(dAtA[i:], m.MiniBlockType)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.MiniBlockType)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.DestinationShard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.DestinationShard))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SourceShard != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.SourceShard))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodeApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendTransactionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NumSent != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.NumSent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VmValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VmValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SameScState {
+		i--
+		if m.SameScState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CallValue) > 0 {
+		i -= len(m.CallValue)
+		copy(dAtA[i:], m.CallValue)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CallValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CallerAddr) > 0 {
+		i -= len(m.CallerAddr)
+		copy(dAtA[i:], m.CallerAddr)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.CallerAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FuncName) > 0 {
+		i -= len(m.FuncName)
+		copy(dAtA[i:], m.FuncName)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.FuncName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScAddress) > 0 {
+		i -= len(m.ScAddress)
+		copy(dAtA[i:], m.ScAddress)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ScAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VmValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VmValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasRemaining != 0 {
+		i = encodeVarintNodeApi(dAtA, i, uint64(m.GasRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReturnCode) > 0 {
+		i -= len(m.ReturnCode)
+		copy(dAtA[i:], m.ReturnCode)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReturnData) > 0 {
+		for iNdEx := len(m.ReturnData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReturnData[iNdEx])
+			copy(dAtA[i:], m.ReturnData[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.ReturnData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metrics) > 0 {
+		for k := range m.Metrics {
+			v := m.Metrics[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintNodeApi(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNodeApi(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNodeApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNodeApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OptionalUint32) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovNodeApi(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *OptionalUint64) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovNodeApi(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccountQueryOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OnFinalBlock {
+		n += 2
+	}
+	if m.OnStartOfEpoch != nil {
+		l = m.OnStartOfEpoch.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.BlockNonce != nil {
+		l = m.BlockNonce.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.BlockRootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.HintEpoch != nil {
+		l = m.HintEpoch.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CodeMetadata)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.DeveloperReward)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyValuePair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyValuePairsChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTTokensChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.BlockInfo != nil {
+		l = m.BlockInfo.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.HeaderV2 != nil {
+		l = m.HeaderV2.Size()
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.WithResults {
+		n += 2
+	}
+	return n
+}
+
+func (m *TransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovNodeApi(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovNodeApi(uint64(m.Epoch))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasPrice))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasLimit))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.SourceShard != 0 {
+		n += 1 + sovNodeApi(uint64(m.SourceShard))
+	}
+	if m.DestinationShard != 0 {
+		n += 1 + sovNodeApi(uint64(m.DestinationShard))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovNodeApi(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.MiniBlockType)
+	if l > 0 {
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.MiniBlockHash)
+	if l > 0 {
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	if m.NotarizedAtSourceInMetaNonce != 0 {
+		n += 2 + sovNodeApi(uint64(m.NotarizedAtSourceInMetaNonce))
+	}
+	if m.NotarizedAtDestinationInMetaNonce != 0 {
+		n += 2 + sovNodeApi(uint64(m.NotarizedAtDestinationInMetaNonce))
+	}
+	if m.Timestamp != 0 {
+		n += 2 + sovNodeApi(uint64(m.Timestamp))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.InitiallyPaidFee)
+	if l > 0 {
+		n += 2 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *SendTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumSent != 0 {
+		n += 1 + sovNodeApi(uint64(m.NumSent))
+	}
+	if len(m.TxHashes) > 0 {
+		for _, b := range m.TxHashes {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VmValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScAddress)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.FuncName)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CallerAddr)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.CallValue)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	if m.SameScState {
+		n += 2
+	}
+	return n
+}
+
+func (m *VmValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReturnData) > 0 {
+		for _, b := range m.ReturnData {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	l = len(m.ReturnCode)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	if m.GasRemaining != 0 {
+		n += 1 + sovNodeApi(uint64(m.GasRemaining))
+	}
+	return n
+}
+
+func (m *ProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovNodeApi(uint64(l))
+		}
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovNodeApi(uint64(l))
+	}
+	return n
+}
+
+func (m *NetworkConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NetworkConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metrics) > 0 {
+		for k, v := range m.Metrics {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovNodeApi(uint64(len(k))) + 1 + len(v) + sovNodeApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovNodeApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovNodeApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNodeApi(x uint64) (n int) {
+	return sovNodeApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *OptionalUint32) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OptionalUint32{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OptionalUint64) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OptionalUint64{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountQueryOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountQueryOptions{`,
+		`OnFinalBlock:` + fmt.Sprintf("%v", this.OnFinalBlock) + `,`,
+		`OnStartOfEpoch:` + strings.Replace(this.OnStartOfEpoch.String(), "OptionalUint32", "OptionalUint32", 1) + `,`,
+		`BlockNonce:` + strings.Replace(this.BlockNonce.String(), "OptionalUint64", "OptionalUint64", 1) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`BlockRootHash:` + fmt.Sprintf("%v", this.BlockRootHash) + `,`,
+		`HintEpoch:` + strings.Replace(this.HintEpoch.String(), "OptionalUint32", "OptionalUint32", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Options:` + strings.Replace(this.Options.String(), "AccountQueryOptions", "AccountQueryOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockInfo{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountResponse{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`CodeHash:` + fmt.Sprintf("%v", this.CodeHash) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
+		`DeveloperReward:` + fmt.Sprintf("%v", this.DeveloperReward) + `,`,
+		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyValuePair) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyValuePair{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyValuePairsChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPairs := "[]*KeyValuePair{"
+	for _, f := range this.Pairs {
+		repeatedStringForPairs += strings.Replace(f.String(), "KeyValuePair", "KeyValuePair", 1) + ","
+	}
+	repeatedStringForPairs += "}"
+	s := strings.Join([]string{`&KeyValuePairsChunk{`,
+		`Pairs:` + repeatedStringForPairs + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTToken{`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "ESDigitalToken", "esdt.ESDigitalToken", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTTokensChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*ESDTToken{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(f.String(), "ESDTToken", "ESDTToken", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&ESDTTokensChunk{`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`BlockInfo:` + strings.Replace(this.BlockInfo.String(), "BlockInfo", "BlockInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockRequest{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardBlockResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardBlockResponse{`,
+		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "block.Header", 1) + `,`,
+		`HeaderV2:` + strings.Replace(fmt.Sprintf("%v", this.HeaderV2), "HeaderV2", "block.HeaderV2", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionRequest{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`WithResults:` + fmt.Sprintf("%v", this.WithResults) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`SourceShard:` + fmt.Sprintf("%v", this.SourceShard) + `,`,
+		`DestinationShard:` + fmt.Sprintf("%v", this.DestinationShard) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`MiniBlockType:` + fmt.Sprintf("%v", this.MiniBlockType) + `,`,
+		`MiniBlockHash:` + fmt.Sprintf("%v", this.MiniBlockHash) + `,`,
+		`NotarizedAtSourceInMetaNonce:` + fmt.Sprintf("%v", this.NotarizedAtSourceInMetaNonce) + `,`,
+		`NotarizedAtDestinationInMetaNonce:` + fmt.Sprintf("%v", this.NotarizedAtDestinationInMetaNonce) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`InitiallyPaidFee:` + fmt.Sprintf("%v", this.InitiallyPaidFee) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendTransactionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*Transaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(fmt.Sprintf("%v", f), "Transaction", "transaction.Transaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&SendTransactionsRequest{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendTransactionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SendTransactionsResponse{`,
+		`NumSent:` + fmt.Sprintf("%v", this.NumSent) + `,`,
+		`TxHashes:` + fmt.Sprintf("%v", this.TxHashes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VmValueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VmValueRequest{`,
+		`ScAddress:` + fmt.Sprintf("%v", this.ScAddress) + `,`,
+		`FuncName:` + fmt.Sprintf("%v", this.FuncName) + `,`,
+		`CallerAddr:` + fmt.Sprintf("%v", this.CallerAddr) + `,`,
+		`CallValue:` + fmt.Sprintf("%v", this.CallValue) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`SameScState:` + fmt.Sprintf("%v", this.SameScState) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VmValueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VmValueResponse{`,
+		`ReturnData:` + fmt.Sprintf("%v", this.ReturnData) + `,`,
+		`ReturnCode:` + fmt.Sprintf("%v", this.ReturnCode) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`GasRemaining:` + fmt.Sprintf("%v", this.GasRemaining) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProofRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProofRequest{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProofResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProofResponse{`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkConfigRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetrics := make([]string, 0, len(this.Metrics))
+	for k, _ := range this.Metrics {
+		keysForMetrics = append(keysForMetrics, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetrics)
+	mapStringForMetrics := "map[string]string{"
+	for _, k := range keysForMetrics {
+		mapStringForMetrics += fmt.Sprintf("%v: %v,", k, this.Metrics[k])
+	}
+	mapStringForMetrics += "}"
+	s := strings.Join([]string{`&NetworkConfigResponse{`,
+		`Metrics:` + mapStringForMetrics + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringNodeApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *OptionalUint32) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalUint32: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalUint32: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptionalUint64) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalUint64: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalUint64: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountQueryOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountQueryOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountQueryOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFinalBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnFinalBlock = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnStartOfEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OnStartOfEpoch == nil {
+				m.OnStartOfEpoch = &OptionalUint32{}
+			}
+			if err := m.OnStartOfEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockNonce == nil {
+				m.BlockNonce = &OptionalUint64{}
+			}
+			if err := m.BlockNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRootHash = append(m.BlockRootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRootHash == nil {
+				m.BlockRootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HintEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HintEpoch == nil {
+				m.HintEpoch = &OptionalUint32{}
+			}
+			if err := m.HintEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &AccountQueryOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = append(m.CodeMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeMetadata == nil {
+				m.CodeMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperReward = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyValuePair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValuePair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValuePair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyValuePairsChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValuePairsChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValuePairsChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &KeyValuePair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &esdt.ESDigitalToken{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTTokensChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTTokensChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTTokensChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &ESDTToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockInfo == nil {
+				m.BlockInfo = &BlockInfo{}
+			}
+			if err := m.BlockInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &block.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderV2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeaderV2 == nil {
+				m.HeaderV2 = &block.HeaderV2{}
+			}
+			if err := m.HeaderV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithResults = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceShard", wireType)
+			}
+			m.SourceShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationShard", wireType)
+			}
+			m.DestinationShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlockType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlockType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedAtSourceInMetaNonce", wireType)
+			}
+			m.NotarizedAtSourceInMetaNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotarizedAtSourceInMetaNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedAtDestinationInMetaNonce", wireType)
+			}
+			m.NotarizedAtDestinationInMetaNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotarizedAtDestinationInMetaNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiallyPaidFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitiallyPaidFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &transaction.Transaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSent", wireType)
+			}
+			m.NumSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, make([]byte, postIndex-iNdEx))
+			copy(m.TxHashes[len(m.TxHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuncName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuncName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SameScState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SameScState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData, make([]byte, postIndex-iNdEx))
+			copy(m.ReturnData[len(m.ReturnData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRemaining", wireType)
+			}
+			m.GasRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNodeApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNodeApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNodeApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNodeApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthNodeApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metrics[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodeApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNodeApi(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNodeApi
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNodeApi
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNodeApi
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNodeApi
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNodeApi
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNodeApi        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNodeApi          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNodeApi = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,582 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=plugins=grpc:. nodeApi.proto
+package grpcapi
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logger.GetOrCreate("api/grpcapi")
+
+const (
+	maxKeyValuePairsPerChunk = 1000
+	maxESDTTokensPerChunk    = 100
+	unixSocketPrefix         = "unix:"
+)
+
+// ArgsNewNodeApiServer holds the arguments needed to create a new instance of nodeApiServer
+type ArgsNewNodeApiServer struct {
+	Facade               shared.FacadeHandler
+	Config               config.ApiGrpcConfig
+	AuthenticationConfig config.ApiAuthenticationConfig
+	AntiFloodConfig      config.WebServerAntifloodConfig
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+}
+
+type nodeApiServer struct {
+	mutFacade     sync.RWMutex
+	facade        shared.FacadeHandler
+	config        config.ApiGrpcConfig
+	authenticator requestAuthenticator
+	throttler     requestThrottler
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+
+	mutServer  sync.Mutex
+	grpcServer *grpc.Server
+}
+
+// NewNodeApiServer returns a new instance of nodeApiServer, which exposes the facade operations over gRPC
+func NewNodeApiServer(args ArgsNewNodeApiServer) (*nodeApiServer, error) {
+	if check.IfNil(args.Facade) {
+		return nil, apiErrors.ErrNilFacadeHandler
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.Config.Enabled && len(args.Config.Interface) == 0 {
+		return nil, ErrEmptyInterface
+	}
+
+	throttler, err := middleware.NewGlobalThrottler(args.AntiFloodConfig.SimultaneousRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	var authenticator requestAuthenticator
+	if args.AuthenticationConfig.Enabled {
+		authenticator, err = middleware.NewAuthenticator(args.AuthenticationConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &nodeApiServer{
+		facade:        args.Facade,
+		config:        args.Config,
+		authenticator: authenticator,
+		throttler:     throttler,
+		marshalizer:   args.Marshalizer,
+		hasher:        args.Hasher,
+	}, nil
+}
+
+// Start starts listening on the configured interface, if the gRPC server is enabled
+func (s *nodeApiServer) Start() error {
+	if !s.config.Enabled {
+		log.Debug("gRPC server is disabled")
+		return nil
+	}
+
+	network, address := splitInterface(s.config.Interface)
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("starting gRPC server", "interface", s.config.Interface)
+	s.serve(listener)
+
+	return nil
+}
+
+func splitInterface(iface string) (string, string) {
+	if strings.HasPrefix(iface, unixSocketPrefix) {
+		return "unix", strings.TrimPrefix(iface, unixSocketPrefix)
+	}
+
+	return "tcp", iface
+}
+
+func (s *nodeApiServer) serve(listener net.Listener) {
+	s.mutServer.Lock()
+	defer s.mutServer.Unlock()
+
+	s.grpcServer = grpc.NewServer(
+		grpc.ForceServerCodec(&gogoCodec{}),
+		grpc.UnaryInterceptor(s.interceptUnary),
+		grpc.StreamInterceptor(s.interceptStream),
+	)
+	RegisterNodeApiServer(s.grpcServer, s)
+
+	go func(grpcServer *grpc.Server) {
+		errServe := grpcServer.Serve(listener)
+		if errServe != nil {
+			log.Error("gRPC server stopped", "error", errServe)
+		}
+	}(s.grpcServer)
+}
+
+// UpdateFacade updates the facade used to resolve the requests
+func (s *nodeApiServer) UpdateFacade(facade shared.FacadeHandler) error {
+	if check.IfNil(facade) {
+		return apiErrors.ErrNilFacadeHandler
+	}
+
+	s.mutFacade.Lock()
+	s.facade = facade
+	s.mutFacade.Unlock()
+
+	return nil
+}
+
+func (s *nodeApiServer) getFacade() shared.FacadeHandler {
+	s.mutFacade.RLock()
+	defer s.mutFacade.RUnlock()
+
+	return s.facade
+}
+
+// GetAccount returns the data of an account
+func (s *nodeApiServer) GetAccount(_ context.Context, request *AccountRequest) (*AccountResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrCouldNotGetAccount, apiErrors.ErrEmptyAddress)
+	}
+
+	account, blockInfo, err := s.getFacade().GetAccount(request.Address, convertAccountQueryOptions(request.Options))
+	if err != nil {
+		return nil, internalError(apiErrors.ErrCouldNotGetAccount, err)
+	}
+
+	return &AccountResponse{
+		Address:         account.Address,
+		Nonce:           account.Nonce,
+		Balance:         account.Balance,
+		Username:        account.Username,
+		Code:            account.Code,
+		CodeHash:        account.CodeHash,
+		RootHash:        account.RootHash,
+		CodeMetadata:    account.CodeMetadata,
+		DeveloperReward: account.DeveloperReward,
+		OwnerAddress:    account.OwnerAddress,
+		BlockInfo:       convertBlockInfo(blockInfo),
+	}, nil
+}
+
+// GetKeyValuePairs streams the key-value pairs of an account, in the data trie order, in chunks. Each chunk is read
+// from the data trie only after the previous one was sent, on the block of the first chunk
+func (s *nodeApiServer) GetKeyValuePairs(request *AccountRequest, stream NodeApi_GetKeyValuePairsServer) error {
+	if len(request.Address) == 0 {
+		return invalidArgumentError(apiErrors.ErrGetKeyValuePairs, apiErrors.ErrEmptyAddress)
+	}
+
+	options := convertAccountQueryOptions(request.Options)
+	pageOptions := common.AccountStoragePageOptions{Limit: maxKeyValuePairsPerChunk}
+	var streamBlockInfo *BlockInfo
+	for {
+		chunk := &KeyValuePairsChunk{
+			Pairs: make([]*KeyValuePair, 0, maxKeyValuePairsPerChunk),
+		}
+		nextKey, blockInfo, err := s.getFacade().IterateKeyValuePairs(request.Address, pageOptions, options, stream.Context(), func(key string, value string) error {
+			pair, errDecode := decodeKeyValuePair(key, value)
+			if errDecode != nil {
+				return errDecode
+			}
+
+			chunk.Pairs = append(chunk.Pairs, pair)
+			return nil
+		})
+		if err != nil {
+			return internalError(apiErrors.ErrGetKeyValuePairs, err)
+		}
+
+		if streamBlockInfo == nil {
+			streamBlockInfo = convertBlockInfo(blockInfo)
+			options, err = pinAccountQueryOptions(options, blockInfo)
+			if err != nil {
+				return internalError(apiErrors.ErrGetKeyValuePairs, err)
+			}
+		}
+		chunk.BlockInfo = streamBlockInfo
+
+		err = stream.Send(chunk)
+		if err != nil {
+			return err
+		}
+		if len(nextKey) == 0 {
+			return nil
+		}
+		pageOptions.StartKey = nextKey
+	}
+}
+
+func decodeKeyValuePair(hexKey string, hexValue string) (*KeyValuePair, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, err
+	}
+	value, err := hex.DecodeString(hexValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyValuePair{
+		Key:   key,
+		Value: value,
+	}, nil
+}
+
+// GetAllESDTTokens streams the ESDT tokens of an account, in the data trie order, in chunks. Each chunk is read
+// from the data trie only after the previous one was sent, on the block of the first chunk
+func (s *nodeApiServer) GetAllESDTTokens(request *AccountRequest, stream NodeApi_GetAllESDTTokensServer) error {
+	if len(request.Address) == 0 {
+		return invalidArgumentError(apiErrors.ErrGetESDTBalance, apiErrors.ErrEmptyAddress)
+	}
+
+	options := convertAccountQueryOptions(request.Options)
+	pageOptions := common.AccountStoragePageOptions{Limit: maxESDTTokensPerChunk}
+	var streamBlockInfo *BlockInfo
+	for {
+		chunk := &ESDTTokensChunk{
+			Tokens: make([]*ESDTToken, 0, maxESDTTokensPerChunk),
+		}
+		nextKey, blockInfo, err := s.getFacade().IterateESDTTokens(request.Address, pageOptions, options, stream.Context(), func(_ string, tokenIdentifier string, token *esdt.ESDigitalToken) error {
+			chunk.Tokens = append(chunk.Tokens, &ESDTToken{
+				Identifier: tokenIdentifier,
+				Token:      token,
+			})
+			return nil
+		})
+		if err != nil {
+			return internalError(apiErrors.ErrGetESDTBalance, err)
+		}
+
+		if streamBlockInfo == nil {
+			streamBlockInfo = convertBlockInfo(blockInfo)
+			options, err = pinAccountQueryOptions(options, blockInfo)
+			if err != nil {
+				return internalError(apiErrors.ErrGetESDTBalance, err)
+			}
+		}
+		chunk.BlockInfo = streamBlockInfo
+
+		// a page can end without tokens, as the ESDT keys are spread across the data trie
+		isLastChunk := len(nextKey) == 0
+		if len(chunk.Tokens) > 0 || isLastChunk {
+			err = stream.Send(chunk)
+			if err != nil {
+				return err
+			}
+		}
+		if isLastChunk {
+			return nil
+		}
+		pageOptions.StartKey = nextKey
+	}
+}
+
+// pinAccountQueryOptions returns the options which resolve the next chunks of a stream on the block of the first one
+func pinAccountQueryOptions(options api.AccountQueryOptions, blockInfo api.BlockInfo) (api.AccountQueryOptions, error) {
+	if len(blockInfo.RootHash) == 0 {
+		return options, nil
+	}
+
+	rootHash, err := hex.DecodeString(blockInfo.RootHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	return api.AccountQueryOptions{
+		BlockRootHash: rootHash,
+		HintEpoch:     options.HintEpoch,
+	}, nil
+}
+
+// GetShardBlock returns a shard block by hash or by nonce
+func (s *nodeApiServer) GetShardBlock(_ context.Context, request *BlockRequest) (*ShardBlockResponse, error) {
+	var result interface{}
+	var err error
+	if len(request.Hash) > 0 {
+		result, err = s.getFacade().GetInternalShardBlockByHash(common.ApiOutputFormatJSON, request.Hash)
+	} else {
+		result, err = s.getFacade().GetInternalShardBlockByNonce(common.ApiOutputFormatJSON, request.Nonce)
+	}
+	if err != nil {
+		return nil, internalError(apiErrors.ErrGetBlock, err)
+	}
+
+	switch header := result.(type) {
+	case *block.Header:
+		return &ShardBlockResponse{Header: header}, nil
+	case *block.HeaderV2:
+		return &ShardBlockResponse{HeaderV2: header}, nil
+	default:
+		return nil, internalError(apiErrors.ErrGetBlock, fmt.Errorf("%w: %T", ErrUnexpectedBlockType, result))
+	}
+}
+
+// GetMetaBlock returns a metachain block by hash or by nonce
+func (s *nodeApiServer) GetMetaBlock(_ context.Context, request *BlockRequest) (*block.MetaBlock, error) {
+	var result interface{}
+	var err error
+	if len(request.Hash) > 0 {
+		result, err = s.getFacade().GetInternalMetaBlockByHash(common.ApiOutputFormatJSON, request.Hash)
+	} else {
+		result, err = s.getFacade().GetInternalMetaBlockByNonce(common.ApiOutputFormatJSON, request.Nonce)
+	}
+	if err != nil {
+		return nil, internalError(apiErrors.ErrGetBlock, err)
+	}
+
+	metaBlock, ok := result.(*block.MetaBlock)
+	if !ok {
+		return nil, internalError(apiErrors.ErrGetBlock, fmt.Errorf("%w: %T", ErrUnexpectedBlockType, result))
+	}
+
+	return metaBlock, nil
+}
+
+// GetTransaction returns a transaction and its processing status
+func (s *nodeApiServer) GetTransaction(_ context.Context, request *TransactionRequest) (*TransactionResponse, error) {
+	if len(request.Hash) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrGetTransaction, apiErrors.ErrValidationEmptyTxHash)
+	}
+
+	tx, err := s.getFacade().GetTransaction(request.Hash, request.WithResults)
+	if err != nil {
+		return nil, internalError(apiErrors.ErrGetTransaction, err)
+	}
+
+	return &TransactionResponse{
+		Hash:                              tx.Hash,
+		Type:                              tx.Type,
+		Nonce:                             tx.Nonce,
+		Round:                             tx.Round,
+		Epoch:                             tx.Epoch,
+		Value:                             tx.Value,
+		Receiver:                          tx.Receiver,
+		Sender:                            tx.Sender,
+		GasPrice:                          tx.GasPrice,
+		GasLimit:                          tx.GasLimit,
+		Data:                              tx.Data,
+		Signature:                         tx.Signature,
+		SourceShard:                       tx.SourceShard,
+		DestinationShard:                  tx.DestinationShard,
+		BlockNonce:                        tx.BlockNonce,
+		BlockHash:                         tx.BlockHash,
+		MiniBlockType:                     tx.MiniBlockType,
+		MiniBlockHash:                     tx.MiniBlockHash,
+		NotarizedAtSourceInMetaNonce:      tx.NotarizedAtSourceInMetaNonce,
+		NotarizedAtDestinationInMetaNonce: tx.NotarizedAtDestinationInMetaNonce,
+		Timestamp:                         tx.Timestamp,
+		Status:                            string(tx.Status),
+		InitiallyPaidFee:                  tx.InitiallyPaidFee,
+	}, nil
+}
+
+// SendTransactions validates and sends the provided transactions, returning their hashes
+func (s *nodeApiServer) SendTransactions(_ context.Context, request *SendTransactionsRequest) (*SendTransactionsResponse, error) {
+	txHashes := make([][]byte, 0, len(request.Transactions))
+	for idx, tx := range request.Transactions {
+		if tx == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s for transaction %d: nil transaction", apiErrors.ErrTxGenerationFailed.Error(), idx)
+		}
+
+		err := s.getFacade().ValidateTransaction(tx)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s for transaction %d: %s", apiErrors.ErrTxGenerationFailed.Error(), idx, err.Error())
+		}
+
+		txHash, err := core.CalculateHash(s.marshalizer, s.hasher, tx)
+		if err != nil {
+			return nil, internalError(apiErrors.ErrTxGenerationFailed, err)
+		}
+		txHashes = append(txHashes, txHash)
+	}
+
+	numSent, err := s.getFacade().SendBulkTransactions(request.Transactions)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SendTransactionsResponse{
+		NumSent:  numSent,
+		TxHashes: txHashes,
+	}, nil
+}
+
+// QueryVmValue executes a smart contract query
+func (s *nodeApiServer) QueryVmValue(_ context.Context, request *VmValueRequest) (*VmValueResponse, error) {
+	query, err := s.createSCQuery(request)
+	if err != nil {
+		return nil, invalidArgumentError(apiErrors.ErrQueryError, err)
+	}
+
+	vmOutput, err := s.getFacade().ExecuteSCQuery(query)
+	if err != nil {
+		return nil, internalError(apiErrors.ErrQueryError, err)
+	}
+
+	return &VmValueResponse{
+		ReturnData:    vmOutput.ReturnData,
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  vmOutput.GasRemaining,
+	}, nil
+}
+
+func (s *nodeApiServer) createSCQuery(request *VmValueRequest) (*process.SCQuery, error) {
+	scAddress, err := s.getFacade().DecodeAddressPubkey(request.ScAddress)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid address: %w", request.ScAddress, err)
+	}
+
+	query := &process.SCQuery{
+		ScAddress:   scAddress,
+		FuncName:    request.FuncName,
+		Arguments:   request.Args,
+		SameScState: request.SameScState,
+	}
+
+	if len(request.CallerAddr) > 0 {
+		query.CallerAddr, err = s.getFacade().DecodeAddressPubkey(request.CallerAddr)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid address: %w", request.CallerAddr, err)
+		}
+	}
+
+	if len(request.CallValue) > 0 {
+		callValue, ok := big.NewInt(0).SetString(request.CallValue, 10)
+		if !ok {
+			return nil, fmt.Errorf("non numeric call value provided: %s", request.CallValue)
+		}
+		query.CallValue = callValue
+	}
+
+	return query, nil
+}
+
+// GetProof returns the Merkle proof of an account for the provided root hash
+func (s *nodeApiServer) GetProof(_ context.Context, request *ProofRequest) (*ProofResponse, error) {
+	if len(request.RootHash) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrGetProof, apiErrors.ErrValidationEmptyRootHash)
+	}
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrGetProof, apiErrors.ErrValidationEmptyAddress)
+	}
+
+	proof, err := s.getFacade().GetProof(request.RootHash, request.Address)
+	if err != nil {
+		return nil, internalError(apiErrors.ErrGetProof, err)
+	}
+
+	return &ProofResponse{
+		Proof:    proof.Proof,
+		Value:    proof.Value,
+		RootHash: proof.RootHash,
+	}, nil
+}
+
+// GetNetworkConfig returns the network config metrics
+func (s *nodeApiServer) GetNetworkConfig(_ context.Context, _ *NetworkConfigRequest) (*NetworkConfigResponse, error) {
+	configMetrics, err := s.getFacade().StatusMetrics().ConfigMetrics()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	metrics := make(map[string]string, len(configMetrics))
+	for key, value := range configMetrics {
+		metrics[key] = fmt.Sprintf("%v", value)
+	}
+
+	return &NetworkConfigResponse{
+		Metrics: metrics,
+	}, nil
+}
+
+func convertAccountQueryOptions(options *AccountQueryOptions) api.AccountQueryOptions {
+	if options == nil {
+		return api.AccountQueryOptions{}
+	}
+
+	result := api.AccountQueryOptions{
+		OnFinalBlock:  options.OnFinalBlock,
+		BlockHash:     options.BlockHash,
+		BlockRootHash: options.BlockRootHash,
+	}
+	if options.OnStartOfEpoch != nil {
+		result.OnStartOfEpoch = core.OptionalUint32{Value: options.OnStartOfEpoch.Value, HasValue: true}
+	}
+	if options.BlockNonce != nil {
+		result.BlockNonce = core.OptionalUint64{Value: options.BlockNonce.Value, HasValue: true}
+	}
+	if options.HintEpoch != nil {
+		result.HintEpoch = core.OptionalUint32{Value: options.HintEpoch.Value, HasValue: true}
+	}
+
+	return result
+}
+
+func convertBlockInfo(blockInfo api.BlockInfo) *BlockInfo {
+	return &BlockInfo{
+		Nonce:    blockInfo.Nonce,
+		Hash:     blockInfo.Hash,
+		RootHash: blockInfo.RootHash,
+	}
+}
+
+func invalidArgumentError(scope error, err error) error {
+	return status.Errorf(codes.InvalidArgument, "%s: %s", scope.Error(), err.Error())
+}
+
+func internalError(scope error, err error) error {
+	return status.Errorf(codes.Internal, "%s: %s", scope.Error(), err.Error())
+}
+
+// Close stops the gRPC server, closing the listener and all the open connections
+func (s *nodeApiServer) Close() error {
+	s.mutServer.Lock()
+	defer s.mutServer.Unlock()
+
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+		s.grpcServer = nil
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *nodeApiServer) IsInterfaceNil() bool {
+	return s == nil
+}
+
+// make sure the server implements the service handlers
+var _ NodeApiServer = (*nodeApiServer)(nil)
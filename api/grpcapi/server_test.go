@@ -0,0 +1,681 @@
+package grpcapi_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/grpcapi"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func createMockArgsNewNodeApiServer() grpcapi.ArgsNewNodeApiServer {
+	return grpcapi.ArgsNewNodeApiServer{
+		Facade: &mock.FacadeStub{},
+		Config: config.ApiGrpcConfig{
+			Enabled:   true,
+			Interface: "localhost:9090",
+		},
+		AntiFloodConfig: config.WebServerAntifloodConfig{
+			SimultaneousRequests: 100,
+		},
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		Hasher:      &hashingMocks.HasherMock{},
+	}
+}
+
+func createAuthenticationConfig() config.ApiAuthenticationConfig {
+	return config.ApiAuthenticationConfig{
+		Enabled:                true,
+		MaxRequestAgeInSeconds: 30,
+		PublicGroups:           []string{"network"},
+		Keys: []config.ApiKeyConfig{
+			{Name: "partner", Key: "partner-key", AllowedGroups: []string{"address"}},
+			{Name: "internal", Key: "internal-key", Secret: "secret", AllowedGroups: []string{"*"}},
+		},
+	}
+}
+
+func startServerAndClient(t *testing.T, facade *mock.FacadeStub) grpcapi.NodeApiClient {
+	args := createMockArgsNewNodeApiServer()
+	args.Facade = facade
+
+	return startServerAndClientWithArgs(t, args)
+}
+
+func startServerAndClientWithArgs(t *testing.T, args grpcapi.ArgsNewNodeApiServer) grpcapi.NodeApiClient {
+	server, err := grpcapi.NewNodeApiServer(args)
+	require.Nil(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server.Serve(listener)
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufconn",
+		grpc.WithContextDialer(func(_ context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = server.Close()
+	})
+
+	return grpcapi.NewNodeApiClient(conn)
+}
+
+func createSignedContext(request proto.Message, fullMethod string, timestamp int64) context.Context {
+	body, _ := proto.Marshal(request)
+	timestampStr := strconv.FormatInt(timestamp, 10)
+	signature := middleware.ComputeRequestSignature([]byte("secret"), http.MethodPost, fullMethod, timestampStr, body)
+
+	return metadata.AppendToOutgoingContext(
+		context.Background(),
+		middleware.ApiKeyHeader, "internal-key",
+		middleware.ApiTimestampHeader, timestampStr,
+		middleware.ApiSignatureHeader, signature,
+	)
+}
+
+func requireStatusError(t *testing.T, err error, expectedCode codes.Code, expectedErr error) {
+	require.NotNil(t, err)
+	assert.Equal(t, expectedCode, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), expectedErr.Error())
+}
+
+func TestNewNodeApiServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		args := createMockArgsNewNodeApiServer()
+		args.Facade = nil
+		server, err := grpcapi.NewNodeApiServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsNewNodeApiServer()
+		args.Marshalizer = nil
+		server, err := grpcapi.NewNodeApiServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, grpcapi.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsNewNodeApiServer()
+		args.Hasher = nil
+		server, err := grpcapi.NewNodeApiServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, grpcapi.ErrNilHasher, err)
+	})
+	t.Run("enabled without interface should error", func(t *testing.T) {
+		args := createMockArgsNewNodeApiServer()
+		args.Config.Interface = ""
+		server, err := grpcapi.NewNodeApiServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, grpcapi.ErrEmptyInterface, err)
+	})
+	t.Run("invalid simultaneous requests should error", func(t *testing.T) {
+		args := createMockArgsNewNodeApiServer()
+		args.AntiFloodConfig.SimultaneousRequests = 0
+		server, err := grpcapi.NewNodeApiServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
+	})
+	t.Run("invalid authentication config should error", func(t *testing.T) {
+		args := createMockArgsNewNodeApiServer()
+		args.AuthenticationConfig = createAuthenticationConfig()
+		args.AuthenticationConfig.Keys[0].Key = ""
+		server, err := grpcapi.NewNodeApiServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.True(t, errors.Is(err, middleware.ErrEmptyApiKey))
+	})
+	t.Run("any interface or unix socket should work", func(t *testing.T) {
+		for _, iface := range []string{"127.0.0.1:9090", "0.0.0.0:9090", "unix:/tmp/grpc.sock"} {
+			args := createMockArgsNewNodeApiServer()
+			args.Config.Interface = iface
+			server, err := grpcapi.NewNodeApiServer(args)
+			assert.False(t, check.IfNil(server), iface)
+			assert.Nil(t, err, iface)
+		}
+	})
+	t.Run("disabled server should not start", func(t *testing.T) {
+		args := createMockArgsNewNodeApiServer()
+		args.Config = config.ApiGrpcConfig{}
+		server, err := grpcapi.NewNodeApiServer(args)
+		require.Nil(t, err)
+		assert.Nil(t, server.Start())
+		assert.Nil(t, server.Close())
+	})
+	t.Run("should work", func(t *testing.T) {
+		server, err := grpcapi.NewNodeApiServer(createMockArgsNewNodeApiServer())
+		assert.False(t, check.IfNil(server))
+		assert.Nil(t, err)
+	})
+}
+
+func TestNodeApiServer_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	server, err := grpcapi.NewNodeApiServer(createMockArgsNewNodeApiServer())
+	require.Nil(t, err)
+
+	assert.Equal(t, apiErrors.ErrNilFacadeHandler, server.UpdateFacade(nil))
+
+	newFacade := &mock.FacadeStub{
+		GetAccountCalled: func(address string, _ api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			return api.AccountResponse{Address: address}, api.BlockInfo{}, nil
+		},
+	}
+	assert.Nil(t, server.UpdateFacade(newFacade))
+
+	response, err := server.GetAccount(context.Background(), &grpcapi.AccountRequest{Address: "erd1"})
+	require.Nil(t, err)
+	assert.Equal(t, "erd1", response.Address)
+}
+
+func TestNodeApiServer_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			if address == "erd1fail" {
+				return api.AccountResponse{}, api.BlockInfo{}, expectedErr
+			}
+
+			assert.Equal(t, api.AccountQueryOptions{
+				BlockNonce: core.OptionalUint64{Value: 37, HasValue: true},
+			}, options)
+			return api.AccountResponse{
+				Address: address,
+				Nonce:   4,
+				Balance: "1000",
+			}, api.BlockInfo{Nonce: 37, Hash: "abcd", RootHash: "ef01"}, nil
+		},
+	}
+	client := startServerAndClient(t, facade)
+
+	t.Run("empty address should error", func(t *testing.T) {
+		_, err := client.GetAccount(context.Background(), &grpcapi.AccountRequest{})
+		requireStatusError(t, err, codes.InvalidArgument, apiErrors.ErrEmptyAddress)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		_, err := client.GetAccount(context.Background(), &grpcapi.AccountRequest{Address: "erd1fail"})
+		requireStatusError(t, err, codes.Internal, expectedErr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		response, err := client.GetAccount(context.Background(), &grpcapi.AccountRequest{
+			Address: "erd1",
+			Options: &grpcapi.AccountQueryOptions{BlockNonce: &grpcapi.OptionalUint64{Value: 37}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "erd1", response.Address)
+		assert.Equal(t, uint64(4), response.Nonce)
+		assert.Equal(t, "1000", response.Balance)
+		assert.Equal(t, &grpcapi.BlockInfo{Nonce: 37, Hash: "abcd", RootHash: "ef01"}, response.BlockInfo)
+	})
+}
+
+func TestNodeApiServer_GetKeyValuePairsShouldStreamChunksOnTheSameBlock(t *testing.T) {
+	t.Parallel()
+
+	numPairs := 2500
+	keys := make([]string, 0, numPairs)
+	for i := 0; i < numPairs; i++ {
+		keys = append(keys, hex.EncodeToString([]byte(fmt.Sprintf("key%05d", i))))
+	}
+	rootHash := []byte{0xef, 0x01}
+	numCalls := 0
+	facade := &mock.FacadeStub{
+		IterateKeyValuePairsCalled: func(_ string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, _ context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error) {
+			if numCalls == 0 {
+				assert.Equal(t, api.AccountQueryOptions{}, options)
+			} else {
+				assert.Equal(t, api.AccountQueryOptions{BlockRootHash: rootHash}, options)
+			}
+			numCalls++
+
+			start := 0
+			for start < len(keys) && keys[start] != pageOptions.StartKey && len(pageOptions.StartKey) > 0 {
+				start++
+			}
+			for idx := start; idx < len(keys); idx++ {
+				if idx-start == pageOptions.Limit {
+					return keys[idx], api.BlockInfo{Nonce: 37, RootHash: hex.EncodeToString(rootHash)}, nil
+				}
+
+				err := handler(keys[idx], hex.EncodeToString([]byte(fmt.Sprintf("value%d", idx))))
+				require.Nil(t, err)
+			}
+
+			return "", api.BlockInfo{Nonce: 37, RootHash: hex.EncodeToString(rootHash)}, nil
+		},
+	}
+	client := startServerAndClient(t, facade)
+
+	stream, err := client.GetKeyValuePairs(context.Background(), &grpcapi.AccountRequest{Address: "erd1"})
+	require.Nil(t, err)
+
+	chunkSizes := make([]int, 0)
+	received := 0
+	for {
+		chunk, errRecv := stream.Recv()
+		if errRecv == io.EOF {
+			break
+		}
+		require.Nil(t, errRecv)
+
+		chunkSizes = append(chunkSizes, len(chunk.Pairs))
+		assert.Equal(t, uint64(37), chunk.BlockInfo.Nonce)
+		for _, pair := range chunk.Pairs {
+			assert.Equal(t, fmt.Sprintf("key%05d", received), string(pair.Key))
+			assert.Equal(t, fmt.Sprintf("value%d", received), string(pair.Value))
+			received++
+		}
+	}
+
+	assert.Equal(t, []int{1000, 1000, 500}, chunkSizes)
+	assert.Equal(t, numPairs, received)
+}
+
+func TestNodeApiServer_GetAllESDTTokens(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		IterateESDTTokensCalled: func(address string, _ common.AccountStoragePageOptions, _ api.AccountQueryOptions, _ context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error) {
+			switch address {
+			case "erd1fail":
+				return "", api.BlockInfo{}, expectedErr
+			case "erd1empty":
+				return "", api.BlockInfo{Nonce: 37}, nil
+			default:
+				_ = handler("01", "TKN-aaaaaa", &esdt.ESDigitalToken{Value: big.NewInt(1)})
+				_ = handler("02", "TKN-bbbbbb", &esdt.ESDigitalToken{Value: big.NewInt(2)})
+				return "", api.BlockInfo{Nonce: 37}, nil
+			}
+		},
+	}
+	client := startServerAndClient(t, facade)
+
+	receiveAll := func(address string) ([]*grpcapi.ESDTTokensChunk, error) {
+		stream, err := client.GetAllESDTTokens(context.Background(), &grpcapi.AccountRequest{Address: address})
+		if err != nil {
+			return nil, err
+		}
+
+		chunks := make([]*grpcapi.ESDTTokensChunk, 0)
+		for {
+			chunk, errRecv := stream.Recv()
+			if errRecv == io.EOF {
+				return chunks, nil
+			}
+			if errRecv != nil {
+				return nil, errRecv
+			}
+
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	t.Run("facade error should error", func(t *testing.T) {
+		_, err := receiveAll("erd1fail")
+		requireStatusError(t, err, codes.Internal, expectedErr)
+	})
+	t.Run("no tokens should send the block info", func(t *testing.T) {
+		chunks, err := receiveAll("erd1empty")
+		require.Nil(t, err)
+		require.Len(t, chunks, 1)
+		assert.Empty(t, chunks[0].Tokens)
+		assert.Equal(t, uint64(37), chunks[0].BlockInfo.Nonce)
+	})
+	t.Run("should work", func(t *testing.T) {
+		chunks, err := receiveAll("erd1")
+		require.Nil(t, err)
+		require.Len(t, chunks, 1)
+		require.Len(t, chunks[0].Tokens, 2)
+		assert.Equal(t, "TKN-aaaaaa", chunks[0].Tokens[0].Identifier)
+		assert.Equal(t, big.NewInt(1), chunks[0].Tokens[0].Token.Value)
+		assert.Equal(t, "TKN-bbbbbb", chunks[0].Tokens[1].Identifier)
+	})
+}
+
+func TestNodeApiServer_GetBlocks(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetInternalShardBlockByHashCalled: func(format common.ApiOutputFormat, hash string) (interface{}, error) {
+			assert.Equal(t, common.ApiOutputFormatJSON, format)
+			assert.Equal(t, "abcd", hash)
+			return &block.HeaderV2{Header: &block.Header{Nonce: 5}}, nil
+		},
+		GetInternalShardBlockByNonceCalled: func(_ common.ApiOutputFormat, nonce uint64) (interface{}, error) {
+			return &block.Header{Nonce: nonce}, nil
+		},
+		GetInternalMetaBlockByNonceCalled: func(_ common.ApiOutputFormat, nonce uint64) (interface{}, error) {
+			return &block.MetaBlock{Nonce: nonce, Epoch: 2}, nil
+		},
+		GetInternalMetaBlockByHashCalled: func(_ common.ApiOutputFormat, _ string) (interface{}, error) {
+			return []byte("unexpected"), nil
+		},
+	}
+	client := startServerAndClient(t, facade)
+
+	shardBlock, err := client.GetShardBlock(context.Background(), &grpcapi.BlockRequest{Nonce: 3, Hash: "abcd"})
+	require.Nil(t, err)
+	assert.Nil(t, shardBlock.Header)
+	assert.Equal(t, uint64(5), shardBlock.HeaderV2.Header.Nonce)
+
+	shardBlock, err = client.GetShardBlock(context.Background(), &grpcapi.BlockRequest{Nonce: 3})
+	require.Nil(t, err)
+	assert.Nil(t, shardBlock.HeaderV2)
+	assert.Equal(t, uint64(3), shardBlock.Header.Nonce)
+
+	metaBlock, err := client.GetMetaBlock(context.Background(), &grpcapi.BlockRequest{Nonce: 7})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), metaBlock.Nonce)
+	assert.Equal(t, uint32(2), metaBlock.Epoch)
+
+	_, err = client.GetMetaBlock(context.Background(), &grpcapi.BlockRequest{Hash: "abcd"})
+	requireStatusError(t, err, codes.Internal, grpcapi.ErrUnexpectedBlockType)
+}
+
+func TestNodeApiServer_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			assert.True(t, withResults)
+			return &transaction.ApiTransactionResult{
+				Hash:   hash,
+				Nonce:  3,
+				Status: transaction.TxStatusSuccess,
+			}, nil
+		},
+	}
+	client := startServerAndClient(t, facade)
+
+	_, err := client.GetTransaction(context.Background(), &grpcapi.TransactionRequest{})
+	requireStatusError(t, err, codes.InvalidArgument, apiErrors.ErrValidationEmptyTxHash)
+
+	response, err := client.GetTransaction(context.Background(), &grpcapi.TransactionRequest{Hash: "abcd", WithResults: true})
+	require.Nil(t, err)
+	assert.Equal(t, "abcd", response.Hash)
+	assert.Equal(t, uint64(3), response.Nonce)
+	assert.Equal(t, string(transaction.TxStatusSuccess), response.Status)
+}
+
+func TestNodeApiServer_SendTransactions(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	txs := []*transaction.Transaction{
+		{Nonce: 1, Value: big.NewInt(10), SndAddr: []byte("sender"), RcvAddr: []byte("receiver")},
+		{Nonce: 2, Value: big.NewInt(20), SndAddr: []byte("sender"), RcvAddr: []byte("receiver")},
+	}
+
+	t.Run("invalid transaction should error", func(t *testing.T) {
+		facade := &mock.FacadeStub{
+			ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+				if tx.Nonce == 2 {
+					return expectedErr
+				}
+				return nil
+			},
+			SendBulkTransactionsHandler: func(_ []*transaction.Transaction) (uint64, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, nil
+			},
+		}
+		client := startServerAndClient(t, facade)
+
+		_, err := client.SendTransactions(context.Background(), &grpcapi.SendTransactionsRequest{Transactions: txs})
+		requireStatusError(t, err, codes.InvalidArgument, expectedErr)
+		assert.Contains(t, err.Error(), "for transaction 1")
+	})
+	t.Run("should work", func(t *testing.T) {
+		facade := &mock.FacadeStub{
+			ValidateTransactionHandler: func(_ *transaction.Transaction) error {
+				return nil
+			},
+			SendBulkTransactionsHandler: func(sentTxs []*transaction.Transaction) (uint64, error) {
+				require.Len(t, sentTxs, 2)
+				assert.Equal(t, big.NewInt(20), sentTxs[1].Value)
+				return uint64(len(sentTxs)), nil
+			},
+		}
+		client := startServerAndClient(t, facade)
+
+		response, err := client.SendTransactions(context.Background(), &grpcapi.SendTransactionsRequest{Transactions: txs})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), response.NumSent)
+
+		marshalizer := &marshal.GogoProtoMarshalizer{}
+		hasher := &hashingMocks.HasherMock{}
+		for i, tx := range txs {
+			expectedHash, _ := core.CalculateHash(marshalizer, hasher, tx)
+			assert.Equal(t, expectedHash, response.TxHashes[i])
+		}
+	})
+}
+
+func TestNodeApiServer_QueryVmValue(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, error) {
+			assert.Equal(t, []byte("sc"), query.ScAddress)
+			assert.Equal(t, []byte("caller"), query.CallerAddr)
+			assert.Equal(t, big.NewInt(5), query.CallValue)
+			assert.Equal(t, [][]byte{[]byte("arg")}, query.Arguments)
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{[]byte("result")},
+				ReturnCode: "ok",
+			}, nil
+		},
+	}
+	client := startServerAndClient(t, facade)
+
+	_, err := client.QueryVmValue(context.Background(), &grpcapi.VmValueRequest{ScAddress: "not hex"})
+	requireStatusError(t, err, codes.InvalidArgument, apiErrors.ErrQueryError)
+
+	response, err := client.QueryVmValue(context.Background(), &grpcapi.VmValueRequest{
+		ScAddress:  hex.EncodeToString([]byte("sc")),
+		FuncName:   "get",
+		CallerAddr: hex.EncodeToString([]byte("caller")),
+		CallValue:  "5",
+		Args:       [][]byte{[]byte("arg")},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("result")}, response.ReturnData)
+	assert.Equal(t, "ok", response.ReturnCode)
+}
+
+func TestNodeApiServer_GetProof(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetProofCalled: func(rootHash string, address string) (*common.GetProofResponse, error) {
+			return &common.GetProofResponse{
+				Proof:    [][]byte{[]byte("node")},
+				Value:    []byte(address),
+				RootHash: rootHash,
+			}, nil
+		},
+	}
+	client := startServerAndClient(t, facade)
+
+	_, err := client.GetProof(context.Background(), &grpcapi.ProofRequest{Address: "erd1"})
+	requireStatusError(t, err, codes.InvalidArgument, apiErrors.ErrValidationEmptyRootHash)
+
+	_, err = client.GetProof(context.Background(), &grpcapi.ProofRequest{RootHash: "abcd"})
+	requireStatusError(t, err, codes.InvalidArgument, apiErrors.ErrValidationEmptyAddress)
+
+	response, err := client.GetProof(context.Background(), &grpcapi.ProofRequest{RootHash: "abcd", Address: "erd1"})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("node")}, response.Proof)
+	assert.Equal(t, []byte("erd1"), response.Value)
+	assert.Equal(t, "abcd", response.RootHash)
+}
+
+func TestNodeApiServer_GetNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{
+				ConfigMetricsCalled: func() (map[string]interface{}, error) {
+					return map[string]interface{}{
+						common.MetricChainId:                   "T",
+						common.MetricNumShardsWithoutMetachain: uint32(3),
+					}, nil
+				},
+			}
+		},
+	}
+	client := startServerAndClient(t, facade)
+
+	response, err := client.GetNetworkConfig(context.Background(), &grpcapi.NetworkConfigRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		common.MetricChainId:                   "T",
+		common.MetricNumShardsWithoutMetachain: "3",
+	}, response.Metrics)
+}
+
+func TestNodeApiServer_Authentication(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountCalled: func(address string, _ api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			return api.AccountResponse{Address: address}, api.BlockInfo{}, nil
+		},
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{
+				ConfigMetricsCalled: func() (map[string]interface{}, error) {
+					return make(map[string]interface{}), nil
+				},
+			}
+		},
+	}
+	args := createMockArgsNewNodeApiServer()
+	args.Facade = facade
+	args.AuthenticationConfig = createAuthenticationConfig()
+	client := startServerAndClientWithArgs(t, args)
+
+	t.Run("public group without key should work", func(t *testing.T) {
+		_, err := client.GetNetworkConfig(context.Background(), &grpcapi.NetworkConfigRequest{})
+		assert.Nil(t, err)
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		_, err := client.GetAccount(context.Background(), &grpcapi.AccountRequest{Address: "erd1"})
+		requireStatusError(t, err, codes.Unauthenticated, middleware.ErrMissingApiKey)
+	})
+	t.Run("missing key on stream should error", func(t *testing.T) {
+		stream, err := client.GetKeyValuePairs(context.Background(), &grpcapi.AccountRequest{Address: "erd1"})
+		require.Nil(t, err)
+
+		_, err = stream.Recv()
+		requireStatusError(t, err, codes.Unauthenticated, middleware.ErrMissingApiKey)
+	})
+	t.Run("static key should work", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), middleware.ApiKeyHeader, "partner-key")
+		response, err := client.GetAccount(ctx, &grpcapi.AccountRequest{Address: "erd1"})
+		require.Nil(t, err)
+		assert.Equal(t, "erd1", response.Address)
+	})
+	t.Run("group not allowed should error", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), middleware.ApiKeyHeader, "partner-key")
+		_, err := client.GetMetaBlock(ctx, &grpcapi.BlockRequest{Nonce: 1})
+		requireStatusError(t, err, codes.PermissionDenied, middleware.ErrRouteGroupNotAllowed)
+	})
+	t.Run("signed call should work", func(t *testing.T) {
+		request := &grpcapi.AccountRequest{Address: "erd1"}
+		ctx := createSignedContext(request, "/proto.NodeApi/GetAccount", time.Now().Unix())
+		response, err := client.GetAccount(ctx, request)
+		require.Nil(t, err)
+		assert.Equal(t, "erd1", response.Address)
+	})
+	t.Run("signed call with another request should error", func(t *testing.T) {
+		ctx := createSignedContext(&grpcapi.AccountRequest{Address: "erd1"}, "/proto.NodeApi/GetAccount", time.Now().Unix()-1)
+		_, err := client.GetAccount(ctx, &grpcapi.AccountRequest{Address: "erd2"})
+		requireStatusError(t, err, codes.Unauthenticated, middleware.ErrInvalidSignature)
+	})
+	t.Run("signed stream should work", func(t *testing.T) {
+		request := &grpcapi.AccountRequest{Address: "erd1"}
+		ctx := createSignedContext(request, "/proto.NodeApi/GetKeyValuePairs", time.Now().Unix())
+		stream, err := client.GetKeyValuePairs(ctx, request)
+		require.Nil(t, err)
+
+		_, err = stream.Recv()
+		assert.Nil(t, err)
+	})
+}
+
+func TestNodeApiServer_ThrottlingShouldRejectTheCallsOverTheLimit(t *testing.T) {
+	t.Parallel()
+
+	startedProcessing := make(chan struct{})
+	finishProcessing := make(chan struct{})
+	facade := &mock.FacadeStub{
+		GetAccountCalled: func(address string, _ api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			close(startedProcessing)
+			<-finishProcessing
+			return api.AccountResponse{Address: address}, api.BlockInfo{}, nil
+		},
+	}
+	args := createMockArgsNewNodeApiServer()
+	args.Facade = facade
+	args.AntiFloodConfig.SimultaneousRequests = 1
+	client := startServerAndClientWithArgs(t, args)
+
+	errFirstCall := make(chan error)
+	go func() {
+		_, err := client.GetAccount(context.Background(), &grpcapi.AccountRequest{Address: "erd1"})
+		errFirstCall <- err
+	}()
+	<-startedProcessing
+
+	_, err := client.GetProof(context.Background(), &grpcapi.ProofRequest{RootHash: "ef01", Address: "erd1"})
+	requireStatusError(t, err, codes.ResourceExhausted, middleware.ErrTooManyRequests)
+
+	close(finishProcessing)
+	assert.Nil(t, <-errFirstCall)
+}
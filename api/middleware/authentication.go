@@ -0,0 +1,373 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ApiKeyHeader is the header holding the API key, or the key identifier for the HMAC signed requests
+	ApiKeyHeader = "X-Api-Key"
+	// ApiTimestampHeader is the header holding the unix timestamp, in seconds, of a HMAC signed request
+	ApiTimestampHeader = "X-Api-Timestamp"
+	// ApiSignatureHeader is the header holding the hex encoded HMAC-SHA256 signature of a request
+	ApiSignatureHeader = "X-Api-Signature"
+
+	allGroupsWildcard = "*"
+)
+
+// ApiRequest holds the parts of a request checked by the authenticator. ReadBody is only called for the HMAC signed
+// requests
+type ApiRequest struct {
+	Group      string
+	Method     string
+	RequestURI string
+	ApiKey     string
+	Timestamp  string
+	Signature  string
+	ReadBody   func() ([]byte, error)
+}
+
+type apiKey struct {
+	name          string
+	secret        []byte
+	allowedGroups map[string]struct{}
+	quotaBucket   *quotaBucket
+}
+
+type quotaBucket struct {
+	mutBucket     sync.Mutex
+	maxRequests   uint32
+	resetInterval time.Duration
+	windowStart   time.Time
+	numRequests   uint32
+}
+
+// seenSignatures holds the signatures of the authenticated requests until their timestamps expire, so that a signed
+// request can not be replayed while its timestamp is still accepted
+type seenSignatures struct {
+	mutSignatures sync.Mutex
+	expiryTimes   map[string]time.Time
+	lastSweep     time.Time
+}
+
+// authenticator is a middleware which authenticates the requests by static API keys or HMAC signatures, allowing
+// each key to reach only its configured route groups, within the limits of its quota bucket
+type authenticator struct {
+	keys           map[string]*apiKey
+	publicGroups   map[string]struct{}
+	maxRequestAge  time.Duration
+	seenSignatures *seenSignatures
+}
+
+// NewAuthenticator creates a new instance of an authenticator
+func NewAuthenticator(cfg config.ApiAuthenticationConfig) (*authenticator, error) {
+	buckets, err := createQuotaBuckets(cfg.QuotaBuckets)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*apiKey, len(cfg.Keys))
+	for _, keyConfig := range cfg.Keys {
+		if len(keyConfig.Key) == 0 {
+			return nil, fmt.Errorf("%w for key %s", ErrEmptyApiKey, keyConfig.Name)
+		}
+		_, exists := keys[keyConfig.Key]
+		if exists {
+			return nil, fmt.Errorf("%w for key %s", ErrDuplicatedApiKey, keyConfig.Name)
+		}
+
+		key := &apiKey{
+			name:          keyConfig.Name,
+			secret:        []byte(keyConfig.Secret),
+			allowedGroups: sliceToSet(keyConfig.AllowedGroups),
+		}
+		if len(keyConfig.QuotaBucket) > 0 {
+			key.quotaBucket, exists = buckets[keyConfig.QuotaBucket]
+			if !exists {
+				return nil, fmt.Errorf("%w: %s, for key %s", ErrUnknownQuotaBucket, keyConfig.QuotaBucket, keyConfig.Name)
+			}
+		}
+
+		keys[keyConfig.Key] = key
+	}
+
+	isHmacUsed := false
+	for _, key := range keys {
+		isHmacUsed = isHmacUsed || len(key.secret) > 0
+	}
+	if isHmacUsed && cfg.MaxRequestAgeInSeconds == 0 {
+		return nil, ErrInvalidMaxRequestAge
+	}
+
+	return &authenticator{
+		keys:          keys,
+		publicGroups:  sliceToSet(cfg.PublicGroups),
+		maxRequestAge: time.Duration(cfg.MaxRequestAgeInSeconds) * time.Second,
+		seenSignatures: &seenSignatures{
+			expiryTimes: make(map[string]time.Time),
+		},
+	}, nil
+}
+
+func createQuotaBuckets(bucketsConfig []config.ApiQuotaBucketConfig) (map[string]*quotaBucket, error) {
+	buckets := make(map[string]*quotaBucket, len(bucketsConfig))
+	for _, bucketConfig := range bucketsConfig {
+		if bucketConfig.MaxRequests == 0 || bucketConfig.ResetIntervalInSeconds == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidQuotaBucket, bucketConfig.Name)
+		}
+		_, exists := buckets[bucketConfig.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedQuotaBucket, bucketConfig.Name)
+		}
+
+		buckets[bucketConfig.Name] = &quotaBucket{
+			maxRequests:   bucketConfig.MaxRequests,
+			resetInterval: time.Duration(bucketConfig.ResetIntervalInSeconds) * time.Second,
+		}
+	}
+
+	return buckets, nil
+}
+
+func sliceToSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (a *authenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request := ApiRequest{
+			Group:      getRouteGroup(c.Request.URL.Path),
+			Method:     c.Request.Method,
+			RequestURI: c.Request.URL.RequestURI(),
+			ApiKey:     c.GetHeader(ApiKeyHeader),
+			Timestamp:  c.GetHeader(ApiTimestampHeader),
+			Signature:  c.GetHeader(ApiSignatureHeader),
+			ReadBody: func() ([]byte, error) {
+				return readAndRestoreBody(c.Request)
+			},
+		}
+
+		err := a.AuthenticateRequest(request)
+		switch {
+		case err == nil:
+			c.Next()
+		case errors.Is(err, ErrRouteGroupNotAllowed):
+			abortWithError(c, http.StatusForbidden, err, shared.ReturnCodeRequestError)
+		case errors.Is(err, ErrQuotaExceeded):
+			abortWithError(c, http.StatusTooManyRequests, err, shared.ReturnCodeSystemBusy)
+		default:
+			abortWithError(c, http.StatusUnauthorized, err, shared.ReturnCodeRequestError)
+		}
+	}
+}
+
+// AuthenticateRequest checks the credentials of a request, whether its key can reach the request route group and
+// whether the quota of its key is not yet reached. The requests without a key can only reach the public groups
+func (a *authenticator) AuthenticateRequest(request ApiRequest) error {
+	if len(request.ApiKey) == 0 {
+		if a.isPublicGroup(request.Group) {
+			return nil
+		}
+
+		return ErrMissingApiKey
+	}
+
+	key, err := a.authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	if !key.isGroupAllowed(request.Group) && !a.isPublicGroup(request.Group) {
+		return fmt.Errorf("%w: %s", ErrRouteGroupNotAllowed, request.Group)
+	}
+
+	if !key.quotaBucket.canProcess(time.Now()) {
+		return fmt.Errorf("%w for key %s", ErrQuotaExceeded, key.name)
+	}
+
+	return nil
+}
+
+func (a *authenticator) authenticate(request ApiRequest) (*apiKey, error) {
+	key, exists := a.keys[request.ApiKey]
+	if !exists {
+		return nil, ErrInvalidApiKey
+	}
+	if len(key.secret) == 0 {
+		return key, nil
+	}
+
+	now := time.Now()
+	requestTime, err := a.checkTimestamp(request.Timestamp, now)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := request.ReadBody()
+	if err != nil {
+		return nil, err
+	}
+
+	expectedSignature := ComputeRequestSignature(key.secret, request.Method, request.RequestURI, request.Timestamp, body)
+	signature := request.Signature
+	if subtle.ConstantTimeCompare([]byte(expectedSignature), []byte(strings.ToLower(signature))) != 1 {
+		return nil, ErrInvalidSignature
+	}
+
+	isFirstSeen := a.seenSignatures.markAsSeen(expectedSignature, requestTime.Add(a.maxRequestAge), now, a.maxRequestAge)
+	if !isFirstSeen {
+		return nil, ErrReplayedRequest
+	}
+
+	return key, nil
+}
+
+func (a *authenticator) checkTimestamp(timestamp string, now time.Time) (time.Time, error) {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidRequestTimestamp, timestamp)
+	}
+
+	requestTime := time.Unix(seconds, 0)
+	age := now.Sub(requestTime)
+	if age < 0 {
+		age = -age
+	}
+	if age > a.maxRequestAge {
+		return time.Time{}, ErrRequestExpired
+	}
+
+	return requestTime, nil
+}
+
+// markAsSeen records the signature until its expiry time, returning false if it was already recorded. The expired
+// signatures are swept at most once per sweep interval
+func (ss *seenSignatures) markAsSeen(signature string, expiryTime time.Time, now time.Time, sweepInterval time.Duration) bool {
+	ss.mutSignatures.Lock()
+	defer ss.mutSignatures.Unlock()
+
+	if now.Sub(ss.lastSweep) >= sweepInterval {
+		ss.lastSweep = now
+		for seenSignature, seenExpiryTime := range ss.expiryTimes {
+			if now.After(seenExpiryTime) {
+				delete(ss.expiryTimes, seenSignature)
+			}
+		}
+	}
+
+	_, isSeen := ss.expiryTimes[signature]
+	if isSeen {
+		return false
+	}
+
+	ss.expiryTimes[signature] = expiryTime
+	return true
+}
+
+func readAndRestoreBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil {
+		return make([]byte, 0), nil
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// ComputeRequestSignature computes the hex encoded HMAC-SHA256 signature of a request, over its method, its request
+// URI (the path and the raw query), its timestamp and the SHA256 hash of its body, separated by new lines
+func ComputeRequestSignature(secret []byte, method string, requestURI string, timestamp string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	payload := strings.Join([]string{method, requestURI, timestamp, hex.EncodeToString(bodyHash[:])}, "\n")
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (a *authenticator) isPublicGroup(group string) bool {
+	_, isPublic := a.publicGroups[group]
+	return isPublic
+}
+
+func (key *apiKey) isGroupAllowed(group string) bool {
+	_, allowAll := key.allowedGroups[allGroupsWildcard]
+	_, isAllowed := key.allowedGroups[group]
+
+	return allowAll || isAllowed
+}
+
+// canProcess returns true if the quota of the current time window is not yet reached. A nil bucket means no quota
+func (qb *quotaBucket) canProcess(now time.Time) bool {
+	if qb == nil {
+		return true
+	}
+
+	qb.mutBucket.Lock()
+	defer qb.mutBucket.Unlock()
+
+	if now.Sub(qb.windowStart) >= qb.resetInterval {
+		qb.windowStart = now
+		qb.numRequests = 0
+	}
+	if qb.numRequests >= qb.maxRequests {
+		return false
+	}
+
+	qb.numRequests++
+	return true
+}
+
+// getRouteGroup returns the route group of a path, which is its first segment
+func getRouteGroup(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	idx := strings.Index(path, "/")
+	if idx < 0 {
+		return path
+	}
+
+	return path[:idx]
+}
+
+func abortWithError(c *gin.Context, status int, err error, code shared.ReturnCode) {
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  code,
+		},
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *authenticator) IsInterfaceNil() bool {
+	return a == nil
+}
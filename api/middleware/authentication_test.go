@@ -0,0 +1,260 @@
+package middleware_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAuthenticationConfig() config.ApiAuthenticationConfig {
+	return config.ApiAuthenticationConfig{
+		Enabled:                true,
+		MaxRequestAgeInSeconds: 30,
+		PublicGroups:           []string{"network"},
+		QuotaBuckets: []config.ApiQuotaBucketConfig{
+			{Name: "partners", MaxRequests: 2, ResetIntervalInSeconds: 3600},
+		},
+		Keys: []config.ApiKeyConfig{
+			{Name: "partner", Key: "partner-key", AllowedGroups: []string{"address"}, QuotaBucket: "partners"},
+			{Name: "internal", Key: "internal-key", Secret: "secret", AllowedGroups: []string{"*"}},
+		},
+	}
+}
+
+func startNodeServerWithAuthenticator(t *testing.T, cfg config.ApiAuthenticationConfig) *gin.Engine {
+	authenticator, err := middleware.NewAuthenticator(cfg)
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.Use(authenticator.MiddlewareHandlerFunc())
+	ws.Handle(http.MethodGet, "/network/config", func(c *gin.Context) {})
+	ws.Handle(http.MethodGet, "/address/:address", func(c *gin.Context) {})
+	ws.Handle(http.MethodPost, "/transaction/send", func(c *gin.Context) {
+		body := new(bytes.Buffer)
+		_, _ = body.ReadFrom(c.Request.Body)
+		c.String(http.StatusOK, body.String())
+	})
+
+	return ws
+}
+
+func serveRequest(ws *gin.Engine, req *http.Request) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func createSignedRequest(method string, url string, body string, secret string, timestamp int64) *http.Request {
+	req, _ := http.NewRequest(method, url, strings.NewReader(body))
+	timestampStr := strconv.FormatInt(timestamp, 10)
+	signature := middleware.ComputeRequestSignature([]byte(secret), method, req.URL.RequestURI(), timestampStr, []byte(body))
+	req.Header.Set(middleware.ApiKeyHeader, "internal-key")
+	req.Header.Set(middleware.ApiTimestampHeader, timestampStr)
+	req.Header.Set(middleware.ApiSignatureHeader, signature)
+
+	return req
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty key should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.Keys[0].Key = ""
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.True(t, errors.Is(err, middleware.ErrEmptyApiKey))
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.Keys[1].Key = cfg.Keys[0].Key
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.True(t, errors.Is(err, middleware.ErrDuplicatedApiKey))
+	})
+	t.Run("unknown quota bucket should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.Keys[0].QuotaBucket = "unknown"
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.True(t, errors.Is(err, middleware.ErrUnknownQuotaBucket))
+	})
+	t.Run("invalid quota bucket should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.QuotaBuckets[0].MaxRequests = 0
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.True(t, errors.Is(err, middleware.ErrInvalidQuotaBucket))
+	})
+	t.Run("duplicated quota bucket should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.QuotaBuckets = append(cfg.QuotaBuckets, cfg.QuotaBuckets[0])
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.True(t, errors.Is(err, middleware.ErrDuplicatedQuotaBucket))
+	})
+	t.Run("signed keys without maximum request age should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.MaxRequestAgeInSeconds = 0
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.Equal(t, middleware.ErrInvalidMaxRequestAge, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		authenticator, err := middleware.NewAuthenticator(createAuthenticationConfig())
+		assert.False(t, check.IfNil(authenticator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAuthenticator_StaticKeys(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWithAuthenticator(t, createAuthenticationConfig())
+
+	// public group
+	req, _ := http.NewRequest(http.MethodGet, "/network/config", nil)
+	assert.Equal(t, http.StatusOK, serveRequest(ws, req).Code)
+
+	// missing key
+	req, _ = http.NewRequest(http.MethodGet, "/address/erd1", nil)
+	assert.Equal(t, http.StatusUnauthorized, serveRequest(ws, req).Code)
+
+	// unknown key
+	req.Header.Set(middleware.ApiKeyHeader, "unknown-key")
+	assert.Equal(t, http.StatusUnauthorized, serveRequest(ws, req).Code)
+
+	// not allowed group
+	req, _ = http.NewRequest(http.MethodPost, "/transaction/send", nil)
+	req.Header.Set(middleware.ApiKeyHeader, "partner-key")
+	assert.Equal(t, http.StatusForbidden, serveRequest(ws, req).Code)
+
+	// allowed group, within the quota
+	req, _ = http.NewRequest(http.MethodGet, "/address/erd1", nil)
+	req.Header.Set(middleware.ApiKeyHeader, "partner-key")
+	assert.Equal(t, http.StatusOK, serveRequest(ws, req).Code)
+
+	// a public group with a key counts against the quota
+	req, _ = http.NewRequest(http.MethodGet, "/network/config", nil)
+	req.Header.Set(middleware.ApiKeyHeader, "partner-key")
+	assert.Equal(t, http.StatusOK, serveRequest(ws, req).Code)
+
+	// quota exhausted
+	req, _ = http.NewRequest(http.MethodGet, "/address/erd1", nil)
+	req.Header.Set(middleware.ApiKeyHeader, "partner-key")
+	resp := serveRequest(ws, req)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.True(t, strings.Contains(resp.Body.String(), middleware.ErrQuotaExceeded.Error()))
+}
+
+func TestAuthenticator_SignedRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWithAuthenticator(t, createAuthenticationConfig())
+	now := time.Now().Unix()
+	body := `{"nonce":1}`
+
+	t.Run("valid signature should work and keep the body", func(t *testing.T) {
+		req := createSignedRequest(http.MethodPost, "/transaction/send?checkSignature=true", body, "secret", now)
+		resp := serveRequest(ws, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, body, resp.Body.String())
+	})
+	t.Run("wrong secret should error", func(t *testing.T) {
+		req := createSignedRequest(http.MethodPost, "/transaction/send", body, "wrong secret", now)
+		resp := serveRequest(ws, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.True(t, strings.Contains(resp.Body.String(), middleware.ErrInvalidSignature.Error()))
+	})
+	t.Run("tampered query should error", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/address/erd1?onFinalBlock=true", "", "secret", now)
+		req.URL.RawQuery = "onFinalBlock=false"
+		assert.Equal(t, http.StatusUnauthorized, serveRequest(ws, req).Code)
+	})
+	t.Run("expired request should error", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/address/erd1", "", "secret", now-60)
+		resp := serveRequest(ws, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.True(t, strings.Contains(resp.Body.String(), middleware.ErrRequestExpired.Error()))
+	})
+	t.Run("missing timestamp should error", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/address/erd1", "", "secret", now)
+		req.Header.Del(middleware.ApiTimestampHeader)
+		assert.Equal(t, http.StatusUnauthorized, serveRequest(ws, req).Code)
+	})
+	t.Run("replayed request should error", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/address/erd1?replayed=true", "", "secret", now)
+		assert.Equal(t, http.StatusOK, serveRequest(ws, req).Code)
+
+		req = createSignedRequest(http.MethodGet, "/address/erd1?replayed=true", "", "secret", now)
+		resp := serveRequest(ws, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.True(t, strings.Contains(resp.Body.String(), middleware.ErrReplayedRequest.Error()))
+
+		req = createSignedRequest(http.MethodGet, "/address/erd1?replayed=true", "", "secret", now+1)
+		assert.Equal(t, http.StatusOK, serveRequest(ws, req).Code)
+	})
+}
+
+func TestAuthenticator_AuthenticateRequest(t *testing.T) {
+	t.Parallel()
+
+	authenticator, err := middleware.NewAuthenticator(createAuthenticationConfig())
+	require.Nil(t, err)
+
+	t.Run("public group without key should work", func(t *testing.T) {
+		assert.Nil(t, authenticator.AuthenticateRequest(middleware.ApiRequest{Group: "network"}))
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		err := authenticator.AuthenticateRequest(middleware.ApiRequest{Group: "address"})
+		assert.Equal(t, middleware.ErrMissingApiKey, err)
+	})
+	t.Run("group not allowed should error", func(t *testing.T) {
+		err := authenticator.AuthenticateRequest(middleware.ApiRequest{Group: "block", ApiKey: "partner-key"})
+		assert.True(t, errors.Is(err, middleware.ErrRouteGroupNotAllowed))
+	})
+	t.Run("signed request should read the body", func(t *testing.T) {
+		body := []byte("body")
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		request := middleware.ApiRequest{
+			Group:      "transaction",
+			Method:     http.MethodPost,
+			RequestURI: "/proto.NodeApi/SendTransactions",
+			ApiKey:     "internal-key",
+			Timestamp:  timestamp,
+			Signature:  middleware.ComputeRequestSignature([]byte("secret"), http.MethodPost, "/proto.NodeApi/SendTransactions", timestamp, body),
+			ReadBody: func() ([]byte, error) {
+				return body, nil
+			},
+		}
+		assert.Nil(t, authenticator.AuthenticateRequest(request))
+
+		expectedErr := errors.New("expected error")
+		request.Signature = "other signature"
+		request.ReadBody = func() ([]byte, error) {
+			return nil, expectedErr
+		}
+		assert.Equal(t, expectedErr, authenticator.AuthenticateRequest(request))
+	})
+	t.Run("quota exceeded should error", func(t *testing.T) {
+		request := middleware.ApiRequest{Group: "address", ApiKey: "partner-key"}
+		assert.Nil(t, authenticator.AuthenticateRequest(request))
+		assert.Nil(t, authenticator.AuthenticateRequest(request))
+
+		err := authenticator.AuthenticateRequest(request)
+		assert.True(t, errors.Is(err, middleware.ErrQuotaExceeded))
+	})
+}
@@ -7,3 +7,45 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrMissingApiKey signals that a request to a not public route group does not hold an API key
+var ErrMissingApiKey = errors.New("missing API key")
+
+// ErrInvalidApiKey signals that a request holds an unknown API key
+var ErrInvalidApiKey = errors.New("invalid API key")
+
+// ErrInvalidSignature signals that the signature of a request does not match its content
+var ErrInvalidSignature = errors.New("invalid request signature")
+
+// ErrInvalidRequestTimestamp signals that the timestamp of a signed request is missing or is not valid
+var ErrInvalidRequestTimestamp = errors.New("invalid request timestamp")
+
+// ErrRequestExpired signals that the timestamp of a signed request is too far from the current time
+var ErrRequestExpired = errors.New("request expired")
+
+// ErrReplayedRequest signals that a signed request was already authenticated
+var ErrReplayedRequest = errors.New("replayed request")
+
+// ErrRouteGroupNotAllowed signals that an API key is not allowed to reach the requested route group
+var ErrRouteGroupNotAllowed = errors.New("route group not allowed for the API key")
+
+// ErrQuotaExceeded signals that the quota bucket of an API key is exhausted
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// ErrEmptyApiKey signals that an API key is configured with an empty value
+var ErrEmptyApiKey = errors.New("empty API key")
+
+// ErrDuplicatedApiKey signals that the same API key is configured more than once
+var ErrDuplicatedApiKey = errors.New("duplicated API key")
+
+// ErrUnknownQuotaBucket signals that an API key references a quota bucket which is not configured
+var ErrUnknownQuotaBucket = errors.New("unknown quota bucket")
+
+// ErrInvalidQuotaBucket signals that a quota bucket is configured with invalid limits
+var ErrInvalidQuotaBucket = errors.New("invalid quota bucket")
+
+// ErrDuplicatedQuotaBucket signals that the same quota bucket is configured more than once
+var ErrDuplicatedQuotaBucket = errors.New("duplicated quota bucket")
+
+// ErrInvalidMaxRequestAge signals that the maximum age of the signed requests is not valid
+var ErrInvalidMaxRequestAge = errors.New("invalid maximum request age")
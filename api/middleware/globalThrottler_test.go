@@ -112,3 +112,15 @@ func makeRequestGlobalThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestGlobalThrottler_StartAndEndProcessing(t *testing.T) {
+	t.Parallel()
+
+	gt, _ := middleware.NewGlobalThrottler(1)
+
+	assert.True(t, gt.StartProcessing("/proto.NodeApi/GetAccount"))
+	assert.False(t, gt.StartProcessing("/proto.NodeApi/GetAccount"))
+
+	gt.EndProcessing("/proto.NodeApi/GetAccount")
+	assert.True(t, gt.StartProcessing("/proto.NodeApi/GetProof"))
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 
@@ -35,6 +36,11 @@ type FacadeStub struct {
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler                  func() (map[string]*state.ValidatorApiResponse, error)
+	GetEquivocationsCalled                      func(publicKey string) ([]*common.EquivocationEvidence, error)
+	GetConsensusRoundCalled                     func(round int64) (*common.ConsensusRoundTrace, error)
+	GetConsensusRoundsCalled                    func(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error)
+	GetConsensusScheduleCalled                  func(startRound int64, endRound int64) (*common.ConsensusSchedule, error)
+	GetValidatorLeaderScheduleCalled            func(publicKey string) (*common.ValidatorLeaderSchedule, error)
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	NodeConfigCalled                            func() map[string]interface{}
 	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
@@ -44,15 +50,25 @@ type FacadeStub struct {
 	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPageCalled                  func(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error)
+	IterateKeyValuePairsCalled                  func(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error)
 	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBatchExecutionCalled    func(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error)
+	GetTransactionStateChangesCalled            func(txHash string) ([]*common.AccountStateChange, error)
+	GetTransactionFinalityCalled                func(tx *transaction.ApiTransactionResult) string
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetESDTTokensPageCalled                     func(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error)
+	IterateESDTTokensCalled                     func(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error)
+	GetAccountHistoryCalled                     func(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error)
 	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetESDTsRolesCalled                         func(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
 	GetNFTTokenIDsRegisteredByAddressCalled     func(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByHashCalled                   func(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	GetHyperblockByNonceCalled                  func(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
 	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
 	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
 	GetInternalShardBlockByRoundCalled          func(format common.ApiOutputFormat, round uint64) (interface{}, error)
@@ -76,6 +92,11 @@ type FacadeStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionPoolHistoryCalled             func(txHash string) (*common.TransactionPoolHistoryApiResponse, error)
+	GetTransactionsPoolEvictionsCalled          func(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetGasPriceSuggestionCalled                 func(numBlocks int) (*common.GasPriceSuggestionApiResponse, error)
+	GetTransactionsByAddressCalled              func(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error)
+	GetEventsCalled                             func(options common.EventsQueryOptions) (*common.EventsApiResponse, error)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
 }
 
@@ -185,6 +206,24 @@ func (f *FacadeStub) GetKeyValuePairs(address string, options api.AccountQueryOp
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetKeyValuePairsPage -
+func (f *FacadeStub) GetKeyValuePairsPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error) {
+	if f.GetKeyValuePairsPageCalled != nil {
+		return f.GetKeyValuePairsPageCalled(address, pageOptions, options)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
+// IterateKeyValuePairs -
+func (f *FacadeStub) IterateKeyValuePairs(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error) {
+	if f.IterateKeyValuePairsCalled != nil {
+		return f.IterateKeyValuePairsCalled(address, pageOptions, options, ctx, handler)
+	}
+
+	return "", api.BlockInfo{}, nil
+}
+
 // GetESDTData -
 func (f *FacadeStub) GetESDTData(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error) {
 	if f.GetESDTDataCalled != nil {
@@ -212,6 +251,33 @@ func (f *FacadeStub) GetAllESDTTokens(address string, options api.AccountQueryOp
 	return make(map[string]*esdt.ESDigitalToken), api.BlockInfo{}, nil
 }
 
+// GetESDTTokensPage -
+func (f *FacadeStub) GetESDTTokensPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error) {
+	if f.GetESDTTokensPageCalled != nil {
+		return f.GetESDTTokensPageCalled(address, pageOptions, options)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
+// IterateESDTTokens -
+func (f *FacadeStub) IterateESDTTokens(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error) {
+	if f.IterateESDTTokensCalled != nil {
+		return f.IterateESDTTokensCalled(address, pageOptions, options, ctx, handler)
+	}
+
+	return "", api.BlockInfo{}, nil
+}
+
+// GetAccountHistory -
+func (f *FacadeStub) GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error) {
+	if f.GetAccountHistoryCalled != nil {
+		return f.GetAccountHistoryCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // GetNFTTokenIDsRegisteredByAddress -
 func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error) {
 	if f.GetNFTTokenIDsRegisteredByAddressCalled != nil {
@@ -268,11 +334,38 @@ func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction
 	return f.GetTransactionHandler(hash, withResults)
 }
 
+// GetTransactionStateChanges -
+func (f *FacadeStub) GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error) {
+	if f.GetTransactionStateChangesCalled != nil {
+		return f.GetTransactionStateChangesCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionFinality -
+func (f *FacadeStub) GetTransactionFinality(tx *transaction.ApiTransactionResult) string {
+	if f.GetTransactionFinalityCalled != nil {
+		return f.GetTransactionFinalityCalled(tx)
+	}
+
+	return ""
+}
+
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
 func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
 	return f.SimulateTransactionExecutionHandler(tx)
 }
 
+// SimulateTransactionsBatchExecution -
+func (f *FacadeStub) SimulateTransactionsBatchExecution(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error) {
+	if f.SimulateTransactionsBatchExecutionCalled != nil {
+		return f.SimulateTransactionsBatchExecutionCalled(txs)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
@@ -293,6 +386,51 @@ func (f *FacadeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRes
 	return f.ValidatorStatisticsHandler()
 }
 
+// GetEquivocations -
+func (f *FacadeStub) GetEquivocations(publicKey string) ([]*common.EquivocationEvidence, error) {
+	if f.GetEquivocationsCalled != nil {
+		return f.GetEquivocationsCalled(publicKey)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusRound -
+func (f *FacadeStub) GetConsensusRound(round int64) (*common.ConsensusRoundTrace, error) {
+	if f.GetConsensusRoundCalled != nil {
+		return f.GetConsensusRoundCalled(round)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusRounds -
+func (f *FacadeStub) GetConsensusRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error) {
+	if f.GetConsensusRoundsCalled != nil {
+		return f.GetConsensusRoundsCalled(startRound, endRound)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusSchedule -
+func (f *FacadeStub) GetConsensusSchedule(startRound int64, endRound int64) (*common.ConsensusSchedule, error) {
+	if f.GetConsensusScheduleCalled != nil {
+		return f.GetConsensusScheduleCalled(startRound, endRound)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorLeaderSchedule -
+func (f *FacadeStub) GetValidatorLeaderSchedule(publicKey string) (*common.ValidatorLeaderSchedule, error) {
+	if f.GetValidatorLeaderScheduleCalled != nil {
+		return f.GetValidatorLeaderScheduleCalled(publicKey)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	return f.ExecuteSCQueryHandler(query)
@@ -371,6 +509,24 @@ func (f *FacadeStub) GetBlockByRound(round uint64, options api.BlockQueryOptions
 	return nil, nil
 }
 
+// GetHyperblockByHash -
+func (f *FacadeStub) GetHyperblockByHash(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	if f.GetHyperblockByHashCalled != nil {
+		return f.GetHyperblockByHashCalled(hash, options)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (f *FacadeStub) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	if f.GetHyperblockByNonceCalled != nil {
+		return f.GetHyperblockByNonceCalled(nonce, options)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByNonce -
 func (f *FacadeStub) GetInternalMetaBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
 	if f.GetInternalMetaBlockByNonceCalled != nil {
@@ -488,6 +644,51 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionPoolHistory -
+func (f *FacadeStub) GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+	if f.GetTransactionPoolHistoryCalled != nil {
+		return f.GetTransactionPoolHistoryCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolEvictions -
+func (f *FacadeStub) GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+	if f.GetTransactionsPoolEvictionsCalled != nil {
+		return f.GetTransactionsPoolEvictionsCalled(maxNumEvictions)
+	}
+
+	return nil, nil
+}
+
+// GetGasPriceSuggestion -
+func (f *FacadeStub) GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+	if f.GetGasPriceSuggestionCalled != nil {
+		return f.GetGasPriceSuggestionCalled(numBlocks)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (f *FacadeStub) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (f *FacadeStub) GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+	if f.GetEventsCalled != nil {
+		return f.GetEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
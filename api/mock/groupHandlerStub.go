@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+// GroupHandlerStub -
+type GroupHandlerStub struct {
+	UpdateFacadeCalled   func(newFacade interface{}) error
+	RegisterRoutesCalled func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig)
+	GetEndpointsCalled   func() []*shared.EndpointHandlerData
+}
+
+// UpdateFacade -
+func (ghs *GroupHandlerStub) UpdateFacade(newFacade interface{}) error {
+	if ghs.UpdateFacadeCalled != nil {
+		return ghs.UpdateFacadeCalled(newFacade)
+	}
+
+	return nil
+}
+
+// RegisterRoutes -
+func (ghs *GroupHandlerStub) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	if ghs.RegisterRoutesCalled != nil {
+		ghs.RegisterRoutesCalled(ws, apiConfig)
+	}
+}
+
+// GetEndpoints -
+func (ghs *GroupHandlerStub) GetEndpoints() []*shared.EndpointHandlerData {
+	if ghs.GetEndpointsCalled != nil {
+		return ghs.GetEndpointsCalled()
+	}
+
+	return make([]*shared.EndpointHandlerData, 0)
+}
+
+// IsInterfaceNil -
+func (ghs *GroupHandlerStub) IsInterfaceNil() bool {
+	return ghs == nil
+}
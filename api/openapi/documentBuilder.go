@@ -0,0 +1,200 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+const (
+	openAPIVersion  = "3.0.3"
+	documentTitle   = "Elrond node REST API"
+	documentVersion = "1.0.0"
+	jsonContentType = "application/json"
+)
+
+// BuildDocument builds the OpenAPI document describing the endpoints of the provided route groups which are enabled
+// in the API routes config. The groups are keyed by their base path
+func BuildDocument(groups map[string]shared.GroupHandler, apiConfig config.ApiRoutesConfig) *Document {
+	generator := newSchemaGenerator()
+	document := &Document{
+		OpenAPI: openAPIVersion,
+		Info: Info{
+			Title:   documentTitle,
+			Version: documentVersion,
+		},
+		Paths: make(map[string]PathItem),
+		Tags:  make([]Tag, 0, len(groups)),
+	}
+
+	groupNames := make([]string, 0, len(groups))
+	for groupName := range groups {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	for _, groupName := range groupNames {
+		document.Tags = append(document.Tags, Tag{Name: groupName})
+
+		for _, endpoint := range groups[groupName].GetEndpoints() {
+			if !shared.IsEndpointOpen(apiConfig, groupName, endpoint.Path) {
+				continue
+			}
+
+			path := convertPath(fmt.Sprintf("/%s%s", groupName, endpoint.Path))
+			pathItem, exists := document.Paths[path]
+			if !exists {
+				pathItem = make(PathItem)
+				document.Paths[path] = pathItem
+			}
+
+			method := strings.ToLower(endpoint.Method)
+			pathItem[method] = createOperation(generator, groupName, method, path, endpoint.Spec)
+		}
+	}
+
+	document.Components.Schemas = generator.schemas
+
+	return document
+}
+
+// convertPath converts the gin path parameters, like :address, to the OpenAPI ones, like {address}
+func convertPath(ginPath string) string {
+	segments := strings.Split(ginPath, "/")
+	for i, segment := range segments {
+		if isPathParameter(segment) {
+			segments[i] = fmt.Sprintf("{%s}", segment[1:])
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func isPathParameter(segment string) bool {
+	return strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*")
+}
+
+func createOperation(generator *schemaGenerator, groupName string, method string, path string, spec *shared.EndpointSpec) *Operation {
+	if spec == nil {
+		spec = &shared.EndpointSpec{}
+	}
+
+	operation := &Operation{
+		OperationID: createOperationID(method, path),
+		Summary:     spec.Summary,
+		Tags:        []string{groupName},
+		Parameters:  make([]*Parameter, 0),
+		Responses: map[string]*Response{
+			"200": {
+				Description: "successful response",
+				Content:     createResponseContent(createDataSchema(generator, spec.ResponseData)),
+			},
+			"default": {
+				Description: "error response",
+				Content:     createResponseContent(&Schema{Nullable: true}),
+			},
+		},
+	}
+
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") {
+			operation.Parameters = append(operation.Parameters, &Parameter{
+				Name:     strings.Trim(segment, "{}"),
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+	}
+	for _, queryParameter := range spec.QueryParameters {
+		operation.Parameters = append(operation.Parameters, &Parameter{
+			Name:   queryParameter,
+			In:     "query",
+			Schema: &Schema{Type: "string"},
+		})
+	}
+
+	if spec.RequestBody != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				jsonContentType: {Schema: generator.schemaOf(spec.RequestBody)},
+			},
+		}
+	}
+
+	return operation
+}
+
+// createOperationID creates a unique operation identifier from the method and the path, e.g. the identifier of
+// GET /address/{address}/balance is getAddressByAddressBalance
+func createOperationID(method string, path string) string {
+	builder := strings.Builder{}
+	builder.WriteString(method)
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") {
+			builder.WriteString("By")
+			segment = strings.Trim(segment, "{}")
+		}
+
+		for _, word := range strings.FieldsFunc(segment, isWordSeparator) {
+			builder.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	return builder.String()
+}
+
+func isWordSeparator(r rune) bool {
+	return r == '-' || r == '_' || r == '.'
+}
+
+func createDataSchema(generator *schemaGenerator, fields shared.ResponseFields) *Schema {
+	if fields == nil {
+		return &Schema{}
+	}
+
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema, len(fields)),
+	}
+	// the fields are described in a fixed order, so that the names of the components do not change between builds
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		schema.Properties[name] = generator.schemaOf(fields[name])
+	}
+
+	return schema
+}
+
+// createResponseContent wraps the data schema in the schema of the shared.GenericAPIResponse
+func createResponseContent(dataSchema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{
+		jsonContentType: {
+			Schema: &Schema{
+				Type: "object",
+				Properties: map[string]*Schema{
+					"data":  dataSchema,
+					"error": {Type: "string"},
+					"code": {
+						Type: "string",
+						Enum: []string{
+							string(shared.ReturnCodeSuccess),
+							string(shared.ReturnCodeInternalError),
+							string(shared.ReturnCodeRequestError),
+							string(shared.ReturnCodeSystemBusy),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type embeddedData struct {
+	Name  string `json:"name"`
+	Value uint64 `json:"value"`
+}
+
+type treeNode struct {
+	embeddedData
+	Name     int          `json:"label"`
+	Value    string       `json:"value"`
+	Balance  *big.Int     `json:"balance"`
+	Data     []byte       `json:"data"`
+	Raw      rawJSONValue `json:"raw"`
+	Children []*treeNode  `json:"children"`
+	Tags     map[string]uint32
+	Ignored  string `json:"-"`
+}
+
+type rawJSONValue struct{}
+
+// MarshalJSON -
+func (rjv rawJSONValue) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+type sendRequest struct {
+	Receiver string `json:"receiver"`
+}
+
+func createGroups() map[string]shared.GroupHandler {
+	return map[string]shared.GroupHandler{
+		"tree": &mock.GroupHandlerStub{
+			GetEndpointsCalled: func() []*shared.EndpointHandlerData {
+				return []*shared.EndpointHandlerData{
+					{
+						Path:   "/:hash/node-with-children",
+						Method: http.MethodGet,
+						Spec: &shared.EndpointSpec{
+							Summary:         "returns a node",
+							QueryParameters: []string{"depth"},
+							ResponseData:    shared.ResponseFields{"node": treeNode{}},
+						},
+					},
+					{
+						Path:   "/send",
+						Method: http.MethodPost,
+						Spec: &shared.EndpointSpec{
+							RequestBody:  sendRequest{},
+							ResponseData: shared.ResponseFields{"txHash": ""},
+						},
+					},
+					{
+						Path:   "/closed",
+						Method: http.MethodGet,
+					},
+				}
+			},
+		},
+	}
+}
+
+func createApiConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"tree": {
+				Routes: []config.RouteConfig{
+					{Name: "/:hash/node-with-children", Open: true},
+					{Name: "/send", Open: true},
+					{Name: "/closed", Open: false},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildDocument_ShouldDescribeOnlyTheOpenEndpoints(t *testing.T) {
+	t.Parallel()
+
+	document := openapi.BuildDocument(createGroups(), createApiConfig())
+
+	assert.Equal(t, "3.0.3", document.OpenAPI)
+	assert.Equal(t, []openapi.Tag{{Name: "tree"}}, document.Tags)
+	require.Len(t, document.Paths, 2)
+	assert.NotNil(t, document.Paths["/tree/{hash}/node-with-children"]["get"])
+	assert.NotNil(t, document.Paths["/tree/send"]["post"])
+}
+
+func TestBuildDocument_ShouldDescribeTheOperations(t *testing.T) {
+	t.Parallel()
+
+	document := openapi.BuildDocument(createGroups(), createApiConfig())
+
+	getOperation := document.Paths["/tree/{hash}/node-with-children"]["get"]
+	assert.Equal(t, "getTreeByHashNodeWithChildren", getOperation.OperationID)
+	assert.Equal(t, "returns a node", getOperation.Summary)
+	require.Len(t, getOperation.Parameters, 2)
+	assert.Equal(t, &openapi.Parameter{Name: "hash", In: "path", Required: true, Schema: &openapi.Schema{Type: "string"}}, getOperation.Parameters[0])
+	assert.Equal(t, &openapi.Parameter{Name: "depth", In: "query", Schema: &openapi.Schema{Type: "string"}}, getOperation.Parameters[1])
+	assert.Nil(t, getOperation.RequestBody)
+
+	envelope := getOperation.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "object", envelope.Type)
+	assert.Equal(t, []string{"successful", "internal_issue", "bad_request", "system_busy"}, envelope.Properties["code"].Enum)
+	assert.Equal(t, &openapi.Schema{Ref: "#/components/schemas/openapi_test.treeNode"}, envelope.Properties["data"].Properties["node"])
+	assert.NotNil(t, getOperation.Responses["default"])
+
+	postOperation := document.Paths["/tree/send"]["post"]
+	assert.Equal(t, "postTreeSend", postOperation.OperationID)
+	require.NotNil(t, postOperation.RequestBody)
+	assert.Equal(t, &openapi.Schema{Ref: "#/components/schemas/openapi_test.sendRequest"}, postOperation.RequestBody.Content["application/json"].Schema)
+}
+
+func TestBuildDocument_ShouldDescribeTheSchemasFollowingTheJSONEncoding(t *testing.T) {
+	t.Parallel()
+
+	document := openapi.BuildDocument(createGroups(), createApiConfig())
+
+	schema := document.Components.Schemas["openapi_test.treeNode"]
+	require.NotNil(t, schema)
+	expectedProperties := map[string]*openapi.Schema{
+		"name":     {Type: "string"},
+		"label":    {Type: "integer", Format: "int64"},
+		"value":    {Type: "string"},
+		"balance":  {Type: "integer", Nullable: true},
+		"data":     {Type: "string", Format: "byte", Nullable: true},
+		"raw":      {},
+		"children": {Type: "array", Items: &openapi.Schema{Ref: "#/components/schemas/openapi_test.treeNode"}, Nullable: true},
+		"Tags":     {Type: "object", AdditionalProperties: &openapi.Schema{Type: "integer", Format: "int64"}, Nullable: true},
+	}
+	assert.Equal(t, expectedProperties, schema.Properties)
+
+	// the document should be serializable as is
+	_, err := json.Marshal(document)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,168 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path"
+	"reflect"
+	"strings"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	bigIntType        = reflect.TypeOf(big.Int{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schemaGenerator creates the schemas of the Go types, following the encoding/json rules. The named structs are
+// described once, in the components of the document, and referenced wherever they are used
+type schemaGenerator struct {
+	schemas     map[string]*Schema
+	namesByType map[reflect.Type]string
+	typesByName map[string]reflect.Type
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas:     make(map[string]*Schema),
+		namesByType: make(map[reflect.Type]string),
+		typesByName: make(map[string]reflect.Type),
+	}
+}
+
+// schemaOf returns the schema of the type of the provided value. A nil value allows any value
+func (sg *schemaGenerator) schemaOf(value interface{}) *Schema {
+	if value == nil {
+		return &Schema{}
+	}
+
+	return sg.schemaForType(reflect.TypeOf(value))
+}
+
+func (sg *schemaGenerator) schemaForType(t reflect.Type) *Schema {
+	isPointer := false
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		isPointer = true
+	}
+
+	switch {
+	case t == bigIntType:
+		return &Schema{Type: "integer", Nullable: isPointer}
+	case implements(t, jsonMarshalerType):
+		return &Schema{}
+	case implements(t, textMarshalerType):
+		return &Schema{Type: "string", Nullable: isPointer}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean", Nullable: isPointer}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32", Nullable: isPointer}
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int64", Nullable: isPointer}
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Nullable: isPointer}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Nullable: isPointer}
+	case reflect.String:
+		return &Schema{Type: "string", Nullable: isPointer}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte", Nullable: true}
+		}
+		return &Schema{Type: "array", Items: sg.schemaForType(t.Elem()), Nullable: true}
+	case reflect.Array:
+		return &Schema{Type: "array", Items: sg.schemaForType(t.Elem()), Nullable: isPointer}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: sg.schemaForType(t.Elem()), Nullable: true}
+	case reflect.Struct:
+		if len(t.Name()) == 0 {
+			return sg.objectSchema(t)
+		}
+		return &Schema{Ref: componentsSchemasPrefix + sg.componentName(t)}
+	default:
+		return &Schema{}
+	}
+}
+
+func implements(t reflect.Type, marshalerType reflect.Type) bool {
+	return t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType)
+}
+
+// componentName returns the name of the component describing the provided named struct, creating it if needed
+func (sg *schemaGenerator) componentName(t reflect.Type) string {
+	name, exists := sg.namesByType[t]
+	if exists {
+		return name
+	}
+
+	name = fmt.Sprintf("%s.%s", path.Base(t.PkgPath()), t.Name())
+	for suffix := 2; sg.typesByName[name] != nil; suffix++ {
+		name = fmt.Sprintf("%s.%s%d", path.Base(t.PkgPath()), t.Name(), suffix)
+	}
+
+	// the name is registered before describing the struct, so that the recursive types reference themselves
+	sg.namesByType[t] = name
+	sg.typesByName[name] = t
+	sg.schemas[name] = sg.objectSchema(t)
+
+	return name
+}
+
+func (sg *schemaGenerator) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	sg.addProperties(schema, t, false)
+
+	return schema
+}
+
+// addProperties adds the fields of the provided struct to the schema. The fields of the embedded structs are promoted,
+// without overwriting the fields of the outer struct
+func (sg *schemaGenerator) addProperties(schema *Schema, t reflect.Type, isEmbedded bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, isIgnored := jsonFieldName(field)
+		if isIgnored {
+			continue
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && len(name) == 0 && fieldType.Kind() == reflect.Struct {
+			sg.addProperties(schema, fieldType, true)
+			continue
+		}
+		if len(field.PkgPath) > 0 {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		_, exists := schema.Properties[name]
+		if exists && isEmbedded {
+			continue
+		}
+		schema.Properties[name] = sg.schemaForType(field.Type)
+	}
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+
+	return strings.Split(tag, ",")[0], false
+}
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -48,6 +49,14 @@ type UpgradeableHttpServerHandler interface {
 	IsInterfaceNil() bool
 }
 
+// UpgradeableGrpcServerHandler defines the actions that an upgradeable gRPC server need to do
+type UpgradeableGrpcServerHandler interface {
+	Start() error
+	UpdateFacade(facade FacadeHandler) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // GroupHandler defines the actions needed to be performed by an gin API group
 type GroupHandler interface {
 	UpdateFacade(newFacade interface{}) error
@@ -55,6 +64,7 @@ type GroupHandler interface {
 		ws *gin.RouterGroup,
 		apiConfig config.ApiRoutesConfig,
 	)
+	GetEndpoints() []*EndpointHandlerData
 	IsInterfaceNil() bool
 }
 
@@ -69,10 +79,17 @@ type FacadeHandler interface {
 	GetNFTTokenIDsRegisteredByAddress(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetESDTTokensPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error)
+	IterateESDTTokens(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error)
+	GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error)
+	IterateKeyValuePairs(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByHash(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
 	GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
 	GetInternalShardBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error)
 	GetInternalShardBlockByRound(format common.ApiOutputFormat, round uint64) (interface{}, error)
@@ -104,10 +121,18 @@ type FacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBatchExecution(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error)
+	GetTransactionFinality(tx *transaction.ApiTransactionResult) string
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetEquivocations(publicKey string) ([]*common.EquivocationEvidence, error)
+	GetConsensusRound(round int64) (*common.ConsensusRoundTrace, error)
+	GetConsensusRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error)
+	GetConsensusSchedule(startRound int64, endRound int64) (*common.ConsensusSchedule, error)
+	GetValidatorLeaderSchedule(publicKey string) (*common.ValidatorLeaderSchedule, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
@@ -120,5 +145,10 @@ type FacadeHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error)
+	GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error)
+	GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error)
 	IsInterfaceNil() bool
 }
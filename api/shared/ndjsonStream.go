@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NDJSONContentType is the content type of the responses streamed as newline delimited JSON objects
+const NDJSONContentType = "application/x-ndjson"
+
+// ndjsonFlushInterval is the number of written objects after which the response is flushed to the client
+const ndjsonFlushInterval = 100
+
+// NDJSONStream streams a response as newline delimited JSON objects. The response status is written along with the
+// first object, so that an error occurring before it is still returned as a generic API response
+type NDJSONStream struct {
+	c          *gin.Context
+	encoder    *json.Encoder
+	numWritten int
+}
+
+// NewNDJSONStream returns a new instance of NDJSONStream writing to the response of the provided context
+func NewNDJSONStream(c *gin.Context) *NDJSONStream {
+	return &NDJSONStream{
+		c:       c,
+		encoder: json.NewEncoder(c.Writer),
+	}
+}
+
+// Write writes the provided object on its own line
+func (stream *NDJSONStream) Write(object interface{}) error {
+	if stream.numWritten == 0 {
+		stream.c.Header("Content-Type", NDJSONContentType)
+		stream.c.Status(http.StatusOK)
+	}
+
+	err := stream.encoder.Encode(object)
+	if err != nil {
+		return err
+	}
+
+	stream.numWritten++
+	if stream.numWritten%ndjsonFlushInterval == 0 {
+		stream.c.Writer.Flush()
+	}
+
+	return nil
+}
+
+// Close writes the provided trailer object, which ends a successful stream, and flushes the response
+func (stream *NDJSONStream) Close(trailer interface{}) {
+	err := stream.Write(trailer)
+	if err != nil {
+		return
+	}
+
+	stream.c.Writer.Flush()
+}
+
+// Fail ends the stream with the provided error. If no object was written yet, the error is returned as a generic API
+// response, otherwise it is written as the last line of the stream
+func (stream *NDJSONStream) Fail(err error, innerErr error) {
+	if stream.numWritten == 0 {
+		RespondWithInternalError(stream.c, err, innerErr)
+		return
+	}
+
+	_ = stream.encoder.Encode(gin.H{
+		"error": fmt.Sprintf("%s: %s", err.Error(), innerErr.Error()),
+		"code":  ReturnCodeInternalError,
+	})
+	stream.c.Writer.Flush()
+}
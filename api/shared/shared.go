@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 )
 
@@ -30,6 +31,34 @@ type EndpointHandlerData struct {
 	Method                string
 	Handler               gin.HandlerFunc
 	AdditionalMiddlewares []AdditionalMiddleware
+	Spec                  *EndpointSpec
+}
+
+// EndpointSpec holds the metadata describing an endpoint in the OpenAPI document
+type EndpointSpec struct {
+	Summary         string
+	QueryParameters []string
+	RequestBody     interface{}
+	ResponseData    ResponseFields
+}
+
+// ResponseFields maps each field of the data object of a successful response to a value of the field's type
+type ResponseFields map[string]interface{}
+
+// IsEndpointOpen returns true if the endpoint with the provided path is enabled in the routes config of its group
+func IsEndpointOpen(apiConfig config.ApiRoutesConfig, groupName string, path string) bool {
+	group, ok := apiConfig.APIPackages[groupName]
+	if !ok {
+		return false
+	}
+
+	for _, route := range group.Routes {
+		if route.Name == path {
+			return route.Open
+		}
+	}
+
+	return false
 }
 
 // GenericAPIResponse defines the structure of all responses on API endpoints
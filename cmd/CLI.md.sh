@@ -6,6 +6,9 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForDbTool
+    generateForRemoteSigner
+    generateForShufflerSim
 }
 
 generateForNode() {
@@ -78,6 +81,48 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForDbTool() {
+    HELP="
+# Elrond DbTool CLI
+
+The **Elrond Node Database Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbtool --help
+
+$(./dbtool/dbtool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbtool/CLI.md
+}
+
+generateForRemoteSigner() {
+    HELP="
+# Elrond Remote Signer CLI
+
+The **Elrond Remote Signer** exposes the following Command Line Interface:
+$(code)
+\$ remotesigner --help
+
+$(./remotesigner/remotesigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./remotesigner/CLI.md
+}
+
+generateForShufflerSim() {
+    HELP="
+# Elrond Shuffler Simulator CLI
+
+The **Elrond Shuffler Simulator** exposes the following Command Line Interface:
+$(code)
+\$ shufflersim --help
+
+$(./shufflersim/shufflersim --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./shufflersim/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
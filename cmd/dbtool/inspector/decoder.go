@@ -0,0 +1,122 @@
+package inspector
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"unicode"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+)
+
+// Entry is the printable form of a (key, value) pair stored in a unit
+type Entry struct {
+	Key         string      `json:"key"`
+	KeyText     string      `json:"keyText,omitempty"`
+	Nonce       *uint64     `json:"nonce,omitempty"`
+	Value       interface{} `json:"value"`
+	DecodeError string      `json:"decodeError,omitempty"`
+}
+
+type decoder struct {
+	marshaller marshal.Marshalizer
+}
+
+// decode converts the (key, value) pair in its printable form. If the value can not be decoded,
+// it is printed as hex and the decoding error is attached to the entry.
+func (d *decoder) decode(unit *unitInfo, key []byte, value []byte, raw bool) *Entry {
+	entry := &Entry{
+		Key:   hex.EncodeToString(key),
+		Value: hex.EncodeToString(value),
+	}
+	if isPrintable(key) {
+		entry.KeyText = string(key)
+	}
+	if unit.keyIsNonce && len(key) == 8 {
+		nonce := binary.BigEndian.Uint64(key)
+		entry.Nonce = &nonce
+	}
+	if raw {
+		return entry
+	}
+
+	decoded, err := d.decodeValue(unit.valueKind, key, value)
+	if err != nil {
+		entry.DecodeError = err.Error()
+		return entry
+	}
+
+	entry.Value = decoded
+
+	return entry
+}
+
+func (d *decoder) decodeValue(kind valueKind, key []byte, value []byte) (interface{}, error) {
+	switch kind {
+	case shardHeaderValue:
+		return process.UnmarshalShardHeader(d.marshaller, value)
+	case metaBlockValue:
+		return d.unmarshal(&block.MetaBlock{}, value)
+	case miniBlockValue:
+		return d.unmarshal(&block.MiniBlock{}, value)
+	case transactionValue:
+		return d.unmarshal(&transaction.Transaction{}, value)
+	case unsignedTransactionValue:
+		return d.unmarshal(&smartContractResult.SmartContractResult{}, value)
+	case rewardTransactionValue:
+		return d.unmarshal(&rewardTx.RewardTx{}, value)
+	case txLogValue:
+		return d.unmarshal(&transaction.Log{}, value)
+	case bootstrapValue:
+		return d.decodeBootstrapValue(key, value)
+	case hashValue:
+		return hex.EncodeToString(value), nil
+	default:
+		return hex.EncodeToString(value), nil
+	}
+}
+
+func (d *decoder) unmarshal(obj interface{}, value []byte) (interface{}, error) {
+	err := d.marshaller.Unmarshal(obj, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// decodeBootstrapValue decodes the entries written by the bootstrap storer: the bootstrap data is saved under the
+// round number and the highest saved round is kept under a static key
+func (d *decoder) decodeBootstrapValue(key []byte, value []byte) (interface{}, error) {
+	if string(key) == common.HighestRoundFromBootStorage {
+		return d.unmarshal(&bootstrapStorage.RoundNum{}, value)
+	}
+
+	_, err := strconv.ParseInt(string(key), 10, 64)
+	if err != nil {
+		return hex.EncodeToString(value), nil
+	}
+
+	return d.unmarshal(&bootstrapStorage.BootstrapData{}, value)
+}
+
+func isPrintable(buff []byte) bool {
+	if len(buff) == 0 {
+		return false
+	}
+
+	for _, b := range buff {
+		if b > unicode.MaxASCII || !unicode.IsPrint(rune(b)) {
+			return false
+		}
+	}
+
+	return true
+}
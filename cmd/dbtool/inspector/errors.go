@@ -0,0 +1,33 @@
+package inspector
+
+import "errors"
+
+// ErrNilConfig signals that a nil config has been provided
+var ErrNilConfig = errors.New("nil config")
+
+// ErrEmptyDBPath signals that an empty database path has been provided
+var ErrEmptyDBPath = errors.New("empty database path")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrUnknownUnit signals that the requested unit is not known
+var ErrUnknownUnit = errors.New("unknown unit")
+
+// ErrUnitNotFound signals that the requested unit does not exist on disk
+var ErrUnitNotFound = errors.New("unit not found on disk")
+
+// ErrEpochNotFound signals that the requested epoch does not exist on disk
+var ErrEpochNotFound = errors.New("epoch not found on disk")
+
+// ErrNotATrieUnit signals that the trie verification was requested on a unit that does not hold trie nodes
+var ErrNotATrieUnit = errors.New("not a trie unit")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrCompactionNotSupported signals that the DB type of the unit does not support manual compaction
+var ErrCompactionNotSupported = errors.New("compaction not supported for this DB type")
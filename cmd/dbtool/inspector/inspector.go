@@ -0,0 +1,322 @@
+package inspector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var log = logger.GetOrCreate("dbtool/inspector")
+
+// ArgsInspector holds the arguments needed to create a new inspector
+type ArgsInspector struct {
+	Config     *config.Config
+	DBPath     string
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+}
+
+// Location identifies a unit on disk. The epoch is ignored for the static units. HeadersShard is only used by the
+// ShardHdrNonceHashStorage unit, which is split by the shard of the headers.
+type Location struct {
+	Unit         string
+	Epoch        uint32
+	Shard        string
+	HeadersShard string
+}
+
+// UnitDescription describes a database found on disk
+type UnitDescription struct {
+	Name     string
+	Path     string
+	IsStatic bool
+	IsKnown  bool
+}
+
+// DumpOptions holds the options for dumping the content of a unit
+type DumpOptions struct {
+	Key   []byte
+	Raw   bool
+	Limit int
+}
+
+type inspector struct {
+	dbPath     string
+	units      map[string]*unitInfo
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+	decoder    *decoder
+}
+
+// NewInspector creates a new inspector over the databases found in the provided path. The path is the node's
+// database directory for a chain ID, for example ./db/1
+func NewInspector(args ArgsInspector) (*inspector, error) {
+	if args.Config == nil {
+		return nil, ErrNilConfig
+	}
+	if len(args.DBPath) == 0 {
+		return nil, ErrEmptyDBPath
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &inspector{
+		dbPath:     args.DBPath,
+		units:      createUnits(args.Config),
+		marshaller: args.Marshaller,
+		hasher:     args.Hasher,
+		decoder:    &decoder{marshaller: args.Marshaller},
+	}, nil
+}
+
+// Epochs returns the sorted epochs found on disk
+func (ins *inspector) Epochs() ([]uint32, error) {
+	dirEntries, err := os.ReadDir(ins.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := common.DefaultEpochString + "_"
+	epochs := make([]uint32, 0)
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() || !strings.HasPrefix(dirEntry.Name(), prefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(dirEntry.Name(), prefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+// ListUnits returns the databases found on disk for the provided epoch and shard, including the static ones
+func (ins *inspector) ListUnits(epoch uint32, shard string) ([]*UnitDescription, error) {
+	epochPath := filepath.Join(ins.epochPath(epoch), shardDirectory(shard))
+	descriptions, err := ins.listDirectory(epochPath, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrEpochNotFound, err)
+	}
+
+	staticPath := filepath.Join(ins.dbPath, common.DefaultStaticDbString, shardDirectory(shard))
+	staticDescriptions, err := ins.listDirectory(staticPath, true)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return append(descriptions, staticDescriptions...), nil
+}
+
+func (ins *inspector) listDirectory(path string, isStatic bool) ([]*UnitDescription, error) {
+	dirEntries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions := make([]*UnitDescription, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() {
+			continue
+		}
+
+		description := &UnitDescription{
+			Name:     dirEntry.Name(),
+			Path:     filepath.Join(path, dirEntry.Name()),
+			IsStatic: isStatic,
+		}
+		unit, found := ins.unitByFilePath(dirEntry.Name(), isStatic)
+		if found {
+			description.Name = unit.name
+			description.IsKnown = true
+		}
+
+		descriptions = append(descriptions, description)
+	}
+
+	return descriptions, nil
+}
+
+// Dump calls the handler for the entries of the unit, until the handler returns false or the limit is reached.
+// If a key is provided, only the value of that key is printed.
+func (ins *inspector) Dump(location Location, options DumpOptions, handler func(entry *Entry) bool) error {
+	unit, persister, err := ins.openUnit(location)
+	if err != nil {
+		return err
+	}
+	defer closePersister(persister)
+
+	if len(options.Key) > 0 {
+		value, errGet := persister.Get(options.Key)
+		if errGet != nil {
+			return errGet
+		}
+
+		handler(ins.decoder.decode(unit, options.Key, value, options.Raw))
+		return nil
+	}
+
+	numEntries := 0
+	persister.RangeKeys(func(key []byte, value []byte) bool {
+		numEntries++
+		shouldContinue := handler(ins.decoder.decode(unit, key, value, options.Raw))
+
+		return shouldContinue && (options.Limit <= 0 || numEntries < options.Limit)
+	})
+
+	return nil
+}
+
+// Count returns the number of entries of the unit
+func (ins *inspector) Count(location Location) (uint64, error) {
+	_, persister, err := ins.openUnit(location)
+	if err != nil {
+		return 0, err
+	}
+	defer closePersister(persister)
+
+	numEntries := uint64(0)
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numEntries++
+		return true
+	})
+
+	return numEntries, nil
+}
+
+// Compact triggers a full compaction of the unit. Only the level DB units can be compacted.
+func (ins *inspector) Compact(location Location) error {
+	unit, err := ins.getUnit(location.Unit)
+	if err != nil {
+		return err
+	}
+
+	dbType := storageUnit.DBType(unit.config.DB.Type)
+	if dbType != storageUnit.LvlDB && dbType != storageUnit.LvlDBSerial {
+		return fmt.Errorf("%w: %s", ErrCompactionNotSupported, dbType)
+	}
+
+	path, err := ins.existingUnitPath(unit, location)
+	if err != nil {
+		return err
+	}
+
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(db.Close())
+	}()
+
+	return db.CompactRange(util.Range{})
+}
+
+// DeleteEpoch removes all the databases of the provided epoch, for all shards
+func (ins *inspector) DeleteEpoch(epoch uint32) error {
+	path := ins.epochPath(epoch)
+	_, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrEpochNotFound, err)
+	}
+
+	return os.RemoveAll(path)
+}
+
+func (ins *inspector) openUnit(location Location) (*unitInfo, storage.Persister, error) {
+	unit, err := ins.getUnit(location.Unit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	persister, err := ins.openUnitPersister(unit, location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unit, persister, nil
+}
+
+func (ins *inspector) openUnitPersister(unit *unitInfo, location Location) (storage.Persister, error) {
+	path, err := ins.existingUnitPath(unit, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageFactory.NewPersisterFactory(unit.config.DB).Create(path)
+}
+
+// existingUnitPath returns the path of the unit, making sure it exists as the persisters would otherwise
+// create a new empty database
+func (ins *inspector) existingUnitPath(unit *unitInfo, location Location) (string, error) {
+	path := ins.unitPath(unit, location)
+	_, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrUnitNotFound, path)
+	}
+
+	return path, nil
+}
+
+func (ins *inspector) unitPath(unit *unitInfo, location Location) string {
+	if !unit.isStatic {
+		return filepath.Join(ins.epochPath(location.Epoch), shardDirectory(location.Shard), unit.config.DB.FilePath)
+	}
+
+	path := filepath.Join(ins.dbPath, common.DefaultStaticDbString, shardDirectory(location.Shard), unit.config.DB.FilePath)
+	if unit.name == "ShardHdrNonceHashStorage" {
+		headersShard := location.HeadersShard
+		if len(headersShard) == 0 {
+			headersShard = location.Shard
+		}
+		path += headersShard
+	}
+
+	return path
+}
+
+func (ins *inspector) epochPath(epoch uint32) string {
+	return filepath.Join(ins.dbPath, fmt.Sprintf("%s_%d", common.DefaultEpochString, epoch))
+}
+
+func shardDirectory(shard string) string {
+	return fmt.Sprintf("%s_%s", common.DefaultShardString, shard)
+}
+
+func closePersister(persister storage.Persister) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close persister", "error", err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ins *inspector) IsInterfaceNil() bool {
+	return ins == nil
+}
@@ -0,0 +1,310 @@
+package inspector
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDBConfig(filePath string) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createTestConfig() *config.Config {
+	cfg := &config.Config{}
+	cfg.BlockHeaderStorage.DB = createDBConfig("BlockHeaders")
+	cfg.BootstrapStorage.DB = createDBConfig("BootstrapData")
+	cfg.AccountsTrieStorage.DB = createDBConfig("AccountsTrie")
+	cfg.MetaHdrNonceHashStorage.DB = createDBConfig("MetaHdrHashNonce")
+	cfg.ShardHdrNonceHashStorage.DB = createDBConfig("ShardHdrHashNonce")
+
+	return cfg
+}
+
+func createMockArgs(dbPath string) ArgsInspector {
+	return ArgsInspector{
+		Config:     createTestConfig(),
+		DBPath:     dbPath,
+		Marshaller: &testscommon.MarshalizerMock{},
+		Hasher:     &hashingMocks.HasherMock{},
+	}
+}
+
+func writeUnit(t *testing.T, path string, entries map[string][]byte) {
+	persister, err := storageFactory.NewPersisterFactory(createDBConfig("")).Create(path)
+	require.Nil(t, err)
+
+	for key, value := range entries {
+		err = persister.Put([]byte(key), value)
+		require.Nil(t, err)
+	}
+
+	err = persister.Close()
+	require.Nil(t, err)
+}
+
+func epochUnitPath(dbPath string, epoch uint32, shard string, filePath string) string {
+	return filepath.Join(dbPath, fmt.Sprintf("Epoch_%d", epoch), "Shard_"+shard, filePath)
+}
+
+func TestNewInspector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t.TempDir())
+		args.Config = nil
+		ins, err := NewInspector(args)
+
+		assert.True(t, check.IfNil(ins))
+		assert.Equal(t, ErrNilConfig, err)
+	})
+	t.Run("empty db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs("")
+		ins, err := NewInspector(args)
+
+		assert.True(t, check.IfNil(ins))
+		assert.Equal(t, ErrEmptyDBPath, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t.TempDir())
+		args.Marshaller = nil
+		ins, err := NewInspector(args)
+
+		assert.True(t, check.IfNil(ins))
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t.TempDir())
+		args.Hasher = nil
+		ins, err := NewInspector(args)
+
+		assert.True(t, check.IfNil(ins))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ins, err := NewInspector(createMockArgs(t.TempDir()))
+
+		assert.False(t, check.IfNil(ins))
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"AccountsTrieStorage", "BlockHeaderStorage", "BootstrapStorage", "MetaHdrNonceHashStorage", "ShardHdrNonceHashStorage"}, ins.UnitNames())
+	})
+}
+
+func TestInspector_EpochsAndListUnits(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	writeUnit(t, epochUnitPath(dbPath, 2, "0", "BlockHeaders"), nil)
+	writeUnit(t, epochUnitPath(dbPath, 10, "0", "BlockHeaders"), nil)
+	writeUnit(t, epochUnitPath(dbPath, 10, "0", "Unknown"), nil)
+	writeUnit(t, filepath.Join(dbPath, "Static", "Shard_0", "MetaHdrHashNonce"), nil)
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_invalid"), os.ModePerm))
+
+	ins, _ := NewInspector(createMockArgs(dbPath))
+
+	epochs, err := ins.Epochs()
+	require.Nil(t, err)
+	assert.Equal(t, []uint32{2, 10}, epochs)
+
+	units, err := ins.ListUnits(10, "0")
+	require.Nil(t, err)
+	require.Equal(t, 3, len(units))
+	assert.Equal(t, &UnitDescription{
+		Name:    "BlockHeaderStorage",
+		Path:    epochUnitPath(dbPath, 10, "0", "BlockHeaders"),
+		IsKnown: true,
+	}, units[0])
+	assert.Equal(t, "Unknown", units[1].Name)
+	assert.False(t, units[1].IsKnown)
+	assert.Equal(t, "MetaHdrNonceHashStorage", units[2].Name)
+	assert.True(t, units[2].IsStatic)
+
+	_, err = ins.ListUnits(3, "0")
+	assert.True(t, errors.Is(err, ErrEpochNotFound))
+}
+
+func TestInspector_DumpAndCount(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &testscommon.MarshalizerMock{}
+	bootstrapData := bootstrapStorage.BootstrapData{LastRound: 37}
+	bootstrapDataBytes, _ := marshaller.Marshal(&bootstrapData)
+	roundNumBytes, _ := marshaller.Marshal(&bootstrapStorage.RoundNum{Num: 38})
+
+	dbPath := t.TempDir()
+	writeUnit(t, epochUnitPath(dbPath, 1, "metachain", "BootstrapData"), map[string][]byte{
+		strconv.Itoa(38):                   bootstrapDataBytes,
+		common.HighestRoundFromBootStorage: roundNumBytes,
+	})
+
+	ins, _ := NewInspector(createMockArgs(dbPath))
+	location := Location{
+		Unit:  "BootstrapStorage",
+		Epoch: 1,
+		Shard: "metachain",
+	}
+
+	numEntries, err := ins.Count(location)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), numEntries)
+
+	entries := make([]*Entry, 0)
+	err = ins.Dump(location, DumpOptions{Key: []byte("38")}, func(entry *Entry) bool {
+		entries = append(entries, entry)
+		return true
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "38", entries[0].KeyText)
+	assert.Equal(t, &bootstrapData, entries[0].Value)
+
+	entries = entries[:0]
+	err = ins.Dump(location, DumpOptions{Limit: 1}, func(entry *Entry) bool {
+		entries = append(entries, entry)
+		return true
+	})
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+
+	location.Epoch = 2
+	_, err = ins.Count(location)
+	assert.True(t, errors.Is(err, ErrUnitNotFound))
+	_, err = os.Stat(epochUnitPath(dbPath, 2, "metachain", "BootstrapData"))
+	assert.True(t, os.IsNotExist(err))
+
+	location.Unit = "MissingStorage"
+	_, err = ins.Count(location)
+	assert.True(t, errors.Is(err, ErrUnknownUnit))
+}
+
+func TestInspector_DumpStaticNonceUnit(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	nonceKey := []byte{0, 0, 0, 0, 0, 0, 0, 7}
+	writeUnit(t, filepath.Join(dbPath, "Static", "Shard_metachain", "ShardHdrHashNonce1"), map[string][]byte{
+		string(nonceKey): []byte("hash"),
+	})
+
+	ins, _ := NewInspector(createMockArgs(dbPath))
+	location := Location{
+		Unit:         "ShardHdrNonceHashStorage",
+		Shard:        "metachain",
+		HeadersShard: "1",
+	}
+
+	var dumped *Entry
+	err := ins.Dump(location, DumpOptions{}, func(entry *Entry) bool {
+		dumped = entry
+		return true
+	})
+	require.Nil(t, err)
+	require.NotNil(t, dumped)
+	assert.Equal(t, uint64(7), *dumped.Nonce)
+	assert.Equal(t, "68617368", dumped.Value)
+}
+
+func TestInspector_DumpUndecodableValue(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	writeUnit(t, epochUnitPath(dbPath, 0, "0", "BlockHeaders"), map[string][]byte{
+		"hash": []byte("not a header"),
+	})
+
+	ins, _ := NewInspector(createMockArgs(dbPath))
+	location := Location{
+		Unit:  "BlockHeaderStorage",
+		Shard: "0",
+	}
+
+	var dumped *Entry
+	err := ins.Dump(location, DumpOptions{}, func(entry *Entry) bool {
+		dumped = entry
+		return true
+	})
+	require.Nil(t, err)
+	assert.NotEmpty(t, dumped.DecodeError)
+	assert.Equal(t, "6e6f74206120686561646572", dumped.Value)
+
+	err = ins.Dump(location, DumpOptions{Raw: true}, func(entry *Entry) bool {
+		dumped = entry
+		return true
+	})
+	require.Nil(t, err)
+	assert.Empty(t, dumped.DecodeError)
+}
+
+func TestInspector_DecodeHeader(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &testscommon.MarshalizerMock{}
+	header := &block.HeaderV2{Header: &block.Header{Nonce: 5, ShardID: 1}}
+	headerBytes, _ := marshaller.Marshal(header)
+
+	d := &decoder{marshaller: marshaller}
+	entry := d.decode(&unitInfo{valueKind: shardHeaderValue}, []byte("hash"), headerBytes, false)
+
+	assert.Empty(t, entry.DecodeError)
+	assert.Equal(t, header, entry.Value)
+}
+
+func TestInspector_CompactAndDeleteEpoch(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	writeUnit(t, epochUnitPath(dbPath, 4, "0", "BlockHeaders"), map[string][]byte{"hash": []byte("header")})
+
+	ins, _ := NewInspector(createMockArgs(dbPath))
+	location := Location{
+		Unit:  "BlockHeaderStorage",
+		Epoch: 4,
+		Shard: "0",
+	}
+
+	err := ins.Compact(location)
+	assert.Nil(t, err)
+
+	numEntries, _ := ins.Count(location)
+	assert.Equal(t, uint64(1), numEntries)
+
+	err = ins.DeleteEpoch(5)
+	assert.True(t, errors.Is(err, ErrEpochNotFound))
+
+	err = ins.DeleteEpoch(4)
+	assert.Nil(t, err)
+
+	epochs, _ := ins.Epochs()
+	assert.Empty(t, epochs)
+}
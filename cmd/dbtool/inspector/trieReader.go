@@ -0,0 +1,52 @@
+package inspector
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var errReadOnly = errors.New("read only storer")
+
+// multiEpochReader is a read-only storer that searches a key in the persisters of several epochs, newest first,
+// the same way the pruning storer does for the trie units
+type multiEpochReader struct {
+	persisters []storage.Persister
+}
+
+// Put returns an error as the reader can not alter the databases
+func (reader *multiEpochReader) Put(_, _ []byte) error {
+	return errReadOnly
+}
+
+// Get returns the value from the newest persister that holds the key
+func (reader *multiEpochReader) Get(key []byte) ([]byte, error) {
+	for _, persister := range reader.persisters {
+		value, err := persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, fmt.Errorf("key %x not found", key)
+}
+
+// Remove returns an error as the reader can not alter the databases
+func (reader *multiEpochReader) Remove(_ []byte) error {
+	return errReadOnly
+}
+
+// Close closes all the persisters
+func (reader *multiEpochReader) Close() error {
+	for _, persister := range reader.persisters {
+		closePersister(persister)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reader *multiEpochReader) IsInterfaceNil() bool {
+	return reader == nil
+}
@@ -0,0 +1,112 @@
+package inspector
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+type valueKind int
+
+const (
+	rawValue valueKind = iota
+	shardHeaderValue
+	metaBlockValue
+	miniBlockValue
+	transactionValue
+	unsignedTransactionValue
+	rewardTransactionValue
+	txLogValue
+	bootstrapValue
+	hashValue
+	trieNodeValue
+)
+
+// unitInfo describes a storage unit, as it is defined in the node's config.toml file
+type unitInfo struct {
+	name      string
+	config    config.StorageConfig
+	isStatic  bool
+	valueKind valueKind
+	// keyIsNonce is set for the nonce -> hash units, which use the big endian encoding of the nonce as key
+	keyIsNonce bool
+}
+
+// createUnits returns the known units, indexed by their section name from the config.toml file
+func createUnits(cfg *config.Config) map[string]*unitInfo {
+	units := []*unitInfo{
+		{name: "TxStorage", config: cfg.TxStorage, valueKind: transactionValue},
+		{name: "MiniBlocksStorage", config: cfg.MiniBlocksStorage, valueKind: miniBlockValue},
+		{name: "PeerBlockBodyStorage", config: cfg.PeerBlockBodyStorage, valueKind: miniBlockValue},
+		{name: "BlockHeaderStorage", config: cfg.BlockHeaderStorage, valueKind: shardHeaderValue},
+		{name: "MetaBlockStorage", config: cfg.MetaBlockStorage, valueKind: metaBlockValue},
+		{name: "UnsignedTransactionStorage", config: cfg.UnsignedTransactionStorage, valueKind: unsignedTransactionValue},
+		{name: "RewardTxStorage", config: cfg.RewardTxStorage, valueKind: rewardTransactionValue},
+		{name: "ReceiptsStorage", config: cfg.ReceiptsStorage},
+		{name: "ScheduledSCRsStorage", config: cfg.ScheduledSCRsStorage},
+		{name: "BootstrapStorage", config: cfg.BootstrapStorage, valueKind: bootstrapValue},
+		{name: "TxLogsStorage", config: cfg.LogsAndEvents.TxLogsStorage, valueKind: txLogValue},
+		{name: "AccountsTrieStorage", config: cfg.AccountsTrieStorage, valueKind: trieNodeValue},
+		{name: "PeerAccountsTrieStorage", config: cfg.PeerAccountsTrieStorage, valueKind: trieNodeValue},
+		{name: "AccountsTrieCheckpointsStorage", config: cfg.AccountsTrieCheckpointsStorage, valueKind: trieNodeValue},
+		{name: "PeerAccountsTrieCheckpointsStorage", config: cfg.PeerAccountsTrieCheckpointsStorage, valueKind: trieNodeValue},
+		{name: "MetaHdrNonceHashStorage", config: cfg.MetaHdrNonceHashStorage, isStatic: true, valueKind: hashValue, keyIsNonce: true},
+		{name: "ShardHdrNonceHashStorage", config: cfg.ShardHdrNonceHashStorage, isStatic: true, valueKind: hashValue, keyIsNonce: true},
+		{name: "StatusMetricsStorage", config: cfg.StatusMetricsStorage, isStatic: true},
+		{name: "TrieEpochRootHashStorage", config: cfg.TrieEpochRootHashStorage, isStatic: true},
+		{name: "HeartbeatStorage", config: cfg.Heartbeat.HeartbeatStorage, isStatic: true},
+		{name: "MiniblocksMetadataStorage", config: cfg.DbLookupExtensions.MiniblocksMetadataStorageConfig},
+		{name: "ResultsHashesByTxHashStorage", config: cfg.DbLookupExtensions.ResultsHashesByTxHashStorageConfig},
+		{name: "MiniblockHashByTxHashStorage", config: cfg.DbLookupExtensions.MiniblockHashByTxHashStorageConfig, isStatic: true, valueKind: hashValue},
+		{name: "EpochByHashStorage", config: cfg.DbLookupExtensions.EpochByHashStorageConfig, isStatic: true},
+		{name: "RoundHashStorage", config: cfg.DbLookupExtensions.RoundHashStorageConfig, isStatic: true, valueKind: hashValue, keyIsNonce: true},
+		{name: "ESDTSuppliesStorage", config: cfg.DbLookupExtensions.ESDTSuppliesStorageConfig, isStatic: true},
+		{name: "AddressTransactionsStorage", config: cfg.DbLookupExtensions.AddressTransactionsStorageConfig, isStatic: true},
+		{name: "OutportOutboxStorage", config: cfg.OutportOutbox.Storage, isStatic: true},
+	}
+
+	unitsMap := make(map[string]*unitInfo, len(units))
+	for _, unit := range units {
+		if len(unit.config.DB.FilePath) == 0 {
+			continue
+		}
+
+		unitsMap[unit.name] = unit
+	}
+
+	return unitsMap
+}
+
+func (ins *inspector) getUnit(name string) (*unitInfo, error) {
+	unit, ok := ins.units[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownUnit, name)
+	}
+
+	return unit, nil
+}
+
+func (ins *inspector) unitByFilePath(filePath string, isStatic bool) (*unitInfo, bool) {
+	for _, unit := range ins.units {
+		if unit.isStatic != isStatic {
+			continue
+		}
+		if unit.config.DB.FilePath == filePath {
+			return unit, true
+		}
+	}
+
+	return nil, false
+}
+
+// UnitNames returns the sorted names of the units known by the inspector
+func (ins *inspector) UnitNames() []string {
+	names := make([]string, 0, len(ins.units))
+	for name := range ins.units {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
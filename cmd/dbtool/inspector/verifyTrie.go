@@ -0,0 +1,114 @@
+package inspector
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+	commonDisabled "github.com/ElrondNetwork/elrond-go/common/disabled"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/hashesHolder/disabled"
+)
+
+const maxTrieLevelInMemory = 5
+
+// VerifyTrie checks that all the trie nodes reachable from the provided root hash can be loaded from the unit.
+// The nodes are searched in the provided epoch and all the older ones found on disk. It returns the number of
+// trie nodes found.
+func (ins *inspector) VerifyTrie(location Location, rootHash []byte) (int, error) {
+	if len(rootHash) == 0 {
+		return 0, ErrEmptyRootHash
+	}
+
+	unit, err := ins.getUnit(location.Unit)
+	if err != nil {
+		return 0, err
+	}
+	if unit.valueKind != trieNodeValue {
+		return 0, ErrNotATrieUnit
+	}
+
+	reader, err := ins.createMultiEpochReader(unit, location)
+	if err != nil {
+		return 0, err
+	}
+
+	tsm, err := ins.createTrieStorageManager(reader)
+	if err != nil {
+		_ = reader.Close()
+		return 0, err
+	}
+	defer func() {
+		log.LogIfError(tsm.Close())
+	}()
+
+	emptyTrie, err := trie.NewTrie(tsm, ins.marshaller, ins.hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return 0, err
+	}
+
+	tr, err := emptyTrie.Recreate(rootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	hashes, err := tr.GetAllHashes()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(hashes), nil
+}
+
+func (ins *inspector) createMultiEpochReader(unit *unitInfo, location Location) (*multiEpochReader, error) {
+	epochs, err := ins.Epochs()
+	if err != nil {
+		return nil, err
+	}
+
+	reader := &multiEpochReader{
+		persisters: make([]storage.Persister, 0, len(epochs)),
+	}
+	for i := len(epochs) - 1; i >= 0; i-- {
+		if epochs[i] > location.Epoch {
+			continue
+		}
+
+		epochLocation := location
+		epochLocation.Epoch = epochs[i]
+		persister, errOpen := ins.openUnitPersister(unit, epochLocation)
+		if errOpen != nil {
+			log.Debug("skipping epoch for trie verification", "epoch", epochs[i], "error", errOpen)
+			continue
+		}
+
+		reader.persisters = append(reader.persisters, persister)
+	}
+
+	if len(reader.persisters) == 0 {
+		return nil, ErrUnitNotFound
+	}
+
+	return reader, nil
+}
+
+func (ins *inspector) createTrieStorageManager(mainStorer common.DBWriteCacher) (common.StorageManager, error) {
+	tsmArgs := trie.NewTrieStorageManagerArgs{
+		MainStorer:        mainStorer,
+		CheckpointsStorer: memorydb.New(),
+		Marshalizer:       ins.marshaller,
+		Hasher:            ins.hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: disabled.NewDisabledCheckpointHashesHolder(),
+		IdleProvider:           commonDisabled.NewProcessStatusHandler(),
+	}
+	options := trie.StorageManagerOptions{
+		PruningEnabled:     false,
+		SnapshotsEnabled:   false,
+		CheckpointsEnabled: false,
+	}
+
+	return trie.CreateTrieStorageManager(tsmArgs, options)
+}
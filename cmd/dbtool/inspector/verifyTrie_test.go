@@ -0,0 +1,85 @@
+package inspector
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTrieInspector(dbPath string) *inspector {
+	args := createMockArgs(dbPath)
+	args.Marshaller = &testscommon.ProtobufMarshalizerMock{}
+	args.Hasher = &testscommon.KeccakMock{}
+	ins, _ := NewInspector(args)
+
+	return ins
+}
+
+func writeTrie(t *testing.T, ins *inspector, path string, numLeaves int) []byte {
+	persister, err := storageFactory.NewPersisterFactory(createDBConfig("")).Create(path)
+	require.Nil(t, err)
+
+	tsm, err := ins.createTrieStorageManager(persister)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(tsm, ins.marshaller, ins.hasher, maxTrieLevelInMemory)
+	require.Nil(t, err)
+
+	for i := 0; i < numLeaves; i++ {
+		key := ins.hasher.Compute(fmt.Sprintf("key%d", i))
+		require.Nil(t, tr.Update(key, key))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+	require.Nil(t, tsm.Close())
+
+	return rootHash
+}
+
+func TestInspector_VerifyTrie(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	ins := createTrieInspector(dbPath)
+	rootHash := writeTrie(t, ins, epochUnitPath(dbPath, 0, "0", "AccountsTrie"), 100)
+	writeUnit(t, epochUnitPath(dbPath, 1, "0", "AccountsTrie"), nil)
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		numNodes, err := ins.VerifyTrie(Location{Unit: "AccountsTrieStorage", Shard: "0"}, nil)
+
+		assert.Equal(t, ErrEmptyRootHash, err)
+		assert.Zero(t, numNodes)
+	})
+	t.Run("not a trie unit should error", func(t *testing.T) {
+		numNodes, err := ins.VerifyTrie(Location{Unit: "BlockHeaderStorage", Shard: "0"}, rootHash)
+
+		assert.Equal(t, ErrNotATrieUnit, err)
+		assert.Zero(t, numNodes)
+	})
+	t.Run("missing unit should error", func(t *testing.T) {
+		numNodes, err := ins.VerifyTrie(Location{Unit: "AccountsTrieStorage", Shard: "1"}, rootHash)
+
+		assert.True(t, errors.Is(err, ErrUnitNotFound))
+		assert.Zero(t, numNodes)
+	})
+	t.Run("missing root should error", func(t *testing.T) {
+		numNodes, err := ins.VerifyTrie(Location{Unit: "AccountsTrieStorage", Shard: "0"}, []byte("missing root hash"))
+
+		assert.NotNil(t, err)
+		assert.Zero(t, numNodes)
+	})
+	t.Run("nodes from older epochs should be found", func(t *testing.T) {
+		numNodes, err := ins.VerifyTrie(Location{Unit: "AccountsTrieStorage", Epoch: 1, Shard: "0"}, rootHash)
+
+		assert.Nil(t, err)
+		assert.True(t, numNodes > 100)
+	})
+}
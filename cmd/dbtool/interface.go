@@ -0,0 +1,15 @@
+package main
+
+import "github.com/ElrondNetwork/elrond-go/cmd/dbtool/inspector"
+
+type inspectorHandler interface {
+	Epochs() ([]uint32, error)
+	ListUnits(epoch uint32, shard string) ([]*inspector.UnitDescription, error)
+	UnitNames() []string
+	Dump(location inspector.Location, options inspector.DumpOptions, handler func(entry *inspector.Entry) bool) error
+	Count(location inspector.Location) (uint64, error)
+	VerifyTrie(location inspector.Location, rootHash []byte) (int, error)
+	Compact(location inspector.Location) error
+	DeleteEpoch(epoch uint32) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/inspector"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/urfave/cli"
+)
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// dbPath defines the flag for the path of the databases of a chain
+	dbPath = cli.StringFlag{
+		Name:  "db-path",
+		Usage: "The path of the node's databases for a chain ID, for example ./db/1",
+		Value: "./db/1",
+	}
+	// configurationFile defines the flag for the node's main configuration file
+	configurationFile = cli.StringFlag{
+		Name:  "config",
+		Usage: "The main configuration file of the node, used to find the units, the marshaller and the hasher",
+		Value: "./config/config.toml",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	epoch = cli.UintFlag{
+		Name:  "epoch",
+		Usage: "The epoch of the unit. Ignored for the static units",
+	}
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The shard of the unit, as found on disk. For example 0 or metachain",
+		Value: "0",
+	}
+	unit = cli.StringFlag{
+		Name:  "unit",
+		Usage: "The name of the unit, as found in the config.toml file. For example BlockHeaderStorage",
+	}
+	headersShard = cli.StringFlag{
+		Name:  "headers-shard",
+		Usage: "Only used by the ShardHdrNonceHashStorage unit: the shard of the notarized headers. Defaults to --shard",
+	}
+	key = cli.StringFlag{
+		Name:  "key",
+		Usage: "The hex encoded key to print. If not provided, all the keys are printed",
+	}
+	raw = cli.BoolFlag{
+		Name:  "raw",
+		Usage: "Boolean option that disables the decoding of the values",
+	}
+	limit = cli.IntFlag{
+		Name:  "limit",
+		Usage: "The maximum number of entries to print. 0 means no limit",
+	}
+	rootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded root hash of the trie",
+	}
+	yes = cli.BoolFlag{
+		Name:  "yes",
+		Usage: "Boolean option that confirms the removal of the epoch",
+	}
+
+	log = logger.GetOrCreate("dbtool")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Node Database Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This tool inspects and maintains a node's databases without starting the node. The node must be stopped"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		configurationFile,
+		logLevel,
+	}
+	app.Before = func(c *cli.Context) error {
+		return logger.SetLogLevel(c.GlobalString(logLevel.Name))
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "epochs",
+			Usage:  "lists the epochs found on disk",
+			Action: epochsAction,
+		},
+		{
+			Name:   "list",
+			Usage:  "lists the units found on disk for an epoch and a shard",
+			Flags:  []cli.Flag{epoch, shard},
+			Action: listAction,
+		},
+		{
+			Name:   "units",
+			Usage:  "lists the unit names known by the tool",
+			Action: unitsAction,
+		},
+		{
+			Name:   "dump",
+			Usage:  "prints the decoded keys and values of a unit, one JSON object per line",
+			Flags:  []cli.Flag{epoch, shard, unit, headersShard, key, raw, limit},
+			Action: dumpAction,
+		},
+		{
+			Name:   "count",
+			Usage:  "counts the entries of a unit",
+			Flags:  []cli.Flag{epoch, shard, unit, headersShard},
+			Action: countAction,
+		},
+		{
+			Name:   "verify-trie",
+			Usage:  "checks that all the trie nodes reachable from a root hash are found in the provided epoch or the older ones",
+			Flags:  []cli.Flag{epoch, shard, unit, rootHash},
+			Action: verifyTrieAction,
+		},
+		{
+			Name:   "compact",
+			Usage:  "compacts a level DB unit",
+			Flags:  []cli.Flag{epoch, shard, unit, headersShard},
+			Action: compactAction,
+		},
+		{
+			Name:   "delete-epoch",
+			Usage:  "removes all the databases of an epoch",
+			Flags:  []cli.Flag{epoch, yes},
+			Action: deleteEpochAction,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func createInspector(c *cli.Context) (inspectorHandler, error) {
+	cfg, err := common.LoadMainConfig(c.GlobalString(configurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller, err := marshalizerFactory.NewMarshalizer(cfg.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := factory.NewHasher(cfg.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return inspector.NewInspector(inspector.ArgsInspector{
+		Config:     cfg,
+		DBPath:     c.GlobalString(dbPath.Name),
+		Marshaller: marshaller,
+		Hasher:     hasher,
+	})
+}
+
+func location(c *cli.Context) inspector.Location {
+	return inspector.Location{
+		Unit:         c.String(unit.Name),
+		Epoch:        uint32(c.Uint(epoch.Name)),
+		Shard:        c.String(shard.Name),
+		HeadersShard: c.String(headersShard.Name),
+	}
+}
+
+func epochsAction(c *cli.Context) error {
+	ins, err := createInspector(c)
+	if err != nil {
+		return err
+	}
+
+	epochs, err := ins.Epochs()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range epochs {
+		fmt.Println(e)
+	}
+
+	return nil
+}
+
+func listAction(c *cli.Context) error {
+	ins, err := createInspector(c)
+	if err != nil {
+		return err
+	}
+
+	descriptions, err := ins.ListUnits(uint32(c.Uint(epoch.Name)), c.String(shard.Name))
+	if err != nil {
+		return err
+	}
+
+	for _, description := range descriptions {
+		printJSON(description)
+	}
+
+	return nil
+}
+
+func unitsAction(c *cli.Context) error {
+	ins, err := createInspector(c)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range ins.UnitNames() {
+		fmt.Println(name)
+	}
+
+	return nil
+}
+
+func dumpAction(c *cli.Context) error {
+	ins, err := createInspector(c)
+	if err != nil {
+		return err
+	}
+
+	keyBytes, err := hex.DecodeString(c.String(key.Name))
+	if err != nil {
+		return fmt.Errorf("invalid key: %w", err)
+	}
+
+	options := inspector.DumpOptions{
+		Key:   keyBytes,
+		Raw:   c.Bool(raw.Name),
+		Limit: c.Int(limit.Name),
+	}
+
+	return ins.Dump(location(c), options, func(entry *inspector.Entry) bool {
+		printJSON(entry)
+		return true
+	})
+}
+
+func countAction(c *cli.Context) error {
+	ins, err := createInspector(c)
+	if err != nil {
+		return err
+	}
+
+	numEntries, err := ins.Count(location(c))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(numEntries)
+
+	return nil
+}
+
+func verifyTrieAction(c *cli.Context) error {
+	ins, err := createInspector(c)
+	if err != nil {
+		return err
+	}
+
+	rootHashBytes, err := hex.DecodeString(c.String(rootHash.Name))
+	if err != nil {
+		return fmt.Errorf("invalid root hash: %w", err)
+	}
+
+	numNodes, err := ins.VerifyTrie(location(c), rootHashBytes)
+	if err != nil {
+		return err
+	}
+
+	log.Info("trie verified", "root hash", rootHashBytes, "num nodes", numNodes)
+
+	return nil
+}
+
+func compactAction(c *cli.Context) error {
+	ins, err := createInspector(c)
+	if err != nil {
+		return err
+	}
+
+	err = ins.Compact(location(c))
+	if err != nil {
+		return err
+	}
+
+	log.Info("unit compacted", "unit", c.String(unit.Name))
+
+	return nil
+}
+
+func deleteEpochAction(c *cli.Context) error {
+	if !c.Bool(yes.Name) {
+		return fmt.Errorf("the removal of the epoch must be confirmed with the --%s flag", yes.Name)
+	}
+
+	ins, err := createInspector(c)
+	if err != nil {
+		return err
+	}
+
+	epochToDelete := uint32(c.Uint(epoch.Name))
+	err = ins.DeleteEpoch(epochToDelete)
+	if err != nil {
+		return err
+	}
+
+	log.Info("epoch deleted", "epoch", epochToDelete)
+
+	return nil
+}
+
+func printJSON(obj interface{}) {
+	buff, err := json.Marshal(obj)
+	if err != nil {
+		log.Warn("cannot encode output", "error", err)
+		return
+	}
+
+	fmt.Println(string(buff))
+}
@@ -208,6 +208,14 @@ var (
 		Value: "./config/validatorKey.pem",
 	}
 
+	// allValidatorKeysPemFile defines a flag for the path to the file that holds all the validator keys managed by
+	// the current node, besides its own validator key
+	allValidatorKeysPemFile = cli.StringFlag{
+		Name:  "all-validator-keys-pem-file",
+		Usage: "The `filepath` for the PEM file which contains the secret keys of all the validator keys managed by the current node, besides its own. If the file is missing, the node runs in single key mode.",
+		Value: "./config/allValidatorsKeys.pem",
+	}
+
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -337,6 +345,20 @@ var (
 		Name:  "serialize-snapshots",
 		Usage: "Flag that will serialize `state snapshotting` and `processing`",
 	}
+	// importSnapshot defines a flag for the directory of an epoch start state snapshot used to bootstrap the node
+	importSnapshot = cli.StringFlag{
+		Name: "import-snapshot",
+		Usage: "This flag specifies the `directory` of an epoch start state snapshot. If set, the node will bootstrap " +
+			"from the snapshot files instead of downloading the state tries from the network.",
+		Value: "",
+	}
+	// importSnapshotTrustedHash defines a flag for the trusted hash of the epoch start meta block of the imported snapshot
+	importSnapshotTrustedHash = cli.StringFlag{
+		Name: "import-snapshot-trusted-hash",
+		Usage: "This flag specifies the hex encoded `hash` of the epoch start meta block of the imported snapshot, " +
+			"as obtained from a trusted source. It is mandatory when the import-snapshot flag is set.",
+		Value: "",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -357,6 +379,7 @@ func getFlags() []cli.Flag {
 		gasScheduleConfigurationDirectory,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		allValidatorKeysPemFile,
 		port,
 		profileMode,
 		useHealthService,
@@ -389,6 +412,8 @@ func getFlags() []cli.Flag {
 		forceStartFromNetwork,
 		disableConsensusWatchdog,
 		serializeSnapshots,
+		importSnapshot,
+		importSnapshotTrustedHash,
 	}
 }
 
@@ -414,6 +439,8 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.ForceStartFromNetwork = ctx.GlobalBool(forceStartFromNetwork.Name)
 	flagsConfig.DisableConsensusWatchdog = ctx.GlobalBool(disableConsensusWatchdog.Name)
 	flagsConfig.SerializeSnapshots = ctx.GlobalBool(serializeSnapshots.Name)
+	flagsConfig.ImportSnapshotPath = ctx.GlobalString(importSnapshot.Name)
+	flagsConfig.ImportSnapshotTrustedHash = ctx.GlobalString(importSnapshotTrustedHash.Name)
 	return flagsConfig
 }
 
@@ -424,6 +451,7 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	cfgs.ConfigurationPathsHolder.GasScheduleDirectoryName = ctx.GlobalString(gasScheduleConfigurationDirectory.Name)
 	cfgs.ConfigurationPathsHolder.SmartContracts = ctx.GlobalString(smartContractsFile.Name)
 	cfgs.ConfigurationPathsHolder.ValidatorKey = ctx.GlobalString(validatorKeyPemFile.Name)
+	cfgs.ConfigurationPathsHolder.AllValidatorKeys = ctx.GlobalString(allValidatorKeysPemFile.Name)
 
 	if ctx.IsSet(startInEpoch.Name) {
 		log.Debug("start in epoch is enabled")
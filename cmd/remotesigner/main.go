@@ -0,0 +1,288 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclMultiSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/multisig"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/multisig"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+)
+
+type cfg struct {
+	keysFile              string
+	address               string
+	certificateFile       string
+	keyFile               string
+	clientCACertificate   string
+	slashingProtectionDir string
+	logLevel              string
+}
+
+const slashingProtectionCacheCapacity = 1000
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// keysFile defines a flag for the PEM file holding the validator keys served by the signer
+	keysFile = cli.StringFlag{
+		Name:        "keys-file",
+		Usage:       "The PEM file holding the BLS keys served by the signer, as written by the keygenerator tool",
+		Value:       "./validatorKeys.pem",
+		Destination: &argsConfig.keysFile,
+	}
+	// address defines a flag for the address the signer listens on
+	address = cli.StringFlag{
+		Name:        "address",
+		Usage:       "The address the signer listens on: either a Unix socket (unix:///path/to/signer.sock) or a host:port served over mutual TLS",
+		Value:       "unix:///var/run/elrond/signer.sock",
+		Destination: &argsConfig.address,
+	}
+	// certificateFile defines a flag for the TLS certificate of the signer
+	certificateFile = cli.StringFlag{
+		Name:        "certificate",
+		Usage:       "The TLS certificate presented to the nodes. Only used for TCP addresses",
+		Value:       "./server.crt",
+		Destination: &argsConfig.certificateFile,
+	}
+	// keyFile defines a flag for the TLS key of the signer
+	keyFile = cli.StringFlag{
+		Name:        "key",
+		Usage:       "The TLS private key of the signer's certificate. Only used for TCP addresses",
+		Value:       "./server.key",
+		Destination: &argsConfig.keyFile,
+	}
+	// clientCACertificate defines a flag for the CA certificate used to authenticate the nodes
+	clientCACertificate = cli.StringFlag{
+		Name:        "client-ca-certificate",
+		Usage:       "The CA certificate the nodes' client certificates must be signed with. Only used for TCP addresses",
+		Value:       "./ca.crt",
+		Destination: &argsConfig.clientCACertificate,
+	}
+	// slashingProtectionDir defines a flag for the directory of the slashing protection database
+	slashingProtectionDir = cli.StringFlag{
+		Name:        "slashing-protection-db",
+		Usage:       "The directory of the slashing protection database, recording every signed block header and signature share",
+		Value:       "./db/slashingProtection",
+		Destination: &argsConfig.slashingProtectionDir,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example, if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,remoteSigner:DEBUG the logs for all packages will have the INFO level, excepting the remoteSigner package which will receive a DEBUG log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("remotesigner")
+)
+
+// multiSignerCreator creates the BLS multi signers of the served keys
+type multiSignerCreator struct {
+	hasher hashing.Hasher
+	keyGen crypto.KeyGenerator
+}
+
+// CreateMultiSigner creates a multi signer bound to the provided key
+func (creator *multiSignerCreator) CreateMultiSigner(privateKey crypto.PrivateKey, publicKeyBytes []byte) (crypto.MultiSigner, error) {
+	blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: creator.hasher}
+	return multisig.NewBLSMultisig(blsSigner, []string{string(publicKeyBytes)}, privateKey, creator.keyGen, uint16(0))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (creator *multiSignerCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Remote Signer"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary holds the validators' BLS keys and signs the consensus messages requested by the nodes, refusing to sign two different block headers for the same round and shard"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		keysFile,
+		address,
+		certificateFile,
+		keyFile,
+		clientCACertificate,
+		slashingProtectionDir,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return startSigner()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("remote signer stopped", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func startSigner() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	keysHolder, err := loadKeys(argsConfig.keysFile)
+	if err != nil {
+		return err
+	}
+
+	slashingProtectionStorer, err := storageUnit.NewStorageUnitFromConf(
+		storageUnit.CacheConfig{
+			Type:     storageUnit.LRUCache,
+			Capacity: slashingProtectionCacheCapacity,
+		},
+		storageUnit.DBConfig{
+			FilePath:          argsConfig.slashingProtectionDir,
+			Type:              storageUnit.LvlDBSerial,
+			BatchDelaySeconds: 1,
+			MaxBatchSize:      1,
+			MaxOpenFiles:      10,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("%w while opening the slashing protection database", err)
+	}
+	defer func() {
+		log.LogIfError(slashingProtectionStorer.Close())
+	}()
+
+	slashingProtection, err := remoteSigner.NewSlashingProtection(slashingProtectionStorer)
+	if err != nil {
+		return err
+	}
+
+	// the nodes' block header hasher, as the signature shares are created on the block header hashes
+	headerHasher := blake2b.NewBlake2b()
+	server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+		KeysHolder:         keysHolder,
+		SingleSigner:       &mclSig.BlsSingleSigner{},
+		SlashingProtection: slashingProtection,
+		Hasher:             headerHasher,
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+	})
+	if err != nil {
+		return err
+	}
+
+	options, err := createServerOptions()
+	if err != nil {
+		return err
+	}
+
+	listener, err := remoteSigner.NewListener(argsConfig.address)
+	if err != nil {
+		return err
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	server.Serve(listener, options...)
+	log.Info("remote signer is now running", "address", argsConfig.address,
+		"num keys", len(keysHolder.GetManagedKeysByCurrentNode()))
+
+	<-sigs
+	log.Info("terminating at user's signal...")
+
+	return server.Close()
+}
+
+func loadKeys(keysFile string) (common.ManagedPeersHolder, error) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(keysFile, keyGen)
+	if err != nil {
+		return nil, err
+	}
+	if len(privateKeys) == 0 {
+		return nil, fmt.Errorf("no keys found in %s", keysFile)
+	}
+
+	hasher, err := blake2b.NewBlake2bWithSize(multisig.BlsHashSize)
+	if err != nil {
+		return nil, err
+	}
+
+	holder, err := keysManagement.NewManagedPeersHolder(keysManagement.ArgsManagedPeersHolder{
+		KeyGenerator: keyGen,
+		MultiSignerCreator: &multiSignerCreator{
+			hasher: hasher,
+			keyGen: keyGen,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, privateKey := range privateKeys {
+		err = holder.AddManagedPeer(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key at index %d in %s", err, i, keysFile)
+		}
+
+		log.Debug("loaded key", "public key", publicKeys[i])
+	}
+
+	return holder, nil
+}
+
+func createServerOptions() ([]grpc.ServerOption, error) {
+	if remoteSigner.IsUnixSocketAddress(argsConfig.address) {
+		log.Warn("the signer listens on a Unix socket, the access is only restricted by the socket's file permissions")
+		return nil, nil
+	}
+
+	transportCredentials, err := remoteSigner.NewServerTransportCredentials(
+		argsConfig.certificateFile,
+		argsConfig.keyFile,
+		argsConfig.clientCACertificate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []grpc.ServerOption{grpc.Creds(transportCredentials)}, nil
+}
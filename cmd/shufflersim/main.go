@@ -0,0 +1,237 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/shufflersim/simulator"
+	"github.com/ElrondNetwork/elrond-go/common"
+	commonFactory "github.com/ElrondNetwork/elrond-go/common/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	nodesSetupFile  string
+	configFile      string
+	epochConfigFile string
+	eventsFile      string
+	numEpochs       uint
+	randomness      string
+	format          string
+	outputFile      string
+	logLevel        string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// nodesSetupFile defines a flag for the genesis nodes setup the simulation starts from
+	nodesSetupFile = cli.StringFlag{
+		Name:        "nodes-setup",
+		Usage:       "The genesis nodes setup file the simulation starts from",
+		Value:       "./config/nodesSetup.json",
+		Destination: &argsConfig.nodesSetupFile,
+	}
+	// configFile defines a flag for the node's main configuration file
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The main configuration file of the node, used for the public key converters and the maximum number of shards",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// epochConfigFile defines a flag for the enable epochs configuration file
+	epochConfigFile = cli.StringFlag{
+		Name:        "epoch-config",
+		Usage:       "The enable epochs configuration file, used for the shuffler's nodes configurations and flags",
+		Value:       "./config/enableEpochs.toml",
+		Destination: &argsConfig.epochConfigFile,
+	}
+	// eventsFile defines a flag for the JSON file holding the simulated events
+	eventsFile = cli.StringFlag{
+		Name: "events",
+		Usage: "The optional JSON file holding the list of events applied before the end of their epoch, for example " +
+			"[{\"epoch\": 1, \"type\": \"stake\", \"publicKey\": \"<hex BLS key>\"}]. The event types are stake, unstake, jail and unjail",
+		Value:       "",
+		Destination: &argsConfig.eventsFile,
+	}
+	// numEpochs defines a flag for the number of simulated epoch changes
+	numEpochs = cli.UintFlag{
+		Name:        "num-epochs",
+		Usage:       "The number of simulated epoch changes",
+		Value:       10,
+		Destination: &argsConfig.numEpochs,
+	}
+	// randomness defines a flag for the seed of the epochs randomness
+	randomness = cli.StringFlag{
+		Name:        "randomness",
+		Usage:       "The seed the randomness of each epoch is derived from. The same seed always produces the same simulation",
+		Value:       "shufflersim",
+		Destination: &argsConfig.randomness,
+	}
+	// format defines a flag for the report format
+	format = cli.StringFlag{
+		Name:        "format",
+		Usage:       "The report format: json or csv. The csv report holds the shard distributions and the keys tables, separated by an empty line",
+		Value:       simulator.FormatJSON,
+		Destination: &argsConfig.format,
+	}
+	// outputFile defines a flag for the report file
+	outputFile = cli.StringFlag{
+		Name:        "output",
+		Usage:       "The file the report is written to. If empty, the report is written to the standard output",
+		Value:       "",
+		Destination: &argsConfig.outputFile,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example, if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,nodesCoordinator:DEBUG the logs for all packages will have the INFO level, excepting the nodesCoordinator package which will receive a DEBUG log level.",
+		Value:       "*:" + logger.LogError.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("shufflersim")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Shuffler Simulator"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary runs the validators shuffler and the nodes coordinator over a number of epochs, starting from a nodes setup and applying staking, unstaking and jail events, and reports the shard distribution of each epoch along with the waiting and eligible epochs of each key"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		nodesSetupFile,
+		configFile,
+		epochConfigFile,
+		eventsFile,
+		numEpochs,
+		randomness,
+		format,
+		outputFile,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return startSimulation()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("simulation failed", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func startSimulation() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+	if argsConfig.format != simulator.FormatJSON && argsConfig.format != simulator.FormatCSV {
+		return fmt.Errorf("%w: %s", simulator.ErrUnknownFormat, argsConfig.format)
+	}
+
+	nodesSetup, err := loadNodesSetup()
+	if err != nil {
+		return err
+	}
+
+	epochConfig, err := common.LoadEpochConfig(argsConfig.epochConfigFile)
+	if err != nil {
+		return err
+	}
+
+	events := make([]*simulator.Event, 0)
+	if len(argsConfig.eventsFile) > 0 {
+		events, err = simulator.LoadEvents(argsConfig.eventsFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	sim, err := simulator.NewSimulator(simulator.ArgsSimulator{
+		NodesSetup:   nodesSetup,
+		EnableEpochs: epochConfig.EnableEpochs,
+		Events:       events,
+		Randomness:   argsConfig.randomness,
+		NumEpochs:    uint32(argsConfig.numEpochs),
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := sim.Run()
+	if err != nil {
+		return err
+	}
+
+	var writer io.Writer = os.Stdout
+	if len(argsConfig.outputFile) > 0 {
+		file, errCreate := os.Create(argsConfig.outputFile)
+		if errCreate != nil {
+			return errCreate
+		}
+		defer func() {
+			log.LogIfError(file.Close())
+		}()
+
+		writer = file
+	}
+
+	return report.Write(writer, argsConfig.format)
+}
+
+func loadNodesSetup() (sharding.GenesisNodesSetupHandler, error) {
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	addressPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for AddressPubkeyConverter", err)
+	}
+
+	validatorPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.ValidatorPubkeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for ValidatorPubkeyConverter", err)
+	}
+
+	nodesSetup, err := sharding.NewNodesSetup(
+		argsConfig.nodesSetupFile,
+		addressPubkeyConverter,
+		validatorPubkeyConverter,
+		generalConfig.GeneralSettings.GenesisMaxNumberOfShards,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodesSetup, nil
+}
@@ -0,0 +1,18 @@
+package simulator
+
+import "errors"
+
+// ErrNilNodesSetup signals that a nil nodes setup has been provided
+var ErrNilNodesSetup = errors.New("nil nodes setup")
+
+// ErrInvalidNumEpochs signals that an invalid number of epochs to simulate has been provided
+var ErrInvalidNumEpochs = errors.New("invalid number of epochs")
+
+// ErrInvalidEvent signals that an invalid event has been provided
+var ErrInvalidEvent = errors.New("invalid event")
+
+// ErrEpochNotComputed signals that the nodes coordinator could not compute the nodes configuration of an epoch
+var ErrEpochNotComputed = errors.New("the nodes coordinator could not compute the nodes configuration of the epoch")
+
+// ErrUnknownFormat signals that an unknown report format has been requested
+var ErrUnknownFormat = errors.New("unknown report format")
@@ -0,0 +1,66 @@
+package simulator
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// EventType defines the kind of change a simulated event applies to a validator
+type EventType string
+
+const (
+	// StakeEvent adds a new validator, registered in the new list of the epoch start
+	StakeEvent EventType = "stake"
+	// UnstakeEvent makes an eligible or waiting validator leave the lists, or drops a new one
+	UnstakeEvent EventType = "unstake"
+	// JailEvent removes a validator from the lists until it is unjailed
+	JailEvent EventType = "jail"
+	// UnjailEvent registers a jailed validator again in the new list of the epoch start
+	UnjailEvent EventType = "unjail"
+)
+
+// Event is a change applied to a validator right before the start of the epoch following the provided one
+type Event struct {
+	Epoch     uint32    `json:"epoch"`
+	Type      EventType `json:"type"`
+	PublicKey string    `json:"publicKey"`
+}
+
+// LoadEvents loads the events from the provided JSON file
+func LoadEvents(filePath string) ([]*Event, error) {
+	events := make([]*Event, 0)
+	err := core.LoadJsonFile(&events, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkEvents(events)
+	if err != nil {
+		return nil, fmt.Errorf("%w in %s", err, filePath)
+	}
+
+	return events, nil
+}
+
+func checkEvents(events []*Event) error {
+	for i, event := range events {
+		if event == nil {
+			return fmt.Errorf("%w: nil event at index %d", ErrInvalidEvent, i)
+		}
+
+		switch event.Type {
+		case StakeEvent, UnstakeEvent, JailEvent, UnjailEvent:
+		default:
+			return fmt.Errorf("%w: unknown type %s at index %d", ErrInvalidEvent, event.Type, i)
+		}
+
+		publicKey, err := hex.DecodeString(event.PublicKey)
+		if err != nil || len(publicKey) == 0 {
+			return fmt.Errorf("%w: invalid public key %s at index %d", ErrInvalidEvent, event.PublicKey, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package simulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+type keyState struct {
+	shardID            uint32
+	list               common.PeerType
+	isUnstaked         bool
+	registrationIndex  uint32
+	numEpochsInWaiting uint32
+	eligibleEpochs     []uint32
+}
+
+// currentList returns the list the key is reported in, an unstaked key that did not leave yet being reported as leaving
+func (ks *keyState) currentList() common.PeerType {
+	if ks.isUnstaked {
+		return common.LeavingList
+	}
+
+	return ks.list
+}
+
+// keysTracker follows the lists of every key seen during a simulation, from the lists computed by the nodes
+// coordinator and from the applied events
+type keysTracker struct {
+	keys             map[string]*keyState
+	eligible         map[uint32][][]byte
+	waiting          map[uint32][][]byte
+	numRegistrations uint32
+}
+
+func newKeysTracker() *keysTracker {
+	return &keysTracker{
+		keys:     make(map[string]*keyState),
+		eligible: make(map[uint32][][]byte),
+		waiting:  make(map[uint32][][]byte),
+	}
+}
+
+// recordEpoch updates the keys with the lists computed for the provided epoch. The eligible or waiting keys missing
+// from the new lists have left and become inactive
+func (kt *keysTracker) recordEpoch(epoch uint32, eligible map[uint32][][]byte, waiting map[uint32][][]byte) {
+	kt.eligible = eligible
+	kt.waiting = waiting
+
+	seen := make(map[string]struct{})
+	for shardID, publicKeys := range eligible {
+		for _, publicKey := range publicKeys {
+			ks := kt.getOrCreateKey(publicKey)
+			if ks.list != common.EligibleList {
+				ks.eligibleEpochs = append(ks.eligibleEpochs, epoch)
+			}
+			ks.list = common.EligibleList
+			ks.shardID = shardID
+			seen[string(publicKey)] = struct{}{}
+		}
+	}
+	for shardID, publicKeys := range waiting {
+		for _, publicKey := range publicKeys {
+			ks := kt.getOrCreateKey(publicKey)
+			ks.list = common.WaitingList
+			ks.shardID = shardID
+			ks.numEpochsInWaiting++
+			seen[string(publicKey)] = struct{}{}
+		}
+	}
+
+	for publicKey, ks := range kt.keys {
+		_, found := seen[publicKey]
+		if found {
+			continue
+		}
+		if ks.list == common.EligibleList || ks.list == common.WaitingList {
+			ks.list = common.InactiveList
+			ks.isUnstaked = false
+		}
+	}
+}
+
+func (kt *keysTracker) getOrCreateKey(publicKey []byte) *keyState {
+	ks, found := kt.keys[string(publicKey)]
+	if !found {
+		ks = &keyState{
+			list: common.InactiveList,
+		}
+		kt.keys[string(publicKey)] = ks
+	}
+
+	return ks
+}
+
+// applyEvent changes the list of the event's key, returning an error if the event is not possible in its current list
+func (kt *keysTracker) applyEvent(event *Event) error {
+	publicKey, err := hex.DecodeString(event.PublicKey)
+	if err != nil {
+		return fmt.Errorf("%w: invalid public key %s", ErrInvalidEvent, event.PublicKey)
+	}
+
+	ks := kt.getOrCreateKey(publicKey)
+	list := ks.currentList()
+	switch event.Type {
+	case StakeEvent:
+		if list != common.InactiveList {
+			return newInvalidEventError(event, list)
+		}
+		kt.register(ks)
+	case UnstakeEvent:
+		switch list {
+		case common.EligibleList, common.WaitingList:
+			ks.isUnstaked = true
+		case common.NewList:
+			ks.list = common.InactiveList
+		default:
+			return newInvalidEventError(event, list)
+		}
+	case JailEvent:
+		switch list {
+		case common.EligibleList, common.WaitingList, common.NewList:
+			ks.list = common.JailedList
+		default:
+			return newInvalidEventError(event, list)
+		}
+	case UnjailEvent:
+		if list != common.JailedList {
+			return newInvalidEventError(event, list)
+		}
+		kt.register(ks)
+	default:
+		return fmt.Errorf("%w: unknown type %s", ErrInvalidEvent, event.Type)
+	}
+
+	return nil
+}
+
+// register places the key in the new list, after the keys registered before it
+func (kt *keysTracker) register(ks *keyState) {
+	ks.list = common.NewList
+	ks.isUnstaked = false
+	ks.registrationIndex = kt.numRegistrations
+	kt.numRegistrations++
+}
+
+func newInvalidEventError(event *Event, list common.PeerType) error {
+	return fmt.Errorf("%w: cannot %s key %s from the %s list", ErrInvalidEvent, event.Type, event.PublicKey, list)
+}
+
+// createValidatorInfoBody creates the peer block the nodes coordinator computes the lists of the next epoch from, as
+// the metachain does at the start of each epoch
+func (kt *keysTracker) createValidatorInfoBody(marshaller marshal.Marshalizer) (*block.Body, error) {
+	validatorsInfo := make([]*state.ShardValidatorInfo, 0, len(kt.keys))
+	validatorsInfo = kt.appendShardValidatorsInfo(validatorsInfo, kt.eligible, common.EligibleList)
+	validatorsInfo = kt.appendShardValidatorsInfo(validatorsInfo, kt.waiting, common.WaitingList)
+
+	newKeys := make([]string, 0)
+	for publicKey, ks := range kt.keys {
+		if ks.list == common.NewList {
+			newKeys = append(newKeys, publicKey)
+		}
+	}
+	sort.Slice(newKeys, func(i, j int) bool {
+		return kt.keys[newKeys[i]].registrationIndex < kt.keys[newKeys[j]].registrationIndex
+	})
+	for _, publicKey := range newKeys {
+		validatorsInfo = append(validatorsInfo, &state.ShardValidatorInfo{
+			PublicKey: []byte(publicKey),
+			List:      string(common.NewList),
+			Index:     kt.keys[publicKey].registrationIndex,
+		})
+	}
+
+	txHashes := make([][]byte, 0, len(validatorsInfo))
+	for _, validatorInfo := range validatorsInfo {
+		buff, err := marshaller.Marshal(validatorInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		txHashes = append(txHashes, buff)
+	}
+
+	return &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				Type:     block.PeerBlock,
+				TxHashes: txHashes,
+			},
+		},
+	}, nil
+}
+
+// appendShardValidatorsInfo appends the keys of the provided lists, indexed by their position so that the nodes
+// coordinator keeps their order. The jailed and unstaked keys are sent in their own lists
+func (kt *keysTracker) appendShardValidatorsInfo(
+	validatorsInfo []*state.ShardValidatorInfo,
+	lists map[uint32][][]byte,
+	listType common.PeerType,
+) []*state.ShardValidatorInfo {
+	for shardID, publicKeys := range lists {
+		for i, publicKey := range publicKeys {
+			list := listType
+			ks := kt.keys[string(publicKey)]
+			if ks.list == common.JailedList || ks.isUnstaked {
+				list = ks.currentList()
+			}
+
+			validatorsInfo = append(validatorsInfo, &state.ShardValidatorInfo{
+				PublicKey: publicKey,
+				ShardId:   shardID,
+				List:      string(list),
+				Index:     uint32(i),
+			})
+		}
+	}
+
+	return validatorsInfo
+}
+
+// createKeyReports returns the reports of all the seen keys, sorted by public key
+func (kt *keysTracker) createKeyReports() []*KeyReport {
+	keyReports := make([]*KeyReport, 0, len(kt.keys))
+	for publicKey, ks := range kt.keys {
+		eligibleEpochs := make([]uint32, len(ks.eligibleEpochs))
+		copy(eligibleEpochs, ks.eligibleEpochs)
+
+		keyReports = append(keyReports, &KeyReport{
+			PublicKey:          hex.EncodeToString([]byte(publicKey)),
+			ShardID:            ks.shardID,
+			List:               string(ks.currentList()),
+			NumEpochsInWaiting: ks.numEpochsInWaiting,
+			EligibleEpochs:     eligibleEpochs,
+		})
+	}
+
+	sort.Slice(keyReports, func(i, j int) bool {
+		return keyReports[i].PublicKey < keyReports[j].PublicKey
+	})
+
+	return keyReports
+}
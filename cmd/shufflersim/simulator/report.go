@@ -0,0 +1,99 @@
+package simulator
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+const (
+	// FormatJSON is the format writing the report as a single JSON object
+	FormatJSON = "json"
+	// FormatCSV is the format writing the report as two CSV tables, the shard distributions followed by the keys,
+	// separated by an empty line
+	FormatCSV = "csv"
+)
+
+// ShardDistribution holds the number of validators of a shard in each list
+type ShardDistribution struct {
+	ShardID     uint32 `json:"shardID"`
+	NumEligible int    `json:"numEligible"`
+	NumWaiting  int    `json:"numWaiting"`
+	NumLeaving  int    `json:"numLeaving"`
+}
+
+// EpochReport holds the shard distribution of an epoch, along with the randomness its lists were shuffled with
+type EpochReport struct {
+	Epoch      uint32               `json:"epoch"`
+	Randomness string               `json:"randomness"`
+	Shards     []*ShardDistribution `json:"shards"`
+}
+
+// KeyReport holds the history of a validator over the simulated epochs. The shard and the list are the ones of the
+// last simulated epoch and the eligible epochs are the epochs in which the validator entered the eligible list
+type KeyReport struct {
+	PublicKey          string   `json:"publicKey"`
+	ShardID            uint32   `json:"shardID"`
+	List               string   `json:"list"`
+	NumEpochsInWaiting uint32   `json:"numEpochsInWaiting"`
+	EligibleEpochs     []uint32 `json:"eligibleEpochs"`
+}
+
+// Report is the result of a simulation
+type Report struct {
+	Epochs []*EpochReport `json:"epochs"`
+	Keys   []*KeyReport   `json:"keys"`
+}
+
+// Write writes the report in the provided format
+func (report *Report) Write(writer io.Writer, format string) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case FormatCSV:
+		return report.writeCSV(writer)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+func (report *Report) writeCSV(writer io.Writer) error {
+	records := [][]string{{"epoch", "randomness", "shard", "eligible", "waiting", "leaving"}}
+	for _, epochReport := range report.Epochs {
+		for _, shard := range epochReport.Shards {
+			records = append(records, []string{
+				strconv.FormatUint(uint64(epochReport.Epoch), 10),
+				epochReport.Randomness,
+				core.GetShardIDString(shard.ShardID),
+				strconv.Itoa(shard.NumEligible),
+				strconv.Itoa(shard.NumWaiting),
+				strconv.Itoa(shard.NumLeaving),
+			})
+		}
+	}
+
+	records = append(records, []string{}, []string{"publicKey", "shard", "list", "epochsInWaiting", "eligibleEpochs"})
+	for _, key := range report.Keys {
+		eligibleEpochs := make([]string, 0, len(key.EligibleEpochs))
+		for _, epoch := range key.EligibleEpochs {
+			eligibleEpochs = append(eligibleEpochs, strconv.FormatUint(uint64(epoch), 10))
+		}
+
+		records = append(records, []string{
+			key.PublicKey,
+			core.GetShardIDString(key.ShardID),
+			key.List,
+			strconv.FormatUint(uint64(key.NumEpochsInWaiting), 10),
+			strings.Join(eligibleEpochs, ";"),
+		})
+	}
+
+	return csv.NewWriter(writer).WriteAll(records)
+}
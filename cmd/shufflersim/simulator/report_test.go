@@ -0,0 +1,70 @@
+package simulator_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/cmd/shufflersim/simulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createReport() *simulator.Report {
+	return &simulator.Report{
+		Epochs: []*simulator.EpochReport{
+			{
+				Epoch:      1,
+				Randomness: "aabb",
+				Shards: []*simulator.ShardDistribution{
+					{ShardID: 0, NumEligible: 3, NumWaiting: 2, NumLeaving: 1},
+					{ShardID: core.MetachainShardId, NumEligible: 3, NumWaiting: 1},
+				},
+			},
+		},
+		Keys: []*simulator.KeyReport{
+			{PublicKey: "aa", ShardID: 0, List: "eligible", NumEpochsInWaiting: 2, EligibleEpochs: []uint32{0, 3}},
+			{PublicKey: "bb", ShardID: core.MetachainShardId, List: "waiting", NumEpochsInWaiting: 1, EligibleEpochs: []uint32{}},
+		},
+	}
+}
+
+func TestReport_WriteJSON(t *testing.T) {
+	t.Parallel()
+
+	report := createReport()
+	buff := &bytes.Buffer{}
+	err := report.Write(buff, simulator.FormatJSON)
+	require.Nil(t, err)
+
+	decodedReport := &simulator.Report{}
+	err = json.Unmarshal(buff.Bytes(), decodedReport)
+	require.Nil(t, err)
+	assert.Equal(t, report, decodedReport)
+}
+
+func TestReport_WriteCSV(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	err := createReport().Write(buff, simulator.FormatCSV)
+	require.Nil(t, err)
+
+	expected := "epoch,randomness,shard,eligible,waiting,leaving\n" +
+		"1,aabb,0,3,2,1\n" +
+		"1,aabb,metachain,3,1,0\n" +
+		"\n" +
+		"publicKey,shard,list,epochsInWaiting,eligibleEpochs\n" +
+		"aa,0,eligible,2,0;3\n" +
+		"bb,metachain,waiting,1,\n"
+	assert.Equal(t, expected, buff.String())
+}
+
+func TestReport_WriteUnknownFormatShouldError(t *testing.T) {
+	t.Parallel()
+
+	err := createReport().Write(&bytes.Buffer{}, "xml")
+	assert.True(t, errors.Is(err, simulator.ErrUnknownFormat))
+}
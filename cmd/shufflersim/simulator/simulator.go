@@ -0,0 +1,259 @@
+package simulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/nodetype"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+const cacheSize = 100
+
+// selfPublicKey is the key the simulated nodes coordinator runs as. It is not a validator so it is never shuffled out
+var selfPublicKey = []byte("shufflersim observer")
+
+// ArgsSimulator holds the arguments needed to create a new shuffling simulator
+type ArgsSimulator struct {
+	NodesSetup   sharding.GenesisNodesSetupHandler
+	EnableEpochs config.EnableEpochs
+	Events       []*Event
+	Randomness   string
+	NumEpochs    uint32
+}
+
+type simulator struct {
+	nodesSetup   sharding.GenesisNodesSetupHandler
+	enableEpochs config.EnableEpochs
+	events       map[uint32][]*Event
+	randomness   string
+	numEpochs    uint32
+	hasher       hashing.Hasher
+	marshaller   marshal.Marshalizer
+}
+
+// NewSimulator creates a new simulator of the validators shuffling, starting from the genesis nodes setup
+func NewSimulator(args ArgsSimulator) (*simulator, error) {
+	if check.IfNil(args.NodesSetup) {
+		return nil, ErrNilNodesSetup
+	}
+	if args.NumEpochs == 0 {
+		return nil, ErrInvalidNumEpochs
+	}
+	err := checkEvents(args.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(map[uint32][]*Event)
+	for _, event := range args.Events {
+		events[event.Epoch] = append(events[event.Epoch], event)
+	}
+
+	return &simulator{
+		nodesSetup:   args.NodesSetup,
+		enableEpochs: args.EnableEpochs,
+		events:       events,
+		randomness:   args.Randomness,
+		numEpochs:    args.NumEpochs,
+		hasher:       sha256.NewSha256(),
+		marshaller:   &marshal.GogoProtoMarshalizer{},
+	}, nil
+}
+
+// Run simulates the configured number of epoch changes with the real shuffler and nodes coordinator. Before each epoch
+// change, the events of the ending epoch are applied in the order they were provided
+func (sim *simulator) Run() (*Report, error) {
+	epochStartNotifier := notifier.NewEpochStartSubscriptionHandler()
+	coordinator, err := sim.createNodesCoordinator(epochStartNotifier)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := newKeysTracker()
+	report := &Report{
+		Epochs: make([]*EpochReport, 0, sim.numEpochs+1),
+	}
+
+	epochReport, err := sim.recordEpoch(coordinator, tracker, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	report.Epochs = append(report.Epochs, epochReport)
+
+	for epoch := uint32(0); epoch < sim.numEpochs; epoch++ {
+		for _, event := range sim.events[epoch] {
+			err = tracker.applyEvent(event)
+			if err != nil {
+				return nil, fmt.Errorf("%w in epoch %d", err, epoch)
+			}
+		}
+
+		body, errCreate := tracker.createValidatorInfoBody(sim.marshaller)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		newEpoch := epoch + 1
+		randomness := sim.computeRandomness(newEpoch)
+		metaBlock := &block.MetaBlock{
+			Epoch:        newEpoch,
+			PrevRandSeed: randomness,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{{}},
+			},
+		}
+		epochStartNotifier.NotifyAllPrepare(metaBlock, body)
+		epochStartNotifier.NotifyAll(metaBlock)
+
+		epochReport, err = sim.recordEpoch(coordinator, tracker, newEpoch, randomness)
+		if err != nil {
+			return nil, err
+		}
+		report.Epochs = append(report.Epochs, epochReport)
+	}
+
+	report.Keys = tracker.createKeyReports()
+
+	return report, nil
+}
+
+func (sim *simulator) createNodesCoordinator(
+	epochStartNotifier nodesCoordinator.EpochStartEventNotifier,
+) (nodesCoordinator.NodesCoordinator, error) {
+	shuffler, err := nodesCoordinator.NewHashValidatorsShuffler(&nodesCoordinator.NodesShufflerArgs{
+		NodesShard:                     sim.nodesSetup.MinNumberOfShardNodes(),
+		NodesMeta:                      sim.nodesSetup.MinNumberOfMetaNodes(),
+		Hysteresis:                     sim.nodesSetup.GetHysteresis(),
+		Adaptivity:                     sim.nodesSetup.GetAdaptivity(),
+		ShuffleBetweenShards:           true,
+		MaxNodesEnableConfig:           sim.enableEpochs.MaxNodesChangeEnableEpoch,
+		BalanceWaitingListsEnableEpoch: sim.enableEpochs.BalanceWaitingListsEnableEpoch,
+		WaitingListFixEnableEpoch:      sim.enableEpochs.WaitingListFixEnableEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eligibleNodesInfo, waitingNodesInfo := sim.nodesSetup.InitialNodesInfo()
+	eligibleValidators, err := nodesCoordinator.NodesInfoToValidators(eligibleNodesInfo)
+	if err != nil {
+		return nil, err
+	}
+	waitingValidators, err := nodesCoordinator.NodesInfoToValidators(waitingNodesInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	bootStorerCache, err := lrucache.NewCache(cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	bootStorer, err := storageUnit.NewStorageUnit(bootStorerCache, memorydb.New())
+	if err != nil {
+		return nil, err
+	}
+
+	consensusGroupCache, err := lrucache.NewCache(cacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	shuffledOutHandler, err := sharding.NewShuffledOutTrigger(selfPublicKey, core.MetachainShardId, func(_ endProcess.ArgEndProcess) error {
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	coordinator, err := nodesCoordinator.NewIndexHashedNodesCoordinator(nodesCoordinator.ArgNodesCoordinator{
+		ShardConsensusGroupSize:    int(sim.nodesSetup.GetShardConsensusGroupSize()),
+		MetaConsensusGroupSize:     int(sim.nodesSetup.GetMetaConsensusGroupSize()),
+		Marshalizer:                sim.marshaller,
+		Hasher:                     sim.hasher,
+		Shuffler:                   shuffler,
+		EpochStartNotifier:         epochStartNotifier,
+		BootStorer:                 bootStorer,
+		ShardIDAsObserver:          core.MetachainShardId,
+		NbShards:                   sim.nodesSetup.NumberOfShards(),
+		EligibleNodes:              eligibleValidators,
+		WaitingNodes:               waitingValidators,
+		SelfPublicKey:              selfPublicKey,
+		ConsensusGroupCache:        consensusGroupCache,
+		ShuffledOutHandler:         shuffledOutHandler,
+		WaitingListFixEnabledEpoch: sim.enableEpochs.WaitingListFixEnableEpoch,
+		ChanStopNode:               make(chan endProcess.ArgEndProcess, 1),
+		NodeTypeProvider:           nodetype.NewNodeTypeProvider(core.NodeTypeObserver),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return coordinator, nil
+}
+
+// computeRandomness returns the randomness the lists of the provided epoch are shuffled with, derived from the
+// configured randomness so that the same configuration always produces the same simulation
+func (sim *simulator) computeRandomness(epoch uint32) []byte {
+	return sim.hasher.Compute(fmt.Sprintf("%s-%d", sim.randomness, epoch))
+}
+
+func (sim *simulator) recordEpoch(
+	coordinator nodesCoordinator.NodesCoordinator,
+	tracker *keysTracker,
+	epoch uint32,
+	randomness []byte,
+) (*EpochReport, error) {
+	eligible, err := coordinator.GetAllEligibleValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w %d: %v", ErrEpochNotComputed, epoch, err)
+	}
+	waiting, err := coordinator.GetAllWaitingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w %d: %v", ErrEpochNotComputed, epoch, err)
+	}
+	leaving, err := coordinator.GetAllLeavingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w %d: %v", ErrEpochNotComputed, epoch, err)
+	}
+
+	tracker.recordEpoch(epoch, eligible, waiting)
+
+	shardIDs := make([]uint32, 0, len(eligible))
+	for shardID := range eligible {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	epochReport := &EpochReport{
+		Epoch:      epoch,
+		Randomness: hex.EncodeToString(randomness),
+		Shards:     make([]*ShardDistribution, 0, len(shardIDs)),
+	}
+	for _, shardID := range shardIDs {
+		epochReport.Shards = append(epochReport.Shards, &ShardDistribution{
+			ShardID:     shardID,
+			NumEligible: len(eligible[shardID]),
+			NumWaiting:  len(waiting[shardID]),
+			NumLeaving:  len(leaving[shardID]),
+		})
+	}
+
+	return epochReport, nil
+}
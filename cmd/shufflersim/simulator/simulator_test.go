@@ -0,0 +1,279 @@
+package simulator_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/cmd/shufflersim/simulator"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodesInfo(shardID uint32, publicKeys ...string) []nodesCoordinator.GenesisNodeInfoHandler {
+	nodesInfo := make([]nodesCoordinator.GenesisNodeInfoHandler, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		nodesInfo = append(nodesInfo, shardingMocks.NewNodeInfo([]byte("addr"+publicKey), []byte(publicKey), shardID, 0))
+	}
+
+	return nodesInfo
+}
+
+// createMockArgsSimulator returns a setup of one shard and the metachain, each with 3 eligible and 2 waiting validators,
+// shuffling one validator per shard and per epoch
+func createMockArgsSimulator() simulator.ArgsSimulator {
+	return simulator.ArgsSimulator{
+		NodesSetup: &testscommon.NodesSetupStub{
+			NumberOfShardsCalled: func() uint32 {
+				return 1
+			},
+			GetShardConsensusGroupSizeCalled: func() uint32 {
+				return 3
+			},
+			GetMetaConsensusGroupSizeCalled: func() uint32 {
+				return 3
+			},
+			MinNumberOfShardNodesCalled: func() uint32 {
+				return 3
+			},
+			MinNumberOfMetaNodesCalled: func() uint32 {
+				return 3
+			},
+			InitialNodesInfoCalled: func() (map[uint32][]nodesCoordinator.GenesisNodeInfoHandler, map[uint32][]nodesCoordinator.GenesisNodeInfoHandler) {
+				eligible := map[uint32][]nodesCoordinator.GenesisNodeInfoHandler{
+					0:                     createNodesInfo(0, "s0", "s1", "s2"),
+					core.MetachainShardId: createNodesInfo(core.MetachainShardId, "m0", "m1", "m2"),
+				}
+				waiting := map[uint32][]nodesCoordinator.GenesisNodeInfoHandler{
+					0:                     createNodesInfo(0, "w0", "w1"),
+					core.MetachainShardId: createNodesInfo(core.MetachainShardId, "mw0", "mw1"),
+				}
+				return eligible, waiting
+			},
+		},
+		EnableEpochs: config.EnableEpochs{
+			MaxNodesChangeEnableEpoch: []config.MaxNodesChangeConfig{
+				{
+					EpochEnable:            0,
+					MaxNumNodes:            100,
+					NodesToShufflePerShard: 1,
+				},
+			},
+		},
+		Randomness: "randomness",
+		NumEpochs:  5,
+	}
+}
+
+func hexKey(publicKey string) string {
+	return hex.EncodeToString([]byte(publicKey))
+}
+
+func getKeyReport(t *testing.T, report *simulator.Report, publicKey string) *simulator.KeyReport {
+	for _, keyReport := range report.Keys {
+		if keyReport.PublicKey == hexKey(publicKey) {
+			return keyReport
+		}
+	}
+
+	require.Fail(t, "key not found in report", publicKey)
+	return nil
+}
+
+func TestNewSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes setup should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.NodesSetup = nil
+		sim, err := simulator.NewSimulator(args)
+		assert.Nil(t, sim)
+		assert.Equal(t, simulator.ErrNilNodesSetup, err)
+	})
+	t.Run("zero epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.NumEpochs = 0
+		sim, err := simulator.NewSimulator(args)
+		assert.Nil(t, sim)
+		assert.Equal(t, simulator.ErrInvalidNumEpochs, err)
+	})
+	t.Run("unknown event type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.Events = []*simulator.Event{{Type: "slash", PublicKey: hexKey("s0")}}
+		sim, err := simulator.NewSimulator(args)
+		assert.Nil(t, sim)
+		assert.True(t, errors.Is(err, simulator.ErrInvalidEvent))
+	})
+	t.Run("invalid event public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.Events = []*simulator.Event{{Type: simulator.StakeEvent, PublicKey: "not hex"}}
+		sim, err := simulator.NewSimulator(args)
+		assert.Nil(t, sim)
+		assert.True(t, errors.Is(err, simulator.ErrInvalidEvent))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := simulator.NewSimulator(createMockArgsSimulator())
+		assert.NotNil(t, sim)
+		assert.Nil(t, err)
+	})
+}
+
+func TestSimulator_RunWithoutEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	sim, _ := simulator.NewSimulator(args)
+
+	report, err := sim.Run()
+	require.Nil(t, err)
+	require.Equal(t, int(args.NumEpochs)+1, len(report.Epochs))
+	assert.Empty(t, report.Epochs[0].Randomness)
+
+	for i, epochReport := range report.Epochs {
+		assert.Equal(t, uint32(i), epochReport.Epoch)
+		require.Equal(t, 2, len(epochReport.Shards))
+		assert.Equal(t, uint32(0), epochReport.Shards[0].ShardID)
+		assert.Equal(t, core.MetachainShardId, epochReport.Shards[1].ShardID)
+		for _, shard := range epochReport.Shards {
+			assert.Equal(t, 3, shard.NumEligible)
+			assert.Equal(t, 2, shard.NumWaiting)
+			assert.Equal(t, 0, shard.NumLeaving)
+		}
+	}
+
+	require.Equal(t, 10, len(report.Keys))
+	numEpochsInWaiting := uint32(0)
+	for _, keyReport := range report.Keys {
+		numEpochsInWaiting += keyReport.NumEpochsInWaiting
+	}
+	assert.Equal(t, 4*(args.NumEpochs+1), numEpochsInWaiting)
+
+	keyReport := getKeyReport(t, report, "s0")
+	require.NotEmpty(t, keyReport.EligibleEpochs)
+	assert.Equal(t, uint32(0), keyReport.EligibleEpochs[0])
+	keyReport = getKeyReport(t, report, "w0")
+	require.NotEmpty(t, keyReport.EligibleEpochs)
+	assert.True(t, keyReport.EligibleEpochs[0] > 0)
+	assert.True(t, keyReport.NumEpochsInWaiting > 0)
+}
+
+func TestSimulator_RunShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := simulator.NewSimulator(createMockArgsSimulator())
+	firstReport, err := sim.Run()
+	require.Nil(t, err)
+
+	sim, _ = simulator.NewSimulator(createMockArgsSimulator())
+	secondReport, err := sim.Run()
+	require.Nil(t, err)
+
+	assert.Equal(t, firstReport, secondReport)
+}
+
+func TestSimulator_RunWithStakeAndUnstake(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	args.NumEpochs = 1
+	args.Events = []*simulator.Event{
+		{Epoch: 0, Type: simulator.StakeEvent, PublicKey: hexKey("new0")},
+		{Epoch: 0, Type: simulator.UnstakeEvent, PublicKey: hexKey("w0")},
+	}
+	sim, _ := simulator.NewSimulator(args)
+
+	report, err := sim.Run()
+	require.Nil(t, err)
+
+	shards := report.Epochs[1].Shards
+	assert.Equal(t, simulator.ShardDistribution{ShardID: 0, NumEligible: 3, NumWaiting: 2, NumLeaving: 1}, *shards[0])
+	assert.Equal(t, simulator.ShardDistribution{ShardID: core.MetachainShardId, NumEligible: 3, NumWaiting: 2}, *shards[1])
+
+	keyReport := getKeyReport(t, report, "new0")
+	assert.Equal(t, "waiting", keyReport.List)
+	assert.Equal(t, uint32(1), keyReport.NumEpochsInWaiting)
+	assert.Empty(t, keyReport.EligibleEpochs)
+
+	keyReport = getKeyReport(t, report, "w0")
+	assert.Equal(t, "inactive", keyReport.List)
+	assert.Equal(t, uint32(1), keyReport.NumEpochsInWaiting)
+}
+
+func TestSimulator_RunWithJailAndUnjail(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	args.NumEpochs = 2
+	args.Events = []*simulator.Event{
+		{Epoch: 0, Type: simulator.JailEvent, PublicKey: hexKey("s0")},
+		{Epoch: 1, Type: simulator.UnjailEvent, PublicKey: hexKey("s0")},
+	}
+	sim, _ := simulator.NewSimulator(args)
+
+	report, err := sim.Run()
+	require.Nil(t, err)
+
+	shards := report.Epochs[1].Shards
+	assert.Equal(t, 3, shards[0].NumEligible)
+	assert.Equal(t, 2, shards[0].NumWaiting)
+	assert.Equal(t, 3, shards[1].NumEligible)
+	assert.Equal(t, 1, shards[1].NumWaiting)
+
+	keyReport := getKeyReport(t, report, "s0")
+	assert.Equal(t, "waiting", keyReport.List)
+	assert.Equal(t, []uint32{0}, keyReport.EligibleEpochs)
+	assert.Equal(t, uint32(1), keyReport.NumEpochsInWaiting)
+}
+
+func TestSimulator_RunWithInvalidEventShouldError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unstake of an unknown key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.Events = []*simulator.Event{{Epoch: 1, Type: simulator.UnstakeEvent, PublicKey: hexKey("unknown")}}
+		sim, _ := simulator.NewSimulator(args)
+
+		report, err := sim.Run()
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, simulator.ErrInvalidEvent))
+	})
+	t.Run("stake of an eligible key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.Events = []*simulator.Event{{Epoch: 0, Type: simulator.StakeEvent, PublicKey: hexKey("s0")}}
+		sim, _ := simulator.NewSimulator(args)
+
+		report, err := sim.Run()
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, simulator.ErrInvalidEvent))
+	})
+	t.Run("unjail of a key that is not jailed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.Events = []*simulator.Event{{Epoch: 0, Type: simulator.UnjailEvent, PublicKey: hexKey("w0")}}
+		sim, _ := simulator.NewSimulator(args)
+
+		report, err := sim.Run()
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, simulator.ErrInvalidEvent))
+	})
+}
@@ -117,6 +117,14 @@ const MetricNumShardsWithoutMetachain = "erd_num_shards_without_meta"
 // MetricTxPoolLoad is the metric for monitoring number of transactions from pool of a node
 const MetricTxPoolLoad = "erd_tx_pool_load"
 
+// MetricTxPoolNumReplacedTxs is the metric for monitoring the number of pending transactions replaced by transactions
+// having the same sender and nonce, but a higher gas price
+const MetricTxPoolNumReplacedTxs = "erd_tx_pool_num_replaced_txs"
+
+// MetricTxPoolNumRejectedReplacements is the metric for monitoring the number of transactions rejected by the pool as
+// underpriced replacements of pending transactions
+const MetricTxPoolNumRejectedReplacements = "erd_tx_pool_num_rejected_replacements"
+
 // MetricCountLeader is the metric for monitoring number of rounds when a node was leader
 const MetricCountLeader = "erd_count_leader"
 
@@ -620,6 +628,8 @@ const (
 	NetStatisticsOrder
 	// OldDatabaseCleanOrder defines the order in which oldDatabaseCleaner component is notified of a start of epoch event
 	OldDatabaseCleanOrder
+	// StateSnapshotExporterOrder defines the order in which the state snapshot exporter is notified of a start of epoch event
+	StateSnapshotExporterOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -662,6 +672,10 @@ const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_cl
 // to process VM queries
 const MetricAreVMQueriesReady = "erd_are_vm_queries_ready"
 
+// MetricOutportOutboxLag is the metric prefix for the number of outport outbox entries not yet delivered to a driver.
+// The full metric name is suffixed with the driver name
+const MetricOutportOutboxLag = "erd_outport_outbox_lag"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
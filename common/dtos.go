@@ -1,5 +1,10 @@
 package common
 
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
 // GetProofResponse is a struct that stores the response of a GetProof API request
 type GetProofResponse struct {
 	Proof    [][]byte
@@ -38,6 +43,201 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Gaps   []NonceGapApiResponse `json:"gaps"`
 }
 
+// PoolJournalEntryApiResponse is a struct that holds the details of a transaction removal from (or rejection by) the pool
+type PoolJournalEntryApiResponse struct {
+	TxHash      string `json:"txHash"`
+	Sender      string `json:"sender"`
+	Nonce       uint64 `json:"nonce"`
+	GasPrice    uint64 `json:"gasPrice"`
+	CacheID     string `json:"cacheId"`
+	Reason      string `json:"reason"`
+	Details     string `json:"details,omitempty"`
+	Timestamp   int64  `json:"timestamp"`
+	SenderScore uint32 `json:"senderScore"`
+	PoolSize    uint64 `json:"poolSize"`
+}
+
+// TransactionPoolHistoryApiResponse is a struct that holds the data to be returned when getting the pool history of a transaction from an API call
+type TransactionPoolHistoryApiResponse struct {
+	TxHash  string                        `json:"txHash"`
+	Entries []PoolJournalEntryApiResponse `json:"entries"`
+}
+
+// TransactionsPoolEvictionsApiResponse is a struct that holds the data to be returned when getting the latest pool evictions from an API call
+type TransactionsPoolEvictionsApiResponse struct {
+	Evictions []PoolJournalEntryApiResponse `json:"evictions"`
+}
+
+// GasPriceSuggestionApiResponse is a struct that holds the data to be returned when getting the gas price suggestion from an API call
+type GasPriceSuggestionApiResponse struct {
+	ShardID              uint32            `json:"shardID"`
+	NumBlocks            int               `json:"numBlocks"`
+	LastBlockNonce       uint64            `json:"lastBlockNonce"`
+	NumTransactions      int               `json:"numTransactions"`
+	MinGasPrice          uint64            `json:"minGasPrice"`
+	SuggestedGasPrice    uint64            `json:"suggestedGasPrice"`
+	GasPricePercentiles  map[string]uint64 `json:"gasPricePercentiles"`
+	AverageBlockFullness float64           `json:"averageBlockFullness"`
+	LastBlockFullness    float64           `json:"lastBlockFullness"`
+}
+
+// AddressTransactionsApiResponse is a struct that holds the data to be returned when getting the transactions of an address from an API call
+type AddressTransactionsApiResponse struct {
+	Address      string                              `json:"address"`
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+	Total        uint64                              `json:"total"`
+}
+
+// EventsQueryOptions holds the blocks range, the filters and the pagination of an events query. The address is bech32
+// encoded, while the topics are hex encoded and are matched, in order, against the first topics of the events
+type EventsQueryOptions struct {
+	FromNonce  uint64
+	ToNonce    uint64
+	Address    string
+	Identifier string
+	Topics     []string
+	From       uint64
+	Size       uint64
+}
+
+// EventApiResponse holds an event logged by an executed transaction, together with the block that included it
+type EventApiResponse struct {
+	TxHash     string   `json:"txHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// EventsApiResponse is a struct that holds the data to be returned when querying the logged events from an API call
+type EventsApiResponse struct {
+	Events  []*EventApiResponse `json:"events"`
+	HasMore bool                `json:"hasMore"`
+}
+
+// HyperblockApiResponse holds a metachain block together with the miniblocks of the shard blocks it notarizes. A
+// hyperblock is final when its nonce is not greater than the highest final metachain nonce
+type HyperblockApiResponse struct {
+	Hash                  string                        `json:"hash"`
+	PrevBlockHash         string                        `json:"prevBlockHash"`
+	Nonce                 uint64                        `json:"nonce"`
+	Round                 uint64                        `json:"round"`
+	Epoch                 uint32                        `json:"epoch"`
+	Timestamp             int64                         `json:"timestamp"`
+	Status                string                        `json:"status"`
+	IsFinal               bool                          `json:"isFinal"`
+	HighestFinalMetaNonce uint64                        `json:"highestFinalMetaNonce"`
+	NumTxs                uint32                        `json:"numTxs"`
+	MetaMiniBlocks        []*HyperblockMiniBlock        `json:"metaMiniBlocks"`
+	ShardBlocks           []*HyperblockShardBlockHeader `json:"shardBlocks"`
+}
+
+// HyperblockShardBlockHeader holds a shard block notarized in a hyperblock
+type HyperblockShardBlockHeader struct {
+	Hash       string                 `json:"hash"`
+	Nonce      uint64                 `json:"nonce"`
+	Round      uint64                 `json:"round"`
+	Shard      uint32                 `json:"shard"`
+	MiniBlocks []*HyperblockMiniBlock `json:"miniBlocks"`
+}
+
+// HyperblockMiniBlock holds a miniblock notarized in a hyperblock, together with its metachain notarization state.
+// The transactions are set only when requested and when the node has them in storage
+type HyperblockMiniBlock struct {
+	Hash                              string                   `json:"hash"`
+	Type                              string                   `json:"type"`
+	SourceShard                       uint32                   `json:"sourceShard"`
+	DestinationShard                  uint32                   `json:"destinationShard"`
+	TxCount                           uint32                   `json:"txCount"`
+	NotarizedAtSourceInMetaNonce      uint64                   `json:"notarizedAtSourceInMetaNonce"`
+	NotarizedAtDestinationInMetaNonce uint64                   `json:"notarizedAtDestinationInMetaNonce"`
+	Finality                          string                   `json:"finality"`
+	TransactionsUnavailable           bool                     `json:"transactionsUnavailable,omitempty"`
+	Transactions                      []*HyperblockTransaction `json:"transactions,omitempty"`
+}
+
+// HyperblockTransaction holds a transaction of a hyperblock together with its finality
+type HyperblockTransaction struct {
+	*transaction.ApiTransactionResult
+	Finality string `json:"finality"`
+}
+
+// ApiTransactionResultWithFinality holds a transaction together with its finality. The finality is not set when the
+// node does not track the metachain notarizations of the transactions
+type ApiTransactionResultWithFinality struct {
+	*transaction.ApiTransactionResult
+	Finality string `json:"finality,omitempty"`
+}
+
+// AccountHistoryQueryOptions holds the blocks range and the tokens of an account history query
+type AccountHistoryQueryOptions struct {
+	FromNonce uint64
+	ToNonce   uint64
+	Step      uint64
+	Tokens    []string
+}
+
+// AccountStoragePageOptions holds the cursor and the size of a page of account storage entries. The start key is the
+// hex encoded data trie key of the first entry of the page and a zero limit means that the page is not limited
+type AccountStoragePageOptions struct {
+	StartKey string
+	Limit    int
+}
+
+// KeyValuePairsPageApiResponse holds a page of the hex encoded key-value pairs of an account. The next key is the start
+// key of the following page and it is empty on the last page
+type KeyValuePairsPageApiResponse struct {
+	Pairs   map[string]string `json:"pairs"`
+	NextKey string            `json:"nextKey"`
+}
+
+// ESDTTokensPageApiResponse holds a page of the ESDT tokens of an account, by token identifier. The next key is the
+// start key of the following page and it is empty on the last page
+type ESDTTokensPageApiResponse struct {
+	Tokens  map[string]*esdt.ESDigitalToken `json:"tokens"`
+	NextKey string                          `json:"nextKey"`
+}
+
+// AccountHistoryPoint holds the state of an account at a given block
+type AccountHistoryPoint struct {
+	BlockNonce    uint64            `json:"blockNonce"`
+	BlockHash     string            `json:"blockHash"`
+	BlockRootHash string            `json:"blockRootHash"`
+	Balance       string            `json:"balance"`
+	Nonce         uint64            `json:"nonce"`
+	ESDTBalances  map[string]string `json:"esdtBalances,omitempty"`
+}
+
+// AccountHistoryApiResponse is a struct that holds the data to be returned when getting the history of an account from an API call
+type AccountHistoryApiResponse struct {
+	Address string                 `json:"address"`
+	History []*AccountHistoryPoint `json:"history"`
+}
+
+// ValueChange holds the value of a field before and after a transaction
+type ValueChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// AccountStateChange holds the changes done on an account by a transaction. The optional fields are set only if the
+// corresponding value changed. The storage keys and values are hex encoded, while the ESDT balances are keyed by the token identifier
+type AccountStateChange struct {
+	Address            string                  `json:"address"`
+	BalanceBefore      string                  `json:"balanceBefore"`
+	BalanceAfter       string                  `json:"balanceAfter"`
+	NonceBefore        uint64                  `json:"nonceBefore"`
+	NonceAfter         uint64                  `json:"nonceAfter"`
+	CodeHash           *ValueChange            `json:"codeHash,omitempty"`
+	Owner              *ValueChange            `json:"owner,omitempty"`
+	DeveloperReward    *ValueChange            `json:"developerReward,omitempty"`
+	ESDTBalanceChanges map[string]*ValueChange `json:"esdtBalanceChanges,omitempty"`
+	StorageChanges     map[string]*ValueChange `json:"storageChanges,omitempty"`
+	IsRemoved          bool                    `json:"isRemoved,omitempty"`
+}
+
 // DelegationDataAPI will be used when requesting the genesis balances from API
 type DelegationDataAPI struct {
 	Address string `json:"address"`
@@ -66,3 +266,115 @@ type EpochStartDataAPI struct {
 	AccumulatedFees   string `json:"accumulatedFees,omitempty"`
 	DeveloperFees     string `json:"developerFees,omitempty"`
 }
+
+// EquivocationMessage holds a consensus message taking part in an equivocation. The byte fields are hex encoded
+type EquivocationMessage struct {
+	MsgType         int64                    `json:"msgType"`
+	BlockHeaderHash string                   `json:"blockHeaderHash"`
+	SignatureShare  string                   `json:"signatureShare,omitempty"`
+	Header          string                   `json:"header,omitempty"`
+	Signature       string                   `json:"signature"`
+	OriginatorPid   string                   `json:"originatorPid"`
+	PeerMessage     *EquivocationPeerMessage `json:"peerMessage"`
+}
+
+// EquivocationPeerMessage holds the signed peer message a consensus message was received in. The byte fields are hex
+// encoded
+type EquivocationPeerMessage struct {
+	From      string `json:"from"`
+	Payload   string `json:"payload"`
+	SeqNo     string `json:"seqNo"`
+	Topic     string `json:"topic"`
+	Signature string `json:"signature"`
+	Key       string `json:"key"`
+}
+
+// EquivocationEvidence holds two conflicting consensus messages of the same kind, sent by the same validator in the
+// same round. The signature shares can be checked against the validator's BLS public key, while the proposed headers
+// can be checked against their header hashes and are only recorded if sent by the round's consensus group leader. Each
+// peer message signature, checked against its originator key, binds the payload holding the consensus message to the
+// originator, while the consensus message signature binds the validator's public key to the same originator
+type EquivocationEvidence struct {
+	Type      string               `json:"type"`
+	PublicKey string               `json:"publicKey"`
+	Round     int64                `json:"round"`
+	ShardID   uint32               `json:"shardID"`
+	ChainID   string               `json:"chainID"`
+	Timestamp int64                `json:"timestamp"`
+	First     *EquivocationMessage `json:"first"`
+	Second    *EquivocationMessage `json:"second"`
+}
+
+// ConsensusSubroundTrace holds the timeline of a subround. The offsets are in milliseconds, relative to the start of
+// the round
+type ConsensusSubroundTrace struct {
+	Name          string `json:"name"`
+	StartOffsetMs int64  `json:"startOffsetMs"`
+	EndOffsetMs   int64  `json:"endOffsetMs"`
+	Status        string `json:"status"`
+}
+
+// ConsensusMessageTrace holds the arrival of a consensus message. The offset is in milliseconds, relative to the start
+// of the round
+type ConsensusMessageTrace struct {
+	Type          string `json:"type"`
+	OriginatorPid string `json:"originatorPid"`
+	OffsetMs      int64  `json:"offsetMs"`
+}
+
+// ConsensusRoundTrace holds the consensus timeline of a round, as seen by the current node. The messages are grouped
+// by the hex encoded public keys of their senders
+type ConsensusRoundTrace struct {
+	Round                     int64                               `json:"round"`
+	StartTimestampMs          int64                               `json:"startTimestampMs"`
+	Leader                    string                              `json:"leader,omitempty"`
+	ConsensusGroupSize        int                                 `json:"consensusGroupSize"`
+	Subrounds                 []*ConsensusSubroundTrace           `json:"subrounds"`
+	Messages                  map[string][]*ConsensusMessageTrace `json:"messages"`
+	NumSignatures             int                                 `json:"numSignatures"`
+	SignaturesThreshold       int                                 `json:"signaturesThreshold"`
+	BlockProcessingDurationMs int64                               `json:"blockProcessingDurationMs"`
+	BlockProcessingError      string                              `json:"blockProcessingError,omitempty"`
+	Outcome                   string                              `json:"outcome"`
+}
+
+// ConsensusScheduleRound holds the consensus group of an upcoming round. The consensus group of a round depends on the
+// randomness of the block committed before it, so only the rounds computable with the last known randomness are
+// knowable. The public keys are hex encoded and the leader is the first member of the consensus group
+type ConsensusScheduleRound struct {
+	Round          int64    `json:"round"`
+	IsKnowable     bool     `json:"isKnowable"`
+	Leader         string   `json:"leader,omitempty"`
+	ConsensusGroup []string `json:"consensusGroup,omitempty"`
+}
+
+// ShardConsensusSchedule holds the consensus groups of the upcoming rounds of a shard, along with the round, the epoch
+// and the origin of the last known header of the shard, which provided the randomness. A header taken from the headers
+// pool may not be final or may belong to a fork, so the consensus groups computed with it are only estimates
+type ShardConsensusSchedule struct {
+	ShardID               uint32                    `json:"shardID"`
+	Epoch                 uint32                    `json:"epoch"`
+	RandomnessSource      string                    `json:"randomnessSource"`
+	RandomnessSourceRound uint64                    `json:"randomnessSourceRound"`
+	Rounds                []*ConsensusScheduleRound `json:"rounds"`
+}
+
+// ConsensusSchedule holds the consensus groups of the upcoming rounds for each shard, along with the node's current epoch
+type ConsensusSchedule struct {
+	Epoch        uint32                    `json:"epoch"`
+	CurrentRound int64                     `json:"currentRound"`
+	Shards       []*ShardConsensusSchedule `json:"shards"`
+}
+
+// ValidatorLeaderSchedule holds the next knowable round in which an eligible validator is the consensus leader, if any,
+// along with the expected number of rounds between its leaderships, given its chances within the shard
+type ValidatorLeaderSchedule struct {
+	PublicKey                        string  `json:"publicKey"`
+	ShardID                          uint32  `json:"shardID"`
+	Epoch                            uint32  `json:"epoch"`
+	RandomnessSource                 string  `json:"randomnessSource"`
+	CurrentRound                     int64   `json:"currentRound"`
+	LastKnowableRound                int64   `json:"lastKnowableRound"`
+	NextLeaderRound                  int64   `json:"nextLeaderRound,omitempty"`
+	ExpectedRoundsBetweenLeaderships float64 `json:"expectedRoundsBetweenLeaderships"`
+}
@@ -7,3 +7,12 @@ var ErrInvalidTimeout = errors.New("invalid timeout value")
 
 // ErrNilArwenChangeLocker signals that a nil arwen change locker has been provided
 var ErrNilArwenChangeLocker = errors.New("nil arwen change locker")
+
+// ErrRoundNotTraced signals that the requested round was not traced or was already removed
+var ErrRoundNotTraced = errors.New("round not traced")
+
+// ErrInvalidRoundsRange signals that an invalid rounds range has been provided
+var ErrInvalidRoundsRange = errors.New("invalid rounds range")
+
+// ErrRoundTracingDisabled signals that the rounds were requested, but the round tracing is disabled
+var ErrRoundTracingDisabled = errors.New("round tracing is disabled")
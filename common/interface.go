@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
 )
 
 // NumNodesDTO represents the DTO structure that will hold the number of nodes split by category and other
@@ -35,6 +36,7 @@ type Trie interface {
 	GetSerializedNode([]byte) ([]byte, error)
 	GetNumNodes() NumNodesDTO
 	GetAllLeavesOnChannel(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error
+	GetLeavesOnChannelFromKey(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte, startKey []byte) error
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
@@ -182,3 +184,15 @@ type PidQueueHandler interface {
 	Len() int
 	IsInterfaceNil() bool
 }
+
+// ManagedPeersHolder defines the operations of an entity that holds the validator keys managed by the current node,
+// besides its own key
+type ManagedPeersHolder interface {
+	AddManagedPeer(privateKeyBytes []byte) error
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	GetMultiSigner(pkBytes []byte) (crypto.MultiSigner, error)
+	GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey
+	IsKeyManagedByCurrentNode(pkBytes []byte) bool
+	IsMultiKeyMode() bool
+	IsInterfaceNil() bool
+}
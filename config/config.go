@@ -159,6 +159,10 @@ type Config struct {
 	TxBlockBodyDataPool         CacheConfig
 	PeerBlockBodyDataPool       CacheConfig
 	TxDataPool                  CacheConfig
+	TxPoolJournal               TxPoolJournalConfig
+	TxPoolReplacement           TxPoolReplacementConfig
+	TxPoolPersistence           TxPoolPersistenceConfig
+	GasPriceSuggestion          GasPriceSuggestionConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesChunksDataPool     CacheConfig
@@ -191,6 +195,9 @@ type Config struct {
 	Consensus           ConsensusConfig
 	StoragePruning      StoragePruningConfig
 	LogsAndEvents       LogsAndEventsConfig
+	OutportOutbox       OutportOutboxConfig
+
+	EquivocationDetection EquivocationDetectionConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
@@ -207,10 +214,41 @@ type Config struct {
 	Versions              VersionsConfig
 	Logs                  LogsConfig
 	TrieSync              TrieSyncConfig
+	StateSnapshotExport   StateSnapshotExportConfig
 	Resolvers             ResolverConfig
 	VMOutputCacher        CacheConfig
 
 	PeersRatingConfig PeersRatingConfig
+	RemoteSigner      RemoteSignerConfig
+}
+
+// TxPoolJournalConfig will hold settings related to the journal of transactions leaving (or rejected by) the pool
+type TxPoolJournalConfig struct {
+	Enabled  bool
+	Capacity uint32
+}
+
+// TxPoolReplacementConfig will hold settings related to the replacement of the pending transactions having the same
+// sender and nonce (replace-by-fee)
+type TxPoolReplacementConfig struct {
+	Enabled                   bool
+	MinGasPriceBumpPercentage uint32
+}
+
+// TxPoolPersistenceConfig will hold settings related to the saving of the transactions pools content on close and its
+// reloading on the next start
+type TxPoolPersistenceConfig struct {
+	Enabled  bool
+	FileName string
+}
+
+// GasPriceSuggestionConfig will hold settings related to the gas price suggestion computed over the latest committed blocks
+type GasPriceSuggestionConfig struct {
+	Enabled                       bool
+	NumBlocks                     uint32
+	Percentiles                   []uint32
+	SuggestionPercentile          uint32
+	CongestionThresholdPercentage uint32
 }
 
 // PeersRatingConfig will hold settings related to peers rating
@@ -447,6 +485,20 @@ type LogsAndEventsConfig struct {
 	TxLogsStorage        StorageConfig
 }
 
+// OutportOutboxConfig holds the configuration for the persistent outbox of the outport drivers
+type OutportOutboxConfig struct {
+	Enabled bool
+	Storage StorageConfig
+}
+
+// EquivocationDetectionConfig holds the configuration for the detection of the validators sending conflicting
+// consensus messages in the same round
+type EquivocationDetectionConfig struct {
+	Enabled      bool
+	PenaltyUnits int
+	Storage      StorageConfig
+}
+
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
 type DbLookupExtensionsConfig struct {
 	Enabled                            bool
@@ -457,6 +509,12 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AddressTransactionsIndexEnabled    bool
+	AddressTransactionsStorageConfig   StorageConfig
+	StateChangesEnabled                bool
+	StateChangesStorageConfig          StorageConfig
+	EventsIndexEnabled                 bool
+	EventsStorageConfig                StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -465,6 +523,7 @@ type DebugConfig struct {
 	Antiflood           AntifloodDebugConfig
 	ShuffleOut          ShuffleOutDebugConfig
 	EpochStart          EpochStartDebugConfig
+	ConsensusRounds     ConsensusRoundsDebugConfig
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -508,10 +567,18 @@ type EpochStartDebugConfig struct {
 	ProcessDataTrieOnCommitEpoch bool
 }
 
+// ConsensusRoundsDebugConfig will hold the consensus round tracer configuration
+type ConsensusRoundsDebugConfig struct {
+	Enabled         bool
+	NumRoundsToKeep int
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging        ApiLoggingConfig
+	Authentication ApiAuthenticationConfig
+	Grpc           ApiGrpcConfig
+	APIPackages    map[string]APIPackageConfig
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -520,6 +587,37 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// ApiAuthenticationConfig holds the configuration related to API requests authentication
+type ApiAuthenticationConfig struct {
+	Enabled                bool
+	MaxRequestAgeInSeconds uint32
+	PublicGroups           []string
+	QuotaBuckets           []ApiQuotaBucketConfig
+	Keys                   []ApiKeyConfig
+}
+
+// ApiQuotaBucketConfig holds the maximum number of requests allowed in a time window, shared by all the keys using it
+type ApiQuotaBucketConfig struct {
+	Name                   string
+	MaxRequests            uint32
+	ResetIntervalInSeconds uint32
+}
+
+// ApiKeyConfig holds the configuration of an API key. When the secret is set, the requests must be HMAC signed
+type ApiKeyConfig struct {
+	Name          string
+	Key           string
+	Secret        string
+	AllowedGroups []string
+	QuotaBucket   string
+}
+
+// ApiGrpcConfig holds the configuration of the gRPC server started alongside the web server
+type ApiGrpcConfig struct {
+	Enabled   bool
+	Interface string
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
 	Routes []RouteConfig
@@ -576,6 +674,7 @@ type ConfigurationPathsHolder struct {
 	Genesis                  string
 	SmartContracts           string
 	ValidatorKey             string
+	AllValidatorKeys         string
 	Epoch                    string
 	RoundActivation          string
 }
@@ -588,6 +687,25 @@ type TrieSyncConfig struct {
 	CheckNodesOnDisk          bool
 }
 
+// StateSnapshotExportConfig represents the configuration for the state snapshot files written at each epoch start
+type StateSnapshotExportConfig struct {
+	Enabled            bool
+	Directory          string
+	ChunkSizeInMB      uint32
+	NumSnapshotsToKeep uint32
+}
+
+// RemoteSignerConfig will hold the settings of the remote signer holding the node's BLS key
+type RemoteSignerConfig struct {
+	Enabled                 bool
+	Address                 string
+	PublicKey               string
+	CertificateFile         string
+	KeyFile                 string
+	CACertificateFile       string
+	RequestTimeoutInSeconds int
+}
+
 // ResolverConfig represents the config options to be used when setting up the resolver instances
 type ResolverConfig struct {
 	NumCrossShardPeers  uint32
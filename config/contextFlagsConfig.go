@@ -23,6 +23,8 @@ type ContextFlagsConfig struct {
 	ForceStartFromNetwork        bool
 	DisableConsensusWatchdog     bool
 	SerializeSnapshots           bool
+	ImportSnapshotPath           string
+	ImportSnapshotTrustedHash    string
 }
 
 // ImportDbConfig will hold the import-db parameters
@@ -5,6 +5,7 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	CovalentConnector      CovalentConfig
+	WebSocketConnector     WebSocketConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -35,3 +36,14 @@ type CovalentConfig struct {
 	RouteSendData        string
 	RouteAcknowledgeData string
 }
+
+// WebSocketConfig will hold the configuration for the web socket outport driver
+type WebSocketConfig struct {
+	Enabled                     bool
+	URL                         string
+	MaxNumClients               int
+	ClientBufferSize            int
+	WithAcknowledge             bool
+	AcknowledgeTimeoutInSec     int
+	FullBufferTimeoutInMillisec int
+}
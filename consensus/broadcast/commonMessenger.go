@@ -43,6 +43,7 @@ type commonMessenger struct {
 	shardCoordinator        sharding.Coordinator
 	peerSignatureHandler    crypto.PeerSignatureHandler
 	delayedBlockBroadcaster delayedBroadcaster
+	managedPeersHolder      common.ManagedPeersHolder
 }
 
 // CommonMessengerArgs holds the arguments for creating commonMessenger instance
@@ -58,6 +59,7 @@ type CommonMessengerArgs struct {
 	MaxDelayCacheSize          uint32
 	MaxValidatorDelayCacheSize uint32
 	AlarmScheduler             core.TimersScheduler
+	ManagedPeersHolder         common.ManagedPeersHolder
 }
 
 func checkCommonMessengerNilParameters(
@@ -90,6 +92,9 @@ func checkCommonMessengerNilParameters(
 	if check.IfNil(args.AlarmScheduler) {
 		return spos.ErrNilAlarmScheduler
 	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return spos.ErrNilManagedPeersHolder
+	}
 	if args.MaxDelayCacheSize == 0 || args.MaxValidatorDelayCacheSize == 0 {
 		return spos.ErrInvalidCacheSize
 	}
@@ -99,7 +104,7 @@ func checkCommonMessengerNilParameters(
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
-	signature, err := cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	signature, err := cm.signMessage(message)
 	if err != nil {
 		return err
 	}
@@ -119,6 +124,25 @@ func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message)
 	return nil
 }
 
+func (cm *commonMessenger) signMessage(message *consensus.Message) ([]byte, error) {
+	privateKey, err := cm.getPrivateKey(message.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cm.peerSignatureHandler.GetPeerSignature(privateKey, message.OriginatorPid)
+}
+
+// getPrivateKey returns the private key of the provided public key, if it is managed by the current node, or the
+// node's own private key otherwise
+func (cm *commonMessenger) getPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if !cm.managedPeersHolder.IsKeyManagedByCurrentNode(pkBytes) {
+		return cm.privateKey, nil
+	}
+
+	return cm.managedPeersHolder.GetPrivateKey(pkBytes)
+}
+
 // BroadcastMiniBlocks will send on miniblocks topic the cross-shard miniblocks
 func (cm *commonMessenger) BroadcastMiniBlocks(miniBlocks map[uint32][]byte) error {
 	for k, v := range miniBlocks {
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -48,6 +49,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -76,6 +78,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -83,6 +86,74 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCommonMessenger_BroadcastConsensusMessageShouldSignWithTheManagedKey(t *testing.T) {
+	managedPk := []byte("managed public key")
+	ownPrivateKey := &mock.PrivateKeyMock{}
+	managedPrivateKey := &mock.PrivateKeyMock{}
+	var usedPrivateKey crypto.PrivateKey
+	singleSignerMock := &mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			usedPrivateKey = private
+			return []byte("signature"), nil
+		},
+	}
+	managedPeersHolder := &cryptoMocks.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == string(managedPk)
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return managedPrivateKey, nil
+		},
+	}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {},
+		},
+		ownPrivateKey,
+		&mock.ShardCoordinatorMock{},
+		&mock.PeerSignatureHandler{Signer: singleSignerMock},
+		managedPeersHolder,
+	)
+
+	err := cm.BroadcastConsensusMessage(&consensus.Message{PubKey: managedPk})
+	assert.Nil(t, err)
+	assert.True(t, usedPrivateKey == managedPrivateKey) // pointer testing
+
+	err = cm.BroadcastConsensusMessage(&consensus.Message{PubKey: []byte("own public key")})
+	assert.Nil(t, err)
+	assert.True(t, usedPrivateKey == ownPrivateKey) // pointer testing
+}
+
+func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenManagedKeyIsMissing(t *testing.T) {
+	expectedErr := errors.New("missing key")
+	managedPeersHolder := &cryptoMocks.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return true
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return nil, expectedErr
+		},
+	}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				assert.Fail(t, "should have not broadcast")
+			},
+		},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.PeerSignatureHandler{Signer: &mock.SingleSignerMock{}},
+		managedPeersHolder,
+	)
+
+	err := cm.BroadcastConsensusMessage(&consensus.Message{PubKey: []byte("managed public key")})
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 	err := errors.New("sign message error")
 	marshalizerMock := &mock.MarshalizerMock{}
@@ -102,6 +173,7 @@ func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -157,6 +229,7 @@ func TestSubroundEndRound_ExtractMiniBlocksAndTransactionsShouldWork(t *testing.
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	metaMiniBlocks, metaTransactions := cm.ExtractMetaMiniBlocksAndTransactions(miniBlocks, transactions)
@@ -199,6 +272,7 @@ func TestCommonMessenger_BroadcastBlockData(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	miniBlocks := map[uint32][]byte{0: []byte("mbs data1"), 1: []byte("mbs data2")}
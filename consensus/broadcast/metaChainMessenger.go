@@ -54,6 +54,7 @@ func NewMetaChainMessenger(
 		shardCoordinator:        args.ShardCoordinator,
 		peerSignatureHandler:    args.PeerSignatureHandler,
 		delayedBlockBroadcaster: dbb,
+		managedPeersHolder:      args.ManagedPeersHolder,
 	}
 
 	mcm := &metaChainMessenger{
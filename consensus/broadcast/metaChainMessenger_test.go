@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,6 +41,7 @@ func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 			MaxValidatorDelayCacheSize: 2,
 			MaxDelayCacheSize:          2,
 			AlarmScheduler:             alarmScheduler,
+			ManagedPeersHolder:         &cryptoMocks.ManagedPeersHolderStub{},
 		},
 	}
 }
@@ -89,6 +91,15 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilPeerSignatureHandlerShouldFa
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestMetaChainMessenger_NewMetaChainMessengerNilManagedPeersHolderShouldFail(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.ManagedPeersHolder = nil
+	mcm, err := broadcast.NewMetaChainMessenger(args)
+
+	assert.Nil(t, mcm)
+	assert.Equal(t, spos.ErrNilManagedPeersHolder, err)
+}
+
 func TestMetaChainMessenger_NewMetaChainMessengerShouldWork(t *testing.T) {
 	args := createDefaultMetaChainArgs()
 	mcm, err := broadcast.NewMetaChainMessenger(args)
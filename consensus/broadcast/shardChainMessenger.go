@@ -43,6 +43,7 @@ func NewShardChainMessenger(
 		privateKey:           args.PrivateKey,
 		shardCoordinator:     args.ShardCoordinator,
 		peerSignatureHandler: args.PeerSignatureHandler,
+		managedPeersHolder:   args.ManagedPeersHolder,
 	}
 
 	dbbArgs := &ArgsDelayedBlockBroadcaster{
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -76,6 +77,7 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			MaxDelayCacheSize:          1,
 			MaxValidatorDelayCacheSize: 1,
 			AlarmScheduler:             alarmScheduler,
+			ManagedPeersHolder:         &cryptoMocks.ManagedPeersHolderStub{},
 		},
 	}
 }
@@ -143,6 +145,15 @@ func TestShardChainMessenger_NewShardChainMessengerNilHeadersSubscriberShouldFai
 	assert.Equal(t, spos.ErrNilHeadersSubscriber, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilManagedPeersHolderShouldFail(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.ManagedPeersHolder = nil
+	scm, err := broadcast.NewShardChainMessenger(args)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilManagedPeersHolder, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerShouldWork(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	scm, err := broadcast.NewShardChainMessenger(args)
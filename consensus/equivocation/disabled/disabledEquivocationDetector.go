@@ -0,0 +1,23 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type disabledEquivocationDetector struct {
+}
+
+// NewDisabledEquivocationDetector returns a new instance of an equivocation detector that does nothing
+func NewDisabledEquivocationDetector() *disabledEquivocationDetector {
+	return &disabledEquivocationDetector{}
+}
+
+// ProcessMessage does nothing
+func (ded *disabledEquivocationDetector) ProcessMessage(_ *consensus.Message, _ p2p.MessageP2P) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ded *disabledEquivocationDetector) IsInterfaceNil() bool {
+	return ded == nil
+}
@@ -0,0 +1,384 @@
+package equivocation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("consensus/equivocation")
+
+const (
+	// SignatureEquivocation is the type of the evidences holding two signature shares on different headers
+	SignatureEquivocation = "signature"
+	// ProposalEquivocation is the type of the evidences holding two different proposed headers
+	ProposalEquivocation = "proposal"
+
+	numRoundsToKeep = 10
+)
+
+type messageKey struct {
+	publicKey string
+	round     int64
+	kind      string
+}
+
+type receivedMessage struct {
+	cnsMsg  *consensus.Message
+	peerMsg p2p.MessageP2P
+}
+
+type messageRecord struct {
+	first      *receivedMessage
+	isRecorded bool
+}
+
+// ArgsEquivocationDetector holds the arguments needed to create an equivocation detector
+type ArgsEquivocationDetector struct {
+	ConsensusService       spos.ConsensusService
+	ShardCoordinator       sharding.Coordinator
+	Hasher                 hashing.Hasher
+	Marshaller             marshal.Marshalizer
+	NodesCoordinator       nodesCoordinator.NodesCoordinator
+	KeyGenerator           crypto.KeyGenerator
+	SignatureShareVerifier SignatureShareVerifier
+	Storer                 storage.Storer
+	PeerHonestyHandler     consensus.PeerHonestyHandler
+	OutportHandler         OutportHandler
+	PenaltyUnits           int
+}
+
+// equivocationDetector keeps the first signature share and the first proposed header sent by each validator in the
+// recent rounds. A second message of the same kind, for a different header hash, is checked and saved, along with the
+// first one and the signed peer messages they were received in, as equivocation evidence. Proposals are only taken into
+// account if sent by the consensus group leader. The validator's peer honesty score is then changed with the configured
+// penalty
+type equivocationDetector struct {
+	consensusService       spos.ConsensusService
+	shardCoordinator       sharding.Coordinator
+	hasher                 hashing.Hasher
+	marshaller             marshal.Marshalizer
+	nodesCoordinator       nodesCoordinator.NodesCoordinator
+	keyGenerator           crypto.KeyGenerator
+	signatureShareVerifier SignatureShareVerifier
+	storer                 storage.Storer
+	peerHonestyHandler     consensus.PeerHonestyHandler
+	outportHandler         OutportHandler
+	penaltyUnits           int
+	topic                  string
+
+	mutMessages  sync.Mutex
+	messages     map[messageKey]*messageRecord
+	highestRound int64
+}
+
+// NewEquivocationDetector creates a new equivocation detector instance
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &equivocationDetector{
+		consensusService:       args.ConsensusService,
+		shardCoordinator:       args.ShardCoordinator,
+		hasher:                 args.Hasher,
+		marshaller:             args.Marshaller,
+		nodesCoordinator:       args.NodesCoordinator,
+		keyGenerator:           args.KeyGenerator,
+		signatureShareVerifier: args.SignatureShareVerifier,
+		storer:                 args.Storer,
+		peerHonestyHandler:     args.PeerHonestyHandler,
+		outportHandler:         args.OutportHandler,
+		penaltyUnits:           args.PenaltyUnits,
+		topic:                  spos.GetConsensusTopicID(args.ShardCoordinator),
+		messages:               make(map[messageKey]*messageRecord),
+	}, nil
+}
+
+func checkArgs(args ArgsEquivocationDetector) error {
+	if check.IfNil(args.ConsensusService) {
+		return ErrNilConsensusService
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SignatureShareVerifier) {
+		return ErrNilSignatureShareVerifier
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.PeerHonestyHandler) {
+		return ErrNilPeerHonestyHandler
+	}
+	if check.IfNil(args.OutportHandler) {
+		return ErrNilOutportHandler
+	}
+	if args.PenaltyUnits > 0 {
+		return fmt.Errorf("%w, should be negative or zero, provided: %d", ErrInvalidPenaltyUnits, args.PenaltyUnits)
+	}
+
+	return nil
+}
+
+// ProcessMessage records the first signature share and the first proposed header sent by a validator in a round and
+// saves the equivocation evidence when a conflicting message of the same kind is received. The message should have
+// its origin already checked against the peer message it was received in
+func (ed *equivocationDetector) ProcessMessage(cnsMsg *consensus.Message, message p2p.MessageP2P) {
+	if cnsMsg == nil || check.IfNil(message) {
+		return
+	}
+
+	kind, ok := ed.getEquivocationType(cnsMsg)
+	if !ok {
+		return
+	}
+
+	key := messageKey{
+		publicKey: string(cnsMsg.PubKey),
+		round:     cnsMsg.RoundIndex,
+		kind:      kind,
+	}
+	received := &receivedMessage{
+		cnsMsg:  cnsMsg,
+		peerMsg: message,
+	}
+	first, isConflicting := ed.addMessage(key, received)
+	if !isConflicting {
+		return
+	}
+
+	err := ed.verifyMessage(kind, cnsMsg)
+	if err != nil {
+		log.Debug("equivocationDetector.ProcessMessage: conflicting message is not valid",
+			"type", kind,
+			"public key", cnsMsg.PubKey,
+			"round", cnsMsg.RoundIndex,
+			"error", err.Error())
+		return
+	}
+
+	err = ed.verifyMessage(kind, first.cnsMsg)
+	if err != nil {
+		log.Debug("equivocationDetector.ProcessMessage: first message is not valid, replacing it",
+			"type", kind,
+			"public key", cnsMsg.PubKey,
+			"round", cnsMsg.RoundIndex,
+			"error", err.Error())
+		ed.replaceFirstMessage(key, first, received)
+		return
+	}
+
+	if !ed.markAsRecorded(key) {
+		return
+	}
+
+	ed.recordEvidence(kind, first, received)
+}
+
+func (ed *equivocationDetector) getEquivocationType(cnsMsg *consensus.Message) (string, bool) {
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	if ed.consensusService.IsMessageWithSignature(msgType) {
+		return SignatureEquivocation, true
+	}
+
+	isProposal := ed.consensusService.IsMessageWithBlockHeader(msgType) ||
+		ed.consensusService.IsMessageWithBlockBodyAndHeader(msgType)
+	if isProposal {
+		return ProposalEquivocation, true
+	}
+
+	return "", false
+}
+
+// addMessage keeps the message if it is the first one for the provided key, otherwise returns the first message and
+// true if the two messages conflict and the equivocation was not yet recorded
+func (ed *equivocationDetector) addMessage(key messageKey, received *receivedMessage) (*receivedMessage, bool) {
+	ed.mutMessages.Lock()
+	defer ed.mutMessages.Unlock()
+
+	ed.removeOldRounds(key.round)
+
+	record, found := ed.messages[key]
+	if !found {
+		ed.messages[key] = &messageRecord{
+			first: received,
+		}
+		return nil, false
+	}
+
+	if record.isRecorded || bytes.Equal(record.first.cnsMsg.BlockHeaderHash, received.cnsMsg.BlockHeaderHash) {
+		return nil, false
+	}
+
+	return record.first, true
+}
+
+func (ed *equivocationDetector) removeOldRounds(round int64) {
+	if round <= ed.highestRound {
+		return
+	}
+
+	ed.highestRound = round
+	for key := range ed.messages {
+		if key.round+numRoundsToKeep < ed.highestRound {
+			delete(ed.messages, key)
+		}
+	}
+}
+
+func (ed *equivocationDetector) replaceFirstMessage(key messageKey, first *receivedMessage, received *receivedMessage) {
+	ed.mutMessages.Lock()
+	defer ed.mutMessages.Unlock()
+
+	record, found := ed.messages[key]
+	if found && record.first == first {
+		record.first = received
+	}
+}
+
+func (ed *equivocationDetector) markAsRecorded(key messageKey) bool {
+	ed.mutMessages.Lock()
+	defer ed.mutMessages.Unlock()
+
+	record, found := ed.messages[key]
+	if !found || record.isRecorded {
+		return false
+	}
+
+	record.isRecorded = true
+
+	return true
+}
+
+func (ed *equivocationDetector) verifyMessage(kind string, cnsMsg *consensus.Message) error {
+	if kind == ProposalEquivocation {
+		headerHash := ed.hasher.Compute(string(cnsMsg.Header))
+		if !bytes.Equal(headerHash, cnsMsg.BlockHeaderHash) {
+			return ErrHeaderHashMismatch
+		}
+
+		return ed.checkProposerIsLeader(cnsMsg)
+	}
+
+	publicKey, err := ed.keyGenerator.PublicKeyFromByteArray(cnsMsg.PubKey)
+	if err != nil {
+		return err
+	}
+
+	return ed.signatureShareVerifier.VerifySigShare(publicKey, cnsMsg.BlockHeaderHash, cnsMsg.SignatureShare)
+}
+
+// checkProposerIsLeader requires the proposed header to be for the message round and the sender to be the leader of the
+// consensus group computed from the header's previous random seed, round and epoch
+func (ed *equivocationDetector) checkProposerIsLeader(cnsMsg *consensus.Message) error {
+	selfShardID := ed.shardCoordinator.SelfId()
+	header, err := process.UnmarshalHeader(selfShardID, ed.marshaller, cnsMsg.Header)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProposedHeader, err)
+	}
+	if header.GetShardID() != selfShardID || header.GetRound() != uint64(cnsMsg.RoundIndex) {
+		return fmt.Errorf("%w: shard %d, round %d", ErrInvalidProposedHeader, header.GetShardID(), header.GetRound())
+	}
+
+	consensusGroup, err := ed.nodesCoordinator.ComputeConsensusGroup(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		selfShardID,
+		header.GetEpoch(),
+	)
+	if err != nil {
+		return err
+	}
+	if len(consensusGroup) == 0 || !bytes.Equal(consensusGroup[0].PubKey(), cnsMsg.PubKey) {
+		return ErrProposerNotLeader
+	}
+
+	return nil
+}
+
+func (ed *equivocationDetector) recordEvidence(kind string, first *receivedMessage, second *receivedMessage) {
+	evidence := &common.EquivocationEvidence{
+		Type:      kind,
+		PublicKey: hex.EncodeToString(first.cnsMsg.PubKey),
+		Round:     first.cnsMsg.RoundIndex,
+		ShardID:   ed.shardCoordinator.SelfId(),
+		ChainID:   string(first.cnsMsg.ChainID),
+		Timestamp: time.Now().Unix(),
+		First:     newEquivocationMessage(first),
+		Second:    newEquivocationMessage(second),
+	}
+
+	log.Warn("equivocation detected",
+		"type", kind,
+		"public key", first.cnsMsg.PubKey,
+		"round", first.cnsMsg.RoundIndex,
+		"first header hash", first.cnsMsg.BlockHeaderHash,
+		"second header hash", second.cnsMsg.BlockHeaderHash)
+
+	err := saveEvidence(ed.storer, evidence)
+	if err != nil {
+		log.Warn("equivocationDetector.recordEvidence: cannot save evidence", "error", err.Error())
+	}
+
+	ed.peerHonestyHandler.ChangeScore(string(first.cnsMsg.PubKey), ed.topic, ed.penaltyUnits)
+
+	go ed.outportHandler.SaveEquivocation(evidence)
+}
+
+func newEquivocationMessage(received *receivedMessage) *common.EquivocationMessage {
+	cnsMsg := received.cnsMsg
+	peerMsg := received.peerMsg
+
+	return &common.EquivocationMessage{
+		MsgType:         cnsMsg.MsgType,
+		BlockHeaderHash: hex.EncodeToString(cnsMsg.BlockHeaderHash),
+		SignatureShare:  hex.EncodeToString(cnsMsg.SignatureShare),
+		Header:          hex.EncodeToString(cnsMsg.Header),
+		Signature:       hex.EncodeToString(cnsMsg.Signature),
+		OriginatorPid:   core.PeerID(cnsMsg.OriginatorPid).Pretty(),
+		PeerMessage: &common.EquivocationPeerMessage{
+			From:      hex.EncodeToString(peerMsg.From()),
+			Payload:   hex.EncodeToString(peerMsg.Payload()),
+			SeqNo:     hex.EncodeToString(peerMsg.SeqNo()),
+			Topic:     peerMsg.Topic(),
+			Signature: hex.EncodeToString(peerMsg.Signature()),
+			Key:       hex.EncodeToString(peerMsg.Key()),
+		},
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
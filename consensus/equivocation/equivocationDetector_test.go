@@ -0,0 +1,469 @@
+package equivocation_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclMultiSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const penaltyUnits = -50
+
+type validatorKey struct {
+	privateKey crypto.PrivateKey
+	pkBytes    []byte
+}
+
+func createMockArgsEquivocationDetector() equivocation.ArgsEquivocationDetector {
+	consensusService, _ := bls.NewConsensusService()
+	hasher, _ := blake2b.NewBlake2bWithSize(multisig.BlsHashSize)
+
+	return equivocation.ArgsEquivocationDetector{
+		ConsensusService:       consensusService,
+		ShardCoordinator:       mock.ShardCoordinatorMock{},
+		Hasher:                 &hashingMocks.HasherMock{},
+		Marshaller:             &marshal.GogoProtoMarshalizer{},
+		NodesCoordinator:       &shardingMocks.NodesCoordinatorStub{},
+		KeyGenerator:           signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		SignatureShareVerifier: &mclMultiSig.BlsMultiSigner{Hasher: hasher},
+		Storer:                 testscommon.CreateMemUnit(),
+		PeerHonestyHandler:     &testscommon.PeerHonestyHandlerStub{},
+		OutportHandler:         &testscommon.OutportStub{},
+		PenaltyUnits:           penaltyUnits,
+	}
+}
+
+func generateValidatorKey(args equivocation.ArgsEquivocationDetector) *validatorKey {
+	sk, pk := args.KeyGenerator.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+
+	return &validatorKey{
+		privateKey: sk,
+		pkBytes:    pkBytes,
+	}
+}
+
+func createNodesCoordinatorWithLeader(leader *validatorKey) *shardingMocks.NodesCoordinatorStub {
+	return &shardingMocks.NodesCoordinatorStub{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+			return []nodesCoordinator.Validator{shardingMocks.NewValidatorMock(leader.pkBytes, 1, 0)}, nil
+		},
+	}
+}
+
+func processMessage(detector spos.EquivocationDetector, cnsMsg *consensus.Message) {
+	peerMessage := &mock.P2PMessageMock{
+		FromField:      []byte("from"),
+		PayloadField:   []byte("payload"),
+		SeqNoField:     []byte("seq no"),
+		TopicField:     "consensus_0",
+		SignatureField: []byte("libp2p signature"),
+		KeyField:       []byte("libp2p key"),
+	}
+	detector.ProcessMessage(cnsMsg, peerMessage)
+}
+
+func createSignatureMessage(
+	t *testing.T,
+	args equivocation.ArgsEquivocationDetector,
+	key *validatorKey,
+	headerHash []byte,
+	round int64,
+) *consensus.Message {
+	signer := args.SignatureShareVerifier.(*mclMultiSig.BlsMultiSigner)
+	share, err := signer.SignShare(key.privateKey, headerHash)
+	require.Nil(t, err)
+
+	return &consensus.Message{
+		BlockHeaderHash: headerHash,
+		SignatureShare:  share,
+		PubKey:          key.pkBytes,
+		Signature:       []byte("peer signature"),
+		MsgType:         int64(bls.MtSignature),
+		RoundIndex:      round,
+		ChainID:         []byte("chain ID"),
+		OriginatorPid:   []byte("pid"),
+	}
+}
+
+func createProposalMessage(
+	t *testing.T,
+	args equivocation.ArgsEquivocationDetector,
+	key *validatorKey,
+	randSeed []byte,
+	round int64,
+) *consensus.Message {
+	header, err := args.Marshaller.Marshal(&block.Header{
+		ShardID:      args.ShardCoordinator.SelfId(),
+		Round:        uint64(round),
+		PrevRandSeed: []byte("prev rand seed"),
+		RandSeed:     randSeed,
+	})
+	require.Nil(t, err)
+
+	return &consensus.Message{
+		BlockHeaderHash: args.Hasher.Compute(string(header)),
+		Header:          header,
+		Body:            []byte("body"),
+		PubKey:          key.pkBytes,
+		Signature:       []byte("peer signature"),
+		MsgType:         int64(bls.MtBlockBodyAndHeader),
+		RoundIndex:      round,
+		ChainID:         []byte("chain ID"),
+		OriginatorPid:   []byte("pid"),
+	}
+}
+
+func TestNewEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil consensus service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.ConsensusService = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilConsensusService, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.ShardCoordinator = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.Hasher = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilHasher, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.Marshaller = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilMarshaller, err)
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.NodesCoordinator = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilNodesCoordinator, err)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.KeyGenerator = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilKeyGenerator, err)
+	})
+	t.Run("nil signature share verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.SignatureShareVerifier = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilSignatureShareVerifier, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.Storer = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilStorer, err)
+	})
+	t.Run("nil peer honesty handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.PeerHonestyHandler = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilPeerHonestyHandler, err)
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.OutportHandler = nil
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, equivocation.ErrNilOutportHandler, err)
+	})
+	t.Run("positive penalty should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector()
+		args.PenaltyUnits = 1
+		detector, err := equivocation.NewEquivocationDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.True(t, errors.Is(err, equivocation.ErrInvalidPenaltyUnits))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		detector, err := equivocation.NewEquivocationDetector(createMockArgsEquivocationDetector())
+		assert.False(t, check.IfNil(detector))
+		assert.Nil(t, err)
+	})
+}
+
+func TestEquivocationDetector_ProcessMessageConflictingSignaturesShouldRecordEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	penalizedKeys := make(map[string]int)
+	args.PeerHonestyHandler = &testscommon.PeerHonestyHandlerStub{
+		ChangeScoreCalled: func(pk string, topic string, units int) {
+			penalizedKeys[pk] += units
+		},
+	}
+	chanEvidence := make(chan *common.EquivocationEvidence, 1)
+	args.OutportHandler = &testscommon.OutportStub{
+		SaveEquivocationCalled: func(evidence *common.EquivocationEvidence) {
+			chanEvidence <- evidence
+		},
+	}
+	detector, _ := equivocation.NewEquivocationDetector(args)
+	key := generateValidatorKey(args)
+
+	first := createSignatureMessage(t, args, key, []byte("header hash A"), 10)
+	second := createSignatureMessage(t, args, key, []byte("header hash B"), 10)
+	processMessage(detector, first)
+	assert.Empty(t, penalizedKeys)
+
+	processMessage(detector, second)
+	assert.Equal(t, map[string]int{string(key.pkBytes): penaltyUnits}, penalizedKeys)
+
+	// the same equivocation is not penalized twice
+	processMessage(detector, second)
+	assert.Equal(t, map[string]int{string(key.pkBytes): penaltyUnits}, penalizedKeys)
+
+	select {
+	case evidence := <-chanEvidence:
+		assert.Equal(t, equivocation.SignatureEquivocation, evidence.Type)
+		assert.Equal(t, hex.EncodeToString(key.pkBytes), evidence.PublicKey)
+		assert.Equal(t, int64(10), evidence.Round)
+		assert.Equal(t, hex.EncodeToString(first.SignatureShare), evidence.First.SignatureShare)
+		assert.Equal(t, hex.EncodeToString(second.SignatureShare), evidence.Second.SignatureShare)
+		require.NotNil(t, evidence.First.PeerMessage)
+		assert.Equal(t, hex.EncodeToString([]byte("payload")), evidence.First.PeerMessage.Payload)
+		assert.Equal(t, hex.EncodeToString([]byte("libp2p signature")), evidence.First.PeerMessage.Signature)
+		assert.Equal(t, hex.EncodeToString([]byte("libp2p key")), evidence.First.PeerMessage.Key)
+		assert.Equal(t, "consensus_0", evidence.First.PeerMessage.Topic)
+	case <-time.After(time.Second):
+		assert.Fail(t, "evidence not sent to outport")
+	}
+
+	evidences, err := equivocation.LoadEvidences(args.Storer, "")
+	require.Nil(t, err)
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, hex.EncodeToString(first.BlockHeaderHash), evidences[0].First.BlockHeaderHash)
+	assert.Equal(t, hex.EncodeToString(second.BlockHeaderHash), evidences[0].Second.BlockHeaderHash)
+}
+
+func TestEquivocationDetector_ProcessMessageShouldNotRecordNonConflictingMessages(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.PeerHonestyHandler = &testscommon.PeerHonestyHandlerStub{
+		ChangeScoreCalled: func(pk string, topic string, units int) {
+			assert.Fail(t, "should have not penalized")
+		},
+	}
+	detector, _ := equivocation.NewEquivocationDetector(args)
+	key := generateValidatorKey(args)
+	otherKey := generateValidatorKey(args)
+
+	// same header hash
+	processMessage(detector, createSignatureMessage(t, args, key, []byte("header hash A"), 10))
+	processMessage(detector, createSignatureMessage(t, args, key, []byte("header hash A"), 10))
+	// other round
+	processMessage(detector, createSignatureMessage(t, args, key, []byte("header hash B"), 11))
+	// other validator
+	processMessage(detector, createSignatureMessage(t, args, otherKey, []byte("header hash B"), 10))
+	// other kind of message
+	processMessage(detector, createProposalMessage(t, args, key, []byte("header B"), 10))
+	// messages not taking part in equivocations
+	processMessage(detector, &consensus.Message{PubKey: key.pkBytes, MsgType: int64(bls.MtBlockBody), RoundIndex: 10})
+	processMessage(detector, &consensus.Message{PubKey: key.pkBytes, MsgType: int64(bls.MtBlockBody), RoundIndex: 10})
+	processMessage(detector, nil)
+	detector.ProcessMessage(createSignatureMessage(t, args, key, []byte("header hash C"), 10), nil)
+
+	evidences, err := equivocation.LoadEvidences(args.Storer, "")
+	require.Nil(t, err)
+	assert.Empty(t, evidences)
+}
+
+func TestEquivocationDetector_ProcessMessageInvalidSignatureShareShouldNotRecordEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	numPenalties := 0
+	args.PeerHonestyHandler = &testscommon.PeerHonestyHandlerStub{
+		ChangeScoreCalled: func(pk string, topic string, units int) {
+			numPenalties++
+		},
+	}
+	detector, _ := equivocation.NewEquivocationDetector(args)
+	key := generateValidatorKey(args)
+
+	first := createSignatureMessage(t, args, key, []byte("header hash A"), 10)
+	forged := createSignatureMessage(t, args, key, []byte("header hash B"), 10)
+	forged.SignatureShare = first.SignatureShare
+	processMessage(detector, first)
+	processMessage(detector, forged)
+	assert.Equal(t, 0, numPenalties)
+
+	// an invalid first message is replaced by the valid conflicting one
+	args.Storer = testscommon.CreateMemUnit()
+	detector, _ = equivocation.NewEquivocationDetector(args)
+	forged = createSignatureMessage(t, args, key, []byte("header hash X"), 10)
+	forged.SignatureShare = first.SignatureShare
+	second := createSignatureMessage(t, args, key, []byte("header hash B"), 10)
+	third := createSignatureMessage(t, args, key, []byte("header hash C"), 10)
+	processMessage(detector, forged)
+	processMessage(detector, second)
+	assert.Equal(t, 0, numPenalties)
+
+	processMessage(detector, third)
+	assert.Equal(t, 1, numPenalties)
+
+	evidences, err := equivocation.LoadEvidences(args.Storer, "")
+	require.Nil(t, err)
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, hex.EncodeToString(second.BlockHeaderHash), evidences[0].First.BlockHeaderHash)
+	assert.Equal(t, hex.EncodeToString(third.BlockHeaderHash), evidences[0].Second.BlockHeaderHash)
+}
+
+func TestEquivocationDetector_ProcessMessageConflictingProposals(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	numPenalties := 0
+	args.PeerHonestyHandler = &testscommon.PeerHonestyHandlerStub{
+		ChangeScoreCalled: func(pk string, topic string, units int) {
+			numPenalties++
+		},
+	}
+	key := generateValidatorKey(args)
+	args.NodesCoordinator = createNodesCoordinatorWithLeader(key)
+	detector, _ := equivocation.NewEquivocationDetector(args)
+
+	first := createProposalMessage(t, args, key, []byte("rand seed A"), 10)
+	mismatched := createProposalMessage(t, args, key, []byte("rand seed B"), 10)
+	mismatched.Header = []byte("header C")
+	processMessage(detector, first)
+	processMessage(detector, mismatched)
+	assert.Equal(t, 0, numPenalties)
+
+	otherRound := createProposalMessage(t, args, key, []byte("rand seed B"), 11)
+	otherRound.RoundIndex = 10
+	processMessage(detector, otherRound)
+	assert.Equal(t, 0, numPenalties)
+
+	second := createProposalMessage(t, args, key, []byte("rand seed B"), 10)
+	processMessage(detector, second)
+	assert.Equal(t, 1, numPenalties)
+
+	evidences, err := equivocation.LoadEvidences(args.Storer, hex.EncodeToString(key.pkBytes))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, equivocation.ProposalEquivocation, evidences[0].Type)
+	assert.Equal(t, hex.EncodeToString(first.Header), evidences[0].First.Header)
+	assert.Equal(t, hex.EncodeToString(second.Header), evidences[0].Second.Header)
+}
+
+func TestEquivocationDetector_ProcessMessageProposalsFromNonLeaderShouldNotRecordEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.PeerHonestyHandler = &testscommon.PeerHonestyHandlerStub{
+		ChangeScoreCalled: func(pk string, topic string, units int) {
+			assert.Fail(t, "should have not penalized")
+		},
+	}
+	key := generateValidatorKey(args)
+	args.NodesCoordinator = createNodesCoordinatorWithLeader(generateValidatorKey(args))
+	detector, _ := equivocation.NewEquivocationDetector(args)
+
+	processMessage(detector, createProposalMessage(t, args, key, []byte("rand seed A"), 10))
+	processMessage(detector, createProposalMessage(t, args, key, []byte("rand seed B"), 10))
+
+	evidences, err := equivocation.LoadEvidences(args.Storer, "")
+	require.Nil(t, err)
+	assert.Empty(t, evidences)
+}
+
+func TestLoadEvidences(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	key := generateValidatorKey(args)
+	otherKey := generateValidatorKey(args)
+	args.NodesCoordinator = createNodesCoordinatorWithLeader(otherKey)
+	detector, _ := equivocation.NewEquivocationDetector(args)
+
+	for _, round := range []int64{12, 11} {
+		processMessage(detector, createSignatureMessage(t, args, key, []byte("header hash A"), round))
+		processMessage(detector, createSignatureMessage(t, args, key, []byte("header hash B"), round))
+	}
+	processMessage(detector, createProposalMessage(t, args, otherKey, []byte("rand seed A"), 11))
+	processMessage(detector, createProposalMessage(t, args, otherKey, []byte("rand seed B"), 11))
+
+	_, err := equivocation.LoadEvidences(nil, "")
+	assert.Equal(t, equivocation.ErrNilStorer, err)
+
+	evidences, err := equivocation.LoadEvidences(args.Storer, "")
+	require.Nil(t, err)
+	assert.Equal(t, 3, len(evidences))
+
+	evidences, err = equivocation.LoadEvidences(args.Storer, hex.EncodeToString(key.pkBytes))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(evidences))
+	assert.Equal(t, int64(11), evidences[0].Round)
+	assert.Equal(t, int64(12), evidences[1].Round)
+
+	evidences, err = equivocation.LoadEvidences(args.Storer, "abcd")
+	require.Nil(t, err)
+	assert.Empty(t, evidences)
+}
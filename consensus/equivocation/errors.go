@@ -0,0 +1,45 @@
+package equivocation
+
+import "errors"
+
+// ErrNilConsensusService signals that a nil consensus service has been provided
+var ErrNilConsensusService = errors.New("nil consensus service")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSignatureShareVerifier signals that a nil signature share verifier has been provided
+var ErrNilSignatureShareVerifier = errors.New("nil signature share verifier")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilPeerHonestyHandler signals that a nil peer honesty handler has been provided
+var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrInvalidPenaltyUnits signals that the provided penalty units value is invalid
+var ErrInvalidPenaltyUnits = errors.New("invalid penalty units")
+
+// ErrHeaderHashMismatch signals that the proposed header does not match the header hash of the message
+var ErrHeaderHashMismatch = errors.New("header hash mismatch")
+
+// ErrInvalidProposedHeader signals that the proposed header cannot be decoded or is not for the message shard and round
+var ErrInvalidProposedHeader = errors.New("invalid proposed header")
+
+// ErrProposerNotLeader signals that the header was not proposed by the consensus group leader
+var ErrProposerNotLeader = errors.New("proposer is not the consensus group leader")
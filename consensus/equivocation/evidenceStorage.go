@@ -0,0 +1,71 @@
+package equivocation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const keySeparator = "_"
+
+var evidenceMarshaller = &marshal.JsonMarshalizer{}
+
+// the evidences are keyed by the hex encoded public key, the round and the type, so a public key filter can be applied
+// on the keys alone
+func evidenceKey(evidence *common.EquivocationEvidence) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s%s", evidence.PublicKey, keySeparator, evidence.Round, keySeparator, evidence.Type))
+}
+
+func saveEvidence(storer storage.Storer, evidence *common.EquivocationEvidence) error {
+	buff, err := evidenceMarshaller.Marshal(evidence)
+	if err != nil {
+		return err
+	}
+
+	return storer.Put(evidenceKey(evidence), buff)
+}
+
+// LoadEvidences returns the equivocation evidences found in the storer, sorted by round. If the hex encoded public key
+// is not empty, only the evidences of that public key are returned
+func LoadEvidences(storer storage.Storer, publicKey string) ([]*common.EquivocationEvidence, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+
+	keyPrefix := ""
+	if len(publicKey) > 0 {
+		keyPrefix = strings.ToLower(publicKey) + keySeparator
+	}
+
+	evidences := make([]*common.EquivocationEvidence, 0)
+	var errUnmarshal error
+	storer.RangeKeys(func(key []byte, val []byte) bool {
+		if !strings.HasPrefix(string(key), keyPrefix) {
+			return true
+		}
+
+		evidence := &common.EquivocationEvidence{}
+		errUnmarshal = evidenceMarshaller.Unmarshal(evidence, val)
+		if errUnmarshal != nil {
+			errUnmarshal = fmt.Errorf("%w for evidence key %s", errUnmarshal, key)
+			return false
+		}
+
+		evidences = append(evidences, evidence)
+		return true
+	})
+	if errUnmarshal != nil {
+		return nil, errUnmarshal
+	}
+
+	sort.SliceStable(evidences, func(i, j int) bool {
+		return evidences[i].Round < evidences[j].Round
+	})
+
+	return evidences, nil
+}
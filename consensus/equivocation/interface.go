@@ -0,0 +1,18 @@
+package equivocation
+
+import (
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// SignatureShareVerifier defines the component able to verify a BLS signature share against the signer's public key
+type SignatureShareVerifier interface {
+	VerifySigShare(pubKey crypto.PublicKey, message []byte, sig []byte) error
+	IsInterfaceNil() bool
+}
+
+// OutportHandler defines the outport component the equivocation evidences are sent to
+type OutportHandler interface {
+	SaveEquivocation(evidence *common.EquivocationEvidence)
+	IsInterfaceNil() bool
+}
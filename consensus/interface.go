@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -147,3 +148,26 @@ type ScheduledProcessor interface {
 	IsProcessedOKWithTimeout() bool
 	IsInterfaceNil() bool
 }
+
+// SigningHandler defines the signing operations done with the validator BLS keys during consensus. The signed data is
+// subject to slashing, so the signers may refuse to sign different block headers for the same round and shard
+type SigningHandler interface {
+	CreateSignatureShareForPublicKey(message []byte, publicKeyBytes []byte, marshalledHeader []byte, shardID uint32) ([]byte, error)
+	CreateBlockSignatureForPublicKey(message []byte, publicKeyBytes []byte, shardID uint32, round int64) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// RoundTracer defines the behaviour of a component able to record the consensus timeline of each round, as seen by
+// the current node
+type RoundTracer interface {
+	SubroundStarted(round int64, subroundName string)
+	SubroundEnded(round int64, subroundName string, isFinished bool, isCanceled bool)
+	ConsensusGroupSelected(round int64, leader []byte, consensusGroupSize int)
+	MessageReceived(cnsMsg *Message)
+	SignaturesCollected(round int64, numSignatures int, threshold int)
+	BlockProcessed(round int64, duration time.Duration, err error)
+	BlockCommitted(round int64)
+	GetRound(round int64) (*common.ConsensusRoundTrace, error)
+	GetRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error)
+	IsInterfaceNil() bool
+}
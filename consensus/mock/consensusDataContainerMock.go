@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -37,6 +38,9 @@ type ConsensusCoreMock struct {
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
 	nodeRedundancyHandler   consensus.NodeRedundancyHandler
 	scheduledProcessor      consensus.ScheduledProcessor
+	managedPeersHolder      common.ManagedPeersHolder
+	signingHandler          consensus.SigningHandler
+	roundTracer             consensus.RoundTracer
 }
 
 // GetAntiFloodHandler -
@@ -224,6 +228,36 @@ func (ccm *ConsensusCoreMock) ScheduledProcessor() consensus.ScheduledProcessor
 	return ccm.scheduledProcessor
 }
 
+// ManagedPeersHolder -
+func (ccm *ConsensusCoreMock) ManagedPeersHolder() common.ManagedPeersHolder {
+	return ccm.managedPeersHolder
+}
+
+// SetManagedPeersHolder -
+func (ccm *ConsensusCoreMock) SetManagedPeersHolder(managedPeersHolder common.ManagedPeersHolder) {
+	ccm.managedPeersHolder = managedPeersHolder
+}
+
+// SigningHandler -
+func (ccm *ConsensusCoreMock) SigningHandler() consensus.SigningHandler {
+	return ccm.signingHandler
+}
+
+// SetSigningHandler -
+func (ccm *ConsensusCoreMock) SetSigningHandler(signingHandler consensus.SigningHandler) {
+	ccm.signingHandler = signingHandler
+}
+
+// RoundTracer -
+func (ccm *ConsensusCoreMock) RoundTracer() consensus.RoundTracer {
+	return ccm.roundTracer
+}
+
+// SetRoundTracer -
+func (ccm *ConsensusCoreMock) SetRoundTracer(roundTracer consensus.RoundTracer) {
+	ccm.roundTracer = roundTracer
+}
+
 // SetNodeRedundancyHandler -
 func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHandler) {
 	ccm.nodeRedundancyHandler = nodeRedundancyHandler
@@ -204,6 +204,7 @@ func InitConsensusCoreWithMultiSigner(multiSigner crypto.MultiSigner) *Consensus
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &NodeRedundancyHandlerStub{}
 	scheduledProcessor := &consensusMocks.ScheduledProcessorStub{}
+	managedPeersHolder := &cryptoMocks.ManagedPeersHolderStub{}
 
 	container := &ConsensusCoreMock{
 		blockChain:              blockChain,
@@ -228,7 +229,41 @@ func InitConsensusCoreWithMultiSigner(multiSigner crypto.MultiSigner) *Consensus
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
 		scheduledProcessor:      scheduledProcessor,
+		managedPeersHolder:      managedPeersHolder,
+		roundTracer:             &consensusMocks.RoundTracerStub{},
 	}
+	container.signingHandler = createSigningHandlerStub(container)
 
 	return container
 }
+
+// createSigningHandlerStub returns a signing handler which signs with the signers set on the provided container, the
+// same way the local signing handler does
+func createSigningHandlerStub(container *ConsensusCoreMock) *consensusMocks.SigningHandlerStub {
+	return &consensusMocks.SigningHandlerStub{
+		CreateSignatureShareForPublicKeyCalled: func(message []byte, publicKeyBytes []byte, marshalledHeader []byte, shardID uint32) ([]byte, error) {
+			if !container.ManagedPeersHolder().IsKeyManagedByCurrentNode(publicKeyBytes) {
+				return container.MultiSigner().CreateSignatureShare(message, nil)
+			}
+
+			multiSigner, err := container.ManagedPeersHolder().GetMultiSigner(publicKeyBytes)
+			if err != nil {
+				return nil, err
+			}
+
+			return multiSigner.CreateSignatureShare(message, nil)
+		},
+		CreateBlockSignatureForPublicKeyCalled: func(message []byte, publicKeyBytes []byte, shardID uint32, round int64) ([]byte, error) {
+			if !container.ManagedPeersHolder().IsKeyManagedByCurrentNode(publicKeyBytes) {
+				return container.SingleSigner().Sign(container.PrivateKey(), message)
+			}
+
+			privateKey, err := container.ManagedPeersHolder().GetPrivateKey(publicKeyBytes)
+			if err != nil {
+				return nil, err
+			}
+
+			return container.SingleSigner().Sign(privateKey, message)
+		},
+	}
+}
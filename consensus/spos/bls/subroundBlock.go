@@ -63,7 +63,8 @@ func checkNewSubroundBlockParams(
 
 // doBlockJob method does the job of the subround Block
 func (sr *subroundBlock) doBlockJob(ctx context.Context) bool {
-	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
+	isSelfLeader := sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound()
+	if !isSelfLeader { // is NOT self leader in this round?
 		return false
 	}
 
@@ -71,7 +72,7 @@ func (sr *subroundBlock) doBlockJob(ctx context.Context) bool {
 		return false
 	}
 
-	if sr.IsSelfJobDone(sr.Current()) {
+	if sr.IsLeaderJobDone(sr.Current()) {
 		return false
 	}
 
@@ -88,7 +89,9 @@ func (sr *subroundBlock) doBlockJob(ctx context.Context) bool {
 		return false
 	}
 
+	createBlockStartTime := time.Now()
 	header, body, err := sr.createBlock(header)
+	sr.RoundTracer().BlockProcessed(sr.RoundHandler().Index(), time.Since(createBlockStartTime), err)
 	if err != nil {
 		printLogMessage(ctx, "doBlockJob.createBlock", err)
 		return false
@@ -99,9 +102,15 @@ func (sr *subroundBlock) doBlockJob(ctx context.Context) bool {
 		return false
 	}
 
-	err = sr.SetSelfJobDone(sr.Current(), true)
+	leader, err := sr.GetLeader()
 	if err != nil {
-		log.Debug("doBlockJob.SetSelfJobDone", "error", err.Error())
+		log.Debug("doBlockJob.GetLeader", "error", err.Error())
+		return false
+	}
+
+	err = sr.SetJobDone(leader, sr.Current(), true)
+	if err != nil {
+		log.Debug("doBlockJob.SetJobDone", "error", err.Error())
 		return false
 	}
 
@@ -182,12 +191,18 @@ func (sr *subroundBlock) sendHeaderAndBlockBody(
 ) bool {
 	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
 
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("sendHeaderAndBlockBody.GetLeader", "error", err.Error())
+		return false
+	}
+
 	cnsMsg := consensus.NewConsensusMessage(
 		headerHash,
 		nil,
 		marshalizedBody,
 		marshalizedHeader,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockBodyAndHeader),
 		sr.RoundHandler().Index(),
@@ -198,7 +213,7 @@ func (sr *subroundBlock) sendHeaderAndBlockBody(
 		sr.CurrentPid(),
 	)
 
-	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
 		log.Debug("sendHeaderAndBlockBody.BroadcastConsensusMessage", "error", err.Error())
 		return false
@@ -217,12 +232,18 @@ func (sr *subroundBlock) sendHeaderAndBlockBody(
 
 // sendBlockBody method sends the proposed block body in the subround Block
 func (sr *subroundBlock) sendBlockBody(bodyHandler data.BodyHandler, marshalizedBody []byte) bool {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("sendBlockBody.GetLeader", "error", err.Error())
+		return false
+	}
+
 	cnsMsg := consensus.NewConsensusMessage(
 		nil,
 		nil,
 		marshalizedBody,
 		nil,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockBody),
 		sr.RoundHandler().Index(),
@@ -233,7 +254,7 @@ func (sr *subroundBlock) sendBlockBody(bodyHandler data.BodyHandler, marshalized
 		sr.CurrentPid(),
 	)
 
-	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
 		log.Debug("sendBlockBody.BroadcastConsensusMessage", "error", err.Error())
 		return false
@@ -250,12 +271,18 @@ func (sr *subroundBlock) sendBlockBody(bodyHandler data.BodyHandler, marshalized
 func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marshalizedHeader []byte) bool {
 	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
 
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("sendBlockHeader.GetLeader", "error", err.Error())
+		return false
+	}
+
 	cnsMsg := consensus.NewConsensusMessage(
 		headerHash,
 		nil,
 		nil,
 		marshalizedHeader,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockHeader),
 		sr.RoundHandler().Index(),
@@ -266,7 +293,7 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 		sr.CurrentPid(),
 	)
 
-	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
 		log.Debug("sendBlockHeader.BroadcastConsensusMessage", "error", err.Error())
 		return false
@@ -309,7 +336,12 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 		return nil, err
 	}
 
-	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	leaderPrivateKey, err := sr.GetLeaderPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	randSeed, err := sr.SingleSigner().Sign(leaderPrivateKey, prevRandSeed)
 	if err != nil {
 		return nil, err
 	}
@@ -536,6 +568,7 @@ func (sr *subroundBlock) processReceivedBlock(ctx context.Context, cnsDta *conse
 		sr.Body,
 		remainingTimeInCurrentRound,
 	)
+	sr.RoundTracer().BlockProcessed(cnsDta.RoundIndex, time.Since(metricStatTime), err)
 
 	if cnsDta.RoundIndex < sr.RoundHandler().Index() {
 		log.Debug("canceled round, round index has been changed",
@@ -86,7 +86,7 @@ func (sr *subroundEndRound) receivedBlockHeaderFinalInfo(_ context.Context, cnsD
 		return false
 	}
 
-	if sr.IsSelfLeaderInCurrentRound() {
+	if sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound() {
 		return false
 	}
 
@@ -156,7 +156,7 @@ func (sr *subroundEndRound) isBlockHeaderFinalInfoValid(cnsDta *consensus.Messag
 }
 
 func (sr *subroundEndRound) receivedHeader(headerHandler data.HeaderHandler) {
-	if sr.ConsensusGroup() == nil || sr.IsSelfLeaderInCurrentRound() {
+	if sr.ConsensusGroup() == nil || sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound() {
 		return
 	}
 
@@ -167,8 +167,8 @@ func (sr *subroundEndRound) receivedHeader(headerHandler data.HeaderHandler) {
 
 // doEndRoundJob method does the job of the subround EndRound
 func (sr *subroundEndRound) doEndRoundJob(_ context.Context) bool {
-	if !sr.IsSelfLeaderInCurrentRound() {
-		if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+	if !sr.IsSelfLeaderInCurrentRound() && !sr.IsMultiKeyLeaderInCurrentRound() {
+		if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) || sr.IsMultiKeyInConsensusGroup() {
 			err := sr.prepareBroadcastBlockDataForValidator()
 			if err != nil {
 				log.Warn("validator in consensus group preparing for delayed broadcast",
@@ -262,6 +262,8 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 		return false
 	}
 
+	sr.RoundTracer().BlockCommitted(int64(sr.Header.GetRound()))
+
 	sr.SetStatus(sr.Current(), spos.SsFinished)
 
 	sr.displayStatistics()
@@ -282,12 +284,18 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 }
 
 func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("createAndBroadcastHeaderFinalInfo.GetLeader", "error", err.Error())
+		return
+	}
+
 	cnsMsg := consensus.NewConsensusMessage(
 		sr.GetData(),
 		nil,
 		nil,
 		nil,
-		[]byte(sr.SelfPubKey()),
+		[]byte(leader),
 		nil,
 		int(MtBlockHeaderFinalInfo),
 		sr.RoundHandler().Index(),
@@ -298,7 +306,7 @@ func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
 		sr.CurrentPid(),
 	)
 
-	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
 		log.Debug("doEndRoundJob.BroadcastConsensusMessage", "error", err.Error())
 		return
@@ -373,9 +381,11 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 		return false
 	}
 
+	sr.RoundTracer().BlockCommitted(int64(header.GetRound()))
+
 	sr.SetStatus(sr.Current(), spos.SsFinished)
 
-	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) || sr.IsMultiKeyInConsensusGroup() {
 		err = sr.setHeaderForValidator(header)
 		if err != nil {
 			log.Warn("doEndRoundJobByParticipant", "error", err.Error())
@@ -484,7 +494,17 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, err
 	}
 
-	return sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHdr)
+	leader, err := sr.GetLeader()
+	if err != nil {
+		return nil, err
+	}
+
+	return sr.SigningHandler().CreateBlockSignatureForPublicKey(
+		marshalizedHdr,
+		[]byte(leader),
+		sr.ShardCoordinator().SelfId(),
+		int64(sr.Header.GetRound()),
+	)
 }
 
 func (sr *subroundEndRound) updateMetricsForLeader() {
@@ -503,7 +523,7 @@ func (sr *subroundEndRound) broadcastBlockDataLeader() error {
 }
 
 func (sr *subroundEndRound) setHeaderForValidator(header data.HeaderHandler) error {
-	idx, err := sr.SelfConsensusGroupIndex()
+	idx, err := sr.getMinConsensusGroupIndexOfManagedKeys()
 	if err != nil {
 		return err
 	}
@@ -520,7 +540,7 @@ func (sr *subroundEndRound) setHeaderForValidator(header data.HeaderHandler) err
 }
 
 func (sr *subroundEndRound) prepareBroadcastBlockDataForValidator() error {
-	idx, err := sr.SelfConsensusGroupIndex()
+	idx, err := sr.getMinConsensusGroupIndexOfManagedKeys()
 	if err != nil {
 		return err
 	}
@@ -535,6 +555,18 @@ func (sr *subroundEndRound) prepareBroadcastBlockDataForValidator() error {
 	return nil
 }
 
+// getMinConsensusGroupIndexOfManagedKeys returns the lowest consensus group index among the node's own key and the
+// keys managed by the current node, as the delayed broadcast only needs to be scheduled once
+func (sr *subroundEndRound) getMinConsensusGroupIndexOfManagedKeys() (int, error) {
+	for idx, validator := range sr.ConsensusGroup() {
+		if sr.IsNodeSelf(validator) {
+			return idx, nil
+		}
+	}
+
+	return 0, spos.ErrNotFoundInConsensus
+}
+
 // doEndRoundConsensusCheck method checks if the consensus is achieved
 func (sr *subroundEndRound) doEndRoundConsensusCheck() bool {
 	if sr.RoundCanceled {
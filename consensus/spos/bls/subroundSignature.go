@@ -62,40 +62,55 @@ func checkNewSubroundSignatureParams(
 
 // doSignatureJob method does the job of the subround Signature
 func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
-	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey())
+	if !isSelfInConsensusGroup && !sr.IsMultiKeyInConsensusGroup() {
 		return true
 	}
 	if !sr.CanDoSubroundJob(sr.Current()) {
 		return false
 	}
+	if !isSelfInConsensusGroup && sr.IsMultiKeyJobDone(sr.Current()) {
+		return false
+	}
+
+	isSelfLeader := sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound()
+
+	if isSelfInConsensusGroup && !sr.doSignatureJobForSingleKey(isSelfLeader) {
+		return false
+	}
+	if !sr.doSignatureJobForManagedKeys(isSelfLeader) {
+		return false
+	}
+
+	if isSelfLeader {
+		go sr.waitAllSignatures()
+	}
+
+	return true
+}
 
-	signatureShare, err := sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
+func (sr *subroundSignature) doSignatureJobForSingleKey(isSelfLeader bool) bool {
+	selfPubKey := []byte(sr.SelfPubKey())
+	signatureShare, err := sr.createSignatureShare(selfPubKey)
 	if err != nil {
-		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
+		log.Debug("doSignatureJob.CreateSignatureShareForPublicKey", "error", err.Error())
 		return false
 	}
 
-	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
-
-	if !isSelfLeader {
-		// TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
-		cnsMsg := consensus.NewConsensusMessage(
-			sr.GetData(),
-			signatureShare,
-			nil,
-			nil,
-			[]byte(sr.SelfPubKey()),
-			nil,
-			int(MtSignature),
-			sr.RoundHandler().Index(),
-			sr.ChainID(),
-			nil,
-			nil,
-			nil,
-			sr.CurrentPid(),
-		)
+	if isSelfLeader {
+		selfIndex, errIndex := sr.SelfConsensusGroupIndex()
+		if errIndex != nil {
+			log.Debug("doSignatureJob.SelfConsensusGroupIndex", "error", errIndex.Error())
+			return false
+		}
 
-		err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+		err = sr.MultiSigner().StoreSignatureShare(uint16(selfIndex), signatureShare)
+		if err != nil {
+			log.Debug("doSignatureJob.StoreSignatureShare", "error", err.Error())
+			return false
+		}
+	} else {
+		err = sr.broadcastSignatureShare(signatureShare, selfPubKey)
 		if err != nil {
 			log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
 			return false
@@ -112,13 +127,108 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 		return false
 	}
 
-	if isSelfLeader {
-		go sr.waitAllSignatures()
+	return true
+}
+
+// doSignatureJobForManagedKeys signs the proposed block with each of the keys managed by the current node that are
+// part of the consensus group. The signature shares are stored directly if the leader is also handled by the current
+// node, otherwise they are broadcast
+func (sr *subroundSignature) doSignatureJobForManagedKeys(isSelfLeader bool) bool {
+	numMultiKeysSignaturesSent := 0
+	for idx, pk := range sr.ConsensusGroup() {
+		pkBytes := []byte(pk)
+		if !sr.IsKeyManagedBySelf(pkBytes) {
+			continue
+		}
+		if sr.IsJobDone(pk, sr.Current()) {
+			continue
+		}
+
+		signatureShare, err := sr.createSignatureShare(pkBytes)
+		if err != nil {
+			log.Debug("doSignatureJobForManagedKeys.CreateSignatureShareForPublicKey",
+				"pk", pkBytes,
+				"error", err.Error())
+			return false
+		}
+
+		if isSelfLeader {
+			err = sr.MultiSigner().StoreSignatureShare(uint16(idx), signatureShare)
+			if err != nil {
+				log.Debug("doSignatureJobForManagedKeys.StoreSignatureShare",
+					"pk", pkBytes,
+					"error", err.Error())
+				return false
+			}
+		} else {
+			err = sr.broadcastSignatureShare(signatureShare, pkBytes)
+			if err != nil {
+				log.Debug("doSignatureJobForManagedKeys.BroadcastConsensusMessage",
+					"pk", pkBytes,
+					"error", err.Error())
+				return false
+			}
+
+			numMultiKeysSignaturesSent++
+		}
+
+		err = sr.SetJobDone(pk, sr.Current(), true)
+		if err != nil {
+			log.Debug("doSignatureJobForManagedKeys.SetJobDone",
+				"subround", sr.Name(),
+				"error", err.Error())
+			return false
+		}
+	}
+
+	if numMultiKeysSignaturesSent > 0 {
+		log.Debug("step 2: multi keys signatures have been sent", "num", numMultiKeysSignaturesSent)
 	}
 
 	return true
 }
 
+// createSignatureShare creates the signature share of the provided public key on the current block header hash. The
+// marshalled header is provided as well, so that a remote signer can check the hash and the header's shard and round
+func (sr *subroundSignature) createSignatureShare(pkBytes []byte) ([]byte, error) {
+	if check.IfNil(sr.Header) {
+		return nil, spos.ErrNilHeader
+	}
+
+	marshalledHeader, err := sr.Marshalizer().Marshal(sr.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	return sr.SigningHandler().CreateSignatureShareForPublicKey(
+		sr.GetData(),
+		pkBytes,
+		marshalledHeader,
+		sr.ShardCoordinator().SelfId(),
+	)
+}
+
+func (sr *subroundSignature) broadcastSignatureShare(signatureShare []byte, pkBytes []byte) error {
+	// TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
+	cnsMsg := consensus.NewConsensusMessage(
+		sr.GetData(),
+		signatureShare,
+		nil,
+		nil,
+		pkBytes,
+		nil,
+		int(MtSignature),
+		sr.RoundHandler().Index(),
+		sr.ChainID(),
+		nil,
+		nil,
+		nil,
+		sr.CurrentPid(),
+	)
+
+	return sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+}
+
 // receivedSignature method is called when a signature is received through the signature channel.
 // If the signature is valid, than the jobDone map corresponding to the node which sent it,
 // is set on true for the subround Signature
@@ -140,7 +250,7 @@ func (sr *subroundSignature) receivedSignature(_ context.Context, cnsDta *consen
 		return false
 	}
 
-	if !sr.IsSelfLeaderInCurrentRound() {
+	if !sr.IsSelfLeaderInCurrentRound() && !sr.IsMultiKeyLeaderInCurrentRound() {
 		return false
 	}
 
@@ -210,8 +320,8 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 		return true
 	}
 
-	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
-	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey())
+	isSelfLeader := sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound()
+	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey()) || sr.IsMultiKeyInConsensusGroup()
 
 	threshold := sr.Threshold(sr.Current())
 	if sr.FallbackHeaderValidator().ShouldApplyFallbackValidation(sr.Header) {
@@ -223,10 +333,12 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 	}
 
 	areSignaturesCollected, numSigs := sr.areSignaturesCollected(threshold)
+	sr.RoundTracer().SignaturesCollected(sr.RoundHandler().Index(), numSigs, threshold)
 	areAllSignaturesCollected := numSigs == sr.ConsensusGroupSize()
 
 	isJobDoneByLeader := isSelfLeader && (areAllSignaturesCollected || (areSignaturesCollected && sr.WaitingAllSignaturesTimeOut))
-	isJobDoneByConsensusNode := !isSelfLeader && isSelfInConsensusGroup && sr.IsSelfJobDone(sr.Current())
+	isSelfJobDone := !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) || sr.IsSelfJobDone(sr.Current())
+	isJobDoneByConsensusNode := !isSelfLeader && isSelfInConsensusGroup && isSelfJobDone && sr.IsMultiKeyJobDone(sr.Current())
 
 	isSubroundFinished := !isSelfInConsensusGroup || isJobDoneByConsensusNode || isJobDoneByLeader
 
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
@@ -16,7 +18,10 @@ import (
 )
 
 func initSubroundSignatureWithContainer(container *mock.ConsensusCoreMock) bls.SubroundSignature {
-	consensusState := initConsensusState()
+	return initSubroundSignatureWithContainerAndState(container, initConsensusState())
+}
+
+func initSubroundSignatureWithContainerAndState(container *mock.ConsensusCoreMock, consensusState *spos.ConsensusState) bls.SubroundSignature {
 	ch := make(chan bool, 1)
 
 	sr, _ := spos.NewSubround(
@@ -274,6 +279,11 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 
 	sr.Data = []byte("X")
 
+	// the marshalled header is needed for the signature share
+	r = sr.DoSignatureJob()
+	assert.False(t, r)
+
+	sr.Header = &block.Header{Round: 1}
 	multiSignerMock := mock.InitMultiSignerMock()
 
 	err := errors.New("create signature share error")
@@ -304,6 +314,52 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobWithManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	managedMultiSigner := mock.InitMultiSignerMock()
+	managedMultiSigner.CreateSignatureShareCalled = func(msg []byte, bitmap []byte) ([]byte, error) {
+		return []byte("MANAGED SIG"), nil
+	}
+	managedKeys := make(map[string]struct{})
+	managedPeersHolder := &cryptoMocks.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			_, found := managedKeys[string(pkBytes)]
+			return found
+		},
+		GetMultiSignerCalled: func(pkBytes []byte) (crypto.MultiSigner, error) {
+			return managedMultiSigner, nil
+		},
+	}
+	container.SetManagedPeersHolder(managedPeersHolder)
+
+	consensusState := initConsensusStateWithManagedPeersHolder(managedPeersHolder)
+	sr := *initSubroundSignatureWithContainerAndState(container, consensusState)
+	sr.SetSelfPubKey("not in consensus")
+	sr.Header = &block.Header{Round: 1}
+	managedKeys[sr.ConsensusGroup()[2]] = struct{}{}
+	managedKeys[sr.ConsensusGroup()[3]] = struct{}{}
+
+	broadcastPubKeys := make([]string, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			assert.Equal(t, []byte("MANAGED SIG"), message.SignatureShare)
+			broadcastPubKeys = append(broadcastPubKeys, string(message.PubKey))
+			return nil
+		},
+	})
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.Equal(t, []string{sr.ConsensusGroup()[2], sr.ConsensusGroup()[3]}, broadcastPubKeys)
+	assert.True(t, sr.IsMultiKeyJobDone(bls.SrSignature))
+
+	r = sr.DoSignatureJob()
+	assert.False(t, r)
+	assert.Equal(t, 2, len(broadcastPubKeys))
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
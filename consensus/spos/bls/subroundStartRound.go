@@ -161,19 +161,28 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "proposer")
 		msg = " (my turn)"
 	}
+	if sr.IsKeyManagedBySelf([]byte(leader)) {
+		msg = " (my turn in multi-key)"
+	}
 
 	log.Debug("step 0: preparing the round",
 		"leader", core.GetTrimmedPk(hex.EncodeToString([]byte(leader))),
 		"messsage", msg)
 
 	pubKeys := sr.ConsensusGroup()
+	sr.RoundTracer().ConsensusGroupSelected(sr.RoundHandler().Index(), []byte(leader), len(pubKeys))
 
 	sr.indexRoundIfNeeded(pubKeys)
 
 	selfIndex, err := sr.SelfConsensusGroupIndex()
 	if err != nil {
-		log.Debug("not in consensus group")
-		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "not in consensus group")
+		if sr.IsMultiKeyInConsensusGroup() {
+			log.Debug("in consensus group with multi keys")
+			sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "participant")
+		} else {
+			log.Debug("not in consensus group")
+			sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "not in consensus group")
+		}
 	} else {
 		if leader != sr.SelfPubKey() {
 			sr.AppStatusHandler().Increment(common.MetricCountConsensus)
@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -37,6 +38,9 @@ type ConsensusCore struct {
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
 	nodeRedundancyHandler         consensus.NodeRedundancyHandler
 	scheduledProcessor            consensus.ScheduledProcessor
+	managedPeersHolder            common.ManagedPeersHolder
+	signingHandler                consensus.SigningHandler
+	roundTracer                   consensus.RoundTracer
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -62,6 +66,9 @@ type ConsensusCoreArgs struct {
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
 	NodeRedundancyHandler         consensus.NodeRedundancyHandler
 	ScheduledProcessor            consensus.ScheduledProcessor
+	ManagedPeersHolder            common.ManagedPeersHolder
+	SigningHandler                consensus.SigningHandler
+	RoundTracer                   consensus.RoundTracer
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -90,6 +97,9 @@ func NewConsensusCore(
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
 		nodeRedundancyHandler:         args.NodeRedundancyHandler,
 		scheduledProcessor:            args.ScheduledProcessor,
+		managedPeersHolder:            args.ManagedPeersHolder,
+		signingHandler:                args.SigningHandler,
+		roundTracer:                   args.RoundTracer,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -205,6 +215,21 @@ func (cc *ConsensusCore) ScheduledProcessor() consensus.ScheduledProcessor {
 	return cc.scheduledProcessor
 }
 
+// ManagedPeersHolder will return the holder of the keys managed by the current node
+func (cc *ConsensusCore) ManagedPeersHolder() common.ManagedPeersHolder {
+	return cc.managedPeersHolder
+}
+
+// SigningHandler will return the handler of the consensus signatures
+func (cc *ConsensusCore) SigningHandler() consensus.SigningHandler {
+	return cc.signingHandler
+}
+
+// RoundTracer will return the recorder of the consensus timeline of each round
+func (cc *ConsensusCore) RoundTracer() consensus.RoundTracer {
+	return cc.roundTracer
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
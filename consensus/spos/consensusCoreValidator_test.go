@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
@@ -31,6 +32,9 @@ func initConsensusDataContainer() *ConsensusCore {
 	headerSigVerifier := &mock.HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &mock.NodeRedundancyHandlerStub{}
+	managedPeersHolder := &cryptoMocks.ManagedPeersHolderStub{}
+	signingHandler := &consensusMocks.SigningHandlerStub{}
+	roundTracer := &consensusMocks.RoundTracerStub{}
 
 	return &ConsensusCore{
 		blockChain:              blockChain,
@@ -52,6 +56,9 @@ func initConsensusDataContainer() *ConsensusCore {
 		headerSigVerifier:       headerSigVerifier,
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
+		managedPeersHolder:      managedPeersHolder,
+		signingHandler:          signingHandler,
+		roundTracer:             roundTracer,
 	}
 }
 
@@ -231,6 +238,39 @@ func TestConsensusContainerValidator_ValidateNilNodeRedundancyHandlerShouldFail(
 	assert.Equal(t, ErrNilNodeRedundancyHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilManagedPeersHolderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.managedPeersHolder = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilManagedPeersHolder, err)
+}
+
+func TestConsensusContainerValidator_ValidateNilSigningHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.signingHandler = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilSigningHandler, err)
+}
+
+func TestConsensusContainerValidator_ValidateNilRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.roundTracer = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilRoundTracer, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -145,7 +145,19 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 			logger.DisplayByteSlice(cnsMsg.PubKey))
 	}
 
-	err = cmv.peerSignatureHandler.VerifyPeerSignature(cnsMsg.PubKey, core.PeerID(cnsMsg.OriginatorPid), cnsMsg.Signature)
+	err = cmv.checkMessageOrigin(cnsMsg, originator)
+	if err != nil {
+		return err
+	}
+
+	cmv.addMessageTypeToPublicKey(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType)
+
+	return nil
+}
+
+// checkMessageOrigin checks that the message was sent by the holder of the public key, through the originator peer
+func (cmv *consensusMessageValidator) checkMessageOrigin(cnsMsg *consensus.Message, originator core.PeerID) error {
+	err := cmv.peerSignatureHandler.VerifyPeerSignature(cnsMsg.PubKey, core.PeerID(cnsMsg.OriginatorPid), cnsMsg.Signature)
 	if err != nil {
 		return fmt.Errorf("%w : verify signature for received message from consensus topic failed: %s",
 			ErrInvalidSignature,
@@ -158,8 +170,6 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 			ErrOriginatorMismatch, p2p.PeerIdToShortString(originator), p2p.PeerIdToShortString(cnsMsgOriginator))
 	}
 
-	cmv.addMessageTypeToPublicKey(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType)
-
 	return nil
 }
 
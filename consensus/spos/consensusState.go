@@ -110,6 +110,18 @@ func (cns *ConsensusState) IsSelfLeaderInCurrentRound() bool {
 	return cns.IsNodeLeaderInCurrentRound(cns.selfPubKey)
 }
 
+// IsMultiKeyLeaderInCurrentRound method checks if one of the keys managed by the current node is leader in the
+// current round
+func (cns *ConsensusState) IsMultiKeyLeaderInCurrentRound() bool {
+	leader, err := cns.GetLeader()
+	if err != nil {
+		log.Debug("GetLeader", "error", err.Error())
+		return false
+	}
+
+	return cns.IsKeyManagedBySelf([]byte(leader))
+}
+
 // GetLeader method gets the leader of the current round
 func (cns *ConsensusState) GetLeader() (string, error) {
 	if cns.consensusGroup == nil {
@@ -186,6 +198,33 @@ func (cns *ConsensusState) IsSelfJobDone(currentSubroundId int) bool {
 	return cns.IsJobDone(cns.selfPubKey, currentSubroundId)
 }
 
+// IsLeaderJobDone method returns true if the leader job for the current subround is done and false otherwise
+func (cns *ConsensusState) IsLeaderJobDone(currentSubroundId int) bool {
+	leader, err := cns.GetLeader()
+	if err != nil {
+		log.Debug("GetLeader", "error", err.Error())
+		return false
+	}
+
+	return cns.IsJobDone(leader, currentSubroundId)
+}
+
+// IsMultiKeyJobDone method returns true if all the keys managed by the current node, that are part of the consensus
+// group, have done their job for the current subround and false otherwise
+func (cns *ConsensusState) IsMultiKeyJobDone(currentSubroundId int) bool {
+	for _, validator := range cns.consensusGroup {
+		if !cns.IsKeyManagedBySelf([]byte(validator)) {
+			continue
+		}
+
+		if !cns.IsJobDone(validator, currentSubroundId) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // IsSubroundFinished method returns true if the current subround is finished and false otherwise
 func (cns *ConsensusState) IsSubroundFinished(subroundID int) bool {
 	isSubroundFinished := cns.Status(subroundID) == SsFinished
@@ -193,9 +232,10 @@ func (cns *ConsensusState) IsSubroundFinished(subroundID int) bool {
 	return isSubroundFinished
 }
 
-// IsNodeSelf method returns true if the message is received from itself and false otherwise
+// IsNodeSelf method returns true if the message is received from itself, or from one of the keys managed by the
+// current node, and false otherwise
 func (cns *ConsensusState) IsNodeSelf(node string) bool {
-	isNodeSelf := node == cns.SelfPubKey()
+	isNodeSelf := node == cns.SelfPubKey() || cns.IsKeyManagedBySelf([]byte(node))
 
 	return isNodeSelf
 }
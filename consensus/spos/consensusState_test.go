@@ -5,15 +5,21 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
 	"github.com/stretchr/testify/assert"
 )
 
 func internalInitConsensusState() *spos.ConsensusState {
+	return internalInitConsensusStateWithManagedPeersHolder(&cryptoMocks.ManagedPeersHolderStub{})
+}
+
+func internalInitConsensusStateWithManagedPeersHolder(managedPeersHolder common.ManagedPeersHolder) *spos.ConsensusState {
 	eligibleList := []string{"1", "2", "3"}
 
 	eligibleNodesPubKeys := make(map[string]struct{})
@@ -24,7 +30,9 @@ func internalInitConsensusState() *spos.ConsensusState {
 	rcns := spos.NewRoundConsensus(
 		eligibleNodesPubKeys,
 		3,
-		"2")
+		"2",
+		managedPeersHolder,
+	)
 
 	rcns.SetConsensusGroup(eligibleList)
 	rcns.ResetRoundState()
@@ -320,6 +328,64 @@ func TestConsensusState_IsNodeSelfShouldReturnTrue(t *testing.T) {
 	assert.True(t, cns.IsNodeSelf(cns.SelfPubKey()))
 }
 
+func TestConsensusState_IsNodeSelfShouldReturnTrueForManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusStateWithManagedPeersHolder(createManagedPeersHolderStub("3"))
+
+	assert.True(t, cns.IsNodeSelf("3"))
+	assert.False(t, cns.IsNodeSelf("1"))
+}
+
+func TestConsensusState_IsMultiKeyLeaderInCurrentRound(t *testing.T) {
+	t.Parallel()
+
+	t.Run("leader is not managed should return false", func(t *testing.T) {
+		t.Parallel()
+
+		cns := internalInitConsensusStateWithManagedPeersHolder(createManagedPeersHolderStub("3"))
+		assert.False(t, cns.IsMultiKeyLeaderInCurrentRound())
+	})
+	t.Run("leader is managed should return true", func(t *testing.T) {
+		t.Parallel()
+
+		cns := internalInitConsensusStateWithManagedPeersHolder(createManagedPeersHolderStub("1"))
+		assert.True(t, cns.IsMultiKeyLeaderInCurrentRound())
+		assert.False(t, cns.IsSelfLeaderInCurrentRound())
+	})
+	t.Run("empty consensus group should return false", func(t *testing.T) {
+		t.Parallel()
+
+		cns := internalInitConsensusStateWithManagedPeersHolder(createManagedPeersHolderStub("1"))
+		cns.SetConsensusGroup(make([]string, 0))
+		assert.False(t, cns.IsMultiKeyLeaderInCurrentRound())
+	})
+}
+
+func TestConsensusState_IsLeaderJobDone(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+	assert.False(t, cns.IsLeaderJobDone(bls.SrBlock))
+
+	_ = cns.SetJobDone("1", bls.SrBlock, true)
+	assert.True(t, cns.IsLeaderJobDone(bls.SrBlock))
+}
+
+func TestConsensusState_IsMultiKeyJobDone(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusStateWithManagedPeersHolder(createManagedPeersHolderStub("1", "3"))
+	assert.True(t, cns.IsMultiKeyInConsensusGroup())
+	assert.False(t, cns.IsMultiKeyJobDone(bls.SrSignature))
+
+	_ = cns.SetJobDone("1", bls.SrSignature, true)
+	assert.False(t, cns.IsMultiKeyJobDone(bls.SrSignature))
+
+	_ = cns.SetJobDone("3", bls.SrSignature, true)
+	assert.True(t, cns.IsMultiKeyJobDone(bls.SrSignature))
+}
+
 func TestConsensusState_IsBlockBodyAlreadyReceivedShouldReturnFalse(t *testing.T) {
 	t.Parallel()
 
@@ -496,3 +562,17 @@ func TestConsensusState_SetAndGetProcessingBlockShouldWork(t *testing.T) {
 
 	assert.Equal(t, true, cns.ProcessingBlock())
 }
+
+func createManagedPeersHolderStub(managedKeys ...string) *cryptoMocks.ManagedPeersHolderStub {
+	keys := make(map[string]struct{})
+	for _, key := range managedKeys {
+		keys[key] = struct{}{}
+	}
+
+	return &cryptoMocks.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			_, found := keys[string(pkBytes)]
+			return found
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package spos
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var _ consensus.RoundTracer = (*disabledRoundTracer)(nil)
+
+type disabledRoundTracer struct {
+}
+
+// NewDisabledRoundTracer returns a new instance of a round tracer that does not record anything
+func NewDisabledRoundTracer() *disabledRoundTracer {
+	return &disabledRoundTracer{}
+}
+
+// SubroundStarted does nothing
+func (drt *disabledRoundTracer) SubroundStarted(_ int64, _ string) {
+}
+
+// SubroundEnded does nothing
+func (drt *disabledRoundTracer) SubroundEnded(_ int64, _ string, _ bool, _ bool) {
+}
+
+// ConsensusGroupSelected does nothing
+func (drt *disabledRoundTracer) ConsensusGroupSelected(_ int64, _ []byte, _ int) {
+}
+
+// MessageReceived does nothing
+func (drt *disabledRoundTracer) MessageReceived(_ *consensus.Message) {
+}
+
+// SignaturesCollected does nothing
+func (drt *disabledRoundTracer) SignaturesCollected(_ int64, _ int, _ int) {
+}
+
+// BlockProcessed does nothing
+func (drt *disabledRoundTracer) BlockProcessed(_ int64, _ time.Duration, _ error) {
+}
+
+// BlockCommitted does nothing
+func (drt *disabledRoundTracer) BlockCommitted(_ int64) {
+}
+
+// GetRound returns ErrRoundTracingDisabled
+func (drt *disabledRoundTracer) GetRound(_ int64) (*common.ConsensusRoundTrace, error) {
+	return nil, common.ErrRoundTracingDisabled
+}
+
+// GetRounds returns ErrRoundTracingDisabled
+func (drt *disabledRoundTracer) GetRounds(_ int64, _ int64) ([]*common.ConsensusRoundTrace, error) {
+	return nil, common.ErrRoundTracingDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drt *disabledRoundTracer) IsInterfaceNil() bool {
+	return drt == nil
+}
@@ -219,3 +219,18 @@ var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
 
 // ErrNilScheduledProcessor signals that the provided scheduled processor is nil
 var ErrNilScheduledProcessor = errors.New("nil scheduled processor")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNilSigningHandler signals that a nil signing handler has been provided
+var ErrNilSigningHandler = errors.New("nil signing handler")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrInvalidNumRoundsToKeep signals that an invalid number of rounds to keep has been provided
+var ErrInvalidNumRoundsToKeep = errors.New("invalid number of rounds to keep")
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -62,6 +63,12 @@ type ConsensusCoreHandler interface {
 	NodeRedundancyHandler() consensus.NodeRedundancyHandler
 	// ScheduledProcessor returns the scheduled txs processor
 	ScheduledProcessor() consensus.ScheduledProcessor
+	// ManagedPeersHolder returns the holder of the keys managed by the current node
+	ManagedPeersHolder() common.ManagedPeersHolder
+	// SigningHandler returns the handler of the consensus signatures
+	SigningHandler() consensus.SigningHandler
+	// RoundTracer returns the recorder of the consensus timeline of each round
+	RoundTracer() consensus.RoundTracer
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -155,3 +162,10 @@ type ConsensusDataIndexer interface {
 	SaveRoundsInfo(roundsInfos []*indexer.RoundInfo)
 	IsInterfaceNil() bool
 }
+
+// EquivocationDetector defines the behavior of a component able to detect the validators sending conflicting consensus
+// messages in the same round
+type EquivocationDetector interface {
+	ProcessMessage(cnsMsg *consensus.Message, message p2p.MessageP2P)
+	IsInterfaceNil() bool
+}
@@ -2,6 +2,9 @@ package spos
 
 import (
 	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 // roundConsensus defines the data needed by spos to do the consensus in each round
@@ -13,6 +16,7 @@ type roundConsensus struct {
 	selfPubKey           string
 	validatorRoundStates map[string]*roundState
 	mut                  sync.RWMutex
+	managedPeersHolder   common.ManagedPeersHolder
 }
 
 // NewRoundConsensus creates a new roundConsensus object
@@ -20,6 +24,7 @@ func NewRoundConsensus(
 	eligibleNodes map[string]struct{},
 	consensusGroupSize int,
 	selfId string,
+	managedPeersHolder common.ManagedPeersHolder,
 ) *roundConsensus {
 
 	rcns := roundConsensus{
@@ -27,6 +32,7 @@ func NewRoundConsensus(
 		consensusGroupSize: consensusGroupSize,
 		selfPubKey:         selfId,
 		mutEligible:        sync.RWMutex{},
+		managedPeersHolder: managedPeersHolder,
 	}
 
 	rcns.validatorRoundStates = make(map[string]*roundState)
@@ -152,6 +158,27 @@ func (rcns *roundConsensus) IsNodeInConsensusGroup(node string) bool {
 	return false
 }
 
+// IsKeyManagedBySelf returns true if the provided key is one of the extra keys managed by the current node
+func (rcns *roundConsensus) IsKeyManagedBySelf(pkBytes []byte) bool {
+	if check.IfNil(rcns.managedPeersHolder) {
+		return false
+	}
+
+	return rcns.managedPeersHolder.IsKeyManagedByCurrentNode(pkBytes)
+}
+
+// IsMultiKeyInConsensusGroup returns true if at least one of the keys managed by the current node is part of the
+// consensus group of the current round
+func (rcns *roundConsensus) IsMultiKeyInConsensusGroup() bool {
+	for i := 0; i < len(rcns.consensusGroup); i++ {
+		if rcns.IsKeyManagedBySelf([]byte(rcns.consensusGroup[i])) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IsNodeInEligibleList method checks if the node is part of the eligible list
 func (rcns *roundConsensus) IsNodeInEligibleList(node string) bool {
 	rcns.mutEligible.RLock()
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,7 +20,9 @@ func initRoundConsensus() *spos.RoundConsensus {
 	rcns := spos.NewRoundConsensus(
 		eligibleNodes,
 		len(eligibleNodes),
-		"2")
+		"2",
+		&cryptoMocks.ManagedPeersHolderStub{},
+	)
 
 	rcns.SetConsensusGroup(pubKeys)
 
@@ -49,7 +52,7 @@ func TestRoundConsensus_ConsensusGroupIndexFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key3")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key3", &cryptoMocks.ManagedPeersHolderStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.ConsensusGroupIndex("key3")
 
@@ -67,7 +70,7 @@ func TestRoundConsensus_ConsensusGroupIndexNotFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4", &cryptoMocks.ManagedPeersHolderStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.ConsensusGroupIndex("key4")
 
@@ -85,7 +88,7 @@ func TestRoundConsensus_IndexSelfConsensusGroupInConsesus(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key2")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key2", &cryptoMocks.ManagedPeersHolderStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.SelfConsensusGroupIndex()
 
@@ -103,7 +106,7 @@ func TestRoundConsensus_IndexSelfConsensusGroupNotFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4", &cryptoMocks.ManagedPeersHolderStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.SelfConsensusGroupIndex()
 
@@ -267,3 +270,30 @@ func TestRoundConsensus_ResetValidationMap(t *testing.T) {
 	assert.Equal(t, false, jobDone)
 	assert.Nil(t, err)
 }
+
+func TestRoundConsensus_IsKeyManagedBySelf(t *testing.T) {
+	t.Parallel()
+
+	eligibleNodes := map[string]struct{}{"key1": {}, "key2": {}, "key3": {}}
+
+	t.Run("nil managed peers holder should return false", func(t *testing.T) {
+		t.Parallel()
+
+		rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key1", nil)
+		rcns.SetConsensusGroup([]string{"key1", "key2", "key3"})
+		assert.False(t, rcns.IsKeyManagedBySelf([]byte("key2")))
+		assert.False(t, rcns.IsMultiKeyInConsensusGroup())
+	})
+	t.Run("managed key should return true", func(t *testing.T) {
+		t.Parallel()
+
+		rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key1", createManagedPeersHolderStub("key4"))
+		rcns.SetConsensusGroup([]string{"key1", "key2", "key3"})
+		assert.True(t, rcns.IsKeyManagedBySelf([]byte("key4")))
+		assert.False(t, rcns.IsKeyManagedBySelf([]byte("key1")))
+		assert.False(t, rcns.IsMultiKeyInConsensusGroup())
+
+		rcns.SetConsensusGroup([]string{"key1", "key4", "key3"})
+		assert.True(t, rcns.IsMultiKeyInConsensusGroup())
+	})
+}
@@ -0,0 +1,311 @@
+package spos
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+const (
+	// SubroundStatusFinished signals that the subround reached its consensus
+	SubroundStatusFinished = "finished"
+	// SubroundStatusTimedOut signals that the subround did not reach its consensus in time
+	SubroundStatusTimedOut = "timed out"
+	// SubroundStatusCanceled signals that the round was canceled during the subround
+	SubroundStatusCanceled = "canceled"
+
+	// RoundOutcomeBlockCommitted signals that the block proposed in the round was committed
+	RoundOutcomeBlockCommitted = "block committed"
+)
+
+var _ consensus.RoundTracer = (*roundTracer)(nil)
+
+// ArgsRoundTracer holds the arguments needed to create a round tracer
+type ArgsRoundTracer struct {
+	RoundHandler     consensus.RoundHandler
+	SyncTimer        ntp.SyncTimer
+	ConsensusService ConsensusService
+	NumRoundsToKeep  int
+}
+
+// roundTracer keeps, in memory, the consensus timeline of the latest rounds. All the offsets are computed with the
+// synchronized clock used by the chronology, relative to the start of each round
+type roundTracer struct {
+	roundHandler     consensus.RoundHandler
+	syncTimer        ntp.SyncTimer
+	consensusService ConsensusService
+	numRoundsToKeep  int64
+
+	mutRounds    sync.RWMutex
+	rounds       map[int64]*common.ConsensusRoundTrace
+	highestRound int64
+}
+
+// NewRoundTracer creates a new round tracer instance
+func NewRoundTracer(args ArgsRoundTracer) (*roundTracer, error) {
+	if check.IfNil(args.RoundHandler) {
+		return nil, ErrNilRoundHandler
+	}
+	if check.IfNil(args.SyncTimer) {
+		return nil, ErrNilSyncTimer
+	}
+	if check.IfNil(args.ConsensusService) {
+		return nil, ErrNilConsensusService
+	}
+	if args.NumRoundsToKeep < 1 {
+		return nil, fmt.Errorf("%w, provided: %d", ErrInvalidNumRoundsToKeep, args.NumRoundsToKeep)
+	}
+
+	return &roundTracer{
+		roundHandler:     args.RoundHandler,
+		syncTimer:        args.SyncTimer,
+		consensusService: args.ConsensusService,
+		numRoundsToKeep:  int64(args.NumRoundsToKeep),
+		rounds:           make(map[int64]*common.ConsensusRoundTrace),
+	}, nil
+}
+
+// SubroundStarted records the start of a subround
+func (rt *roundTracer) SubroundStarted(round int64, subroundName string) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getOrCreateRound(round)
+	if trace == nil {
+		return
+	}
+
+	trace.Subrounds = append(trace.Subrounds, &common.ConsensusSubroundTrace{
+		Name:          subroundName,
+		StartOffsetMs: rt.computeOffset(round),
+	})
+}
+
+// SubroundEnded records the end of a subround. The first subround which does not finish sets the outcome of the round
+func (rt *roundTracer) SubroundEnded(round int64, subroundName string, isFinished bool, isCanceled bool) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getOrCreateRound(round)
+	if trace == nil {
+		return
+	}
+
+	status := SubroundStatusFinished
+	if !isFinished {
+		status = SubroundStatusTimedOut
+		if isCanceled {
+			status = SubroundStatusCanceled
+		}
+		if len(trace.Outcome) == 0 {
+			trace.Outcome = fmt.Sprintf("%s in subround %s", status, subroundName)
+		}
+	}
+
+	for i := len(trace.Subrounds) - 1; i >= 0; i-- {
+		subround := trace.Subrounds[i]
+		if subround.Name == subroundName && len(subround.Status) == 0 {
+			subround.EndOffsetMs = rt.computeOffset(round)
+			subround.Status = status
+			return
+		}
+	}
+}
+
+// ConsensusGroupSelected records the leader and the size of the consensus group of the round
+func (rt *roundTracer) ConsensusGroupSelected(round int64, leader []byte, consensusGroupSize int) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getOrCreateRound(round)
+	if trace == nil {
+		return
+	}
+
+	trace.Leader = hex.EncodeToString(leader)
+	trace.ConsensusGroupSize = consensusGroupSize
+}
+
+// MessageReceived records the arrival of a validated consensus message
+func (rt *roundTracer) MessageReceived(cnsMsg *consensus.Message) {
+	if cnsMsg == nil {
+		return
+	}
+
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getOrCreateRound(cnsMsg.RoundIndex)
+	if trace == nil {
+		return
+	}
+
+	publicKey := hex.EncodeToString(cnsMsg.PubKey)
+	trace.Messages[publicKey] = append(trace.Messages[publicKey], &common.ConsensusMessageTrace{
+		Type:          rt.consensusService.GetStringValue(consensus.MessageType(cnsMsg.MsgType)),
+		OriginatorPid: core.PeerID(cnsMsg.OriginatorPid).Pretty(),
+		OffsetMs:      rt.computeOffset(cnsMsg.RoundIndex),
+	})
+}
+
+// SignaturesCollected records the number of signatures collected in the round, along with the required threshold
+func (rt *roundTracer) SignaturesCollected(round int64, numSignatures int, threshold int) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getOrCreateRound(round)
+	if trace == nil {
+		return
+	}
+
+	trace.NumSignatures = numSignatures
+	trace.SignaturesThreshold = threshold
+}
+
+// BlockProcessed records the duration of the block creation or processing done in the round
+func (rt *roundTracer) BlockProcessed(round int64, duration time.Duration, err error) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getOrCreateRound(round)
+	if trace == nil {
+		return
+	}
+
+	trace.BlockProcessingDurationMs = int64(duration / time.Millisecond)
+	trace.BlockProcessingError = ""
+	if err != nil {
+		trace.BlockProcessingError = err.Error()
+	}
+}
+
+// BlockCommitted records that the block proposed in the round was committed
+func (rt *roundTracer) BlockCommitted(round int64) {
+	rt.mutRounds.Lock()
+	defer rt.mutRounds.Unlock()
+
+	trace := rt.getOrCreateRound(round)
+	if trace == nil {
+		return
+	}
+
+	trace.Outcome = RoundOutcomeBlockCommitted
+}
+
+// GetRound returns the consensus timeline of the provided round
+func (rt *roundTracer) GetRound(round int64) (*common.ConsensusRoundTrace, error) {
+	rt.mutRounds.RLock()
+	defer rt.mutRounds.RUnlock()
+
+	trace, found := rt.rounds[round]
+	if !found {
+		return nil, fmt.Errorf("%w: %d", common.ErrRoundNotTraced, round)
+	}
+
+	return copyRoundTrace(trace), nil
+}
+
+// GetRounds returns the consensus timelines of the traced rounds within the provided range, including its ends,
+// sorted by round
+func (rt *roundTracer) GetRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error) {
+	if startRound > endRound {
+		return nil, fmt.Errorf("%w, start round %d is greater than end round %d", common.ErrInvalidRoundsRange, startRound, endRound)
+	}
+
+	rt.mutRounds.RLock()
+	defer rt.mutRounds.RUnlock()
+
+	traces := make([]*common.ConsensusRoundTrace, 0)
+	for round, trace := range rt.rounds {
+		if round >= startRound && round <= endRound {
+			traces = append(traces, copyRoundTrace(trace))
+		}
+	}
+
+	sort.Slice(traces, func(i, j int) bool {
+		return traces[i].Round < traces[j].Round
+	})
+
+	return traces, nil
+}
+
+// getOrCreateRound returns the trace of the provided round, creating it if needed, or nil if the round is too old
+// to be kept. The rounds older than the kept ones are removed. It should be called under mutex protection
+func (rt *roundTracer) getOrCreateRound(round int64) *common.ConsensusRoundTrace {
+	trace, found := rt.rounds[round]
+	if found {
+		return trace
+	}
+
+	if round+rt.numRoundsToKeep <= rt.highestRound {
+		return nil
+	}
+
+	if round > rt.highestRound {
+		rt.highestRound = round
+		for oldRound := range rt.rounds {
+			if oldRound+rt.numRoundsToKeep <= rt.highestRound {
+				delete(rt.rounds, oldRound)
+			}
+		}
+	}
+
+	trace = &common.ConsensusRoundTrace{
+		Round:            round,
+		StartTimestampMs: rt.computeRoundStartTime(round).UnixNano() / int64(time.Millisecond),
+		Subrounds:        make([]*common.ConsensusSubroundTrace, 0),
+		Messages:         make(map[string][]*common.ConsensusMessageTrace),
+	}
+	rt.rounds[round] = trace
+
+	return trace
+}
+
+// computeRoundStartTime returns the start time of the provided round, knowing that all the rounds have the same
+// duration
+func (rt *roundTracer) computeRoundStartTime(round int64) time.Time {
+	numRounds := round - rt.roundHandler.Index()
+
+	return rt.roundHandler.TimeStamp().Add(time.Duration(numRounds) * rt.roundHandler.TimeDuration())
+}
+
+func (rt *roundTracer) computeOffset(round int64) int64 {
+	offset := rt.syncTimer.CurrentTime().Sub(rt.computeRoundStartTime(round))
+
+	return int64(offset / time.Millisecond)
+}
+
+func copyRoundTrace(trace *common.ConsensusRoundTrace) *common.ConsensusRoundTrace {
+	traceCopy := *trace
+
+	traceCopy.Subrounds = make([]*common.ConsensusSubroundTrace, 0, len(trace.Subrounds))
+	for _, subround := range trace.Subrounds {
+		subroundCopy := *subround
+		traceCopy.Subrounds = append(traceCopy.Subrounds, &subroundCopy)
+	}
+
+	traceCopy.Messages = make(map[string][]*common.ConsensusMessageTrace, len(trace.Messages))
+	for publicKey, messages := range trace.Messages {
+		messagesCopy := make([]*common.ConsensusMessageTrace, 0, len(messages))
+		for _, message := range messages {
+			messageCopy := *message
+			messagesCopy = append(messagesCopy, &messageCopy)
+		}
+		traceCopy.Messages[publicKey] = messagesCopy
+	}
+
+	return &traceCopy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *roundTracer) IsInterfaceNil() bool {
+	return rt == nil
+}
@@ -0,0 +1,265 @@
+package spos_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tracedRoundDuration = 6 * time.Second
+
+var tracedRoundStart = time.Unix(1000, 0)
+
+func createMockArgsRoundTracer(currentTime *time.Time) spos.ArgsRoundTracer {
+	consensusService, _ := bls.NewConsensusService()
+
+	return spos.ArgsRoundTracer{
+		RoundHandler: &mock.RoundHandlerMock{
+			IndexCalled: func() int64 {
+				return 10
+			},
+			TimeStampCalled: func() time.Time {
+				return tracedRoundStart
+			},
+			TimeDurationCalled: func() time.Duration {
+				return tracedRoundDuration
+			},
+		},
+		SyncTimer: &mock.SyncTimerMock{
+			CurrentTimeCalled: func() time.Time {
+				return *currentTime
+			},
+		},
+		ConsensusService: consensusService,
+		NumRoundsToKeep:  3,
+	}
+}
+
+func TestNewRoundTracer(t *testing.T) {
+	t.Parallel()
+
+	currentTime := tracedRoundStart
+
+	t.Run("nil round handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTracer(&currentTime)
+		args.RoundHandler = nil
+		tracer, err := spos.NewRoundTracer(args)
+		assert.True(t, check.IfNil(tracer))
+		assert.Equal(t, spos.ErrNilRoundHandler, err)
+	})
+	t.Run("nil sync timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTracer(&currentTime)
+		args.SyncTimer = nil
+		tracer, err := spos.NewRoundTracer(args)
+		assert.True(t, check.IfNil(tracer))
+		assert.Equal(t, spos.ErrNilSyncTimer, err)
+	})
+	t.Run("nil consensus service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTracer(&currentTime)
+		args.ConsensusService = nil
+		tracer, err := spos.NewRoundTracer(args)
+		assert.True(t, check.IfNil(tracer))
+		assert.Equal(t, spos.ErrNilConsensusService, err)
+	})
+	t.Run("invalid number of rounds to keep should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTracer(&currentTime)
+		args.NumRoundsToKeep = 0
+		tracer, err := spos.NewRoundTracer(args)
+		assert.True(t, check.IfNil(tracer))
+		assert.True(t, errors.Is(err, spos.ErrInvalidNumRoundsToKeep))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := spos.NewRoundTracer(createMockArgsRoundTracer(&currentTime))
+		assert.False(t, check.IfNil(tracer))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRoundTracer_TraceRound(t *testing.T) {
+	t.Parallel()
+
+	currentTime := tracedRoundStart
+	args := createMockArgsRoundTracer(&currentTime)
+	tracer, _ := spos.NewRoundTracer(args)
+	advanceTo := func(offset time.Duration) {
+		currentTime = tracedRoundStart.Add(offset)
+	}
+
+	leader := []byte("leader")
+	tracer.SubroundStarted(10, "(START_ROUND)")
+	advanceTo(10 * time.Millisecond)
+	tracer.ConsensusGroupSelected(10, leader, 7)
+	tracer.SubroundEnded(10, "(START_ROUND)", true, false)
+	tracer.SubroundStarted(10, "(BLOCK)")
+
+	advanceTo(500 * time.Millisecond)
+	tracer.MessageReceived(&consensus.Message{
+		RoundIndex:    10,
+		PubKey:        leader,
+		MsgType:       int64(bls.MtBlockBodyAndHeader),
+		OriginatorPid: []byte("leader pid"),
+	})
+	advanceTo(800 * time.Millisecond)
+	tracer.BlockProcessed(10, 300*time.Millisecond, nil)
+	tracer.SubroundEnded(10, "(BLOCK)", true, false)
+	tracer.SubroundStarted(10, "(SIGNATURE)")
+	tracer.SignaturesCollected(10, 4, 5)
+
+	advanceTo(4 * time.Second)
+	tracer.SubroundEnded(10, "(SIGNATURE)", false, false)
+
+	trace, err := tracer.GetRound(10)
+	require.Nil(t, err)
+	assert.Equal(t, int64(10), trace.Round)
+	assert.Equal(t, tracedRoundStart.UnixNano()/int64(time.Millisecond), trace.StartTimestampMs)
+	assert.Equal(t, hex.EncodeToString(leader), trace.Leader)
+	assert.Equal(t, 7, trace.ConsensusGroupSize)
+	assert.Equal(t, 4, trace.NumSignatures)
+	assert.Equal(t, 5, trace.SignaturesThreshold)
+	assert.Equal(t, int64(300), trace.BlockProcessingDurationMs)
+	assert.Empty(t, trace.BlockProcessingError)
+	assert.Equal(t, "timed out in subround (SIGNATURE)", trace.Outcome)
+
+	require.Equal(t, 3, len(trace.Subrounds))
+	assert.Equal(t, "(START_ROUND)", trace.Subrounds[0].Name)
+	assert.Equal(t, int64(0), trace.Subrounds[0].StartOffsetMs)
+	assert.Equal(t, int64(10), trace.Subrounds[0].EndOffsetMs)
+	assert.Equal(t, spos.SubroundStatusFinished, trace.Subrounds[0].Status)
+	assert.Equal(t, int64(800), trace.Subrounds[1].EndOffsetMs)
+	assert.Equal(t, spos.SubroundStatusTimedOut, trace.Subrounds[2].Status)
+	assert.Equal(t, int64(4000), trace.Subrounds[2].EndOffsetMs)
+
+	messages := trace.Messages[hex.EncodeToString(leader)]
+	require.Equal(t, 1, len(messages))
+	assert.Equal(t, args.ConsensusService.GetStringValue(bls.MtBlockBodyAndHeader), messages[0].Type)
+	assert.Equal(t, core.PeerID("leader pid").Pretty(), messages[0].OriginatorPid)
+	assert.Equal(t, int64(500), messages[0].OffsetMs)
+}
+
+func TestRoundTracer_OutcomeShouldBeSetByTheFirstEvent(t *testing.T) {
+	t.Parallel()
+
+	currentTime := tracedRoundStart
+	tracer, _ := spos.NewRoundTracer(createMockArgsRoundTracer(&currentTime))
+
+	tracer.SubroundStarted(10, "(BLOCK)")
+	tracer.SubroundEnded(10, "(BLOCK)", false, true)
+	tracer.SubroundStarted(10, "(SIGNATURE)")
+	tracer.SubroundEnded(10, "(SIGNATURE)", false, false)
+
+	trace, _ := tracer.GetRound(10)
+	assert.Equal(t, "canceled in subround (BLOCK)", trace.Outcome)
+	assert.Equal(t, spos.SubroundStatusCanceled, trace.Subrounds[0].Status)
+
+	tracer.BlockCommitted(11)
+	trace, _ = tracer.GetRound(11)
+	assert.Equal(t, spos.RoundOutcomeBlockCommitted, trace.Outcome)
+	assert.Equal(t, tracedRoundStart.Add(tracedRoundDuration).UnixNano()/int64(time.Millisecond), trace.StartTimestampMs)
+}
+
+func TestRoundTracer_GetRoundShouldReturnACopy(t *testing.T) {
+	t.Parallel()
+
+	currentTime := tracedRoundStart
+	tracer, _ := spos.NewRoundTracer(createMockArgsRoundTracer(&currentTime))
+
+	tracer.SubroundStarted(10, "(BLOCK)")
+	trace, _ := tracer.GetRound(10)
+	trace.Subrounds[0].Status = "altered"
+	trace.Outcome = "altered"
+
+	trace, _ = tracer.GetRound(10)
+	assert.Empty(t, trace.Subrounds[0].Status)
+	assert.Empty(t, trace.Outcome)
+}
+
+func TestRoundTracer_ShouldRemoveOldRounds(t *testing.T) {
+	t.Parallel()
+
+	currentTime := tracedRoundStart
+	tracer, _ := spos.NewRoundTracer(createMockArgsRoundTracer(&currentTime))
+
+	for round := int64(10); round <= 13; round++ {
+		tracer.SubroundStarted(round, "(START_ROUND)")
+	}
+
+	_, err := tracer.GetRound(10)
+	assert.True(t, errors.Is(err, common.ErrRoundNotTraced))
+
+	// events of rounds older than the kept ones are ignored
+	tracer.SubroundStarted(10, "(BLOCK)")
+	_, err = tracer.GetRound(10)
+	assert.True(t, errors.Is(err, common.ErrRoundNotTraced))
+
+	for round := int64(11); round <= 13; round++ {
+		_, err = tracer.GetRound(round)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRoundTracer_GetRounds(t *testing.T) {
+	t.Parallel()
+
+	currentTime := tracedRoundStart
+	tracer, _ := spos.NewRoundTracer(createMockArgsRoundTracer(&currentTime))
+
+	tracer.BlockCommitted(12)
+	tracer.BlockCommitted(10)
+	tracer.SubroundStarted(11, "(START_ROUND)")
+
+	traces, err := tracer.GetRounds(11, 10)
+	assert.Nil(t, traces)
+	assert.True(t, errors.Is(err, common.ErrInvalidRoundsRange))
+
+	traces, err = tracer.GetRounds(0, 100)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(traces))
+	for i, trace := range traces {
+		assert.Equal(t, int64(10+i), trace.Round)
+	}
+
+	traces, err = tracer.GetRounds(11, 12)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(traces))
+	assert.Equal(t, int64(11), traces[0].Round)
+	assert.Equal(t, int64(12), traces[1].Round)
+}
+
+func TestDisabledRoundTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer := spos.NewDisabledRoundTracer()
+	assert.False(t, check.IfNil(tracer))
+
+	tracer.SubroundStarted(10, "(BLOCK)")
+	tracer.BlockCommitted(10)
+
+	trace, err := tracer.GetRound(10)
+	assert.Nil(t, trace)
+	assert.Equal(t, common.ErrRoundTracingDisabled, err)
+
+	traces, err := tracer.GetRounds(0, 10)
+	assert.Nil(t, traces)
+	assert.Equal(t, common.ErrRoundTracingDisabled, err)
+}
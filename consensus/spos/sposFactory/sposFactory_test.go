@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -149,6 +150,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	assert.Nil(t, err)
@@ -181,6 +183,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	assert.Nil(t, err)
@@ -204,6 +207,7 @@ func TestGetBroadcastMessenger_NilShardCoordinatorShouldErr(t *testing.T) {
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	assert.Nil(t, bm)
@@ -231,6 +235,7 @@ func TestGetBroadcastMessenger_InvalidShardIdShouldErr(t *testing.T) {
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 
 	assert.Nil(t, bm)
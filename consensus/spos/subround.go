@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 )
 
@@ -132,8 +133,12 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 	startTime := roundHandler.TimeStamp()
 	maxTime := roundHandler.TimeDuration() * MaxThresholdPercent / 100
 
+	roundIndex := roundHandler.Index()
+	sr.RoundTracer().SubroundStarted(roundIndex, sr.name)
+
 	sr.Job(ctx)
 	if sr.Check() {
+		sr.RoundTracer().SubroundEnded(roundIndex, sr.name, true, false)
 		return true
 	}
 
@@ -141,9 +146,12 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 		select {
 		case <-sr.consensusStateChangedChannel:
 			if sr.Check() {
+				sr.RoundTracer().SubroundEnded(roundIndex, sr.name, true, false)
 				return true
 			}
 		case <-time.After(roundHandler.RemainingTime(startTime, maxTime)):
+			sr.RoundTracer().SubroundEnded(roundIndex, sr.name, false, sr.RoundCanceled)
+
 			if sr.Extend != nil {
 				sr.RoundCanceled = true
 				sr.Extend(sr.current)
@@ -204,6 +212,21 @@ func (sr *Subround) ConsensusChannel() chan bool {
 	return sr.consensusStateChangedChannel
 }
 
+// GetLeaderPrivateKey returns the private key of the leader of the current round. This is either the node's own key
+// or one of the keys managed by the current node
+func (sr *Subround) GetLeaderPrivateKey() (crypto.PrivateKey, error) {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		return nil, err
+	}
+
+	if !sr.IsKeyManagedBySelf([]byte(leader)) {
+		return sr.PrivateKey(), nil
+	}
+
+	return sr.ManagedPeersHolder().GetPrivateKey([]byte(leader))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sr *Subround) IsInterfaceNil() bool {
 	return sr == nil
@@ -10,6 +10,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -50,7 +52,9 @@ func initConsensusState() *spos.ConsensusState {
 	rcns := spos.NewRoundConsensus(
 		eligibleNodesKeys,
 		consensusGroupSize,
-		eligibleList[indexLeader])
+		eligibleList[indexLeader],
+		&cryptoMocks.ManagedPeersHolderStub{},
+	)
 
 	rcns.SetConsensusGroup(eligibleList)
 	rcns.ResetRoundState()
@@ -681,6 +685,58 @@ func testDoWork(t *testing.T, checkDone bool, shouldWork bool) {
 	assert.Equal(t, shouldWork, r)
 }
 
+func TestSubround_DoWorkShouldTraceTheSubround(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+	tracedEvents := make([]string, 0)
+	container.SetRoundTracer(&consensusMocks.RoundTracerStub{
+		SubroundStartedCalled: func(round int64, subroundName string) {
+			tracedEvents = append(tracedEvents, "started "+subroundName)
+		},
+		SubroundEndedCalled: func(round int64, subroundName string, isFinished bool, isCanceled bool) {
+			assert.False(t, isFinished)
+			assert.True(t, isCanceled)
+			tracedEvents = append(tracedEvents, "ended "+subroundName)
+		},
+	})
+
+	sr, _ := spos.NewSubround(
+		-1,
+		bls.SrStartRound,
+		bls.SrBlock,
+		int64(0*roundTimeDuration/100),
+		int64(5*roundTimeDuration/100),
+		"(START_ROUND)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+		&statusHandler.AppStatusHandlerStub{},
+	)
+	sr.Job = func(_ context.Context) bool {
+		sr.RoundCanceled = true
+		return false
+	}
+	sr.Check = func() bool {
+		return false
+	}
+
+	maxTime := time.Now().Add(100 * time.Millisecond)
+	roundHandlerMock := &mock.RoundHandlerMock{}
+	roundHandlerMock.RemainingTimeCalled = func(time.Time, time.Duration) time.Duration {
+		return time.Until(maxTime)
+	}
+
+	r := sr.DoWork(context.Background(), roundHandlerMock)
+	assert.False(t, r)
+	assert.Equal(t, []string{"started (START_ROUND)", "ended (START_ROUND)"}, tracedEvents)
+}
+
 func TestSubround_DoWorkShouldReturnTrueWhenJobIsDoneAndConsensusIsDoneAfterAWhile(t *testing.T) {
 	t.Parallel()
 
@@ -74,6 +74,8 @@ type Worker struct {
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	equivocationDetector      EquivocationDetector
+	roundTracer               consensus.RoundTracer
 	closer                    core.SafeCloser
 }
 
@@ -103,6 +105,8 @@ type WorkerArgs struct {
 	PublicKeySize            int
 	AppStatusHandler         core.AppStatusHandler
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
+	EquivocationDetector     EquivocationDetector
+	RoundTracer              consensus.RoundTracer
 }
 
 // NewWorker creates a new Worker object
@@ -149,6 +153,8 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
 		nodeRedundancyHandler:    args.NodeRedundancyHandler,
+		equivocationDetector:     args.EquivocationDetector,
+		roundTracer:              args.RoundTracer,
 		closer:                   closing.NewSafeChanCloser(),
 	}
 
@@ -247,6 +253,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.NodeRedundancyHandler) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
+	if check.IfNil(args.RoundTracer) {
+		return ErrNilRoundTracer
+	}
 
 	return nil
 }
@@ -378,9 +390,16 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 
 	err = wrk.consensusMessageValidator.checkConsensusMessageValidity(cnsMsg, message.Peer())
 	if err != nil {
+		if errors.Is(err, ErrMessageTypeLimitReached) {
+			wrk.checkEquivocation(cnsMsg, message)
+		}
+
 		return err
 	}
 
+	wrk.equivocationDetector.ProcessMessage(cnsMsg, message)
+	wrk.roundTracer.MessageReceived(cnsMsg)
+
 	wrk.networkShardingCollector.UpdatePeerIDInfo(message.Peer(), cnsMsg.PubKey, wrk.shardCoordinator.SelfId())
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
@@ -415,6 +434,18 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	return nil
 }
 
+// checkEquivocation passes to the equivocation detector a message rejected only because its sender already sent a
+// message of the same type in the current round, once the message origin is checked
+func (wrk *Worker) checkEquivocation(cnsMsg *consensus.Message, message p2p.MessageP2P) {
+	err := wrk.consensusMessageValidator.checkMessageOrigin(cnsMsg, message.Peer())
+	if err != nil {
+		log.Trace("checkEquivocation.checkMessageOrigin", "error", err.Error())
+		return
+	}
+
+	wrk.equivocationDetector.ProcessMessage(cnsMsg, message)
+}
+
 func (wrk *Worker) shouldBlacklistPeer(err error) bool {
 	if err == nil ||
 		errors.Is(err, ErrMessageForPastRound) ||
@@ -519,7 +550,7 @@ func (wrk *Worker) processReceivedHeaderMetric(cnsDta *consensus.Message) {
 }
 
 func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
-	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
+	if wrk.consensusState.IsNodeSelf(string(cnsDta.PubKey)) {
 		return ErrMessageFromItself
 	}
 
@@ -21,10 +21,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const roundTimeDuration = 100 * time.Millisecond
@@ -110,6 +112,8 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		PublicKeySize:            PublicKeySize,
 		AppStatusHandler:         appStatusHandler,
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
+		EquivocationDetector:     &consensusMocks.EquivocationDetectorStub{},
+		RoundTracer:              &consensusMocks.RoundTracerStub{},
 	}
 
 	return workerArgs
@@ -364,6 +368,28 @@ func TestWorker_NewWorkerNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestWorker_NewWorkerEquivocationDetectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
+func TestWorker_NewWorkerRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.RoundTracer = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -822,6 +848,91 @@ func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
 }
 
+func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldPassAuthenticMessagesToEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	detectedMessages := make([]*consensus.Message, 0)
+	workerArgs.EquivocationDetector = &consensusMocks.EquivocationDetectorStub{
+		ProcessMessageCalled: func(cnsMsg *consensus.Message, message p2p.MessageP2P) {
+			detectedMessages = append(detectedMessages, cnsMsg)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+	blk := &block.Body{}
+	blkStr, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkStr,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	assert.Nil(t, err)
+
+	err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	// a message not sent through its originator is not passed to the detector
+	err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	require.Equal(t, 2, len(detectedMessages))
+	assert.Equal(t, cnsMsg.PubKey, detectedMessages[1].PubKey)
+	assert.Equal(t, cnsMsg.Body, detectedMessages[1].Body)
+}
+
+func TestWorker_ProcessReceivedMessageShouldPassValidMessagesToRoundTracer(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	tracedMessages := make([]*consensus.Message, 0)
+	workerArgs.RoundTracer = &consensusMocks.RoundTracerStub{
+		MessageReceivedCalled: func(cnsMsg *consensus.Message) {
+			tracedMessages = append(tracedMessages, cnsMsg)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+	blk := &block.Body{}
+	blkStr, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkStr,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	assert.Nil(t, err)
+
+	err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	require.Equal(t, 1, len(tracedMessages))
+	assert.Equal(t, cnsMsg.PubKey, tracedMessages[0].PubKey)
+}
+
 func TestWorker_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
@@ -5,6 +5,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 var _ dataRetriever.PoolsHolder = (*dataPool)(nil)
@@ -24,6 +25,7 @@ type dataPool struct {
 	smartContracts       storage.Cacher
 	peerAuthentications  storage.Cacher
 	heartbeats           storage.Cacher
+	poolJournal          txcache.PoolJournalHandler
 }
 
 // DataPoolArgs represents the data pool's constructor structure
@@ -40,6 +42,7 @@ type DataPoolArgs struct {
 	SmartContracts           storage.Cacher
 	PeerAuthentications      storage.Cacher
 	Heartbeats               storage.Cacher
+	PoolJournal              txcache.PoolJournalHandler
 }
 
 // NewDataPool creates a data pools holder object
@@ -80,6 +83,9 @@ func NewDataPool(args DataPoolArgs) (*dataPool, error) {
 	if check.IfNil(args.Heartbeats) {
 		return nil, dataRetriever.ErrNilHeartbeatPool
 	}
+	if check.IfNil(args.PoolJournal) {
+		return nil, storage.ErrNilPoolJournal
+	}
 
 	return &dataPool{
 		transactions:         args.Transactions,
@@ -94,6 +100,7 @@ func NewDataPool(args DataPoolArgs) (*dataPool, error) {
 		smartContracts:       args.SmartContracts,
 		peerAuthentications:  args.PeerAuthentications,
 		heartbeats:           args.Heartbeats,
+		poolJournal:          args.PoolJournal,
 	}, nil
 }
 
@@ -157,6 +164,11 @@ func (dp *dataPool) Heartbeats() storage.Cacher {
 	return dp.heartbeats
 }
 
+// PoolJournal returns the journal of transactions leaving (or rejected by) the pool
+func (dp *dataPool) PoolJournal() txcache.PoolJournalHandler {
+	return dp.poolJournal
+}
+
 // Close closes all the components
 func (dp *dataPool) Close() error {
 	var lastError error
@@ -7,6 +7,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +30,7 @@ func createMockDataPoolArgs() dataPool.DataPoolArgs {
 		SmartContracts:           testscommon.NewCacherStub(),
 		PeerAuthentications:      testscommon.NewCacherStub(),
 		Heartbeats:               testscommon.NewCacherStub(),
+		PoolJournal:              txcache.NewDisabledPoolJournal(),
 	}
 }
 
@@ -141,6 +144,17 @@ func TestNewDataPool_NilHeartbeatsShouldErr(t *testing.T) {
 	assert.Nil(t, tdp)
 }
 
+func TestNewDataPool_NilPoolJournalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockDataPoolArgs()
+	args.PoolJournal = nil
+	tdp, err := dataPool.NewDataPool(args)
+
+	assert.Equal(t, storage.ErrNilPoolJournal, err)
+	assert.Nil(t, tdp)
+}
+
 func TestNewDataPool_NilPeerBlocksShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -183,6 +197,7 @@ func TestNewDataPool_OkValsShouldWork(t *testing.T) {
 	assert.True(t, args.SmartContracts == tdp.SmartContracts())
 	assert.True(t, args.PeerAuthentications == tdp.PeerAuthentications())
 	assert.True(t, args.Heartbeats == tdp.Heartbeats())
+	assert.True(t, args.PoolJournal == tdp.PoolJournal())
 }
 
 func TestNewDataPool_Close(t *testing.T) {
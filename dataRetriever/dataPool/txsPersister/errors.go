@@ -0,0 +1,12 @@
+package txsPersister
+
+import "errors"
+
+// ErrEmptyFilePath signals that an empty file path has been provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrUnknownFormatVersion signals that the persisted file has an unknown format version
+var ErrUnknownFormatVersion = errors.New("unknown format version")
@@ -0,0 +1,25 @@
+package txsPersister
+
+const formatVersion = 1
+
+// persistedItem holds an item of a pool, marshalled with the internal marshaller, together with the identifier of
+// the cache that held it
+type persistedItem struct {
+	CacheID string `json:"cacheID"`
+	Hash    []byte `json:"hash"`
+	Data    []byte `json:"data"`
+}
+
+// persistedPools is the content of the file in which the pools are saved
+type persistedPools struct {
+	Version              uint32           `json:"version"`
+	Transactions         []*persistedItem `json:"transactions"`
+	UnsignedTransactions []*persistedItem `json:"unsignedTransactions"`
+	RewardTransactions   []*persistedItem `json:"rewardTransactions"`
+}
+
+// LoadStatistics holds the number of items added back in the pools and the number of the stale ones, dropped
+type LoadStatistics struct {
+	NumLoaded  int
+	NumDropped int
+}
@@ -0,0 +1,5 @@
+package txsPersister
+
+type itemsIterator interface {
+	ForEachItem(handler func(cacheID string, key []byte, value interface{}))
+}
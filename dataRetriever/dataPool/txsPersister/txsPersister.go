@@ -0,0 +1,250 @@
+package txsPersister
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var log = logger.GetOrCreate("dataretriever/txspersister")
+
+const filePermissions = 0644
+
+// ArgsTxsPersister holds the arguments needed to create a new transactions pools persister
+type ArgsTxsPersister struct {
+	FilePath         string
+	DataPool         dataRetriever.PoolsHolder
+	Marshaller       marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+	StorageService   dataRetriever.StorageService
+}
+
+// txsPersister saves the content of the transactions pools in a local file and loads it back, dropping the
+// transactions that became stale in the meantime
+type txsPersister struct {
+	filePath         string
+	dataPool         dataRetriever.PoolsHolder
+	marshaller       marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+	storageService   dataRetriever.StorageService
+}
+
+type poolToPersist struct {
+	pool      dataRetriever.ShardedDataCacherNotifier
+	unitType  dataRetriever.UnitType
+	newObject func() interface{}
+	items     *[]*persistedItem
+}
+
+// NewTxsPersister creates a new transactions pools persister
+func NewTxsPersister(args ArgsTxsPersister) (*txsPersister, error) {
+	if len(args.FilePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+	if check.IfNil(args.DataPool) {
+		return nil, dataRetriever.ErrNilDataPoolHolder
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, dataRetriever.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, dataRetriever.ErrNilStore
+	}
+
+	return &txsPersister{
+		filePath:         args.FilePath,
+		dataPool:         args.DataPool,
+		marshaller:       args.Marshaller,
+		shardCoordinator: args.ShardCoordinator,
+		storageService:   args.StorageService,
+	}, nil
+}
+
+// Save writes the content of the transactions pools in the file. The file is replaced atomically, so an interrupted
+// save does not leave a truncated file behind
+func (tp *txsPersister) Save() error {
+	content := &persistedPools{
+		Version: formatVersion,
+	}
+
+	for _, p := range tp.getPoolsToPersist(content) {
+		tp.collectItems(p)
+	}
+
+	buff, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(tp.filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tmpFilePath := tp.filePath + ".tmp"
+	err = ioutil.WriteFile(tmpFilePath, buff, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("txsPersister.Save",
+		"file", tp.filePath,
+		"num txs", len(content.Transactions),
+		"num scrs", len(content.UnsignedTransactions),
+		"num rewards", len(content.RewardTransactions),
+	)
+
+	return os.Rename(tmpFilePath, tp.filePath)
+}
+
+func (tp *txsPersister) collectItems(p *poolToPersist) {
+	iterator, ok := p.pool.(itemsIterator)
+	if !ok {
+		log.Debug("txsPersister.collectItems: pool does not support iteration", "unit", p.unitType.String())
+		return
+	}
+
+	iterator.ForEachItem(func(cacheID string, key []byte, value interface{}) {
+		data, err := tp.marshaller.Marshal(value)
+		if err != nil {
+			log.Trace("txsPersister.collectItems: cannot marshal item", "hash", key, "error", err)
+			return
+		}
+
+		*p.items = append(*p.items, &persistedItem{
+			CacheID: cacheID,
+			Hash:    key,
+			Data:    data,
+		})
+	})
+}
+
+// Load adds the saved items back in the transactions pools and removes the file. The items already stored in the
+// blockchain and the transactions with a nonce lower than the one of their sender account are dropped. A missing
+// file is not an error, there is nothing to load
+func (tp *txsPersister) Load(accounts state.AccountsAdapter) (*LoadStatistics, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	stats := &LoadStatistics{}
+	buff, err := ioutil.ReadFile(tp.filePath)
+	if os.IsNotExist(err) {
+		return stats, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	content := &persistedPools{}
+	err = json.Unmarshal(buff, content)
+	if err != nil {
+		return nil, err
+	}
+	if content.Version != formatVersion {
+		return nil, ErrUnknownFormatVersion
+	}
+
+	for _, p := range tp.getPoolsToPersist(content) {
+		tp.loadItems(p, accounts, stats)
+	}
+
+	err = os.Remove(tp.filePath)
+	if err != nil {
+		log.Warn("txsPersister.Load: cannot remove the file", "file", tp.filePath, "error", err)
+	}
+
+	return stats, nil
+}
+
+func (tp *txsPersister) loadItems(p *poolToPersist, accounts state.AccountsAdapter, stats *LoadStatistics) {
+	if check.IfNil(p.pool) {
+		stats.NumDropped += len(*p.items)
+		return
+	}
+
+	storer := tp.storageService.GetStorer(p.unitType)
+
+	for _, item := range *p.items {
+		value := p.newObject()
+		err := tp.marshaller.Unmarshal(value, item.Data)
+		if err != nil {
+			log.Trace("txsPersister.loadItems: cannot unmarshal item", "hash", item.Hash, "error", err)
+			stats.NumDropped++
+			continue
+		}
+
+		if !check.IfNil(storer) && storer.Has(item.Hash) == nil {
+			stats.NumDropped++
+			continue
+		}
+		if tp.isStaleTransaction(value, accounts) {
+			stats.NumDropped++
+			continue
+		}
+
+		p.pool.AddData(item.Hash, value, len(item.Data), item.CacheID)
+		stats.NumLoaded++
+	}
+}
+
+// isStaleTransaction returns true for the regular transactions sent from the self shard whose nonce is lower than
+// the current nonce of the sender or whose sender does not exist anymore
+func (tp *txsPersister) isStaleTransaction(value interface{}, accounts state.AccountsAdapter) bool {
+	tx, ok := value.(*transaction.Transaction)
+	if !ok {
+		return false
+	}
+	if tp.shardCoordinator.ComputeId(tx.SndAddr) != tp.shardCoordinator.SelfId() {
+		return false
+	}
+
+	account, err := accounts.GetExistingAccount(tx.SndAddr)
+	if err != nil {
+		return true
+	}
+
+	return tx.Nonce < account.GetNonce()
+}
+
+func (tp *txsPersister) getPoolsToPersist(content *persistedPools) []*poolToPersist {
+	return []*poolToPersist{
+		{
+			pool:      tp.dataPool.Transactions(),
+			unitType:  dataRetriever.TransactionUnit,
+			newObject: func() interface{} { return &transaction.Transaction{} },
+			items:     &content.Transactions,
+		},
+		{
+			pool:      tp.dataPool.UnsignedTransactions(),
+			unitType:  dataRetriever.UnsignedTransactionUnit,
+			newObject: func() interface{} { return &smartContractResult.SmartContractResult{} },
+			items:     &content.UnsignedTransactions,
+		},
+		{
+			pool:      tp.dataPool.RewardTransactions(),
+			unitType:  dataRetriever.RewardTransactionUnit,
+			newObject: func() interface{} { return &rewardTx.RewardTx{} },
+			items:     &content.RewardTransactions,
+		},
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *txsPersister) IsInterfaceNil() bool {
+	return tp == nil
+}
@@ -0,0 +1,152 @@
+package txsPersister
+
+import (
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsTxsPersister(t *testing.T) ArgsTxsPersister {
+	return ArgsTxsPersister{
+		FilePath:         filepath.Join(t.TempDir(), "TxPool.json"),
+		DataPool:         dataRetrieverMock.NewPoolsHolderMock(),
+		Marshaller:       &testscommon.MarshalizerMock{},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+		StorageService:   genericMocks.NewChainStorerMock(0),
+	}
+}
+
+func TestNewTxsPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		args := createArgsTxsPersister(t)
+		args.FilePath = ""
+		persister, err := NewTxsPersister(args)
+		require.True(t, check.IfNil(persister))
+		require.Equal(t, ErrEmptyFilePath, err)
+	})
+	t.Run("nil data pool should error", func(t *testing.T) {
+		args := createArgsTxsPersister(t)
+		args.DataPool = nil
+		persister, err := NewTxsPersister(args)
+		require.True(t, check.IfNil(persister))
+		require.Equal(t, dataRetriever.ErrNilDataPoolHolder, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		args := createArgsTxsPersister(t)
+		args.Marshaller = nil
+		persister, err := NewTxsPersister(args)
+		require.True(t, check.IfNil(persister))
+		require.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		args := createArgsTxsPersister(t)
+		args.ShardCoordinator = nil
+		persister, err := NewTxsPersister(args)
+		require.True(t, check.IfNil(persister))
+		require.Equal(t, dataRetriever.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		args := createArgsTxsPersister(t)
+		args.StorageService = nil
+		persister, err := NewTxsPersister(args)
+		require.True(t, check.IfNil(persister))
+		require.Equal(t, dataRetriever.ErrNilStore, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		persister, err := NewTxsPersister(createArgsTxsPersister(t))
+		require.False(t, check.IfNil(persister))
+		require.Nil(t, err)
+	})
+}
+
+func TestTxsPersister_Load(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		persister, _ := NewTxsPersister(createArgsTxsPersister(t))
+		stats, err := persister.Load(nil)
+		require.Nil(t, stats)
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("missing file should not load anything", func(t *testing.T) {
+		persister, _ := NewTxsPersister(createArgsTxsPersister(t))
+		stats, err := persister.Load(&stateMock.AccountsStub{})
+		require.Nil(t, err)
+		require.Equal(t, &LoadStatistics{}, stats)
+	})
+	t.Run("unknown format version should error", func(t *testing.T) {
+		args := createArgsTxsPersister(t)
+		require.Nil(t, ioutil.WriteFile(args.FilePath, []byte(`{"version":37}`), filePermissions))
+		persister, _ := NewTxsPersister(args)
+		stats, err := persister.Load(&stateMock.AccountsStub{})
+		require.Nil(t, stats)
+		require.Equal(t, ErrUnknownFormatVersion, err)
+	})
+}
+
+func TestTxsPersister_SaveAndLoadShouldDropStaleItems(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsTxsPersister(t)
+	pools := args.DataPool
+	pools.Transactions().AddData([]byte("alice-5"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 5}, 100, "0")
+	pools.Transactions().AddData([]byte("alice-6"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 6}, 100, "0")
+	pools.Transactions().AddData([]byte("alice-7"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 7}, 100, "0")
+	pools.Transactions().AddData([]byte("carol-0"), &transaction.Transaction{SndAddr: []byte("carol"), Nonce: 0}, 100, "0")
+	pools.UnsignedTransactions().AddData([]byte("scr"), &smartContractResult.SmartContractResult{Nonce: 3, Value: big.NewInt(1)}, 100, "0")
+	pools.RewardTransactions().AddData([]byte("reward"), &rewardTx.RewardTx{Round: 4, Value: big.NewInt(2)}, 100, "4294967295_0")
+
+	persister, _ := NewTxsPersister(args)
+	require.Nil(t, persister.Save())
+
+	// alice-7 got included in a block in the meantime
+	require.Nil(t, args.StorageService.GetStorer(dataRetriever.TransactionUnit).Put([]byte("alice-7"), []byte("tx")))
+
+	args.DataPool = dataRetrieverMock.NewPoolsHolderMock()
+	persister, _ = NewTxsPersister(args)
+	accounts := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			if string(address) != "alice" {
+				return nil, errors.New("account not found")
+			}
+
+			account, _ := state.NewUserAccount(address)
+			account.IncreaseNonce(6)
+			return account, nil
+		},
+	}
+
+	stats, err := persister.Load(accounts)
+	require.Nil(t, err)
+	require.Equal(t, &LoadStatistics{NumLoaded: 3, NumDropped: 3}, stats)
+
+	_, found := args.DataPool.Transactions().SearchFirstData([]byte("alice-6"))
+	require.True(t, found)
+	_, found = args.DataPool.Transactions().SearchFirstData([]byte("alice-5"))
+	require.False(t, found)
+	_, found = args.DataPool.UnsignedTransactions().SearchFirstData([]byte("scr"))
+	require.True(t, found)
+	_, found = args.DataPool.RewardTransactions().SearchFirstData([]byte("reward"))
+	require.True(t, found)
+
+	_, err = os.Stat(args.FilePath)
+	require.True(t, os.IsNotExist(err))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageCacherAdapter"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 )
 
@@ -62,11 +63,21 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 
 	mainConfig := args.Config
 
+	poolJournal, err := createTxPoolJournal(mainConfig.TxPoolJournal)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the transactions pool journal", err)
+	}
+
 	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
 		Config:         factory.GetCacherFromConfig(mainConfig.TxDataPool),
 		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
 		SelfShardID:    args.ShardCoordinator.SelfId(),
 		TxGasHandler:   args.EconomicsData,
+		PoolJournal:    poolJournal,
+		Replacement: txcache.ReplacementConfig{
+			Enabled:                   mainConfig.TxPoolReplacement.Enabled,
+			MinGasPriceBumpPercentage: mainConfig.TxPoolReplacement.MinGasPriceBumpPercentage,
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating the cache for the transactions", err)
@@ -158,10 +169,19 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 		SmartContracts:           smartContracts,
 		PeerAuthentications:      peerAuthPool,
 		Heartbeats:               heartbeatPool,
+		PoolJournal:              poolJournal,
 	}
 	return dataPool.NewDataPool(dataPoolArgs)
 }
 
+func createTxPoolJournal(journalConfig config.TxPoolJournalConfig) (txcache.PoolJournalHandler, error) {
+	if !journalConfig.Enabled {
+		return txcache.NewDisabledPoolJournal(), nil
+	}
+
+	return txcache.NewPoolJournal(journalConfig.Capacity)
+}
+
 func createTrieSyncDB(args ArgsDataPool) (storage.Persister, error) {
 	mainConfig := args.Config
 
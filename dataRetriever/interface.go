@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 // UnitType is the type for Storage unit identifiers
@@ -45,6 +46,16 @@ func (ut UnitType) String() string {
 		return "TrieEpochRootHashUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case AddressTransactionsUnit:
+		return "AddressTransactionsUnit"
+	case OutportOutboxUnit:
+		return "OutportOutboxUnit"
+	case StateChangesUnit:
+		return "StateChangesUnit"
+	case EventsUnit:
+		return "EventsUnit"
+	case EquivocationsUnit:
+		return "EquivocationsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -105,6 +116,16 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// AddressTransactionsUnit is the address <-> transactions history storage unit identifier
+	AddressTransactionsUnit UnitType = 25
+	// OutportOutboxUnit is the outport drivers outbox storage unit identifier
+	OutportOutboxUnit UnitType = 26
+	// StateChangesUnit is the transaction hash <-> state changes storage unit identifier
+	StateChangesUnit UnitType = 27
+	// EventsUnit is the logged events index storage unit identifier
+	EventsUnit UnitType = 28
+	// EquivocationsUnit is the equivocation evidences storage unit identifier
+	EquivocationsUnit UnitType = 29
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	// TODO: Add only unit types lower than 100
@@ -329,6 +350,7 @@ type PoolsHolder interface {
 	CurrentBlockTxs() TransactionCacher
 	PeerAuthentications() storage.Cacher
 	Heartbeats() storage.Cacher
+	PoolJournal() txcache.PoolJournalHandler
 	Close() error
 	IsInterfaceNil() bool
 }
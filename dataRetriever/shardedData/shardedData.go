@@ -270,6 +270,23 @@ func (sd *shardedData) Keys() [][]byte {
 	return keys
 }
 
+// ForEachItem iterates over the items of all the shard stores, providing the identifier of the store holding them
+func (sd *shardedData) ForEachItem(handler func(cacheID string, key []byte, value interface{})) {
+	sd.mutShardedDataStore.RLock()
+	defer sd.mutShardedDataStore.RUnlock()
+
+	for _, shard := range sd.shardedDataStore {
+		for _, key := range shard.cache.Keys() {
+			value, ok := shard.cache.Peek(key)
+			if !ok {
+				continue
+			}
+
+			handler(shard.cacheID, key, value)
+		}
+	}
+}
+
 // GetCounts returns the total number of transactions in the pool
 func (sd *shardedData) GetCounts() counting.CountsWithSize {
 	sd.mutShardedDataStore.RLock()
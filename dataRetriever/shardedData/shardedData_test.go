@@ -249,6 +249,20 @@ func TestShardedData_Keys(t *testing.T) {
 	assert.ElementsMatch(t, txsHashes, sd.Keys())
 }
 
+func TestShardedData_ForEachItem(t *testing.T) {
+	sd, _ := NewShardedData("", defaultTestConfig)
+
+	sd.AddData([]byte("hash-x"), "x", 0, "1")
+	sd.AddData([]byte("hash-y"), "y", 0, "1_2")
+
+	items := make(map[string]string)
+	sd.ForEachItem(func(cacheID string, key []byte, value interface{}) {
+		items[cacheID+"/"+string(key)] = value.(string)
+	})
+
+	assert.Equal(t, map[string]string{"1/hash-x": "x", "1_2/hash-y": "y"}, items)
+}
+
 func TestShardedData_RegisterAddedDataHandlerNotAddedShouldNotCall(t *testing.T) {
 	t.Parallel()
 
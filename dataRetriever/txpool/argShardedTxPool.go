@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
@@ -14,6 +15,8 @@ import (
 type ArgShardedTxPool struct {
 	Config         storageUnit.CacheConfig
 	TxGasHandler   txcache.TxGasHandler
+	PoolJournal    txcache.PoolJournalHandler
+	Replacement    txcache.ReplacementConfig
 	NumberOfShards uint32
 	SelfShardID    uint32
 }
@@ -43,6 +46,9 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.TxGasHandler.MinGasPrice() == 0 {
 		return fmt.Errorf("%w: MinGasPrice is not valid", dataRetriever.ErrCacheConfigInvalidEconomics)
 	}
+	if check.IfNil(args.PoolJournal) {
+		return fmt.Errorf("%w: PoolJournal is not valid", storage.ErrNilPoolJournal)
+	}
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
@@ -17,3 +17,12 @@ type txCache interface {
 	Diagnose(deep bool)
 	GetTransactionsPoolForSender(sender string) []*txcache.WrappedTransaction
 }
+
+type txReplacementHandler interface {
+	CheckTxReplacement(tx *txcache.WrappedTransaction) error
+	RemoveTxsReplacedBy(tx *txcache.WrappedTransaction) error
+}
+
+type replacementStatisticsHandler interface {
+	GetReplacementStatistics() txcache.ReplacementStatistics
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -116,6 +117,7 @@ func newPool() dataRetriever.ShardedDataCacherNotifier {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		PoolJournal:    txcache.NewDisabledPoolJournal(),
 		NumberOfShards: 2,
 		SelfShardID:    0,
 	}
@@ -28,6 +28,7 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	poolJournal                  txcache.PoolJournalHandler
 }
 
 type txPoolShard struct {
@@ -56,6 +57,7 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
 		CountPerSenderThreshold:       args.Config.SizePerSender,
 		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+		Replacement:                   args.Replacement,
 	}
 
 	// We do not reserve cross tx cache capacity for [metachain] -> [me] (no transactions), [me] -> me (already reserved above).
@@ -78,6 +80,7 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		poolJournal:                  args.PoolJournal,
 	}
 
 	return shardedTxPoolObject, nil
@@ -140,6 +143,11 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 			return txcache.NewDisabledCache()
 		}
 
+		err = cache.SetPoolJournal(txPool.poolJournal)
+		if err != nil {
+			log.Error("shardedTxPool.createTxCache()", "err", err)
+		}
+
 		return cache
 	}
 
@@ -187,6 +195,12 @@ func (txPool *shardedTxPool) AddData(key []byte, value interface{}, sizeInBytes
 // addTx adds the transaction to the cache
 func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
+	err := txPool.removeTxsReplacedInOtherCaches(tx, shard.CacheID)
+	if err != nil {
+		log.Trace("shardedTxPool.addTx(): underpriced replacement", "tx", tx.TxHash, "cacheID", shard.CacheID, "err", err)
+		return
+	}
+
 	cache := shard.Cache
 	_, added := cache.AddTx(tx)
 	if added {
@@ -194,6 +208,68 @@ func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID strin
 	}
 }
 
+// removeTxsReplacedInOtherCaches removes the pending transactions having the same sender and nonce as the provided one,
+// held by the other caches of the sender's shard. Nothing is removed if the transaction would be rejected as an
+// underpriced replacement by any of the sender's caches.
+func (txPool *shardedTxPool) removeTxsReplacedInOtherCaches(tx *txcache.WrappedTransaction, cacheID string) error {
+	handlers := txPool.getReplacementHandlersOfShard(tx.SenderShardID)
+	for _, handler := range handlers {
+		err := handler.CheckTxReplacement(tx)
+		if err != nil {
+			// the cache holding the pending transactions records the rejected replacement
+			return handler.RemoveTxsReplacedBy(tx)
+		}
+	}
+
+	for otherCacheID, handler := range handlers {
+		if otherCacheID == cacheID {
+			continue
+		}
+
+		err := handler.RemoveTxsReplacedBy(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckTxReplacement verifies whether the transaction would be rejected by the replace-by-fee policy of any of the
+// caches holding the transactions of the sender's shard, returning ErrUnderpricedReplacement if so. The pool is not altered.
+func (txPool *shardedTxPool) CheckTxReplacement(tx *txcache.WrappedTransaction) error {
+	for _, cache := range txPool.getReplacementHandlersOfShard(tx.SenderShardID) {
+		err := cache.CheckTxReplacement(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (txPool *shardedTxPool) getReplacementHandlersOfShard(senderShardID uint32) map[string]txReplacementHandler {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	handlers := make(map[string]txReplacementHandler)
+	for cacheID, shard := range txPool.backingMap {
+		sourceShardID, _, err := process.ParseShardCacherIdentifier(cacheID)
+		if err != nil || sourceShardID != senderShardID {
+			continue
+		}
+
+		handler, ok := shard.Cache.(txReplacementHandler)
+		if !ok {
+			continue
+		}
+
+		handlers[cacheID] = handler
+	}
+
+	return handlers
+}
+
 func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
 	txPool.mutexAddCallbacks.RLock()
 	defer txPool.mutexAddCallbacks.RUnlock()
@@ -227,17 +303,31 @@ func (txPool *shardedTxPool) searchFirstTx(txHash []byte) (tx data.TransactionHa
 }
 
 // RemoveData removes the transaction from the pool
+// This is called for transactions found invalid at processing time
 func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
-	txPool.removeTx(key, cacheID)
+	txPool.removeTx(key, cacheID, txcache.RemovalReasonDiscarded)
 }
 
 // removeTx removes the transaction from the pool
-func (txPool *shardedTxPool) removeTx(txHash []byte, cacheID string) bool {
+func (txPool *shardedTxPool) removeTx(txHash []byte, cacheID string, reason txcache.RemovalReason) bool {
 	shard := txPool.getOrCreateShard(cacheID)
-	return shard.Cache.RemoveTxByHash(txHash)
+	if !txPool.poolJournal.IsEnabled() {
+		return shard.Cache.RemoveTxByHash(txHash)
+	}
+
+	tx, found := shard.Cache.GetByTxHash(txHash)
+	removed := shard.Cache.RemoveTxByHash(txHash)
+	if found && removed {
+		entry := txcache.NewPoolJournalEntry(tx, shard.CacheID, reason)
+		entry.PoolSize = uint64(shard.Cache.Len())
+		txPool.poolJournal.Record(entry)
+	}
+
+	return removed
 }
 
 // RemoveSetOfDataFromPool removes a bunch of transactions from the pool
+// This is called for transactions included in a committed block
 func (txPool *shardedTxPool) RemoveSetOfDataFromPool(keys [][]byte, cacheID string) {
 	txPool.removeTxBulk(keys, cacheID)
 }
@@ -246,7 +336,7 @@ func (txPool *shardedTxPool) RemoveSetOfDataFromPool(keys [][]byte, cacheID stri
 func (txPool *shardedTxPool) removeTxBulk(txHashes [][]byte, cacheID string) {
 	numRemoved := 0
 	for _, key := range txHashes {
-		if txPool.removeTx(key, cacheID) {
+		if txPool.removeTx(key, cacheID, txcache.RemovalReasonIncluded) {
 			numRemoved++
 		}
 	}
@@ -300,6 +390,39 @@ func (txPool *shardedTxPool) ClearShardStore(cacheID string) {
 	shard.Cache.Clear()
 }
 
+// ForEachItem iterates over the transactions of all the caches, providing the identifier of the cache (prior to
+// the routing to the caches unions) in which each transaction was added
+func (txPool *shardedTxPool) ForEachItem(handler func(cacheID string, key []byte, value interface{})) {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range txPool.backingMap {
+		shard.Cache.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
+			handler(process.ShardCacherIdentifier(tx.SenderShardID, tx.ReceiverShardID), txHash, tx.Tx)
+		})
+	}
+}
+
+// GetReplacementStatistics returns the counters of the replace-by-fee policy, summed over all the caches
+func (txPool *shardedTxPool) GetReplacementStatistics() txcache.ReplacementStatistics {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	statistics := txcache.ReplacementStatistics{}
+	for _, shard := range txPool.backingMap {
+		cache, ok := shard.Cache.(replacementStatisticsHandler)
+		if !ok {
+			continue
+		}
+
+		cacheStatistics := cache.GetReplacementStatistics()
+		statistics.NumReplacedTxs += cacheStatistics.NumReplacedTxs
+		statistics.NumRejectedReplacements += cacheStatistics.NumRejectedReplacements
+	}
+
+	return statistics
+}
+
 // RegisterOnAdded registers a new handler to be called when a new transaction is added
 func (txPool *shardedTxPool) RegisterOnAdded(handler func(key []byte, value interface{})) {
 	if handler == nil {
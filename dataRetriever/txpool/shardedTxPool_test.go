@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -11,7 +12,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -38,6 +41,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 			MinimumGasPrice:      1000000000,
 			GasProcessingDivisor: 100,
 		},
+		PoolJournal:    txcache.NewDisabledPoolJournal(),
 		NumberOfShards: 1,
 	}
 
@@ -93,6 +97,12 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.Nil(t, pool)
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidSharding.Error())
+
+	args = goodArgs
+	args.PoolJournal = nil
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, storage.ErrNilPoolJournal))
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
@@ -104,6 +114,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 			MinimumGasPrice:      1000000000,
 			GasProcessingDivisor: 1,
 		},
+		PoolJournal:    txcache.NewDisabledPoolJournal(),
 		NumberOfShards: 2,
 	}
 
@@ -117,6 +128,8 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 	require.Equal(t, 100, int(pool.configPrototypeSourceMe.NumSendersToPreemptivelyEvict))
 	require.Equal(t, 300000, int(pool.configPrototypeSourceMe.CountThreshold))
 
+	require.False(t, pool.configPrototypeSourceMe.Replacement.Enabled)
+
 	require.Equal(t, 300000, int(pool.configPrototypeDestinationMe.MaxNumItems))
 	require.Equal(t, 209715200, int(pool.configPrototypeDestinationMe.MaxNumBytes))
 }
@@ -181,6 +194,97 @@ func Test_AddData(t *testing.T) {
 	require.True(t, ok)
 }
 
+func Test_GetReplacementStatistics(t *testing.T) {
+	config := storageUnit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        10,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+	}
+	args := ArgShardedTxPool{
+		Config: config,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		PoolJournal: txcache.NewDisabledPoolJournal(),
+		Replacement: txcache.ReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+		},
+		NumberOfShards: 2,
+	}
+	pool, _ := NewShardedTxPool(args)
+	require.True(t, pool.configPrototypeSourceMe.Replacement.Enabled)
+
+	pool.AddData([]byte("hash-x"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 100}, 0, "0")
+	pool.AddData([]byte("hash-y"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 200}, 0, "0")
+	pool.AddData([]byte("hash-z"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 210}, 0, "0_1")
+
+	cache := pool.getTxCache("0")
+	require.Equal(t, 1, cache.Len())
+	_, ok := cache.GetByTxHash([]byte("hash-y"))
+	require.True(t, ok)
+
+	expectedStatistics := txcache.ReplacementStatistics{NumReplacedTxs: 1, NumRejectedReplacements: 1}
+	require.Equal(t, expectedStatistics, pool.GetReplacementStatistics())
+}
+
+func Test_AddDataShouldReplaceTheSameNonceTxOfOtherDestinationShard(t *testing.T) {
+	args := ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		PoolJournal: txcache.NewDisabledPoolJournal(),
+		Replacement: txcache.ReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+		},
+		NumberOfShards: 2,
+	}
+	pool, _ := NewShardedTxPool(args)
+
+	pool.AddData([]byte("hash-cross"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 100}, 0, "0_1")
+	require.True(t, errors.Is(pool.CheckTxReplacement(createWrappedTx("alice", 42, 109)), storage.ErrUnderpricedReplacement))
+	require.Nil(t, pool.CheckTxReplacement(createWrappedTx("alice", 42, 110)))
+
+	pool.AddData([]byte("hash-cancel"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 110}, 0, "0")
+
+	_, ok := pool.SearchFirstData([]byte("hash-cross"))
+	require.False(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-cancel"))
+	require.True(t, ok)
+	require.Equal(t, uint64(1), pool.GetReplacementStatistics().NumReplacedTxs)
+}
+
+func Test_ForEachItem(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 7), 0, "1_0")
+
+	cacheIDs := make(map[string]string)
+	pool.ForEachItem(func(cacheID string, key []byte, value interface{}) {
+		require.NotNil(t, value.(data.TransactionHandler))
+		cacheIDs[string(key)] = cacheID
+	})
+
+	require.Equal(t, map[string]string{"hash-x": "0", "hash-y": "0_1", "hash-z": "1_0"}, cacheIDs)
+}
+
 func Test_AddData_NoPanic_IfNotATransaction(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 
@@ -245,6 +349,37 @@ func Test_RemoveData(t *testing.T) {
 	require.Nil(t, yTx)
 }
 
+func Test_RemovalsAreRecordedInPoolJournal(t *testing.T) {
+	journal, _ := txcache.NewPoolJournal(10)
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.poolJournal = journal
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("bob", 43), 0, "0")
+	pool.AddData([]byte("hash-z"), createTx("carol", 44), 0, "1")
+
+	pool.RemoveData([]byte("hash-x"), "0")
+	pool.RemoveSetOfDataFromPool([][]byte{[]byte("hash-y")}, "0")
+	pool.RemoveData([]byte("hash-missing"), "0")
+
+	entries := journal.GetEntriesForTx([]byte("hash-x"))
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, txcache.RemovalReasonDiscarded, entries[0].Reason)
+	require.Equal(t, "0", entries[0].CacheID)
+	require.Equal(t, []byte("alice"), entries[0].Sender)
+	require.Equal(t, uint64(42), entries[0].Nonce)
+	require.Equal(t, uint64(1), entries[0].PoolSize)
+
+	entries = journal.GetEntriesForTx([]byte("hash-y"))
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, txcache.RemovalReasonIncluded, entries[0].Reason)
+	require.Equal(t, uint64(0), entries[0].PoolSize)
+
+	require.Equal(t, 0, len(journal.GetEntriesForTx([]byte("hash-missing"))))
+	require.Equal(t, 1, len(journal.GetLatestEvictions(10)))
+}
+
 func Test_RemoveSetOfDataFromPool(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -373,6 +508,7 @@ func Test_routeToCacheUnions(t *testing.T) {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		PoolJournal:    txcache.NewDisabledPoolJournal(),
 		NumberOfShards: 4,
 		SelfShardID:    42,
 	}
@@ -394,6 +530,13 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createWrappedTx(sender string, nonce uint64, gasPrice uint64) *txcache.WrappedTransaction {
+	return &txcache.WrappedTransaction{
+		Tx:     &transaction.Transaction{SndAddr: []byte(sender), Nonce: nonce, GasPrice: gasPrice},
+		TxHash: []byte(fmt.Sprintf("hash-%s-%d-%d", sender, nonce, gasPrice)),
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -416,6 +559,7 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		PoolJournal:    txcache.NewDisabledPoolJournal(),
 		NumberOfShards: 4,
 		SelfShardID:    0,
 	}
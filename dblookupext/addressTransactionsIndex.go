@@ -0,0 +1,315 @@
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common/logging"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	addressTxsCounterKeyPrefix = "addrTxsCount_"
+	addressTxsEntryKeyPrefix   = "addrTx_"
+	addressTxsRevertKeyPrefix  = "addrTxsRevert_"
+)
+
+// addressTransactionsIndex keeps, for each address, the ordered list of transactions the address took part in
+// (as sender, receiver, smart contract result or ESDT transfer participant). For an address, the following records are held:
+//   - a counter, holding the number of indexed transactions
+//   - one record for each transaction, keyed by the address and the position of the transaction in the history of the address
+//
+// For each indexed block, the list of touched addresses is saved as well, so that the block can be reverted.
+type addressTransactionsIndex struct {
+	storer                  storage.Storer
+	marshalizer             marshal.Marshalizer
+	esdtTransferIdentifiers map[string]struct{}
+}
+
+type addressTxPair struct {
+	address []byte
+	txHash  []byte
+}
+
+func newAddressTransactionsIndex(storer storage.Storer, marshalizer marshal.Marshalizer) *addressTransactionsIndex {
+	return &addressTransactionsIndex{
+		storer:      storer,
+		marshalizer: marshalizer,
+		esdtTransferIdentifiers: map[string]struct{}{
+			core.BuiltInFunctionESDTTransfer:         {},
+			core.BuiltInFunctionESDTNFTTransfer:      {},
+			core.BuiltInFunctionMultiESDTNFTTransfer: {},
+		},
+	}
+}
+
+func (ati *addressTransactionsIndex) saveBlock(
+	blockHeaderHash []byte,
+	txs map[string]data.TransactionHandler,
+	scResults map[string]data.TransactionHandler,
+	logs []*data.LogData,
+) error {
+	revertKey := buildAddressTxsRevertKey(blockHeaderHash)
+	if ati.storer.Has(revertKey) == nil {
+		// block already indexed, nothing to do
+		return nil
+	}
+
+	pairs := ati.extractAddressTxPairs(txs, scResults, logs)
+	touchedAddresses := make([][]byte, 0, len(pairs))
+	for _, pair := range pairs {
+		err := ati.appendTx(pair.address, pair.txHash)
+		if err != nil {
+			logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err,
+				"addressTransactionsIndex.saveBlock() cannot index transaction",
+				"txHash", pair.txHash, "err", err)
+			continue
+		}
+
+		touchedAddresses = append(touchedAddresses, pair.address)
+	}
+
+	revertRecordBytes, err := ati.marshalizer.Marshal(&batch.Batch{Data: touchedAddresses})
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(revertKey, revertRecordBytes)
+}
+
+func (ati *addressTransactionsIndex) extractAddressTxPairs(
+	txs map[string]data.TransactionHandler,
+	scResults map[string]data.TransactionHandler,
+	logs []*data.LogData,
+) []*addressTxPair {
+	pairs := make(map[string]*addressTxPair)
+	addPair := func(address []byte, txHash []byte) {
+		if len(address) == 0 || len(txHash) == 0 {
+			return
+		}
+
+		key := string(address) + string(txHash)
+		pairs[key] = &addressTxPair{
+			address: address,
+			txHash:  txHash,
+		}
+	}
+
+	for txHash, tx := range txs {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		addPair(tx.GetSndAddr(), []byte(txHash))
+		addPair(tx.GetRcvAddr(), []byte(txHash))
+	}
+
+	for scrHash, scrHandler := range scResults {
+		scr, ok := scrHandler.(*smartContractResult.SmartContractResult)
+		if !ok {
+			continue
+		}
+
+		txHash := []byte(scrHash)
+		if len(scr.OriginalTxHash) > 0 {
+			txHash = scr.OriginalTxHash
+		}
+
+		addPair(scr.SndAddr, txHash)
+		addPair(scr.RcvAddr, txHash)
+	}
+
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, address := range ati.extractESDTTransfersParticipants(logData.LogHandler) {
+			addPair(address, []byte(logData.TxHash))
+		}
+	}
+
+	sortedPairs := make([]*addressTxPair, 0, len(pairs))
+	for _, pair := range pairs {
+		sortedPairs = append(sortedPairs, pair)
+	}
+
+	sort.Slice(sortedPairs, func(i, j int) bool {
+		cmp := bytes.Compare(sortedPairs[i].address, sortedPairs[j].address)
+		if cmp != 0 {
+			return cmp < 0
+		}
+
+		return bytes.Compare(sortedPairs[i].txHash, sortedPairs[j].txHash) < 0
+	})
+
+	return sortedPairs
+}
+
+// extractESDTTransfersParticipants returns the senders and the receivers of the ESDT transfers signaled by the given log.
+// For all the ESDT transfer events, the receiver is the last topic.
+func (ati *addressTransactionsIndex) extractESDTTransfersParticipants(txLog data.LogHandler) [][]byte {
+	participants := make([][]byte, 0)
+	for _, eventHandler := range txLog.GetLogEvents() {
+		if check.IfNil(eventHandler) {
+			continue
+		}
+
+		event, ok := eventHandler.(*transaction.Event)
+		if !ok {
+			continue
+		}
+
+		_, isESDTTransfer := ati.esdtTransferIdentifiers[string(event.Identifier)]
+		if !isESDTTransfer || len(event.Topics) < 4 {
+			continue
+		}
+
+		participants = append(participants, event.Address, event.Topics[len(event.Topics)-1])
+	}
+
+	return participants
+}
+
+func (ati *addressTransactionsIndex) appendTx(address []byte, txHash []byte) error {
+	numTxs, err := ati.getNumTxs(address)
+	if err != nil {
+		return err
+	}
+
+	err = ati.storer.Put(buildAddressTxsEntryKey(address, numTxs), txHash)
+	if err != nil {
+		return err
+	}
+
+	return ati.putNumTxs(address, numTxs+1)
+}
+
+func (ati *addressTransactionsIndex) revertBlock(blockHeaderHash []byte) error {
+	revertKey := buildAddressTxsRevertKey(blockHeaderHash)
+	revertRecordBytes, err := ati.storer.Get(revertKey)
+	if err != nil {
+		// block not indexed, nothing to revert
+		return nil
+	}
+
+	revertRecord := &batch.Batch{}
+	err = ati.marshalizer.Unmarshal(revertRecord, revertRecordBytes)
+	if err != nil {
+		return err
+	}
+
+	numRevertedTxsByAddress := make(map[string]uint64)
+	for _, address := range revertRecord.Data {
+		numRevertedTxsByAddress[string(address)]++
+	}
+
+	for address, numRevertedTxs := range numRevertedTxsByAddress {
+		err = ati.removeLastTxs([]byte(address), numRevertedTxs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ati.storer.Remove(revertKey)
+}
+
+func (ati *addressTransactionsIndex) removeLastTxs(address []byte, numTxsToRemove uint64) error {
+	numTxs, err := ati.getNumTxs(address)
+	if err != nil {
+		return err
+	}
+
+	if numTxsToRemove > numTxs {
+		numTxsToRemove = numTxs
+	}
+	for i := numTxs - numTxsToRemove; i < numTxs; i++ {
+		err = ati.storer.Remove(buildAddressTxsEntryKey(address, i))
+		if err != nil {
+			return err
+		}
+	}
+
+	return ati.putNumTxs(address, numTxs-numTxsToRemove)
+}
+
+// getTxsHashes returns at most "size" transactions hashes of the given address, newest first, skipping the newest "from" ones.
+// It also returns the total number of indexed transactions of the address.
+func (ati *addressTransactionsIndex) getTxsHashes(address []byte, from uint64, size uint64) ([][]byte, uint64, error) {
+	numTxs, err := ati.getNumTxs(address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hashes := make([][]byte, 0)
+	if from >= numTxs {
+		return hashes, numTxs, nil
+	}
+
+	newestIndex := numTxs - from - 1
+	numTxsToFetch := size
+	if numTxsToFetch > newestIndex+1 {
+		numTxsToFetch = newestIndex + 1
+	}
+	for i := uint64(0); i < numTxsToFetch; i++ {
+		txHash, errGet := ati.storer.Get(buildAddressTxsEntryKey(address, newestIndex-i))
+		if errGet != nil {
+			return nil, 0, errGet
+		}
+
+		hashes = append(hashes, txHash)
+	}
+
+	return hashes, numTxs, nil
+}
+
+func (ati *addressTransactionsIndex) getNumTxs(address []byte) (uint64, error) {
+	numTxsBytes, err := ati.storer.Get(buildAddressTxsCounterKey(address))
+	if err != nil {
+		if storage.IsNotFoundInStorageErr(err) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+	if len(numTxsBytes) != 8 {
+		return 0, errInvalidAddressTxsCounter
+	}
+
+	return binary.BigEndian.Uint64(numTxsBytes), nil
+}
+
+func (ati *addressTransactionsIndex) putNumTxs(address []byte, numTxs uint64) error {
+	numTxsBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(numTxsBytes, numTxs)
+
+	return ati.storer.Put(buildAddressTxsCounterKey(address), numTxsBytes)
+}
+
+func (ati *addressTransactionsIndex) isEnabled() bool {
+	return true
+}
+
+func buildAddressTxsCounterKey(address []byte) []byte {
+	return append([]byte(addressTxsCounterKeyPrefix), address...)
+}
+
+func buildAddressTxsEntryKey(address []byte, index uint64) []byte {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+
+	key := append([]byte(addressTxsEntryKeyPrefix), address...)
+	return append(key, indexBytes...)
+}
+
+func buildAddressTxsRevertKey(blockHeaderHash []byte) []byte {
+	return append([]byte(addressTxsRevertKeyPrefix), blockHeaderHash...)
+}
@@ -0,0 +1,189 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createAddressTransactionsIndexForTests() *addressTransactionsIndex {
+	return newAddressTransactionsIndex(testscommon.CreateMemUnit(), &mock.MarshalizerMock{})
+}
+
+func TestAddressTransactionsIndex_SaveBlockAndGetTxsHashes(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndexForTests()
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	err := index.saveBlock([]byte("blockA"), txs, nil, nil)
+	require.Nil(t, err)
+
+	txs = map[string]data.TransactionHandler{
+		"txB": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")},
+		"txC": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+	}
+	err = index.saveBlock([]byte("blockB"), txs, nil, nil)
+	require.Nil(t, err)
+
+	hashes, total, err := index.getTxsHashes([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, [][]byte{[]byte("txC"), []byte("txB"), []byte("txA")}, hashes)
+
+	hashes, total, err = index.getTxsHashes([]byte("alice"), 1, 1)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, [][]byte{[]byte("txB")}, hashes)
+
+	hashes, total, err = index.getTxsHashes([]byte("alice"), 3, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), total)
+	require.Empty(t, hashes)
+
+	hashes, total, err = index.getTxsHashes([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, [][]byte{[]byte("txA")}, hashes)
+
+	hashes, total, err = index.getTxsHashes([]byte("dave"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+	require.Empty(t, hashes)
+}
+
+func TestAddressTransactionsIndex_SaveBlockTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndexForTests()
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	_ = index.saveBlock([]byte("blockA"), txs, nil, nil)
+	_ = index.saveBlock([]byte("blockA"), txs, nil, nil)
+
+	_, total, err := index.getTxsHashes([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+}
+
+func TestAddressTransactionsIndex_SaveBlockWithResultsAndLogs(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndexForTests()
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("contract")},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scrA": &smartContractResult.SmartContractResult{
+			SndAddr:        []byte("contract"),
+			RcvAddr:        []byte("bob"),
+			OriginalTxHash: []byte("txA"),
+		},
+	}
+	logs := []*data.LogData{
+		{
+			TxHash: "txB",
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("carol"),
+						Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+						Topics:     [][]byte{[]byte("TKN-abcdef"), nil, []byte("10"), []byte("dave")},
+					},
+					{
+						Address:    []byte("erin"),
+						Identifier: []byte("something"),
+						Topics:     [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("frank")},
+					},
+				},
+			},
+		},
+		nil,
+	}
+
+	err := index.saveBlock([]byte("block"), txs, scrs, logs)
+	require.Nil(t, err)
+
+	checkAddressTxs := func(address string, expectedHashes ...string) {
+		hashes, total, errGet := index.getTxsHashes([]byte(address), 0, 10)
+		require.Nil(t, errGet)
+		require.Equal(t, uint64(len(expectedHashes)), total)
+		for i, expectedHash := range expectedHashes {
+			require.Equal(t, []byte(expectedHash), hashes[i])
+		}
+	}
+
+	checkAddressTxs("alice", "txA")
+	checkAddressTxs("contract", "txA")
+	checkAddressTxs("bob", "txA")
+	checkAddressTxs("carol", "txB")
+	checkAddressTxs("dave", "txB")
+	checkAddressTxs("erin")
+	checkAddressTxs("frank")
+}
+
+func TestAddressTransactionsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressTransactionsIndexForTests()
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	_ = index.saveBlock([]byte("blockA"), txs, nil, nil)
+
+	txs = map[string]data.TransactionHandler{
+		"txB": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")},
+	}
+	_ = index.saveBlock([]byte("blockB"), txs, nil, nil)
+
+	err := index.revertBlock([]byte("blockB"))
+	require.Nil(t, err)
+
+	hashes, total, err := index.getTxsHashes([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, [][]byte{[]byte("txA")}, hashes)
+
+	_, total, err = index.getTxsHashes([]byte("carol"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+
+	// reverting a block which was not indexed should do nothing
+	err = index.revertBlock([]byte("blockB"))
+	require.Nil(t, err)
+
+	_, total, _ = index.getTxsHashes([]byte("alice"), 0, 10)
+	require.Equal(t, uint64(1), total)
+
+	// the reverted block can be indexed again
+	_ = index.saveBlock([]byte("blockB"), txs, nil, nil)
+	_, total, _ = index.getTxsHashes([]byte("carol"), 0, 10)
+	require.Equal(t, uint64(1), total)
+}
+
+func TestDisabledAddressTransactionsIndex(t *testing.T) {
+	t.Parallel()
+
+	index := &disabledAddressTransactionsIndex{}
+
+	require.False(t, index.isEnabled())
+	require.Nil(t, index.saveBlock([]byte("block"), nil, nil, nil))
+	require.Nil(t, index.revertBlock([]byte("block")))
+
+	hashes, total, err := index.getTxsHashes([]byte("alice"), 0, 10)
+	require.Nil(t, hashes)
+	require.Equal(t, uint64(0), total)
+	require.Equal(t, ErrAddressTransactionsIndexDisabled, err)
+}
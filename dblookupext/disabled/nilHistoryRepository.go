@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
@@ -20,7 +21,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ []*block.MiniBlock, _ []*data.LogData) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler, _ []*block.MiniBlock, _ []*data.LogData) error {
 	return nil
 }
 
@@ -62,3 +63,22 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
 }
+
+// GetTransactionsHashesByAddress returns a disabled history repository error
+func (nhr *nilHistoryRepository) GetTransactionsHashesByAddress(_ []byte, _ uint64, _ uint64) ([][]byte, uint64, error) {
+	return nil, 0, errorDisabledHistoryRepository
+}
+
+// RecordStateChanges does nothing
+func (nhr *nilHistoryRepository) RecordStateChanges(_ []byte, _ []*common.AccountStateChange) {
+}
+
+// GetStateChangesByTxHash returns a disabled history repository error
+func (nhr *nilHistoryRepository) GetStateChangesByTxHash(_ []byte) ([]*common.AccountStateChange, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetEvents returns a disabled history repository error
+func (nhr *nilHistoryRepository) GetEvents(_ *dblookupext.EventsQuery) ([]*dblookupext.IndexedEvent, bool, error) {
+	return nil, false, errorDisabledHistoryRepository
+}
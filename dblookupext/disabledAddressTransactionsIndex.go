@@ -0,0 +1,24 @@
+package dblookupext
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+type disabledAddressTransactionsIndex struct {
+}
+
+func (dati *disabledAddressTransactionsIndex) saveBlock(_ []byte, _ map[string]data.TransactionHandler, _ map[string]data.TransactionHandler, _ []*data.LogData) error {
+	return nil
+}
+
+func (dati *disabledAddressTransactionsIndex) revertBlock(_ []byte) error {
+	return nil
+}
+
+func (dati *disabledAddressTransactionsIndex) getTxsHashes(_ []byte, _ uint64, _ uint64) ([][]byte, uint64, error) {
+	return nil, 0, ErrAddressTransactionsIndexDisabled
+}
+
+func (dati *disabledAddressTransactionsIndex) isEnabled() bool {
+	return false
+}
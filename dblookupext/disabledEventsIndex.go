@@ -0,0 +1,24 @@
+package dblookupext
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+type disabledEventsIndex struct {
+}
+
+func (dei *disabledEventsIndex) saveBlock(_ []byte, _ uint64, _ []*data.LogData) error {
+	return nil
+}
+
+func (dei *disabledEventsIndex) revertBlock(_ []byte, _ uint64) error {
+	return nil
+}
+
+func (dei *disabledEventsIndex) getEvents(_ *EventsQuery) ([]*IndexedEvent, bool, error) {
+	return nil, false, ErrEventsIndexDisabled
+}
+
+func (dei *disabledEventsIndex) isEnabled() bool {
+	return false
+}
@@ -0,0 +1,25 @@
+package dblookupext
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+type disabledStateChangesIndex struct {
+}
+
+func (dsci *disabledStateChangesIndex) recordStateChanges(_ []byte, _ []*common.AccountStateChange) {
+}
+
+func (dsci *disabledStateChangesIndex) saveBlock(_ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+func (dsci *disabledStateChangesIndex) revertBlock(_ *block.Body) error {
+	return nil
+}
+
+func (dsci *disabledStateChangesIndex) getStateChanges(_ []byte) ([]*common.AccountStateChange, error) {
+	return nil, ErrStateChangesIndexDisabled
+}
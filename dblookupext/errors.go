@@ -20,3 +20,16 @@ func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) er
 func newErrCannotSaveMiniblockMetadata(hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save miniblock metadata, hash [%s]: %w", hex.EncodeToString(hash), originalErr)
 }
+
+var errInvalidAddressTxsCounter = errors.New("invalid address transactions counter")
+
+// ErrAddressTransactionsIndexDisabled signals that the address transactions index is disabled
+var ErrAddressTransactionsIndexDisabled = errors.New("address transactions index is disabled")
+
+var errInvalidEventsIndexRecord = errors.New("invalid events index record")
+
+// ErrEventsIndexDisabled signals that the events index is disabled
+var ErrEventsIndexDisabled = errors.New("events index is disabled")
+
+// ErrStateChangesIndexDisabled signals that the state changes index is disabled
+var ErrStateChangesIndexDisabled = errors.New("state changes index is disabled")
@@ -0,0 +1,511 @@
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	eventsBlockKeyPrefix   = "evBlock_"
+	eventsCounterKeyPrefix = "evCount_"
+	eventsEntryKeyPrefix   = "evEntry_"
+
+	eventsByAddressKeyPrefix         = "addr_"
+	eventsByIdentifierKeyPrefix      = "id_"
+	eventsByIdentifierTopicKeyPrefix = "idTopic_"
+)
+
+// EventsQuery holds the blocks range, the filters and the pagination of an events query. An empty filter matches
+// all the events. The topics are matched, in order, against the first topics of the events and an empty topic
+// matches any value.
+type EventsQuery struct {
+	FromNonce  uint64
+	ToNonce    uint64
+	Address    []byte
+	Identifier []byte
+	Topics     [][]byte
+	From       uint64
+	Size       uint64
+}
+
+// IndexedEvent holds an event logged by an executed transaction, together with the block that included it
+type IndexedEvent struct {
+	TxHash     []byte   `json:"txHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  []byte   `json:"blockHash"`
+	Address    []byte   `json:"address"`
+	Identifier []byte   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+type eventsBlockRecord struct {
+	BlockHash []byte          `json:"blockHash"`
+	Events    []*IndexedEvent `json:"events"`
+	IndexKeys [][]byte        `json:"indexKeys"`
+}
+
+// eventsIndex keeps the events logged in each block and makes them searchable by the emitting address, by the event
+// identifier and by the event identifier together with the first topic. The following records are held:
+//   - one record for each block with events, keyed by the block nonce, holding the events in a deterministic order
+//   - for each index key, a counter and the ordered list of the nonces of the blocks holding matching events
+//
+// Each block record also holds the index keys it touched, so that the block can be reverted.
+type eventsIndex struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+}
+
+func newEventsIndex(storer storage.Storer) *eventsIndex {
+	return &eventsIndex{
+		storer: storer,
+		// the events records are not protobuf structures, so they are saved as JSON
+		marshalizer: &marshal.JsonMarshalizer{},
+	}
+}
+
+func (ei *eventsIndex) saveBlock(blockHeaderHash []byte, blockNonce uint64, logs []*data.LogData) error {
+	existingRecord, err := ei.getBlockRecord(blockNonce)
+	if err != nil {
+		return err
+	}
+	if existingRecord != nil {
+		if bytes.Equal(existingRecord.BlockHash, blockHeaderHash) {
+			// block already indexed, nothing to do
+			return nil
+		}
+
+		// a block with the same nonce, left behind by a fork, is replaced
+		err = ei.removeBlockRecord(blockNonce, existingRecord)
+		if err != nil {
+			return err
+		}
+	}
+
+	events := extractIndexedEvents(blockHeaderHash, blockNonce, logs)
+	if len(events) == 0 {
+		return nil
+	}
+
+	record := &eventsBlockRecord{
+		BlockHash: blockHeaderHash,
+		Events:    events,
+		IndexKeys: buildEventsIndexKeys(events),
+	}
+	for _, indexKey := range record.IndexKeys {
+		err = ei.appendNonce(indexKey, blockNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	recordBytes, err := ei.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return ei.storer.Put(buildEventsBlockKey(blockNonce), recordBytes)
+}
+
+// extractIndexedEvents returns the events of the given logs, ordered by the transaction hash and then by their
+// position in the log
+func extractIndexedEvents(blockHeaderHash []byte, blockNonce uint64, logs []*data.LogData) []*IndexedEvent {
+	sortedLogs := make([]*data.LogData, 0, len(logs))
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		sortedLogs = append(sortedLogs, logData)
+	}
+
+	sort.SliceStable(sortedLogs, func(i, j int) bool {
+		return sortedLogs[i].TxHash < sortedLogs[j].TxHash
+	})
+
+	events := make([]*IndexedEvent, 0)
+	for _, logData := range sortedLogs {
+		for _, eventHandler := range logData.LogHandler.GetLogEvents() {
+			if check.IfNil(eventHandler) {
+				continue
+			}
+
+			event, ok := eventHandler.(*transaction.Event)
+			if !ok {
+				continue
+			}
+
+			events = append(events, &IndexedEvent{
+				TxHash:     []byte(logData.TxHash),
+				BlockNonce: blockNonce,
+				BlockHash:  blockHeaderHash,
+				Address:    event.Address,
+				Identifier: event.Identifier,
+				Topics:     event.Topics,
+				Data:       event.Data,
+			})
+		}
+	}
+
+	return events
+}
+
+func buildEventsIndexKeys(events []*IndexedEvent) [][]byte {
+	keys := make(map[string]struct{})
+	for _, event := range events {
+		if len(event.Address) > 0 {
+			keys[string(buildEventsByAddressKey(event.Address))] = struct{}{}
+		}
+		if len(event.Identifier) == 0 {
+			continue
+		}
+
+		keys[string(buildEventsByIdentifierKey(event.Identifier))] = struct{}{}
+		if len(event.Topics) > 0 && len(event.Topics[0]) > 0 {
+			keys[string(buildEventsByIdentifierTopicKey(event.Identifier, event.Topics[0]))] = struct{}{}
+		}
+	}
+
+	sortedKeys := make([][]byte, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, []byte(key))
+	}
+
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		return bytes.Compare(sortedKeys[i], sortedKeys[j]) < 0
+	})
+
+	return sortedKeys
+}
+
+func (ei *eventsIndex) appendNonce(indexKey []byte, blockNonce uint64) error {
+	numEntries, err := ei.removeNoncesFrom(indexKey, blockNonce)
+	if err != nil {
+		return err
+	}
+
+	err = ei.storer.Put(buildEventsEntryKey(indexKey, numEntries), nonceToBytes(blockNonce))
+	if err != nil {
+		return err
+	}
+
+	return ei.storer.Put(buildEventsCounterKey(indexKey), nonceToBytes(numEntries+1))
+}
+
+// removeNoncesFrom removes the trailing entries of the given index key pointing to blocks with a nonce greater or
+// equal to the given one and returns the number of remaining entries
+func (ei *eventsIndex) removeNoncesFrom(indexKey []byte, blockNonce uint64) (uint64, error) {
+	numEntries, err := ei.getNumEntries(indexKey)
+	if err != nil {
+		return 0, err
+	}
+
+	initialNumEntries := numEntries
+	for numEntries > 0 {
+		nonce, errGet := ei.getEntryNonce(indexKey, numEntries-1)
+		if errGet != nil {
+			return 0, errGet
+		}
+		if nonce < blockNonce {
+			break
+		}
+
+		errGet = ei.storer.Remove(buildEventsEntryKey(indexKey, numEntries-1))
+		if errGet != nil {
+			return 0, errGet
+		}
+		numEntries--
+	}
+
+	if numEntries == initialNumEntries {
+		return numEntries, nil
+	}
+
+	return numEntries, ei.storer.Put(buildEventsCounterKey(indexKey), nonceToBytes(numEntries))
+}
+
+func (ei *eventsIndex) revertBlock(blockHeaderHash []byte, blockNonce uint64) error {
+	record, err := ei.getBlockRecord(blockNonce)
+	if err != nil {
+		return err
+	}
+	if record == nil || !bytes.Equal(record.BlockHash, blockHeaderHash) {
+		// block not indexed, nothing to revert
+		return nil
+	}
+
+	return ei.removeBlockRecord(blockNonce, record)
+}
+
+func (ei *eventsIndex) removeBlockRecord(blockNonce uint64, record *eventsBlockRecord) error {
+	for _, indexKey := range record.IndexKeys {
+		_, err := ei.removeNoncesFrom(indexKey, blockNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ei.storer.Remove(buildEventsBlockKey(blockNonce))
+}
+
+// getEvents returns at most "size" events matching the query, oldest first, skipping the first "from" ones. It also
+// returns whether more matching events exist in the requested blocks range.
+func (ei *eventsIndex) getEvents(query *EventsQuery) ([]*IndexedEvent, bool, error) {
+	events := make([]*IndexedEvent, 0)
+	hasMore := false
+	numSkipped := uint64(0)
+
+	handler := func(blockNonce uint64) (bool, error) {
+		record, err := ei.getBlockRecord(blockNonce)
+		if err != nil {
+			return false, err
+		}
+		if record == nil {
+			// no events logged in the block
+			return true, nil
+		}
+
+		for _, event := range record.Events {
+			if !eventMatchesQuery(event, query) {
+				continue
+			}
+			if numSkipped < query.From {
+				numSkipped++
+				continue
+			}
+			if uint64(len(events)) == query.Size {
+				hasMore = true
+				return false, nil
+			}
+
+			events = append(events, event)
+		}
+
+		return true, nil
+	}
+
+	err := ei.forEachCandidateNonce(query, handler)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return events, hasMore, nil
+}
+
+// forEachCandidateNonce calls the handler, in ascending order, for the nonces of the blocks in the query range that
+// might hold matching events, using the smallest of the applicable index lists. Without an applicable index, all the
+// nonces in the range are iterated. The iteration stops when the handler returns false or an error.
+func (ei *eventsIndex) forEachCandidateNonce(query *EventsQuery, handler func(blockNonce uint64) (bool, error)) error {
+	if query.FromNonce > query.ToNonce {
+		return nil
+	}
+
+	indexKey, numEntries, err := ei.selectIndexKey(query)
+	if err != nil {
+		return err
+	}
+
+	if indexKey == nil {
+		for nonce := query.FromNonce; ; nonce++ {
+			shouldContinue, errHandle := handler(nonce)
+			if errHandle != nil || !shouldContinue || nonce == query.ToNonce {
+				return errHandle
+			}
+		}
+	}
+
+	position, err := ei.searchFirstPosition(indexKey, numEntries, query.FromNonce)
+	if err != nil {
+		return err
+	}
+
+	for ; position < numEntries; position++ {
+		nonce, errGet := ei.getEntryNonce(indexKey, position)
+		if errGet != nil {
+			return errGet
+		}
+		if nonce > query.ToNonce {
+			return nil
+		}
+
+		shouldContinue, errHandle := handler(nonce)
+		if errHandle != nil || !shouldContinue {
+			return errHandle
+		}
+	}
+
+	return nil
+}
+
+// selectIndexKey returns the applicable index key with the fewest entries, or nil if the query has no indexed filter
+func (ei *eventsIndex) selectIndexKey(query *EventsQuery) ([]byte, uint64, error) {
+	candidates := make([][]byte, 0, 3)
+	if len(query.Address) > 0 {
+		candidates = append(candidates, buildEventsByAddressKey(query.Address))
+	}
+	if len(query.Identifier) > 0 {
+		if len(query.Topics) > 0 && len(query.Topics[0]) > 0 {
+			candidates = append(candidates, buildEventsByIdentifierTopicKey(query.Identifier, query.Topics[0]))
+		}
+		candidates = append(candidates, buildEventsByIdentifierKey(query.Identifier))
+	}
+
+	var selectedKey []byte
+	selectedNumEntries := uint64(0)
+	for _, candidate := range candidates {
+		numEntries, err := ei.getNumEntries(candidate)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if selectedKey == nil || numEntries < selectedNumEntries {
+			selectedKey = candidate
+			selectedNumEntries = numEntries
+		}
+	}
+
+	return selectedKey, selectedNumEntries, nil
+}
+
+// searchFirstPosition returns the position of the first entry of the index key pointing to a block with a nonce
+// greater or equal to the given one
+func (ei *eventsIndex) searchFirstPosition(indexKey []byte, numEntries uint64, blockNonce uint64) (uint64, error) {
+	low, high := uint64(0), numEntries
+	for low < high {
+		middle := low + (high-low)/2
+		nonce, err := ei.getEntryNonce(indexKey, middle)
+		if err != nil {
+			return 0, err
+		}
+
+		if nonce < blockNonce {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+
+	return low, nil
+}
+
+func eventMatchesQuery(event *IndexedEvent, query *EventsQuery) bool {
+	if len(query.Address) > 0 && !bytes.Equal(query.Address, event.Address) {
+		return false
+	}
+	if len(query.Identifier) > 0 && !bytes.Equal(query.Identifier, event.Identifier) {
+		return false
+	}
+	if len(query.Topics) > len(event.Topics) {
+		return false
+	}
+	for i, topic := range query.Topics {
+		if len(topic) > 0 && !bytes.Equal(topic, event.Topics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ei *eventsIndex) getBlockRecord(blockNonce uint64) (*eventsBlockRecord, error) {
+	recordBytes, err := ei.storer.Get(buildEventsBlockKey(blockNonce))
+	if err != nil {
+		if storage.IsNotFoundInStorageErr(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	record := &eventsBlockRecord{}
+	err = ei.marshalizer.Unmarshal(record, recordBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (ei *eventsIndex) getNumEntries(indexKey []byte) (uint64, error) {
+	numEntriesBytes, err := ei.storer.Get(buildEventsCounterKey(indexKey))
+	if err != nil {
+		if storage.IsNotFoundInStorageErr(err) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return bytesToNonce(numEntriesBytes)
+}
+
+func (ei *eventsIndex) getEntryNonce(indexKey []byte, position uint64) (uint64, error) {
+	nonceBytes, err := ei.storer.Get(buildEventsEntryKey(indexKey, position))
+	if err != nil {
+		return 0, err
+	}
+
+	return bytesToNonce(nonceBytes)
+}
+
+func (ei *eventsIndex) isEnabled() bool {
+	return true
+}
+
+func nonceToBytes(nonce uint64) []byte {
+	nonceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBytes, nonce)
+
+	return nonceBytes
+}
+
+func bytesToNonce(nonceBytes []byte) (uint64, error) {
+	if len(nonceBytes) != 8 {
+		return 0, errInvalidEventsIndexRecord
+	}
+
+	return binary.BigEndian.Uint64(nonceBytes), nil
+}
+
+func buildEventsBlockKey(blockNonce uint64) []byte {
+	return append([]byte(eventsBlockKeyPrefix), nonceToBytes(blockNonce)...)
+}
+
+func buildEventsCounterKey(indexKey []byte) []byte {
+	return append([]byte(eventsCounterKeyPrefix), indexKey...)
+}
+
+func buildEventsEntryKey(indexKey []byte, position uint64) []byte {
+	key := append([]byte(eventsEntryKeyPrefix), indexKey...)
+	return append(key, nonceToBytes(position)...)
+}
+
+func buildEventsByAddressKey(address []byte) []byte {
+	return appendLengthPrefixed([]byte(eventsByAddressKeyPrefix), address)
+}
+
+func buildEventsByIdentifierKey(identifier []byte) []byte {
+	return appendLengthPrefixed([]byte(eventsByIdentifierKeyPrefix), identifier)
+}
+
+func buildEventsByIdentifierTopicKey(identifier []byte, topic []byte) []byte {
+	key := appendLengthPrefixed([]byte(eventsByIdentifierTopicKeyPrefix), identifier)
+	return appendLengthPrefixed(key, topic)
+}
+
+// appendLengthPrefixed appends the length of the value before the value itself, so that the index keys built from
+// variable length values do not collide
+func appendLengthPrefixed(key []byte, value []byte) []byte {
+	lengthBytes := make([]byte, 2)
+	binary.BigEndian.PutUint16(lengthBytes, uint16(len(value)))
+
+	key = append(key, lengthBytes...)
+	return append(key, value...)
+}
@@ -0,0 +1,173 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createEventsLog(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		TxHash:     txHash,
+		LogHandler: &transaction.Log{Events: events},
+	}
+}
+
+func createEvent(address string, identifier string, topics ...string) *transaction.Event {
+	event := &transaction.Event{
+		Address:    []byte(address),
+		Identifier: []byte(identifier),
+		Topics:     make([][]byte, 0, len(topics)),
+	}
+	for _, topic := range topics {
+		event.Topics = append(event.Topics, []byte(topic))
+	}
+
+	return event
+}
+
+func getEventsTxHashes(events []*IndexedEvent) []string {
+	hashes := make([]string, 0, len(events))
+	for _, event := range events {
+		hashes = append(hashes, string(event.TxHash))
+	}
+
+	return hashes
+}
+
+func createIndexWithEvents(t *testing.T) *eventsIndex {
+	index := newEventsIndex(testscommon.CreateMemUnit())
+
+	err := index.saveBlock([]byte("block1"), 1, []*data.LogData{
+		createEventsLog("txB", createEvent("alice", "ESDTTransfer", "TKN", "", "100", "bob")),
+		createEventsLog("txA", createEvent("sc", "swap", "TKN", "OTHER")),
+	})
+	require.Nil(t, err)
+
+	err = index.saveBlock([]byte("block3"), 3, []*data.LogData{
+		createEventsLog("txC",
+			createEvent("carol", "ESDTTransfer", "OTHER", "", "5", "alice"),
+			createEvent("sc", "swap", "OTHER", "TKN"),
+		),
+	})
+	require.Nil(t, err)
+
+	err = index.saveBlock([]byte("block4"), 4, []*data.LogData{
+		createEventsLog("txD", createEvent("alice", "ESDTTransfer", "TKN", "", "7", "dave")),
+	})
+	require.Nil(t, err)
+
+	return index
+}
+
+func TestEventsIndex_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	index := createIndexWithEvents(t)
+
+	t.Run("by identifier", func(t *testing.T) {
+		events, hasMore, err := index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Identifier: []byte("ESDTTransfer"), Size: 10})
+		require.Nil(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []string{"txB", "txC", "txD"}, getEventsTxHashes(events))
+		require.Equal(t, uint64(3), events[1].BlockNonce)
+		require.Equal(t, []byte("block3"), events[1].BlockHash)
+	})
+	t.Run("by address and identifier", func(t *testing.T) {
+		events, _, err := index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Address: []byte("alice"), Identifier: []byte("ESDTTransfer"), Size: 10})
+		require.Nil(t, err)
+		require.Equal(t, []string{"txB", "txD"}, getEventsTxHashes(events))
+	})
+	t.Run("by identifier and topics", func(t *testing.T) {
+		query := &EventsQuery{FromNonce: 0, ToNonce: 10, Identifier: []byte("swap"), Topics: [][]byte{[]byte("TKN")}, Size: 10}
+		events, _, err := index.getEvents(query)
+		require.Nil(t, err)
+		require.Equal(t, []string{"txA"}, getEventsTxHashes(events))
+
+		query = &EventsQuery{FromNonce: 0, ToNonce: 10, Identifier: []byte("swap"), Topics: [][]byte{nil, []byte("TKN")}, Size: 10}
+		events, _, err = index.getEvents(query)
+		require.Nil(t, err)
+		require.Equal(t, []string{"txC"}, getEventsTxHashes(events))
+	})
+	t.Run("nonces range", func(t *testing.T) {
+		events, _, err := index.getEvents(&EventsQuery{FromNonce: 2, ToNonce: 3, Identifier: []byte("ESDTTransfer"), Size: 10})
+		require.Nil(t, err)
+		require.Equal(t, []string{"txC"}, getEventsTxHashes(events))
+	})
+	t.Run("pagination", func(t *testing.T) {
+		events, hasMore, err := index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, From: 1, Size: 2})
+		require.Nil(t, err)
+		require.True(t, hasMore)
+		require.Equal(t, []string{"txB", "txC"}, getEventsTxHashes(events))
+
+		events, hasMore, err = index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, From: 3, Size: 2})
+		require.Nil(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []string{"txC", "txD"}, getEventsTxHashes(events))
+	})
+	t.Run("unknown address", func(t *testing.T) {
+		events, hasMore, err := index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Address: []byte("eve"), Size: 10})
+		require.Nil(t, err)
+		require.False(t, hasMore)
+		require.Empty(t, events)
+	})
+}
+
+func TestEventsIndex_SaveBlockTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	index := createIndexWithEvents(t)
+	err := index.saveBlock([]byte("block4"), 4, []*data.LogData{
+		createEventsLog("txD", createEvent("alice", "ESDTTransfer", "TKN", "", "7", "dave")),
+	})
+	require.Nil(t, err)
+
+	events, _, err := index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Address: []byte("alice"), Size: 10})
+	require.Nil(t, err)
+	require.Equal(t, []string{"txB", "txD"}, getEventsTxHashes(events))
+}
+
+func TestEventsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index := createIndexWithEvents(t)
+
+	// not matching hash
+	err := index.revertBlock([]byte("block3-fork"), 3)
+	require.Nil(t, err)
+	events, _, _ := index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Identifier: []byte("swap"), Size: 10})
+	require.Equal(t, []string{"txA", "txC"}, getEventsTxHashes(events))
+
+	err = index.revertBlock([]byte("block4"), 4)
+	require.Nil(t, err)
+	err = index.revertBlock([]byte("block3"), 3)
+	require.Nil(t, err)
+
+	events, _, _ = index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Identifier: []byte("ESDTTransfer"), Size: 10})
+	require.Equal(t, []string{"txB"}, getEventsTxHashes(events))
+	events, _, _ = index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Address: []byte("carol"), Size: 10})
+	require.Empty(t, events)
+
+	// a block replacing a not reverted one with the same nonce
+	err = index.saveBlock([]byte("block3"), 3, []*data.LogData{createEventsLog("txC", createEvent("carol", "claim"))})
+	require.Nil(t, err)
+	err = index.saveBlock([]byte("block3-fork"), 3, []*data.LogData{createEventsLog("txE", createEvent("erin", "claim"))})
+	require.Nil(t, err)
+
+	events, _, _ = index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Identifier: []byte("claim"), Size: 10})
+	require.Equal(t, []string{"txE"}, getEventsTxHashes(events))
+	events, _, _ = index.getEvents(&EventsQuery{FromNonce: 0, ToNonce: 10, Address: []byte("carol"), Size: 10})
+	require.Empty(t, events)
+}
+
+func TestEventsIndex_IndexKeysShouldNotCollide(t *testing.T) {
+	t.Parallel()
+
+	require.NotEqual(t,
+		buildEventsByIdentifierTopicKey([]byte("ab"), []byte("c")),
+		buildEventsByIdentifierTopicKey([]byte("a"), []byte("bc")),
+	)
+}
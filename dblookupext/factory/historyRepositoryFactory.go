@@ -85,6 +85,12 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		AddressTransactionsStorer:   hpf.store.GetStorer(dataRetriever.AddressTransactionsUnit),
+		AddressTransactionsEnabled:  hpf.dbLookupExtensionsConfig.AddressTransactionsIndexEnabled,
+		StateChangesStorer:          hpf.store.GetStorer(dataRetriever.StateChangesUnit),
+		StateChangesEnabled:         hpf.dbLookupExtensionsConfig.StateChangesEnabled,
+		EventsStorer:                hpf.store.GetStorer(dataRetriever.EventsUnit),
+		EventsIndexEnabled:          hpf.dbLookupExtensionsConfig.EventsIndexEnabled,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/logging"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -38,6 +39,12 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	AddressTransactionsStorer   storage.Storer
+	AddressTransactionsEnabled  bool
+	StateChangesStorer          storage.Storer
+	StateChangesEnabled         bool
+	EventsStorer                storage.Storer
+	EventsIndexEnabled          bool
 }
 
 type historyRepository struct {
@@ -51,6 +58,9 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	addressTransactionsIndex   addressTransactionsIndexer
+	stateChangesIndex          stateChangesIndexer
+	eventsIndex                eventsIndexer
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -97,12 +107,36 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if arguments.AddressTransactionsEnabled && check.IfNil(arguments.AddressTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if arguments.StateChangesEnabled && check.IfNil(arguments.StateChangesStorer) {
+		return nil, core.ErrNilStore
+	}
+	if arguments.EventsIndexEnabled && check.IfNil(arguments.EventsStorer) {
+		return nil, core.ErrNilStore
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
 
+	var addressTxsIndex addressTransactionsIndexer = &disabledAddressTransactionsIndex{}
+	if arguments.AddressTransactionsEnabled {
+		addressTxsIndex = newAddressTransactionsIndex(arguments.AddressTransactionsStorer, arguments.Marshalizer)
+	}
+
+	var stateChangesIdx stateChangesIndexer = &disabledStateChangesIndex{}
+	if arguments.StateChangesEnabled {
+		stateChangesIdx = newStateChangesIndex(arguments.StateChangesStorer)
+	}
+
+	var eventsIdx eventsIndexer = &disabledEventsIndex{}
+	if arguments.EventsIndexEnabled {
+		eventsIdx = newEventsIndex(arguments.EventsStorer)
+	}
+
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
 		miniblocksMetadataStorer:              arguments.MiniblocksMetadataStorer,
@@ -118,6 +152,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
+		addressTransactionsIndex:                     addressTxsIndex,
+		stateChangesIndex:                            stateChangesIdx,
+		eventsIndex:                                  eventsIdx,
 	}, nil
 }
 
@@ -126,6 +163,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 	createdIntraShardMiniBlocks []*block.MiniBlock,
@@ -178,6 +216,21 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.addressTransactionsIndex.saveBlock(blockHeaderHash, txsFromPool, scrResultsFromPool, logs)
+	if err != nil {
+		return err
+	}
+
+	err = hr.stateChangesIndex.saveBlock(txsFromPool)
+	if err != nil {
+		return err
+	}
+
+	err = hr.eventsIndex.saveBlock(blockHeaderHash, blockHeader.GetNonce(), logs)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -480,7 +533,56 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	body, ok := blockBody.(*block.Body)
+	if ok {
+		err = hr.stateChangesIndex.revertBlock(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !hr.addressTransactionsIndex.isEnabled() && !hr.eventsIndex.isEnabled() {
+		return nil
+	}
+
+	blockHeaderHash, err := core.CalculateHash(hr.marshalizer, hr.hasher, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	err = hr.addressTransactionsIndex.revertBlock(blockHeaderHash)
+	if err != nil {
+		return err
+	}
+
+	return hr.eventsIndex.revertBlock(blockHeaderHash, blockHeader.GetNonce())
+}
+
+// GetTransactionsHashesByAddress will return, newest first, at most "size" hashes of the transactions the given address
+// took part in, skipping the newest "from" ones. The total number of indexed transactions of the address is returned as well.
+func (hr *historyRepository) GetTransactionsHashesByAddress(address []byte, from uint64, size uint64) ([][]byte, uint64, error) {
+	return hr.addressTransactionsIndex.getTxsHashes(address, from, size)
+}
+
+// RecordStateChanges will hold the state changes of the given transaction until the block containing it is recorded
+func (hr *historyRepository) RecordStateChanges(txHash []byte, stateChanges []*common.AccountStateChange) {
+	hr.stateChangesIndex.recordStateChanges(txHash, stateChanges)
+}
+
+// GetStateChangesByTxHash will return the changes done on the accounts by the given executed transaction
+func (hr *historyRepository) GetStateChangesByTxHash(txHash []byte) ([]*common.AccountStateChange, error) {
+	return hr.stateChangesIndex.getStateChanges(txHash)
+}
+
+// GetEvents will return, oldest first, at most "size" events matching the query, skipping the first "from" ones.
+// It also returns whether more matching events exist in the requested blocks range.
+func (hr *historyRepository) GetEvents(query *EventsQuery) ([]*IndexedEvent, bool, error) {
+	return hr.eventsIndex.getEvents(query)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -8,11 +8,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
@@ -88,6 +91,27 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AddressTransactionsEnabled = true
+	args.AddressTransactionsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.StateChangesEnabled = true
+	args.StateChangesStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.EventsIndexEnabled = true
+	args.EventsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -108,7 +132,7 @@ func TestHistoryRepository_RecordBlockInvalidBlockRoundByHashStorerExpectError(t
 	repo, err := NewHistoryRepository(args)
 	require.Nil(t, err)
 
-	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil)
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil, nil)
 	require.Equal(t, err, errPut)
 }
 
@@ -140,7 +164,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -152,6 +176,131 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordAndRevertBlockWithAddressTransactionsIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	_, _, err = repo.GetTransactionsHashesByAddress([]byte("alice"), 0, 10)
+	require.Equal(t, ErrAddressTransactionsIndexDisabled, err)
+
+	args.AddressTransactionsEnabled = true
+	args.AddressTransactionsStorer = testscommon.CreateMemUnit()
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	blockHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+
+	err = repo.RecordBlock(blockHeaderHash, blockHeader, &block.Body{}, txs, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	hashes, total, err := repo.GetTransactionsHashesByAddress([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, [][]byte{[]byte("txA")}, hashes)
+
+	err = repo.RevertBlock(blockHeader, &block.Body{})
+	require.Nil(t, err)
+
+	_, total, err = repo.GetTransactionsHashesByAddress([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+}
+
+func TestHistoryRepository_RecordBlockWithStateChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	_, err = repo.GetStateChangesByTxHash([]byte("txA"))
+	require.Equal(t, ErrStateChangesIndexDisabled, err)
+
+	args.StateChangesEnabled = true
+	args.StateChangesStorer = testscommon.CreateMemUnit()
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	stateChanges := []*common.AccountStateChange{{Address: "alice", BalanceBefore: "10", BalanceAfter: "7"}}
+	repo.RecordStateChanges([]byte("txA"), stateChanges)
+
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	blockHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txA")}},
+		},
+	}
+
+	err = repo.RecordBlock(blockHeaderHash, blockHeader, body, txs, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	recordedStateChanges, err := repo.GetStateChangesByTxHash([]byte("txA"))
+	require.Nil(t, err)
+	require.Equal(t, stateChanges, recordedStateChanges)
+
+	err = repo.RevertBlock(blockHeader, body)
+	require.Nil(t, err)
+
+	_, err = repo.GetStateChangesByTxHash([]byte("txA"))
+	require.Equal(t, ErrNotFoundInStorage, err)
+}
+
+func TestHistoryRepository_RecordAndRevertBlockWithEventsIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	query := &EventsQuery{FromNonce: 0, ToNonce: 10, Identifier: []byte("ESDTTransfer"), Size: 10}
+	_, _, err = repo.GetEvents(query)
+	require.Equal(t, ErrEventsIndexDisabled, err)
+
+	args.EventsIndexEnabled = true
+	args.EventsStorer = testscommon.CreateMemUnit()
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	blockHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+	logs := []*data.LogData{
+		{
+			TxHash: "txA",
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{{Address: []byte("alice"), Identifier: []byte("ESDTTransfer")}},
+			},
+		},
+	}
+
+	err = repo.RecordBlock(blockHeaderHash, blockHeader, &block.Body{}, nil, nil, nil, nil, logs)
+	require.Nil(t, err)
+
+	events, hasMore, err := repo.GetEvents(query)
+	require.Nil(t, err)
+	require.False(t, hasMore)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, []byte("txA"), events[0].TxHash)
+	require.Equal(t, blockHeaderHash, events[0].BlockHash)
+
+	err = repo.RevertBlock(blockHeader, &block.Body{})
+	require.Nil(t, err)
+
+	events, _, err = repo.GetEvents(query)
+	require.Nil(t, err)
+	require.Empty(t, events)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -176,7 +325,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -214,7 +363,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil, nil, nil)
+	}, nil, nil, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -272,7 +421,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -472,7 +621,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -480,7 +629,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -527,7 +676,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -584,7 +733,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -597,7 +746,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -711,7 +860,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil, nil, nil,
+				}, nil, nil, nil, nil, nil,
 			)
 		}
 
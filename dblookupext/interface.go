@@ -3,6 +3,7 @@ package dblookupext
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
 
@@ -17,6 +18,7 @@ type HistoryRepository interface {
 	RecordBlock(blockHeaderHash []byte,
 		blockHeader data.HeaderHandler,
 		blockBody data.BodyHandler,
+		txsFromPool map[string]data.TransactionHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
 		createdIntraShardMiniBlocks []*block.MiniBlock,
@@ -25,6 +27,10 @@ type HistoryRepository interface {
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetTransactionsHashesByAddress(address []byte, from uint64, size uint64) ([][]byte, uint64, error)
+	RecordStateChanges(txHash []byte, stateChanges []*common.AccountStateChange)
+	GetStateChangesByTxHash(txHash []byte) ([]*common.AccountStateChange, error)
+	GetEvents(query *EventsQuery) ([]*IndexedEvent, bool, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsEnabled() bool
@@ -47,3 +53,24 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+type addressTransactionsIndexer interface {
+	saveBlock(blockHeaderHash []byte, txs map[string]data.TransactionHandler, scResults map[string]data.TransactionHandler, logs []*data.LogData) error
+	revertBlock(blockHeaderHash []byte) error
+	getTxsHashes(address []byte, from uint64, size uint64) ([][]byte, uint64, error)
+	isEnabled() bool
+}
+
+type stateChangesIndexer interface {
+	recordStateChanges(txHash []byte, stateChanges []*common.AccountStateChange)
+	saveBlock(txs map[string]data.TransactionHandler) error
+	revertBlock(body *block.Body) error
+	getStateChanges(txHash []byte) ([]*common.AccountStateChange, error)
+}
+
+type eventsIndexer interface {
+	saveBlock(blockHeaderHash []byte, blockNonce uint64, logs []*data.LogData) error
+	revertBlock(blockHeaderHash []byte, blockNonce uint64) error
+	getEvents(query *EventsQuery) ([]*IndexedEvent, bool, error)
+	isEnabled() bool
+}
@@ -0,0 +1,104 @@
+package dblookupext
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/logging"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const sizeOfPendingStateChangesCache = 50000
+
+// stateChangesIndex keeps, for each executed transaction, the changes it did on the accounts. The state changes are
+// reported while the transactions are processed and are held in a cache until the block containing the transactions
+// is recorded. A transaction processed again (e.g. after a block got reverted) overrides its previous state changes.
+type stateChangesIndex struct {
+	storer              storage.Storer
+	marshalizer         marshal.Marshalizer
+	pendingStateChanges storage.Cacher
+}
+
+type stateChangesRecord struct {
+	StateChanges []*common.AccountStateChange `json:"stateChanges"`
+}
+
+func newStateChangesIndex(storer storage.Storer) *stateChangesIndex {
+	pendingStateChanges, _ := lrucache.NewCache(sizeOfPendingStateChangesCache)
+
+	return &stateChangesIndex{
+		storer: storer,
+		// the state changes are not protobuf structures, so they are saved as JSON
+		marshalizer:         &marshal.JsonMarshalizer{},
+		pendingStateChanges: pendingStateChanges,
+	}
+}
+
+func (sci *stateChangesIndex) recordStateChanges(txHash []byte, stateChanges []*common.AccountStateChange) {
+	sci.pendingStateChanges.Put(txHash, stateChanges, len(stateChanges))
+}
+
+func (sci *stateChangesIndex) saveBlock(txs map[string]data.TransactionHandler) error {
+	for txHash := range txs {
+		stateChangesI, found := sci.pendingStateChanges.Get([]byte(txHash))
+		if !found {
+			continue
+		}
+
+		stateChanges, ok := stateChangesI.([]*common.AccountStateChange)
+		if !ok {
+			continue
+		}
+
+		recordBytes, err := sci.marshalizer.Marshal(&stateChangesRecord{StateChanges: stateChanges})
+		if err != nil {
+			return err
+		}
+
+		err = sci.storer.Put([]byte(txHash), recordBytes)
+		if err != nil {
+			logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err,
+				"stateChangesIndex.saveBlock() cannot save state changes",
+				"txHash", []byte(txHash), "err", err)
+			continue
+		}
+
+		sci.pendingStateChanges.Remove([]byte(txHash))
+	}
+
+	return nil
+}
+
+func (sci *stateChangesIndex) revertBlock(body *block.Body) error {
+	for _, miniBlock := range body.MiniBlocks {
+		if miniBlock.Type != block.TxBlock {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			err := sci.storer.Remove(txHash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (sci *stateChangesIndex) getStateChanges(txHash []byte) ([]*common.AccountStateChange, error) {
+	recordBytes, err := sci.storer.Get(txHash)
+	if err != nil {
+		return nil, ErrNotFoundInStorage
+	}
+
+	record := &stateChangesRecord{}
+	err = sci.marshalizer.Unmarshal(record, recordBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record.StateChanges, nil
+}
@@ -0,0 +1,77 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateChangesIndex_SaveBlockAndGetStateChanges(t *testing.T) {
+	t.Parallel()
+
+	index := newStateChangesIndex(testscommon.CreateMemUnit())
+
+	stateChangesA := []*common.AccountStateChange{
+		{
+			Address:       "alice",
+			BalanceBefore: "100",
+			BalanceAfter:  "70",
+			NonceBefore:   1,
+			NonceAfter:    2,
+			ESDTBalanceChanges: map[string]*common.ValueChange{
+				"TKN-abcdef": {Before: "0", After: "5"},
+			},
+		},
+	}
+	index.recordStateChanges([]byte("txA"), stateChangesA)
+	index.recordStateChanges([]byte("txB"), []*common.AccountStateChange{{Address: "bob"}})
+
+	// not saved until the block containing the transaction is recorded
+	_, err := index.getStateChanges([]byte("txA"))
+	require.Equal(t, ErrNotFoundInStorage, err)
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{},
+		"txC": &transaction.Transaction{},
+	}
+	err = index.saveBlock(txs)
+	require.Nil(t, err)
+
+	stateChanges, err := index.getStateChanges([]byte("txA"))
+	require.Nil(t, err)
+	require.Equal(t, stateChangesA, stateChanges)
+
+	_, err = index.getStateChanges([]byte("txB"))
+	require.Equal(t, ErrNotFoundInStorage, err)
+	_, err = index.getStateChanges([]byte("txC"))
+	require.Equal(t, ErrNotFoundInStorage, err)
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txA")}},
+		},
+	}
+	err = index.revertBlock(body)
+	require.Nil(t, err)
+
+	_, err = index.getStateChanges([]byte("txA"))
+	require.Equal(t, ErrNotFoundInStorage, err)
+}
+
+func TestDisabledStateChangesIndex(t *testing.T) {
+	t.Parallel()
+
+	index := &disabledStateChangesIndex{}
+	index.recordStateChanges([]byte("txA"), []*common.AccountStateChange{{Address: "alice"}})
+	require.Nil(t, index.saveBlock(map[string]data.TransactionHandler{"txA": &transaction.Transaction{}}))
+	require.Nil(t, index.revertBlock(&block.Body{}))
+
+	stateChanges, err := index.getStateChanges([]byte("txA"))
+	require.Nil(t, stateChanges)
+	require.Equal(t, ErrStateChangesIndexDisabled, err)
+}
@@ -20,6 +20,10 @@ func (p *peerShardMapper) GetLastKnownPeerID(_ []byte) (core.PeerID, bool) {
 func (p *peerShardMapper) UpdatePeerIDPublicKeyPair(_ core.PeerID, _ []byte) {
 }
 
+// UpdatePeerIDPublicKeyPairFromBatch does nothing
+func (p *peerShardMapper) UpdatePeerIDPublicKeyPairFromBatch(_ core.PeerID, _ []byte, _ []byte) {
+}
+
 // PutPeerIdShardId does nothing
 func (p *peerShardMapper) PutPeerIdShardId(_ core.PeerID, _ uint32) {
 }
@@ -0,0 +1,214 @@
+package bootstrap
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+func (e *epochStartBootstrap) isSnapshotImport() bool {
+	return len(e.flagsConfig.ImportSnapshotPath) > 0
+}
+
+// loadSnapshot reads the snapshot manifest and puts the exported headers, miniblocks and transactions in the data
+// pool, where the syncers look before requesting anything from the network. It returns the epoch start meta block,
+// which replaces the one agreed upon by the connected peers in the regular flow, so it is only accepted if its hash
+// matches the trusted hash provided by the operator.
+func (e *epochStartBootstrap) loadSnapshot() (data.MetaHeaderHandler, error) {
+	trustedHash, err := e.getSnapshotTrustedHash()
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := snapshot.NewReader(e.flagsConfig.ImportSnapshotPath)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := reader.Manifest()
+	if manifest.ChainID != string(e.coreComponentsHolder.ChainID()) {
+		return nil, fmt.Errorf("%w: snapshot chain ID %s", epochStart.ErrSnapshotChainIDMismatch, manifest.ChainID)
+	}
+	if !bytes.Equal(manifest.EpochStartMetaHash, trustedHash) {
+		return nil, fmt.Errorf("%w: snapshot hash %x, trusted hash %x",
+			epochStart.ErrUntrustedSnapshot, manifest.EpochStartMetaHash, trustedHash)
+	}
+
+	log.Info("start in epoch bootstrap: importing snapshot", "path", e.flagsConfig.ImportSnapshotPath,
+		"epoch", manifest.Epoch, "shard", manifest.ShardID)
+
+	var epochStartMeta data.MetaHeaderHandler
+	cacheIDs := make(map[string]string)
+	err = reader.ReadSection(snapshot.BlocksSection, func(kind snapshot.RecordKind, key []byte, value []byte) error {
+		if !bytes.Equal(e.coreComponentsHolder.Hasher().Compute(string(value)), key) {
+			return fmt.Errorf("%w for key %x", epochStart.ErrSnapshotHashMismatch, key)
+		}
+
+		metaBlock, errAdd := e.addSnapshotRecordToPool(kind, key, value, cacheIDs)
+		if errAdd != nil {
+			return errAdd
+		}
+		if !check.IfNil(metaBlock) && bytes.Equal(key, manifest.EpochStartMetaHash) {
+			epochStartMeta = metaBlock
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(epochStartMeta) {
+		return nil, epochStart.ErrMissingEpochStartMetaInSnapshot
+	}
+	if !epochStartMeta.IsStartOfEpochBlock() || epochStartMeta.GetEpoch() != manifest.Epoch {
+		return nil, fmt.Errorf("%w: meta block for epoch %d is not the start of epoch %d",
+			epochStart.ErrUntrustedSnapshot, epochStartMeta.GetEpoch(), manifest.Epoch)
+	}
+
+	e.snapshotReader = reader
+	e.checkNodesOnDisk = true
+
+	return epochStartMeta, nil
+}
+
+func (e *epochStartBootstrap) getSnapshotTrustedHash() ([]byte, error) {
+	if len(e.flagsConfig.ImportSnapshotTrustedHash) == 0 {
+		return nil, epochStart.ErrInvalidSnapshotTrustedHash
+	}
+
+	trustedHash, err := hex.DecodeString(e.flagsConfig.ImportSnapshotTrustedHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", epochStart.ErrInvalidSnapshotTrustedHash, err)
+	}
+
+	return trustedHash, nil
+}
+
+func (e *epochStartBootstrap) addSnapshotRecordToPool(
+	kind snapshot.RecordKind,
+	key []byte,
+	value []byte,
+	cacheIDs map[string]string,
+) (data.MetaHeaderHandler, error) {
+	marshaller := e.coreComponentsHolder.InternalMarshalizer()
+
+	switch kind {
+	case snapshot.MetaBlockRecord:
+		metaBlock := &block.MetaBlock{}
+		err := marshaller.Unmarshal(metaBlock, value)
+		if err != nil {
+			return nil, err
+		}
+
+		e.dataPool.Headers().AddHeader(key, metaBlock)
+		return metaBlock, nil
+	case snapshot.ShardHeaderRecord:
+		header, err := process.UnmarshalShardHeader(marshaller, value)
+		if err != nil {
+			return nil, err
+		}
+
+		e.dataPool.Headers().AddHeader(key, header)
+	case snapshot.MiniBlockRecord:
+		miniBlock := &block.MiniBlock{}
+		err := marshaller.Unmarshal(miniBlock, value)
+		if err != nil {
+			return nil, err
+		}
+
+		e.dataPool.MiniBlocks().Put(key, miniBlock, len(value))
+		cacheID := process.ShardCacherIdentifier(miniBlock.SenderShardID, miniBlock.ReceiverShardID)
+		for _, txHash := range miniBlock.TxHashes {
+			cacheIDs[string(txHash)] = cacheID
+		}
+	case snapshot.TransactionRecord:
+		return nil, e.addSnapshotTransactionToPool(&transaction.Transaction{}, key, value, cacheIDs, e.dataPool.Transactions())
+	case snapshot.UnsignedTransactionRecord:
+		return nil, e.addSnapshotTransactionToPool(&smartContractResult.SmartContractResult{}, key, value, cacheIDs, e.dataPool.UnsignedTransactions())
+	case snapshot.RewardTransactionRecord:
+		return nil, e.addSnapshotTransactionToPool(&rewardTx.RewardTx{}, key, value, cacheIDs, e.dataPool.RewardTransactions())
+	default:
+		log.Debug("start in epoch bootstrap: unknown snapshot record", "kind", kind, "key", key)
+	}
+
+	return nil, nil
+}
+
+func (e *epochStartBootstrap) addSnapshotTransactionToPool(
+	tx data.TransactionHandler,
+	key []byte,
+	value []byte,
+	cacheIDs map[string]string,
+	pool dataRetriever.ShardedDataCacherNotifier,
+) error {
+	err := e.coreComponentsHolder.InternalMarshalizer().Unmarshal(tx, value)
+	if err != nil {
+		return err
+	}
+
+	cacheID, ok := cacheIDs[string(key)]
+	if !ok {
+		log.Debug("start in epoch bootstrap: snapshot transaction without miniblock", "hash", key)
+		return nil
+	}
+
+	pool.AddData(key, tx, len(value), cacheID)
+
+	return nil
+}
+
+// importSnapshotTrieNodes saves the exported trie nodes in the trie storage, where the trie syncers find them
+// instead of requesting them from the network. The syncers still validate the whole trie starting from the root hash.
+func (e *epochStartBootstrap) importSnapshotTrieNodes(trieID string, section snapshot.Section) error {
+	if check.IfNil(e.snapshotReader) {
+		return nil
+	}
+
+	manifest := e.snapshotReader.Manifest()
+	if manifest.ShardID != e.shardCoordinator.SelfId() {
+		return fmt.Errorf("%w: snapshot shard %d, node shard %d",
+			epochStart.ErrSnapshotShardMismatch, manifest.ShardID, e.shardCoordinator.SelfId())
+	}
+
+	e.mutTrieStorageManagers.RLock()
+	trieStorageManager := e.trieStorageManagers[trieID]
+	e.mutTrieStorageManagers.RUnlock()
+
+	syncTrieStorageManager, err := trie.NewSyncTrieStorageManager(trieStorageManager)
+	if err != nil {
+		return err
+	}
+
+	hasher := e.coreComponentsHolder.Hasher()
+	numNodes := 0
+	err = e.snapshotReader.ReadSection(section, func(kind snapshot.RecordKind, key []byte, value []byte) error {
+		if kind != snapshot.TrieNodeRecord {
+			return nil
+		}
+		if !bytes.Equal(hasher.Compute(string(value)), key) {
+			return fmt.Errorf("%w for trie node %x", epochStart.ErrSnapshotHashMismatch, key)
+		}
+
+		numNodes++
+		return syncTrieStorageManager.Put(key, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("start in epoch bootstrap: imported trie nodes from snapshot", "trie", trieID, "num nodes", numNodes)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 )
@@ -56,3 +57,10 @@ type NodeTypeProviderHandler interface {
 	GetType() core.NodeType
 	IsInterfaceNil() bool
 }
+
+// SnapshotReader defines the methods to read a state snapshot exported at the start of an epoch
+type SnapshotReader interface {
+	Manifest() *snapshot.Manifest
+	ReadSection(section snapshot.Section, handler func(kind snapshot.RecordKind, key []byte, value []byte) error) error
+	IsInterfaceNil() bool
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
 	factoryInterceptors "github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/factory"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/types"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
 	factoryDisabled "github.com/ElrondNetwork/elrond-go/factory/disabled"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
@@ -106,6 +107,7 @@ type epochStartBootstrap struct {
 	maxHardCapForMissingNodes  int
 	trieSyncerVersion          int
 	checkNodesOnDisk           bool
+	snapshotReader             SnapshotReader
 
 	// created components
 	requestHandler            process.RequestHandler
@@ -310,7 +312,7 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 		log.Warn("epochStartBootstrap.Bootstrap: forcing start from network")
 	}
 
-	shouldStartFromNetwork := e.generalConfig.GeneralSettings.StartInEpochEnabled || e.flagsConfig.ForceStartFromNetwork
+	shouldStartFromNetwork := e.generalConfig.GeneralSettings.StartInEpochEnabled || e.flagsConfig.ForceStartFromNetwork || e.isSnapshotImport()
 	if !shouldStartFromNetwork {
 		return e.bootstrapFromLocalStorage()
 	}
@@ -337,7 +339,7 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 	}
 
 	params, shouldContinue, err := e.startFromSavedEpoch()
-	shouldContinue = shouldContinue || e.flagsConfig.ForceStartFromNetwork
+	shouldContinue = shouldContinue || e.flagsConfig.ForceStartFromNetwork || e.isSnapshotImport()
 	if !shouldContinue {
 		return params, err
 	}
@@ -347,7 +349,11 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 		return Parameters{}, err
 	}
 
-	e.epochStartMeta, err = e.epochStartMetaBlockSyncer.SyncEpochStartMeta(DefaultTimeToWaitForRequestedData)
+	if e.isSnapshotImport() {
+		e.epochStartMeta, err = e.loadSnapshot()
+	} else {
+		e.epochStartMeta, err = e.epochStartMetaBlockSyncer.SyncEpochStartMeta(DefaultTimeToWaitForRequestedData)
+	}
 	if err != nil {
 		return Parameters{}, err
 	}
@@ -767,6 +773,11 @@ func (e *epochStartBootstrap) requestAndProcessForMeta(peerMiniBlocks []*block.M
 	e.trieContainer = triesContainer
 	e.trieStorageManagers = trieStorageManagers
 
+	err = e.importSnapshotTrieNodes(factory.PeerAccountTrie, snapshot.PeerAccountsSection)
+	if err != nil {
+		return err
+	}
+
 	log.Debug("start in epoch bootstrap: started syncValidatorAccountsState")
 	err = e.syncValidatorAccountsState(e.epochStartMeta.GetValidatorStatsRootHash())
 	if err != nil {
@@ -774,6 +785,11 @@ func (e *epochStartBootstrap) requestAndProcessForMeta(peerMiniBlocks []*block.M
 	}
 	log.Debug("start in epoch bootstrap: syncUserAccountsState")
 
+	err = e.importSnapshotTrieNodes(factory.UserAccountTrie, snapshot.UserAccountsSection)
+	if err != nil {
+		return err
+	}
+
 	err = e.syncUserAccountsState(e.epochStartMeta.GetRootHash())
 	if err != nil {
 		return err
@@ -933,6 +949,11 @@ func (e *epochStartBootstrap) requestAndProcessForShard(peerMiniBlocks []*block.
 	e.trieContainer = triesContainer
 	e.trieStorageManagers = trieStorageManagers
 
+	err = e.importSnapshotTrieNodes(factory.UserAccountTrie, snapshot.UserAccountsSection)
+	if err != nil {
+		return err
+	}
+
 	log.Debug("start in epoch bootstrap: started syncUserAccountsState", "rootHash", dts.rootHashToSync)
 	err = e.syncUserAccountsState(dts.rootHashToSync)
 	if err != nil {
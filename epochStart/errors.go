@@ -331,3 +331,21 @@ var ErrNilScheduledTxsHandler = errors.New("nil scheduled transactions handler")
 
 // ErrNilScheduledDataSyncerFactory signals that a nil scheduled data syncer factory was provided
 var ErrNilScheduledDataSyncerFactory = errors.New("nil scheduled data syncer factory")
+
+// ErrSnapshotChainIDMismatch signals that the imported snapshot was exported on a different chain
+var ErrSnapshotChainIDMismatch = errors.New("snapshot chain ID mismatch")
+
+// ErrSnapshotShardMismatch signals that the imported snapshot was exported on a different shard
+var ErrSnapshotShardMismatch = errors.New("snapshot shard mismatch")
+
+// ErrSnapshotHashMismatch signals that a snapshot record does not match its hash
+var ErrSnapshotHashMismatch = errors.New("snapshot record hash mismatch")
+
+// ErrInvalidSnapshotTrustedHash signals that a snapshot import was requested without a valid trusted epoch start meta hash
+var ErrInvalidSnapshotTrustedHash = errors.New("invalid trusted hash for the snapshot epoch start meta block")
+
+// ErrUntrustedSnapshot signals that the epoch start meta block of the imported snapshot does not match the trusted one
+var ErrUntrustedSnapshot = errors.New("untrusted snapshot epoch start meta block")
+
+// ErrMissingEpochStartMetaInSnapshot signals that the imported snapshot does not contain the epoch start meta block
+var ErrMissingEpochStartMetaInSnapshot = errors.New("missing epoch start meta block in snapshot")
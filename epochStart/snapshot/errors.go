@@ -0,0 +1,45 @@
+package snapshot
+
+import "errors"
+
+// ErrEmptyDirectory signals that an empty directory has been provided
+var ErrEmptyDirectory = errors.New("empty snapshot directory")
+
+// ErrInvalidChunkSize signals that an invalid maximum chunk size has been provided
+var ErrInvalidChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilHeadersPool signals that a nil headers pool has been provided
+var ErrNilHeadersPool = errors.New("nil headers pool")
+
+// ErrMissingTrieStorageManager signals that a needed trie storage manager has not been provided
+var ErrMissingTrieStorageManager = errors.New("missing trie storage manager")
+
+// ErrUnsupportedVersion signals that the snapshot was written with an unsupported format version
+var ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+
+// ErrChecksumMismatch signals that a chunk file does not match the checksum from the manifest
+var ErrChecksumMismatch = errors.New("chunk checksum mismatch")
+
+// ErrInvalidRecord signals that a chunk file contains an invalid record
+var ErrInvalidRecord = errors.New("invalid snapshot record")
+
+// ErrRecordAfterClose signals that a record was written after the writer was closed
+var ErrRecordAfterClose = errors.New("record written after close")
+
+// ErrExportInProgress signals that a previous export has not finished yet
+var ErrExportInProgress = errors.New("snapshot export in progress")
+
+// ErrWrongHeaderType signals that the stored header is not of the expected type
+var ErrWrongHeaderType = errors.New("wrong header type")
@@ -0,0 +1,617 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+)
+
+var log = logger.GetOrCreate("epochStart/snapshot")
+
+const snapshotDirectoryPrefix = "snapshot_"
+const incompleteSuffix = ".tmp"
+
+// ArgsExporter holds the arguments needed to create a new snapshot exporter
+type ArgsExporter struct {
+	Directory                      string
+	MaxChunkSize                   int64
+	NumSnapshotsToKeep             uint32
+	ChainID                        string
+	ShardCoordinator               sharding.Coordinator
+	Marshaller                     marshal.Marshalizer
+	Hasher                         hashing.Hasher
+	StorageService                 dataRetriever.StorageService
+	HeadersPool                    dataRetriever.HeadersPool
+	TrieStorageManagers            map[string]common.StorageManager
+	ScheduledMiniBlocksEnableEpoch uint32
+}
+
+type exporter struct {
+	directory                      string
+	maxChunkSize                   int64
+	numSnapshotsToKeep             uint32
+	chainID                        string
+	shardCoordinator               sharding.Coordinator
+	marshaller                     marshal.Marshalizer
+	hasher                         hashing.Hasher
+	storageService                 dataRetriever.StorageService
+	headersPool                    dataRetriever.HeadersPool
+	userTrieStorageManager         common.StorageManager
+	peerTrieStorageManager         common.StorageManager
+	scheduledMiniBlocksEnableEpoch uint32
+	isExporting                    atomic.Flag
+}
+
+// exportSession holds the state of a single export
+type exportSession struct {
+	writer        *chunkWriter
+	manifest      *Manifest
+	writtenHashes map[string]struct{}
+	miniBlocks    map[string]*block.MiniBlock
+	txsMiniBlocks []*block.MiniBlock
+	numTrieNodes  uint64
+	numDataTries  uint64
+}
+
+// NewExporter creates a component able to export, at the start of an epoch, the state and the headers needed by a
+// fresh node to bootstrap in that epoch
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if len(args.Directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if args.MaxChunkSize <= 0 {
+		return nil, ErrInvalidChunkSize
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.HeadersPool) {
+		return nil, ErrNilHeadersPool
+	}
+
+	userTrieStorageManager := args.TrieStorageManagers[trieFactory.UserAccountTrie]
+	if check.IfNil(userTrieStorageManager) {
+		return nil, fmt.Errorf("%w for %s", ErrMissingTrieStorageManager, trieFactory.UserAccountTrie)
+	}
+	peerTrieStorageManager := args.TrieStorageManagers[trieFactory.PeerAccountTrie]
+	isMeta := args.ShardCoordinator.SelfId() == core.MetachainShardId
+	if isMeta && check.IfNil(peerTrieStorageManager) {
+		return nil, fmt.Errorf("%w for %s", ErrMissingTrieStorageManager, trieFactory.PeerAccountTrie)
+	}
+
+	return &exporter{
+		directory:                      args.Directory,
+		maxChunkSize:                   args.MaxChunkSize,
+		numSnapshotsToKeep:             args.NumSnapshotsToKeep,
+		chainID:                        args.ChainID,
+		shardCoordinator:               args.ShardCoordinator,
+		marshaller:                     args.Marshaller,
+		hasher:                         args.Hasher,
+		storageService:                 args.StorageService,
+		headersPool:                    args.HeadersPool,
+		userTrieStorageManager:         userTrieStorageManager,
+		peerTrieStorageManager:         peerTrieStorageManager,
+		scheduledMiniBlocksEnableEpoch: args.ScheduledMiniBlocksEnableEpoch,
+	}, nil
+}
+
+// EpochStartAction starts, on a separate go routine, the export of the epoch that has just started
+func (e *exporter) EpochStartAction(hdr data.HeaderHandler) {
+	if check.IfNil(hdr) {
+		return
+	}
+
+	epochStartMetaHash, err := e.epochStartMetaHash(hdr)
+	if err != nil {
+		log.Warn("snapshot exporter: cannot compute the epoch start meta hash", "epoch", hdr.GetEpoch(), "error", err)
+		return
+	}
+
+	go func() {
+		directory, errExport := e.Export(epochStartMetaHash)
+		if errExport != nil {
+			log.Warn("snapshot exporter: export failed", "epoch", hdr.GetEpoch(), "error", errExport)
+			return
+		}
+
+		log.Info("snapshot exporter: export finished", "epoch", hdr.GetEpoch(), "directory", directory)
+	}()
+}
+
+func (e *exporter) epochStartMetaHash(hdr data.HeaderHandler) ([]byte, error) {
+	shardHeader, ok := hdr.(data.ShardHeaderHandler)
+	if ok {
+		return shardHeader.GetEpochStartMetaHash(), nil
+	}
+
+	metaHeader, ok := hdr.(data.MetaHeaderHandler)
+	if !ok {
+		return nil, ErrWrongHeaderType
+	}
+
+	return core.CalculateHash(e.marshaller, e.hasher, metaHeader)
+}
+
+// EpochStartPrepare does nothing
+func (e *exporter) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder returns the notification order of the exporter
+func (e *exporter) NotifyOrder() uint32 {
+	return common.StateSnapshotExporterOrder
+}
+
+// Export writes the snapshot of the epoch started by the provided meta block and returns the snapshot directory.
+// The snapshot is written in a temporary directory, renamed only after the manifest has been written.
+func (e *exporter) Export(epochStartMetaHash []byte) (string, error) {
+	if e.isExporting.SetReturningPrevious() {
+		return "", ErrExportInProgress
+	}
+	defer e.isExporting.Reset()
+
+	epochStartMeta, metaBytes, err := e.getMetaBlock(epochStartMetaHash)
+	if err != nil {
+		return "", fmt.Errorf("%w for the epoch start meta block", err)
+	}
+
+	finalDirectory := filepath.Join(e.directory, fmt.Sprintf("%sepoch_%d_shard_%s",
+		snapshotDirectoryPrefix, epochStartMeta.GetEpoch(), core.GetShardIDString(e.shardCoordinator.SelfId())))
+	tempDirectory := finalDirectory + incompleteSuffix
+	err = os.RemoveAll(tempDirectory)
+	if err != nil {
+		return "", err
+	}
+
+	writer, err := newChunkWriter(tempDirectory, e.maxChunkSize)
+	if err != nil {
+		return "", err
+	}
+
+	session := &exportSession{
+		writer: writer,
+		manifest: &Manifest{
+			ChainID:            e.chainID,
+			ShardID:            e.shardCoordinator.SelfId(),
+			Epoch:              epochStartMeta.GetEpoch(),
+			EpochStartMetaHash: epochStartMetaHash,
+		},
+		writtenHashes: make(map[string]struct{}),
+		miniBlocks:    make(map[string]*block.MiniBlock),
+		txsMiniBlocks: make([]*block.MiniBlock, 0),
+	}
+
+	err = e.writeSnapshot(session, epochStartMeta, metaBytes)
+	if err != nil {
+		writer.abort()
+		return "", err
+	}
+
+	err = os.RemoveAll(finalDirectory)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.Rename(tempDirectory, finalDirectory)
+	if err != nil {
+		return "", err
+	}
+
+	e.removeOldSnapshots()
+
+	return finalDirectory, nil
+}
+
+func (e *exporter) writeSnapshot(session *exportSession, epochStartMeta data.MetaHeaderHandler, metaBytes []byte) error {
+	err := session.writer.startSection(BlocksSection)
+	if err != nil {
+		return err
+	}
+
+	err = e.writeRecord(session, MetaBlockRecord, session.manifest.EpochStartMetaHash, metaBytes)
+	if err != nil {
+		return err
+	}
+
+	userAccountsRootHash, err := e.writeBlocks(session, epochStartMeta)
+	if err != nil {
+		return err
+	}
+
+	if e.shardCoordinator.SelfId() == core.MetachainShardId {
+		session.manifest.PeerAccountsRootHash = epochStartMeta.GetValidatorStatsRootHash()
+		err = e.writeTrie(session, PeerAccountsSection, e.peerTrieStorageManager, session.manifest.PeerAccountsRootHash, false)
+		if err != nil {
+			return fmt.Errorf("%w while exporting the peer accounts trie", err)
+		}
+	}
+
+	session.manifest.UserAccountsRootHash = userAccountsRootHash
+	err = e.writeTrie(session, UserAccountsSection, e.userTrieStorageManager, userAccountsRootHash, true)
+	if err != nil {
+		return fmt.Errorf("%w while exporting the user accounts trie", err)
+	}
+
+	log.Debug("snapshot exporter: state exported", "epoch", session.manifest.Epoch,
+		"num trie nodes", session.numTrieNodes, "num data tries", session.numDataTries)
+
+	return session.writer.close(session.manifest)
+}
+
+// writeBlocks writes the headers, miniblocks and transactions requested by the start in epoch bootstrap and
+// returns the root hash of the user accounts trie to be exported. The data that is not found is skipped, as the
+// importing node will request it from the network.
+func (e *exporter) writeBlocks(session *exportSession, epochStartMeta data.MetaHeaderHandler) ([]byte, error) {
+	prevEpochStartHash := epochStartMeta.GetEpochStartHandler().GetEconomicsHandler().GetPrevEpochStartHash()
+	e.writeMetaBlockIfFound(session, prevEpochStartHash)
+	e.writeMiniBlocksIfFound(session, filterMiniBlockHeaders(epochStartMeta.GetMiniBlockHeaderHandlers(), block.PeerBlock), false)
+
+	isMeta := e.shardCoordinator.SelfId() == core.MetachainShardId
+	var ownEpochStartData data.EpochStartShardDataHandler
+	for _, epochStartData := range epochStartMeta.GetEpochStartHandler().GetLastFinalizedHeaderHandlers() {
+		e.writeMiniBlocksIfFound(session, epochStartData.GetPendingMiniBlockHeaderHandlers(), false)
+		if epochStartData.GetShardID() == e.shardCoordinator.SelfId() {
+			ownEpochStartData = epochStartData
+		}
+		if !isMeta && epochStartData.GetShardID() != e.shardCoordinator.SelfId() {
+			continue
+		}
+
+		_, err := e.writeShardHeaderIfFound(session, epochStartData.GetHeaderHash())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if isMeta {
+		return epochStartMeta.GetRootHash(), nil
+	}
+	if ownEpochStartData == nil {
+		return nil, fmt.Errorf("%w for shard %d", ErrWrongHeaderType, e.shardCoordinator.SelfId())
+	}
+
+	return e.writeOwnShardData(session, ownEpochStartData)
+}
+
+func (e *exporter) writeOwnShardData(session *exportSession, epochStartData data.EpochStartShardDataHandler) ([]byte, error) {
+	e.writeMetaBlockIfFound(session, epochStartData.GetLastFinishedMetaBlock())
+	e.writeMetaBlockIfFound(session, epochStartData.GetFirstPendingMetaBlock())
+
+	notarizedHeader, err := e.writeShardHeaderIfFound(session, epochStartData.GetHeaderHash())
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(notarizedHeader) {
+		return nil, fmt.Errorf("%w: notarized shard header %x not found", ErrWrongHeaderType, epochStartData.GetHeaderHash())
+	}
+
+	// the start in epoch bootstrap processes again the scheduled data, so the previous header, the referenced meta
+	// blocks and the miniblocks and transactions of the notarized header are exported as well
+	headersWithScheduledData := []data.ShardHeaderHandler{notarizedHeader}
+	prevHeader, err := e.writeShardHeaderIfFound(session, notarizedHeader.GetPrevHash())
+	if err != nil {
+		return nil, err
+	}
+	if !check.IfNil(prevHeader) {
+		headersWithScheduledData = append(headersWithScheduledData, prevHeader)
+	}
+
+	for _, header := range headersWithScheduledData {
+		for _, metaHash := range header.GetMetaBlockHashes() {
+			metaBlock := e.writeMetaBlockIfFound(session, metaHash)
+			if !check.IfNil(metaBlock) {
+				e.writeMetaBlockIfFound(session, metaBlock.GetPrevHash())
+			}
+		}
+	}
+
+	e.writeMiniBlocksIfFound(session, notarizedHeader.GetMiniBlockHeaderHandlers(), true)
+	if !check.IfNil(prevHeader) {
+		e.writeMiniBlocksIfFound(session, prevHeader.GetMiniBlockHeaderHandlers(), false)
+	}
+	e.writeTransactions(session)
+
+	return e.rootHashToExport(notarizedHeader), nil
+}
+
+// rootHashToExport mirrors the root hash selection done by the start in epoch bootstrap
+func (e *exporter) rootHashToExport(notarizedHeader data.ShardHeaderHandler) []byte {
+	if e.scheduledMiniBlocksEnableEpoch > notarizedHeader.GetEpoch() {
+		return notarizedHeader.GetRootHash()
+	}
+
+	additionalData := notarizedHeader.GetAdditionalData()
+	if additionalData != nil {
+		return additionalData.GetScheduledRootHash()
+	}
+
+	return notarizedHeader.GetRootHash()
+}
+
+func (e *exporter) writeMetaBlockIfFound(session *exportSession, hash []byte) data.MetaHeaderHandler {
+	if len(hash) == 0 {
+		return nil
+	}
+
+	metaBlock, buff, err := e.getMetaBlock(hash)
+	if err != nil {
+		log.Debug("snapshot exporter: meta block not exported", "hash", hash, "error", err)
+		return nil
+	}
+
+	err = e.writeRecord(session, MetaBlockRecord, hash, buff)
+	if err != nil {
+		log.Debug("snapshot exporter: meta block not exported", "hash", hash, "error", err)
+		return nil
+	}
+
+	return metaBlock
+}
+
+func (e *exporter) getMetaBlock(hash []byte) (data.MetaHeaderHandler, []byte, error) {
+	buff, err := e.getHeaderBytes(dataRetriever.MetaBlockUnit, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = e.marshaller.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metaBlock, buff, nil
+}
+
+func (e *exporter) writeShardHeaderIfFound(session *exportSession, hash []byte) (data.ShardHeaderHandler, error) {
+	if len(hash) == 0 {
+		return nil, nil
+	}
+
+	buff, err := e.getHeaderBytes(dataRetriever.BlockHeaderUnit, hash)
+	if err != nil {
+		log.Debug("snapshot exporter: shard header not exported", "hash", hash, "error", err)
+		return nil, nil
+	}
+
+	header, err := process.UnmarshalShardHeader(e.marshaller, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, e.writeRecord(session, ShardHeaderRecord, hash, buff)
+}
+
+// getHeaderBytes returns the header from storage or, if it was not yet saved, from the headers pool
+func (e *exporter) getHeaderBytes(unit dataRetriever.UnitType, hash []byte) ([]byte, error) {
+	buff, err := e.storageService.Get(unit, hash)
+	if err == nil {
+		return buff, nil
+	}
+
+	header, errPool := e.headersPool.GetHeaderByHash(hash)
+	if errPool != nil {
+		return nil, err
+	}
+
+	return e.marshaller.Marshal(header)
+}
+
+func (e *exporter) writeMiniBlocksIfFound(session *exportSession, miniBlockHeaders []data.MiniBlockHeaderHandler, withTransactions bool) {
+	for _, miniBlockHeader := range miniBlockHeaders {
+		hash := miniBlockHeader.GetHash()
+		miniBlock, ok := session.miniBlocks[string(hash)]
+		if !ok {
+			buff, err := e.storageService.Get(dataRetriever.MiniBlockUnit, hash)
+			if err != nil {
+				log.Debug("snapshot exporter: miniblock not exported", "hash", hash, "error", err)
+				continue
+			}
+
+			miniBlock = &block.MiniBlock{}
+			err = e.marshaller.Unmarshal(miniBlock, buff)
+			if err != nil {
+				log.Debug("snapshot exporter: miniblock not exported", "hash", hash, "error", err)
+				continue
+			}
+
+			err = e.writeRecord(session, MiniBlockRecord, hash, buff)
+			if err != nil {
+				log.Debug("snapshot exporter: miniblock not exported", "hash", hash, "error", err)
+				continue
+			}
+			session.miniBlocks[string(hash)] = miniBlock
+		}
+
+		if withTransactions {
+			session.txsMiniBlocks = append(session.txsMiniBlocks, miniBlock)
+		}
+	}
+}
+
+func (e *exporter) writeTransactions(session *exportSession) {
+	for _, miniBlock := range session.txsMiniBlocks {
+		unit, kind, ok := transactionsUnitAndKind(miniBlock.Type)
+		if !ok {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			buff, err := e.storageService.Get(unit, txHash)
+			if err != nil {
+				log.Debug("snapshot exporter: transaction not exported", "hash", txHash, "error", err)
+				continue
+			}
+
+			err = e.writeRecord(session, kind, txHash, buff)
+			if err != nil {
+				log.Debug("snapshot exporter: transaction not exported", "hash", txHash, "error", err)
+			}
+		}
+	}
+}
+
+func transactionsUnitAndKind(miniBlockType block.Type) (dataRetriever.UnitType, RecordKind, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, TransactionRecord, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, UnsignedTransactionRecord, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, RewardTransactionRecord, true
+	default:
+		return 0, 0, false
+	}
+}
+
+func filterMiniBlockHeaders(miniBlockHeaders []data.MiniBlockHeaderHandler, miniBlockType block.Type) []data.MiniBlockHeaderHandler {
+	filtered := make([]data.MiniBlockHeaderHandler, 0)
+	for _, miniBlockHeader := range miniBlockHeaders {
+		if block.Type(miniBlockHeader.GetTypeInt32()) == miniBlockType {
+			filtered = append(filtered, miniBlockHeader)
+		}
+	}
+
+	return filtered
+}
+
+// writeRecord writes the record once, even if the same block is referenced several times
+func (e *exporter) writeRecord(session *exportSession, kind RecordKind, hash []byte, buff []byte) error {
+	if _, ok := session.writtenHashes[string(hash)]; ok {
+		return nil
+	}
+
+	err := session.writer.writeRecord(kind, hash, buff)
+	if err != nil {
+		return err
+	}
+	session.writtenHashes[string(hash)] = struct{}{}
+
+	return nil
+}
+
+// writeTrie writes all the trie nodes reachable from the root hash. For the user accounts trie, the data tries of
+// the accounts are written as well. Pruning is buffered meanwhile, so the exported nodes are not removed.
+func (e *exporter) writeTrie(
+	session *exportSession,
+	section Section,
+	tsm common.StorageManager,
+	rootHash []byte,
+	withDataTries bool,
+) error {
+	tsm.EnterPruningBufferingMode()
+	defer tsm.ExitPruningBufferingMode()
+
+	err := session.writer.startSection(section)
+	if err != nil {
+		return err
+	}
+
+	dataTriesRootHashes := make([][]byte, 0)
+	err = trie.WalkTrieNodes(tsm, rootHash, e.marshaller, e.hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+		session.numTrieNodes++
+		if withDataTries && len(leafValue) > 0 {
+			account := state.NewEmptyUserAccount()
+			errUnmarshal := e.marshaller.Unmarshal(account, leafValue)
+			if errUnmarshal != nil {
+				return errUnmarshal
+			}
+			if len(account.RootHash) > 0 {
+				dataTriesRootHashes = append(dataTriesRootHashes, account.RootHash)
+			}
+		}
+
+		return session.writer.writeRecord(TrieNodeRecord, hash, encodedNode)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		session.numDataTries++
+		err = trie.WalkTrieNodes(tsm, dataTrieRootHash, e.marshaller, e.hasher, func(hash []byte, encodedNode []byte, _ []byte) error {
+			session.numTrieNodes++
+			return session.writer.writeRecord(TrieNodeRecord, hash, encodedNode)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeOldSnapshots keeps only the newest snapshots of the shard, if a limit is set
+func (e *exporter) removeOldSnapshots() {
+	if e.numSnapshotsToKeep == 0 {
+		return
+	}
+
+	dirEntries, err := os.ReadDir(e.directory)
+	if err != nil {
+		log.Debug("snapshot exporter: cannot read the snapshots directory", "error", err)
+		return
+	}
+
+	shardSuffix := "_shard_" + core.GetShardIDString(e.shardCoordinator.SelfId())
+	epochs := make([]uint32, 0)
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if !dirEntry.IsDir() || !strings.HasPrefix(name, snapshotDirectoryPrefix) || !strings.HasSuffix(name, shardSuffix) {
+			continue
+		}
+
+		var epoch uint32
+		_, errScan := fmt.Sscanf(strings.TrimSuffix(name, shardSuffix), snapshotDirectoryPrefix+"epoch_%d", &epoch)
+		if errScan != nil {
+			continue
+		}
+		epochs = append(epochs, epoch)
+	}
+
+	if uint32(len(epochs)) <= e.numSnapshotsToKeep {
+		return
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+	for _, epoch := range epochs[:uint32(len(epochs))-e.numSnapshotsToKeep] {
+		path := filepath.Join(e.directory, fmt.Sprintf("%sepoch_%d%s", snapshotDirectoryPrefix, epoch, shardSuffix))
+		err = os.RemoveAll(path)
+		if err != nil {
+			log.Warn("snapshot exporter: cannot remove old snapshot", "path", path, "error", err)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
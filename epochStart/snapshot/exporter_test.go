@@ -0,0 +1,310 @@
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createMockArgsExporter(directory string) ArgsExporter {
+	return ArgsExporter{
+		Directory:        directory,
+		MaxChunkSize:     1024 * 1024,
+		ChainID:          "chain ID",
+		ShardCoordinator: &testscommon.ShardsCoordinatorMock{CurrentShard: core.MetachainShardId},
+		Marshaller:       &testscommon.ProtobufMarshalizerMock{},
+		Hasher:           &testscommon.KeccakMock{},
+		StorageService:   &storage.ChainStorerStub{},
+		HeadersPool:      &mock.HeadersCacherStub{},
+		TrieStorageManagers: map[string]common.StorageManager{
+			trieFactory.UserAccountTrie: &testscommon.StorageManagerStub{},
+			trieFactory.PeerAccountTrie: &testscommon.StorageManagerStub{},
+		},
+	}
+}
+
+func createTrieStorageManager(t *testing.T, args ArgsExporter) common.StorageManager {
+	storageManagerArgs, options := storage.GetStorageManagerArgsAndOptions()
+	storageManagerArgs.Marshalizer = args.Marshaller
+	storageManagerArgs.Hasher = args.Hasher
+	options.PruningEnabled = false
+	options.SnapshotsEnabled = false
+	options.CheckpointsEnabled = false
+
+	tsm, err := trie.CreateTrieStorageManager(storageManagerArgs, options)
+	require.Nil(t, err)
+
+	return tsm
+}
+
+func commitTrie(t *testing.T, tsm common.StorageManager, args ArgsExporter, leaves map[string][]byte) []byte {
+	tr, err := trie.NewTrie(tsm, args.Marshaller, args.Hasher, 5)
+	require.Nil(t, err)
+
+	for key, value := range leaves {
+		require.Nil(t, tr.Update([]byte(key), value))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func countTrieNodes(t *testing.T, tsm common.StorageManager, args ArgsExporter, rootHash []byte) int {
+	numNodes := 0
+	err := trie.WalkTrieNodes(tsm, rootHash, args.Marshaller, args.Hasher, func(_ []byte, _ []byte, _ []byte) error {
+		numNodes++
+		return nil
+	})
+	require.Nil(t, err)
+
+	return numNodes
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter("")
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.Equal(t, ErrEmptyDirectory, err)
+	})
+	t.Run("invalid chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.MaxChunkSize = 0
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.Equal(t, ErrInvalidChunkSize, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.ShardCoordinator = nil
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.Marshaller = nil
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.Hasher = nil
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.StorageService = nil
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.Equal(t, ErrNilStorageService, err)
+	})
+	t.Run("nil headers pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.HeadersPool = nil
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.Equal(t, ErrNilHeadersPool, err)
+	})
+	t.Run("missing user accounts trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		delete(args.TrieStorageManagers, trieFactory.UserAccountTrie)
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.True(t, errors.Is(err, ErrMissingTrieStorageManager))
+	})
+	t.Run("missing peer accounts trie storage manager should error on meta", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		delete(args.TrieStorageManagers, trieFactory.PeerAccountTrie)
+		e, err := NewExporter(args)
+		assert.True(t, check.IfNil(e))
+		assert.True(t, errors.Is(err, ErrMissingTrieStorageManager))
+	})
+	t.Run("missing peer accounts trie storage manager should work on shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{CurrentShard: 0}
+		delete(args.TrieStorageManagers, trieFactory.PeerAccountTrie)
+		e, err := NewExporter(args)
+		assert.False(t, check.IfNil(e))
+		assert.Nil(t, err)
+		assert.Equal(t, common.StateSnapshotExporterOrder, e.NotifyOrder())
+	})
+}
+
+func TestExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.StorageService = &storage.ChainStorerStub{
+			GetCalled: func(_ dataRetriever.UnitType, _ []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		args.HeadersPool = &mock.HeadersCacherStub{
+			GetHeaderByHashCalled: func(_ []byte) (data.HeaderHandler, error) {
+				return nil, expectedErr
+			},
+		}
+		e, _ := NewExporter(args)
+
+		directory, err := e.Export([]byte("meta hash"))
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Empty(t, directory)
+	})
+	t.Run("meta snapshot should contain the blocks and the tries", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter(t.TempDir())
+		args.NumSnapshotsToKeep = 1
+		tsm := createTrieStorageManager(t, args)
+		args.TrieStorageManagers = map[string]common.StorageManager{
+			trieFactory.UserAccountTrie: tsm,
+			trieFactory.PeerAccountTrie: tsm,
+		}
+
+		dataTrieRootHash := commitTrie(t, tsm, args, map[string][]byte{
+			"key1": []byte("value1"),
+			"key2": []byte("value2"),
+		})
+		leaves := make(map[string][]byte)
+		for i := 0; i < 20; i++ {
+			account := &state.UserAccountData{Nonce: uint64(i)}
+			if i == 0 {
+				account.RootHash = dataTrieRootHash
+			}
+			buff, err := args.Marshaller.Marshal(account)
+			require.Nil(t, err)
+			leaves[fmt.Sprintf("address%d", i)] = buff
+		}
+		rootHash := commitTrie(t, tsm, args, leaves)
+		peerRootHash := commitTrie(t, tsm, args, map[string][]byte{"validator": []byte("peer account")})
+
+		metaBlocks := make(map[string][]byte)
+		createMetaBlock := func(epoch uint32) []byte {
+			metaBlock := &block.MetaBlock{
+				Epoch:                  epoch,
+				RootHash:               rootHash,
+				ValidatorStatsRootHash: peerRootHash,
+			}
+			buff, _ := args.Marshaller.Marshal(metaBlock)
+			hash := args.Hasher.Compute(string(buff))
+			metaBlocks[string(hash)] = buff
+
+			return hash
+		}
+		args.StorageService = &storage.ChainStorerStub{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				buff, ok := metaBlocks[string(key)]
+				if unitType != dataRetriever.MetaBlockUnit || !ok {
+					return nil, expectedErr
+				}
+
+				return buff, nil
+			},
+		}
+		args.HeadersPool = &mock.HeadersCacherStub{
+			GetHeaderByHashCalled: func(_ []byte) (data.HeaderHandler, error) {
+				return nil, expectedErr
+			},
+		}
+		e, _ := NewExporter(args)
+
+		oldDirectory, err := e.Export(createMetaBlock(1))
+		require.Nil(t, err)
+
+		metaHash := createMetaBlock(2)
+		directory, err := e.Export(metaHash)
+		require.Nil(t, err)
+		assert.Equal(t, filepath.Join(args.Directory, "snapshot_epoch_2_shard_metachain"), directory)
+
+		_, err = os.Stat(oldDirectory)
+		assert.True(t, os.IsNotExist(err))
+
+		r, err := NewReader(directory)
+		require.Nil(t, err)
+		manifest := r.Manifest()
+		assert.Equal(t, args.ChainID, manifest.ChainID)
+		assert.Equal(t, core.MetachainShardId, manifest.ShardID)
+		assert.Equal(t, uint32(2), manifest.Epoch)
+		assert.Equal(t, metaHash, manifest.EpochStartMetaHash)
+		assert.Equal(t, rootHash, manifest.UserAccountsRootHash)
+		assert.Equal(t, peerRootHash, manifest.PeerAccountsRootHash)
+
+		blocks := make(map[string]RecordKind)
+		err = r.ReadSection(BlocksSection, func(kind RecordKind, key []byte, _ []byte) error {
+			blocks[string(key)] = kind
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]RecordKind{string(metaHash): MetaBlockRecord}, blocks)
+
+		numPeerNodes := 0
+		err = r.ReadSection(PeerAccountsSection, func(_ RecordKind, key []byte, value []byte) error {
+			numPeerNodes++
+			assert.Equal(t, args.Hasher.Compute(string(value)), key)
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, countTrieNodes(t, tsm, args, peerRootHash), numPeerNodes)
+
+		numUserNodes := 0
+		err = r.ReadSection(UserAccountsSection, func(_ RecordKind, key []byte, value []byte) error {
+			numUserNodes++
+			assert.Equal(t, args.Hasher.Compute(string(value)), key)
+			return nil
+		})
+		require.Nil(t, err)
+		expectedNumNodes := countTrieNodes(t, tsm, args, rootHash) + countTrieNodes(t, tsm, args, dataTrieRootHash)
+		assert.Equal(t, expectedNumNodes, numUserNodes)
+	})
+}
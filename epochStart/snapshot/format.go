@@ -0,0 +1,121 @@
+package snapshot
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Version is the current version of the snapshot format
+const Version = uint32(1)
+
+// ManifestFileName is the name of the file describing the snapshot content
+const ManifestFileName = "manifest.json"
+
+const chunkFileNameFormat = "chunk_%06d.bin"
+
+// RecordKind defines the type of the data held by a record
+type RecordKind byte
+
+const (
+	// MetaBlockRecord is the kind of the records holding meta blocks
+	MetaBlockRecord RecordKind = iota + 1
+	// ShardHeaderRecord is the kind of the records holding shard headers
+	ShardHeaderRecord
+	// MiniBlockRecord is the kind of the records holding miniblocks
+	MiniBlockRecord
+	// TransactionRecord is the kind of the records holding transactions
+	TransactionRecord
+	// UnsignedTransactionRecord is the kind of the records holding smart contract results
+	UnsignedTransactionRecord
+	// RewardTransactionRecord is the kind of the records holding reward transactions
+	RewardTransactionRecord
+	// TrieNodeRecord is the kind of the records holding trie nodes
+	TrieNodeRecord
+)
+
+// Section groups the records of a snapshot. Every chunk file holds records of a single section.
+type Section string
+
+const (
+	// BlocksSection holds the headers, miniblocks and transactions needed by the start in epoch bootstrap
+	BlocksSection Section = "blocks"
+	// PeerAccountsSection holds the nodes of the peer accounts trie
+	PeerAccountsSection Section = "peerAccounts"
+	// UserAccountsSection holds the nodes of the user accounts trie and of all the data tries
+	UserAccountsSection Section = "userAccounts"
+)
+
+// ChunkInfo describes a chunk file of the snapshot
+type ChunkInfo struct {
+	FileName   string  `json:"fileName"`
+	Section    Section `json:"section"`
+	Size       int64   `json:"size"`
+	NumRecords uint64  `json:"numRecords"`
+	Checksum   string  `json:"checksum"`
+}
+
+// Manifest describes the content of a snapshot
+type Manifest struct {
+	Version              uint32      `json:"version"`
+	ChainID              string      `json:"chainID"`
+	ShardID              uint32      `json:"shardID"`
+	Epoch                uint32      `json:"epoch"`
+	EpochStartMetaHash   []byte      `json:"epochStartMetaHash"`
+	UserAccountsRootHash []byte      `json:"userAccountsRootHash"`
+	PeerAccountsRootHash []byte      `json:"peerAccountsRootHash,omitempty"`
+	Chunks               []ChunkInfo `json:"chunks"`
+}
+
+// A record is encoded as the kind byte followed by the uvarint length prefixed key and value
+func encodeRecord(buff []byte, kind RecordKind, key []byte, value []byte) []byte {
+	buff = append(buff[:0], byte(kind))
+	buff = appendWithLength(buff, key)
+	buff = appendWithLength(buff, value)
+
+	return buff
+}
+
+func appendWithLength(buff []byte, data []byte) []byte {
+	var lenBuff [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuff[:], uint64(len(data)))
+	buff = append(buff, lenBuff[:n]...)
+
+	return append(buff, data...)
+}
+
+// decodeRecord decodes the record found at the beginning of the buffer and returns the number of bytes read
+func decodeRecord(buff []byte) (RecordKind, []byte, []byte, int, error) {
+	if len(buff) == 0 {
+		return 0, nil, nil, 0, fmt.Errorf("%w: empty record", ErrInvalidRecord)
+	}
+
+	kind := RecordKind(buff[0])
+	offset := 1
+	key, n, err := readWithLength(buff[offset:])
+	if err != nil {
+		return 0, nil, nil, 0, err
+	}
+	offset += n
+
+	value, n, err := readWithLength(buff[offset:])
+	if err != nil {
+		return 0, nil, nil, 0, err
+	}
+	offset += n
+
+	return kind, key, value, offset, nil
+}
+
+func readWithLength(buff []byte) ([]byte, int, error) {
+	length, n := binary.Uvarint(buff)
+	if n <= 0 {
+		return nil, 0, fmt.Errorf("%w: invalid length", ErrInvalidRecord)
+	}
+	if uint64(len(buff)-n) < length {
+		return nil, 0, fmt.Errorf("%w: truncated data", ErrInvalidRecord)
+	}
+
+	end := n + int(length)
+
+	return buff[n:end], end, nil
+}
@@ -0,0 +1,111 @@
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+type reader struct {
+	directory string
+	manifest  *Manifest
+}
+
+// NewReader creates a reader over the snapshot found in the provided directory
+func NewReader(directory string) (*reader, error) {
+	if len(directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+
+	manifest, err := ReadManifest(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reader{
+		directory: directory,
+		manifest:  manifest,
+	}, nil
+}
+
+// ReadManifest reads and validates the manifest of the snapshot found in the provided directory
+func ReadManifest(directory string) (*Manifest, error) {
+	buff, err := os.ReadFile(filepath.Join(directory, ManifestFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(buff, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, manifest.Version)
+	}
+
+	return manifest, nil
+}
+
+// Manifest returns the manifest of the snapshot
+func (r *reader) Manifest() *Manifest {
+	return r.manifest
+}
+
+// ReadSection calls the handler for every record of the provided section. Each chunk is checked against its
+// checksum before any of its records is handled.
+func (r *reader) ReadSection(section Section, handler func(kind RecordKind, key []byte, value []byte) error) error {
+	for _, chunk := range r.manifest.Chunks {
+		if chunk.Section != section {
+			continue
+		}
+
+		err := r.readChunk(chunk, handler)
+		if err != nil {
+			return fmt.Errorf("%w in chunk %s", err, chunk.FileName)
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) readChunk(chunk ChunkInfo, handler func(kind RecordKind, key []byte, value []byte) error) error {
+	buff, err := os.ReadFile(filepath.Join(r.directory, chunk.FileName))
+	if err != nil {
+		return err
+	}
+
+	checksum := sha256.Sum256(buff)
+	if int64(len(buff)) != chunk.Size || hex.EncodeToString(checksum[:]) != chunk.Checksum {
+		return ErrChecksumMismatch
+	}
+
+	numRecords := uint64(0)
+	for offset := 0; offset < len(buff); {
+		kind, key, value, n, errDecode := decodeRecord(buff[offset:])
+		if errDecode != nil {
+			return errDecode
+		}
+		offset += n
+		numRecords++
+
+		err = handler(kind, key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	if numRecords != chunk.NumRecords {
+		return fmt.Errorf("%w: expected %d records, found %d", ErrInvalidRecord, chunk.NumRecords, numRecords)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *reader) IsInterfaceNil() bool {
+	return r == nil
+}
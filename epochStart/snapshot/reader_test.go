@@ -0,0 +1,185 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRecord struct {
+	kind  RecordKind
+	key   []byte
+	value []byte
+}
+
+func writeTestSnapshot(t *testing.T, directory string, maxChunkSize int64, numRecords int) *Manifest {
+	writer, err := newChunkWriter(directory, maxChunkSize)
+	require.Nil(t, err)
+
+	require.Nil(t, writer.startSection(BlocksSection))
+	require.Nil(t, writer.writeRecord(MetaBlockRecord, []byte("meta hash"), []byte("meta block")))
+
+	require.Nil(t, writer.startSection(UserAccountsSection))
+	for i := 0; i < numRecords; i++ {
+		require.Nil(t, writer.writeRecord(TrieNodeRecord, []byte(fmt.Sprintf("hash%d", i)), []byte(fmt.Sprintf("node%d", i))))
+	}
+
+	manifest := &Manifest{
+		ChainID: "chain ID",
+		Epoch:   7,
+	}
+	require.Nil(t, writer.close(manifest))
+
+	return manifest
+}
+
+func readTestSection(t *testing.T, r *reader, section Section) []testRecord {
+	records := make([]testRecord, 0)
+	err := r.ReadSection(section, func(kind RecordKind, key []byte, value []byte) error {
+		records = append(records, testRecord{kind: kind, key: key, value: value})
+		return nil
+	})
+	require.Nil(t, err)
+
+	return records
+}
+
+func TestNewChunkWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := newChunkWriter("", 10)
+		assert.Nil(t, writer)
+		assert.Equal(t, ErrEmptyDirectory, err)
+	})
+	t.Run("invalid chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := newChunkWriter(t.TempDir(), 0)
+		assert.Nil(t, writer)
+		assert.Equal(t, ErrInvalidChunkSize, err)
+	})
+	t.Run("write after close should error", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := newChunkWriter(t.TempDir(), 10)
+		require.Nil(t, err)
+		require.Nil(t, writer.close(&Manifest{}))
+
+		err = writer.writeRecord(TrieNodeRecord, []byte("key"), []byte("value"))
+		assert.Equal(t, ErrRecordAfterClose, err)
+	})
+}
+
+func TestReader_ReadSection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("records should be read back by section", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		writeTestSnapshot(t, directory, 1024*1024, 100)
+
+		r, err := NewReader(directory)
+		require.Nil(t, err)
+		assert.Equal(t, "chain ID", r.Manifest().ChainID)
+		assert.Equal(t, uint32(7), r.Manifest().Epoch)
+		assert.Equal(t, Version, r.Manifest().Version)
+
+		blocks := readTestSection(t, r, BlocksSection)
+		require.Equal(t, 1, len(blocks))
+		assert.Equal(t, testRecord{kind: MetaBlockRecord, key: []byte("meta hash"), value: []byte("meta block")}, blocks[0])
+
+		nodes := readTestSection(t, r, UserAccountsSection)
+		require.Equal(t, 100, len(nodes))
+		for i, node := range nodes {
+			assert.Equal(t, TrieNodeRecord, node.kind)
+			assert.Equal(t, []byte(fmt.Sprintf("hash%d", i)), node.key)
+			assert.Equal(t, []byte(fmt.Sprintf("node%d", i)), node.value)
+		}
+
+		assert.Zero(t, len(readTestSection(t, r, PeerAccountsSection)))
+	})
+	t.Run("small chunk size should split the records in multiple chunks", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		manifest := writeTestSnapshot(t, directory, 64, 100)
+		assert.True(t, len(manifest.Chunks) > 2)
+		for _, chunk := range manifest.Chunks {
+			assert.True(t, chunk.Size <= 64)
+		}
+
+		r, err := NewReader(directory)
+		require.Nil(t, err)
+		assert.Equal(t, 100, len(readTestSection(t, r, UserAccountsSection)))
+	})
+	t.Run("altered chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		manifest := writeTestSnapshot(t, directory, 1024*1024, 10)
+
+		chunkPath := filepath.Join(directory, manifest.Chunks[len(manifest.Chunks)-1].FileName)
+		buff, err := os.ReadFile(chunkPath)
+		require.Nil(t, err)
+		buff[len(buff)-1]++
+		require.Nil(t, os.WriteFile(chunkPath, buff, 0644))
+
+		r, err := NewReader(directory)
+		require.Nil(t, err)
+		err = r.ReadSection(UserAccountsSection, func(_ RecordKind, _ []byte, _ []byte) error {
+			return nil
+		})
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	})
+	t.Run("handler error should stop the read", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		writeTestSnapshot(t, directory, 1024*1024, 10)
+
+		r, err := NewReader(directory)
+		require.Nil(t, err)
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err = r.ReadSection(UserAccountsSection, func(_ RecordKind, _ []byte, _ []byte) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Equal(t, 1, numCalls)
+	})
+}
+
+func TestReadManifest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing manifest should error", func(t *testing.T) {
+		t.Parallel()
+
+		manifest, err := ReadManifest(t.TempDir())
+		assert.Nil(t, manifest)
+		assert.NotNil(t, err)
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		buff, _ := json.Marshal(&Manifest{Version: Version + 1})
+		require.Nil(t, os.WriteFile(filepath.Join(directory, ManifestFileName), buff, 0644))
+
+		manifest, err := ReadManifest(directory)
+		assert.Nil(t, manifest)
+		assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+	})
+}
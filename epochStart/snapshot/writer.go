@@ -0,0 +1,170 @@
+package snapshot
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// chunkWriter writes the records in chunk files of bounded size. A new chunk is started whenever the current one
+// would grow past the maximum size or a new section begins. The manifest is written last, so a directory
+// without manifest is an incomplete snapshot.
+type chunkWriter struct {
+	directory    string
+	maxChunkSize int64
+	chunks       []ChunkInfo
+	section      Section
+	file         *os.File
+	bufWriter    *bufio.Writer
+	checksum     hash.Hash
+	current      ChunkInfo
+	recordBuff   []byte
+	closed       bool
+}
+
+func newChunkWriter(directory string, maxChunkSize int64) (*chunkWriter, error) {
+	if len(directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if maxChunkSize <= 0 {
+		return nil, ErrInvalidChunkSize
+	}
+
+	err := os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkWriter{
+		directory:    directory,
+		maxChunkSize: maxChunkSize,
+		chunks:       make([]ChunkInfo, 0),
+	}, nil
+}
+
+// startSection closes the current chunk, so the following records are written in a new one
+func (cw *chunkWriter) startSection(section Section) error {
+	err := cw.finishChunk()
+	if err != nil {
+		return err
+	}
+
+	cw.section = section
+
+	return nil
+}
+
+func (cw *chunkWriter) writeRecord(kind RecordKind, key []byte, value []byte) error {
+	if cw.closed {
+		return ErrRecordAfterClose
+	}
+
+	cw.recordBuff = encodeRecord(cw.recordBuff, kind, key, value)
+	recordSize := int64(len(cw.recordBuff))
+	if cw.file != nil && cw.current.Size+recordSize > cw.maxChunkSize {
+		err := cw.finishChunk()
+		if err != nil {
+			return err
+		}
+	}
+
+	if cw.file == nil {
+		err := cw.startChunk()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := cw.bufWriter.Write(cw.recordBuff)
+	if err != nil {
+		return err
+	}
+
+	cw.current.Size += recordSize
+	cw.current.NumRecords++
+
+	return nil
+}
+
+func (cw *chunkWriter) startChunk() error {
+	fileName := fmt.Sprintf(chunkFileNameFormat, len(cw.chunks))
+	file, err := os.Create(filepath.Join(cw.directory, fileName))
+	if err != nil {
+		return err
+	}
+
+	cw.file = file
+	cw.checksum = sha256.New()
+	cw.bufWriter = bufio.NewWriter(io.MultiWriter(file, cw.checksum))
+	cw.current = ChunkInfo{
+		FileName: fileName,
+		Section:  cw.section,
+	}
+
+	return nil
+}
+
+func (cw *chunkWriter) finishChunk() error {
+	if cw.file == nil {
+		return nil
+	}
+
+	err := cw.bufWriter.Flush()
+	if err != nil {
+		return err
+	}
+
+	err = cw.file.Sync()
+	if err != nil {
+		return err
+	}
+
+	err = cw.file.Close()
+	if err != nil {
+		return err
+	}
+
+	cw.current.Checksum = hex.EncodeToString(cw.checksum.Sum(nil))
+	cw.chunks = append(cw.chunks, cw.current)
+	cw.file = nil
+
+	return nil
+}
+
+// close finishes the last chunk and writes the manifest
+func (cw *chunkWriter) close(manifest *Manifest) error {
+	err := cw.finishChunk()
+	if err != nil {
+		return err
+	}
+	cw.closed = true
+
+	manifest.Version = Version
+	manifest.Chunks = cw.chunks
+	buff, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(cw.directory, ManifestFileName), buff, 0644)
+}
+
+// abort closes the current chunk, if any, and removes the written files
+func (cw *chunkWriter) abort() {
+	if cw.file != nil {
+		_ = cw.file.Close()
+		cw.file = nil
+	}
+	cw.closed = true
+
+	err := os.RemoveAll(cw.directory)
+	if err != nil {
+		log.Warn("cannot remove incomplete snapshot", "directory", cw.directory, "error", err)
+	}
+}
@@ -236,6 +236,12 @@ var ErrNilMessenger = errors.New("nil messenger")
 // ErrNilMiniBlocksProvider signals a nil miniBlocks provider
 var ErrNilMiniBlocksProvider = errors.New("nil miniBlocks provider")
 
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNilSigningHandler signals that a nil signing handler has been provided
+var ErrNilSigningHandler = errors.New("nil signing handler")
+
 // ErrNilMultiSigner signals that a nil multi-signer was provided
 var ErrNilMultiSigner = errors.New("nil multi signer")
 
@@ -478,3 +484,6 @@ var ErrNilProcessStatusHandler = errors.New("nil process status handler")
 
 // ErrDBIsClosed is raised when the DB is closed
 var ErrDBIsClosed = errors.New("DB is closed")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
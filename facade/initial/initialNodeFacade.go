@@ -1,6 +1,7 @@
 package initial
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
@@ -98,6 +99,21 @@ func (inf *initialNodeFacade) GetAllESDTTokens(_ string, _ api.AccountQueryOptio
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// GetESDTTokensPage returns nil and error
+func (inf *initialNodeFacade) GetESDTTokensPage(_ string, _ common.AccountStoragePageOptions, _ api.AccountQueryOptions) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
+// IterateESDTTokens returns an empty key and error
+func (inf *initialNodeFacade) IterateESDTTokens(_ string, _ common.AccountStoragePageOptions, _ api.AccountQueryOptions, _ context.Context, _ func(string, string, *esdt.ESDigitalToken) error) (string, api.BlockInfo, error) {
+	return "", api.BlockInfo{}, errNodeStarting
+}
+
+// GetAccountHistory returns a nil structure and error
+func (inf *initialNodeFacade) GetAccountHistory(_ string, _ common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetNFTTokenIDsRegisteredByAddress returns nil and error
 func (inf *initialNodeFacade) GetNFTTokenIDsRegisteredByAddress(_ string, _ api.AccountQueryOptions) ([]string, api.BlockInfo, error) {
 	return nil, api.BlockInfo{}, errNodeStarting
@@ -141,6 +157,31 @@ func (inf *initialNodeFacade) ValidatorStatisticsApi() (map[string]*state.Valida
 	return nil, errNodeStarting
 }
 
+// GetEquivocations returns nil and error
+func (inf *initialNodeFacade) GetEquivocations(_ string) ([]*common.EquivocationEvidence, error) {
+	return nil, errNodeStarting
+}
+
+// GetConsensusRound returns nil and error
+func (inf *initialNodeFacade) GetConsensusRound(_ int64) (*common.ConsensusRoundTrace, error) {
+	return nil, errNodeStarting
+}
+
+// GetConsensusRounds returns nil and error
+func (inf *initialNodeFacade) GetConsensusRounds(_ int64, _ int64) ([]*common.ConsensusRoundTrace, error) {
+	return nil, errNodeStarting
+}
+
+// GetConsensusSchedule returns nil and error
+func (inf *initialNodeFacade) GetConsensusSchedule(_ int64, _ int64) (*common.ConsensusSchedule, error) {
+	return nil, errNodeStarting
+}
+
+// GetValidatorLeaderSchedule returns nil and error
+func (inf *initialNodeFacade) GetValidatorLeaderSchedule(_ string) (*common.ValidatorLeaderSchedule, error) {
+	return nil, errNodeStarting
+}
+
 // SendBulkTransactions returns 0 and error
 func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction) (uint64, error) {
 	return uint64(0), errNodeStarting
@@ -151,11 +192,26 @@ func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transa
 	return nil, errNodeStarting
 }
 
+// SimulateTransactionsBatchExecution returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionsBatchExecution(_ []*transaction.Transaction) ([]*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransaction returns nil and error
 func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.ApiTransactionResult, error) {
 	return nil, errNodeStarting
 }
 
+// GetTransactionStateChanges returns nil and error
+func (inf *initialNodeFacade) GetTransactionStateChanges(_ string) ([]*common.AccountStateChange, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionFinality returns empty string
+func (inf *initialNodeFacade) GetTransactionFinality(_ *transaction.ApiTransactionResult) string {
+	return ""
+}
+
 // ComputeTransactionGasLimit returns 0 and error
 func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nil, errNodeStarting
@@ -256,6 +312,16 @@ func (inf *initialNodeFacade) GetBlockByRound(_ uint64, _ api.BlockQueryOptions)
 	return nil, errNodeStarting
 }
 
+// GetHyperblockByHash returns nil and error
+func (inf *initialNodeFacade) GetHyperblockByHash(_ string, _ api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetHyperblockByNonce returns nil and error
+func (inf *initialNodeFacade) GetHyperblockByNonce(_ uint64, _ api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetInternalMetaBlockByHash return nil and error
 func (inf *initialNodeFacade) GetInternalMetaBlockByHash(_ common.ApiOutputFormat, _ string) (interface{}, error) {
 	return nil, errNodeStarting
@@ -306,6 +372,16 @@ func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ api.AccountQueryOptio
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// GetKeyValuePairsPage returns nil and error
+func (inf *initialNodeFacade) GetKeyValuePairsPage(_ string, _ common.AccountStoragePageOptions, _ api.AccountQueryOptions) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
+// IterateKeyValuePairs returns an empty key and error
+func (inf *initialNodeFacade) IterateKeyValuePairs(_ string, _ common.AccountStoragePageOptions, _ api.AccountQueryOptions, _ context.Context, _ func(string, string) error) (string, api.BlockInfo, error) {
+	return "", api.BlockInfo{}, errNodeStarting
+}
+
 // GetDirectStakedList returns empty slice
 func (inf *initialNodeFacade) GetDirectStakedList() ([]*api.DirectStakedValue, error) {
 	return nil, errNodeStarting
@@ -361,6 +437,31 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionPoolHistory returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionPoolHistory(_ string) (*common.TransactionPoolHistoryApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolEvictions returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolEvictions(_ int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGasPriceSuggestion returns nil and error
+func (inf *initialNodeFacade) GetGasPriceSuggestion(_ int) (*common.GasPriceSuggestionApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsByAddress returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsByAddress(_ string, _ uint64, _ uint64) (*common.AddressTransactionsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetEvents returns nil and error
+func (inf *initialNodeFacade) GetEvents(_ common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -32,6 +32,12 @@ type NodeHandler interface {
 	// GetKeyValuePairs returns the key-value pairs under a given address
 	GetKeyValuePairs(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
 
+	// GetKeyValuePairsPage returns a page of the key-value pairs under a given address
+	GetKeyValuePairsPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error)
+
+	// IterateKeyValuePairs calls the handler with the key-value pairs under a given address, starting with the page start key
+	IterateKeyValuePairs(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error)
+
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error)
 
@@ -50,6 +56,13 @@ type NodeHandler interface {
 	// GetAllESDTTokens returns the value of a key from a given account
 	GetAllESDTTokens(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 
+	// GetESDTTokensPage returns a page of the esdt tokens of a given account
+	GetESDTTokensPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error)
+
+	// IterateESDTTokens calls the handler with the esdt tokens of a given account, starting with the page start key
+	IterateESDTTokens(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error)
+	GetAccountHistory(address string, options common.AccountHistoryQueryOptions, ctx context.Context) (*common.AccountHistoryApiResponse, error)
+
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
 
@@ -79,6 +92,11 @@ type NodeHandler interface {
 
 	// ValidatorStatisticsApi return the statistics for all the validators
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetEquivocations(publicKey string) ([]*common.EquivocationEvidence, error)
+	GetConsensusRound(round int64) (*common.ConsensusRoundTrace, error)
+	GetConsensusRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error)
+	GetConsensusSchedule(startRound int64, endRound int64) (*common.ConsensusSchedule, error)
+	GetValidatorLeaderSchedule(publicKey string) (*common.ValidatorLeaderSchedule, error)
 	DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 
@@ -98,6 +116,7 @@ type NodeHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxsBatch(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -110,13 +129,22 @@ type ApiResolver interface {
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error)
+	GetTransactionFinality(tx *transaction.ApiTransactionResult) string
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error)
+	GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error)
+	GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByHash(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
 	GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
 	GetInternalShardBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error)
 	GetInternalShardBlockByRound(format common.ApiOutputFormat, round uint64) (interface{}, error)
@@ -22,7 +22,11 @@ type ApiResolverStub struct {
 	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByHashCalled                   func(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	GetHyperblockByNonceCalled                  func(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
 	GetTransactionHandler                       func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateChangesCalled            func(txHash string) ([]*common.AccountStateChange, error)
+	GetTransactionFinalityCalled                func(tx *transaction.ApiTransactionResult) string
 	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
 	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
 	GetInternalShardBlockByRoundCalled          func(format common.ApiOutputFormat, round uint64) (interface{}, error)
@@ -37,6 +41,11 @@ type ApiResolverStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionPoolHistoryCalled             func(txHash string) (*common.TransactionPoolHistoryApiResponse, error)
+	GetTransactionsPoolEvictionsCalled          func(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetGasPriceSuggestionCalled                 func(numBlocks int) (*common.GasPriceSuggestionApiResponse, error)
+	GetTransactionsByAddressCalled              func(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error)
+	GetEventsCalled                             func(options common.EventsQueryOptions) (*common.EventsApiResponse, error)
 	GetGasConfigsCalled                         func() map[string]map[string]uint64
 }
 
@@ -49,6 +58,24 @@ func (ars *ApiResolverStub) GetTransaction(hash string, withEvents bool) (*trans
 	return nil, nil
 }
 
+// GetTransactionStateChanges -
+func (ars *ApiResolverStub) GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error) {
+	if ars.GetTransactionStateChangesCalled != nil {
+		return ars.GetTransactionStateChangesCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionFinality -
+func (ars *ApiResolverStub) GetTransactionFinality(tx *transaction.ApiTransactionResult) string {
+	if ars.GetTransactionFinalityCalled != nil {
+		return ars.GetTransactionFinalityCalled(tx)
+	}
+
+	return ""
+}
+
 // GetBlockByHash -
 func (ars *ApiResolverStub) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	if ars.GetBlockByHashCalled != nil {
@@ -76,6 +103,24 @@ func (ars *ApiResolverStub) GetBlockByRound(round uint64, options api.BlockQuery
 	return nil, nil
 }
 
+// GetHyperblockByHash -
+func (ars *ApiResolverStub) GetHyperblockByHash(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	if ars.GetHyperblockByHashCalled != nil {
+		return ars.GetHyperblockByHashCalled(hash, options)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (ars *ApiResolverStub) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	if ars.GetHyperblockByNonceCalled != nil {
+		return ars.GetHyperblockByNonceCalled(nonce, options)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery -
 func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
 	if ars.ExecuteSCQueryHandler != nil {
@@ -198,6 +243,51 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string)
 	return nil, nil
 }
 
+// GetTransactionPoolHistory -
+func (ars *ApiResolverStub) GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+	if ars.GetTransactionPoolHistoryCalled != nil {
+		return ars.GetTransactionPoolHistoryCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolEvictions -
+func (ars *ApiResolverStub) GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+	if ars.GetTransactionsPoolEvictionsCalled != nil {
+		return ars.GetTransactionsPoolEvictionsCalled(maxNumEvictions)
+	}
+
+	return nil, nil
+}
+
+// GetGasPriceSuggestion -
+func (ars *ApiResolverStub) GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+	if ars.GetGasPriceSuggestionCalled != nil {
+		return ars.GetGasPriceSuggestionCalled(numBlocks)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (ars *ApiResolverStub) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error) {
+	if ars.GetTransactionsByAddressCalled != nil {
+		return ars.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (ars *ApiResolverStub) GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+	if ars.GetEventsCalled != nil {
+		return ars.GetEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
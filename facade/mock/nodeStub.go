@@ -32,6 +32,11 @@ type NodeStub struct {
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
 	ValidatorStatisticsApiCalled                   func() (map[string]*state.ValidatorApiResponse, error)
+	GetEquivocationsCalled                         func(publicKey string) ([]*common.EquivocationEvidence, error)
+	GetConsensusRoundCalled                        func(round int64) (*common.ConsensusRoundTrace, error)
+	GetConsensusRoundsCalled                       func(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error)
+	GetConsensusScheduleCalled                     func(startRound int64, endRound int64) (*common.ConsensusSchedule, error)
+	GetValidatorLeaderScheduleCalled               func(publicKey string) (*common.ValidatorLeaderSchedule, error)
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
@@ -41,10 +46,15 @@ type NodeStub struct {
 	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetESDTDataCalled                              func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetESDTTokensPageCalled                        func(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error)
+	IterateESDTTokensCalled                        func(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error)
+	GetAccountHistoryCalled                        func(address string, options common.AccountHistoryQueryOptions, ctx context.Context) (*common.AccountHistoryApiResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled        func(address string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
 	GetESDTsWithRoleCalled                         func(address string, role string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
 	GetESDTsRolesCalled                            func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
 	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPageCalled                     func(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error)
+	IterateKeyValuePairsCalled                     func(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
@@ -96,6 +106,24 @@ func (ns *NodeStub) GetKeyValuePairs(address string, options api.AccountQueryOpt
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetKeyValuePairsPage -
+func (ns *NodeStub) GetKeyValuePairsPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error) {
+	if ns.GetKeyValuePairsPageCalled != nil {
+		return ns.GetKeyValuePairsPageCalled(address, pageOptions, options, ctx)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
+// IterateKeyValuePairs -
+func (ns *NodeStub) IterateKeyValuePairs(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error) {
+	if ns.IterateKeyValuePairsCalled != nil {
+		return ns.IterateKeyValuePairsCalled(address, pageOptions, options, ctx, handler)
+	}
+
+	return "", api.BlockInfo{}, nil
+}
+
 // GetValueForKey -
 func (ns *NodeStub) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetValueForKeyCalled != nil {
@@ -166,6 +194,51 @@ func (ns *NodeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResp
 	return ns.ValidatorStatisticsApiCalled()
 }
 
+// GetEquivocations -
+func (ns *NodeStub) GetEquivocations(publicKey string) ([]*common.EquivocationEvidence, error) {
+	if ns.GetEquivocationsCalled != nil {
+		return ns.GetEquivocationsCalled(publicKey)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusRound -
+func (ns *NodeStub) GetConsensusRound(round int64) (*common.ConsensusRoundTrace, error) {
+	if ns.GetConsensusRoundCalled != nil {
+		return ns.GetConsensusRoundCalled(round)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusRounds -
+func (ns *NodeStub) GetConsensusRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error) {
+	if ns.GetConsensusRoundsCalled != nil {
+		return ns.GetConsensusRoundsCalled(startRound, endRound)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusSchedule -
+func (ns *NodeStub) GetConsensusSchedule(startRound int64, endRound int64) (*common.ConsensusSchedule, error) {
+	if ns.GetConsensusScheduleCalled != nil {
+		return ns.GetConsensusScheduleCalled(startRound, endRound)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorLeaderSchedule -
+func (ns *NodeStub) GetValidatorLeaderSchedule(publicKey string) (*common.ValidatorLeaderSchedule, error) {
+	if ns.GetValidatorLeaderScheduleCalled != nil {
+		return ns.GetValidatorLeaderScheduleCalled(publicKey)
+	}
+
+	return nil, nil
+}
+
 // DirectTrigger -
 func (ns *NodeStub) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return ns.DirectTriggerCalled(epoch, withEarlyEndOfEpoch)
@@ -239,6 +312,33 @@ func (ns *NodeStub) GetAllESDTTokens(address string, options api.AccountQueryOpt
 	return make(map[string]*esdt.ESDigitalToken), api.BlockInfo{}, nil
 }
 
+// GetESDTTokensPage -
+func (ns *NodeStub) GetESDTTokensPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error) {
+	if ns.GetESDTTokensPageCalled != nil {
+		return ns.GetESDTTokensPageCalled(address, pageOptions, options, ctx)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
+// IterateESDTTokens -
+func (ns *NodeStub) IterateESDTTokens(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error) {
+	if ns.IterateESDTTokensCalled != nil {
+		return ns.IterateESDTTokensCalled(address, pageOptions, options, ctx, handler)
+	}
+
+	return "", api.BlockInfo{}, nil
+}
+
+// GetAccountHistory -
+func (ns *NodeStub) GetAccountHistory(address string, options common.AccountHistoryQueryOptions, ctx context.Context) (*common.AccountHistoryApiResponse, error) {
+	if ns.GetAccountHistoryCalled != nil {
+		return ns.GetAccountHistoryCalled(address, options, ctx)
+	}
+
+	return nil, nil
+}
+
 // GetTokenSupply -
 func (ns *NodeStub) GetTokenSupply(_ string) (*api.ESDTSupply, error) {
 	return nil, nil
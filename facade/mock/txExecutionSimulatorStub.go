@@ -7,7 +7,8 @@ import (
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled       func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxsBatchCalled func(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +20,15 @@ func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSi
 	return &txSimData.SimulationResults{}, nil
 }
 
+// ProcessTxsBatch -
+func (t *TxExecutionSimulatorStub) ProcessTxsBatch(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error) {
+	if t.ProcessTxsBatchCalled != nil {
+		return t.ProcessTxsBatchCalled(txs)
+	}
+
+	return make([]*txSimData.SimulationResults, len(txs)), nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
@@ -225,6 +225,55 @@ func (nf *nodeFacade) GetAllESDTTokens(address string, options apiData.AccountQu
 	return nf.node.GetAllESDTTokens(address, options, ctx)
 }
 
+// GetKeyValuePairsPage returns a page of the key-value pairs under the provided address
+func (nf *nodeFacade) GetKeyValuePairsPage(address string, pageOptions common.AccountStoragePageOptions, options apiData.AccountQueryOptions) (*common.KeyValuePairsPageApiResponse, apiData.BlockInfo, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetKeyValuePairsPage(address, pageOptions, options, ctx)
+}
+
+// GetESDTTokensPage returns a page of the esdt tokens of the provided address
+func (nf *nodeFacade) GetESDTTokensPage(address string, pageOptions common.AccountStoragePageOptions, options apiData.AccountQueryOptions) (*common.ESDTTokensPageApiResponse, apiData.BlockInfo, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetESDTTokensPage(address, pageOptions, options, ctx)
+}
+
+// IterateKeyValuePairs calls the handler with the key-value pairs under the provided address, starting with the page
+// start key. The iteration is bounded by the provided context and not by the trie operations deadline, as it streams
+// the pairs to the caller instead of holding them
+func (nf *nodeFacade) IterateKeyValuePairs(
+	address string,
+	pageOptions common.AccountStoragePageOptions,
+	options apiData.AccountQueryOptions,
+	ctx context.Context,
+	handler func(key string, value string) error,
+) (string, apiData.BlockInfo, error) {
+	return nf.node.IterateKeyValuePairs(address, pageOptions, options, ctx, handler)
+}
+
+// IterateESDTTokens calls the handler with the esdt tokens of the provided address, starting with the page start key.
+// The iteration is bounded by the provided context and not by the trie operations deadline
+func (nf *nodeFacade) IterateESDTTokens(
+	address string,
+	pageOptions common.AccountStoragePageOptions,
+	options apiData.AccountQueryOptions,
+	ctx context.Context,
+	handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error,
+) (string, apiData.BlockInfo, error) {
+	return nf.node.IterateESDTTokens(address, pageOptions, options, ctx, handler)
+}
+
+// GetAccountHistory returns the state of an account at each of the requested blocks
+func (nf *nodeFacade) GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetAccountHistory(address, options, ctx)
+}
+
 // GetTokenSupply returns the provided token supply
 func (nf *nodeFacade) GetTokenSupply(token string) (*apiData.ESDTSupply, error) {
 	return nf.node.GetTokenSupply(token)
@@ -279,6 +328,31 @@ func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRe
 	return nf.node.ValidatorStatisticsApi()
 }
 
+// GetEquivocations will return the equivocation evidences recorded by the node
+func (nf *nodeFacade) GetEquivocations(publicKey string) ([]*common.EquivocationEvidence, error) {
+	return nf.node.GetEquivocations(publicKey)
+}
+
+// GetConsensusRound will return the consensus timeline recorded by the node for the provided round
+func (nf *nodeFacade) GetConsensusRound(round int64) (*common.ConsensusRoundTrace, error) {
+	return nf.node.GetConsensusRound(round)
+}
+
+// GetConsensusRounds will return the consensus timelines recorded by the node for the provided rounds range
+func (nf *nodeFacade) GetConsensusRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error) {
+	return nf.node.GetConsensusRounds(startRound, endRound)
+}
+
+// GetConsensusSchedule will return the consensus groups of the upcoming rounds, for each shard
+func (nf *nodeFacade) GetConsensusSchedule(startRound int64, endRound int64) (*common.ConsensusSchedule, error) {
+	return nf.node.GetConsensusSchedule(startRound, endRound)
+}
+
+// GetValidatorLeaderSchedule will return the next knowable round in which the validator is the consensus leader
+func (nf *nodeFacade) GetValidatorLeaderSchedule(publicKey string) (*common.ValidatorLeaderSchedule, error) {
+	return nf.node.GetValidatorLeaderSchedule(publicKey)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nf.node.SendBulkTransactions(txs)
@@ -289,11 +363,27 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.txSimulatorProc.ProcessTx(tx)
 }
 
+// SimulateTransactionsBatchExecution will simulate the execution of the provided transactions, in order, on a shared
+// state and will return the results of each of them
+func (nf *nodeFacade) SimulateTransactionsBatchExecution(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxsBatch(txs)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.apiResolver.GetTransaction(hash, withResults)
 }
 
+// GetTransactionStateChanges returns the changes done on the accounts by the given executed transaction
+func (nf *nodeFacade) GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error) {
+	return nf.apiResolver.GetTransactionStateChanges(txHash)
+}
+
+// GetTransactionFinality returns the finality of the given transaction, as seen from the metachain notarization state
+func (nf *nodeFacade) GetTransactionFinality(tx *transaction.ApiTransactionResult) string {
+	return nf.apiResolver.GetTransactionFinality(tx)
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
 func (nf *nodeFacade) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
 	return nf.apiResolver.GetTransactionsPool(fields)
@@ -314,6 +404,31 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender)
 }
 
+// GetTransactionPoolHistory will return the recorded pool removals and rejections of the given transaction
+func (nf *nodeFacade) GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+	return nf.apiResolver.GetTransactionPoolHistory(txHash)
+}
+
+// GetTransactionsPoolEvictions will return the latest recorded pool evictions and rejections
+func (nf *nodeFacade) GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolEvictions(maxNumEvictions)
+}
+
+// GetGasPriceSuggestion returns the gas price suggestion computed over the latest committed blocks
+func (nf *nodeFacade) GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+	return nf.apiResolver.GetGasPriceSuggestion(numBlocks)
+}
+
+// GetTransactionsByAddress will return, newest first, the transactions the given address took part in
+func (nf *nodeFacade) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsByAddress(address, from, size)
+}
+
+// GetEvents returns the events logged in the requested blocks range, matching the query filters
+func (nf *nodeFacade) GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+	return nf.apiResolver.GetEvents(options)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -444,6 +559,16 @@ func (nf *nodeFacade) GetBlockByRound(round uint64, options apiData.BlockQueryOp
 	return nf.apiResolver.GetBlockByRound(round, options)
 }
 
+// GetHyperblockByHash will return the hyperblock of the metachain block with the given hash
+func (nf *nodeFacade) GetHyperblockByHash(hash string, options apiData.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	return nf.apiResolver.GetHyperblockByHash(hash, options)
+}
+
+// GetHyperblockByNonce will return the hyperblock of the metachain block with the given nonce
+func (nf *nodeFacade) GetHyperblockByNonce(nonce uint64, options apiData.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	return nf.apiResolver.GetHyperblockByNonce(nonce, options)
+}
+
 // GetInternalMetaBlockByHash return the meta block for a given hash
 func (nf *nodeFacade) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	return nf.apiResolver.GetInternalMetaBlockByHash(format, hash)
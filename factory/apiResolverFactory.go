@@ -7,12 +7,15 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	errorsErd "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
+	"github.com/ElrondNetwork/elrond-go/node/external/feeMarket"
 	"github.com/ElrondNetwork/elrond-go/node/external/logs"
 	"github.com/ElrondNetwork/elrond-go/node/external/timemachine/fee"
 	"github.com/ElrondNetwork/elrond-go/node/external/transactionAPI"
@@ -47,6 +50,7 @@ type ApiResolverArgs struct {
 	BootstrapComponents BootstrapComponentsHolder
 	CryptoComponents    CryptoComponentsHolder
 	ProcessComponents   ProcessComponentsHolder
+	StatusComponents    StatusComponentsHolder
 	GasScheduleNotifier common.GasScheduleNotifierAPI
 	Bootstrapper        process.Bootstrapper
 	AllowVMQueriesChan  chan struct{}
@@ -241,6 +245,8 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		TxTypeHandler:            txTypeHandler,
 		LogsFacade:               logsFacade,
 		DataFieldParser:          dataFieldParser,
+		ForkDetector:             args.ProcessComponents.ForkDetector(),
+		BlockTracker:             args.ProcessComponents.BlockTracker(),
 	}
 	apiTransactionProcessor, err := transactionAPI.NewAPITransactionProcessor(argsAPITransactionProc)
 	if err != nil {
@@ -257,20 +263,32 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	apiHyperblockProcessor, err := createAPIHyperblockProcessor(args, apiTransactionProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPriceSuggestionHandler, err := createGasPriceSuggestionHandler(args)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:           scQueryService,
-		StatusMetricsHandler:     args.CoreComponents.StatusHandlerUtils().Metrics(),
-		TxCostHandler:            txCostHandler,
-		TotalStakedValueHandler:  totalStakedValueHandler,
-		DirectStakedListHandler:  directStakedListHandler,
-		DelegatedListHandler:     delegatedListHandler,
-		APITransactionHandler:    apiTransactionProcessor,
-		APIBlockHandler:          apiBlockProcessor,
-		APIInternalBlockHandler:  apiInternalBlockProcessor,
-		GenesisNodesSetupHandler: args.CoreComponents.GenesisNodesSetup(),
-		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
-		AccountsParser:           args.ProcessComponents.AccountsParser(),
-		GasScheduleNotifier:      args.GasScheduleNotifier,
+		SCQueryService:            scQueryService,
+		StatusMetricsHandler:      args.CoreComponents.StatusHandlerUtils().Metrics(),
+		TxCostHandler:             txCostHandler,
+		TotalStakedValueHandler:   totalStakedValueHandler,
+		DirectStakedListHandler:   directStakedListHandler,
+		DelegatedListHandler:      delegatedListHandler,
+		APITransactionHandler:     apiTransactionProcessor,
+		APIBlockHandler:           apiBlockProcessor,
+		APIInternalBlockHandler:   apiInternalBlockProcessor,
+		APIHyperblockHandler:      apiHyperblockProcessor,
+		GenesisNodesSetupHandler:  args.CoreComponents.GenesisNodesSetup(),
+		ValidatorPubKeyConverter:  args.CoreComponents.ValidatorPubKeyConverter(),
+		AccountsParser:            args.ProcessComponents.AccountsParser(),
+		GasScheduleNotifier:       args.GasScheduleNotifier,
+		GasPriceSuggestionHandler: gasPriceSuggestionHandler,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -532,6 +550,20 @@ func createAPIInternalBlockProcessor(args *ApiResolverArgs, apiTransactionHandle
 	return blockAPI.CreateAPIInternalBlockProcessor(blockApiArgs)
 }
 
+func createAPIHyperblockProcessor(args *ApiResolverArgs, apiTransactionHandler external.APITransactionHandler) (blockAPI.APIHyperblockHandler, error) {
+	blockApiArgs, err := createAPIBlockProcessorArgs(args, apiTransactionHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblockApiArgs := &blockAPI.ArgAPIHyperblockProcessor{
+		ArgAPIBlockProcessor: blockApiArgs,
+		ForkDetector:         args.ProcessComponents.ForkDetector(),
+	}
+
+	return blockAPI.CreateAPIHyperblockProcessor(hyperblockApiArgs)
+}
+
 func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler external.APITransactionHandler) (*blockAPI.ArgAPIBlockProcessor, error) {
 	statusComputer, err := txstatus.NewStatusComputer(
 		args.ProcessComponents.ShardCoordinator().SelfId(),
@@ -564,6 +596,34 @@ func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler ex
 	return blockApiArgs, nil
 }
 
+func createGasPriceSuggestionHandler(args *ApiResolverArgs) (external.GasPriceSuggestionHandler, error) {
+	gasPriceSuggestionConfig := args.Configs.GeneralConfig.GasPriceSuggestion
+	if !gasPriceSuggestionConfig.Enabled {
+		return feeMarket.NewDisabledGasPriceEstimator(), nil
+	}
+	if check.IfNil(args.StatusComponents) {
+		return nil, errorsErd.ErrNilStatusComponents
+	}
+
+	gasPriceEstimator, err := feeMarket.NewGasPriceEstimator(feeMarket.ArgsGasPriceEstimator{
+		EconomicsHandler:              args.CoreComponents.EconomicsData(),
+		NumBlocks:                     gasPriceSuggestionConfig.NumBlocks,
+		Percentiles:                   gasPriceSuggestionConfig.Percentiles,
+		SuggestionPercentile:          gasPriceSuggestionConfig.SuggestionPercentile,
+		CongestionThresholdPercentage: gasPriceSuggestionConfig.CongestionThresholdPercentage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.StatusComponents.OutportHandler().SubscribeDriver(gasPriceEstimator)
+	if err != nil {
+		return nil, err
+	}
+
+	return gasPriceEstimator, nil
+}
+
 func createLogsFacade(args *ApiResolverArgs) (LogsFacade, error) {
 	return logs.NewLogsFacade(logs.ArgsNewLogsFacade{
 		StorageService:  args.DataComponents.StorageService(),
@@ -31,6 +31,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/stateChanges"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -116,12 +117,17 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		return nil, err
 	}
 
+	accounts, stateChangesCollector, err := pcf.createAccountsForShardProcessing()
+	if err != nil {
+		return nil, err
+	}
+
 	mapDNSAddresses, err := smartContractParser.GetDeployedSCAddresses(genesis.DNSType)
 	if err != nil {
 		return nil, err
 	}
 
-	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(pcf.state.AccountsAdapter(), mapDNSAddresses)
+	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(accounts, mapDNSAddresses)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +137,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 	log.Debug("blockProcessorCreator: enable epoch for repair callback", "epoch", pcf.epochConfig.EnableEpochs.RepairCallbackEnableEpoch)
 
 	vmFactory, err := pcf.createVMFactoryShard(
-		pcf.state.AccountsAdapter(),
+		accounts,
 		builtInFuncFactory.BuiltInFunctionContainer(),
 		esdtTransferParser,
 		arwenChangeLocker,
@@ -222,7 +228,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		ArgsParser:          argsParser,
 		Hasher:              pcf.coreData.Hasher(),
 		Marshalizer:         pcf.coreData.InternalMarshalizer(),
-		AccountsDB:          pcf.state.AccountsAdapter(),
+		AccountsDB:          accounts,
 		BlockChainHook:      vmFactory.BlockChainHookImpl(),
 		BuiltInFunctions:    builtInFuncFactory.BuiltInFunctionContainer(),
 		PubkeyConv:          pcf.coreData.AddressPubKeyConverter(),
@@ -247,7 +253,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 	}
 
 	rewardsTxProcessor, err := rewardTransaction.NewRewardTxProcessor(
-		pcf.state.AccountsAdapter(),
+		accounts,
 		pcf.coreData.AddressPubKeyConverter(),
 		pcf.bootstrapComponents.ShardCoordinator(),
 	)
@@ -256,7 +262,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 	}
 
 	argsNewTxProcessor := transaction.ArgsNewTxProcessor{
-		Accounts:                              pcf.state.AccountsAdapter(),
+		Accounts:                              accounts,
 		Hasher:                                pcf.coreData.Hasher(),
 		PubkeyConv:                            pcf.coreData.AddressPubKeyConverter(),
 		Marshalizer:                           pcf.coreData.InternalMarshalizer(),
@@ -277,11 +283,16 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		RelayedTxV2EnableEpoch:                enableEpochs.RelayedTransactionsV2EnableEpoch,
 		AddFailedRelayedToInvalidDisableEpoch: enableEpochs.AddFailedRelayedTxToInvalidMBsDisableEpoch,
 	}
-	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
+	shardTxProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
 	}
 
+	transactionProcessor, err := pcf.createStateChangesTxProcessor(shardTxProcessor, stateChangesCollector)
+	if err != nil {
+		return nil, err
+	}
+
 	scheduledTxsExecutionHandler.SetTransactionProcessor(transactionProcessor)
 
 	vmFactoryTxSimulator, err := pcf.createShardTxSimulatorProcessor(txSimulatorProcessorArgs, argsNewScProcessor, argsNewTxProcessor, esdtTransferParser, arwenChangeLocker, mapDNSAddresses)
@@ -318,7 +329,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		pcf.coreData.Hasher(),
 		pcf.data.Datapool(),
 		pcf.coreData.AddressPubKeyConverter(),
-		pcf.state.AccountsAdapter(),
+		accounts,
 		requestHandler,
 		transactionProcessor,
 		scProcessor,
@@ -362,7 +373,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		Hasher:                               pcf.coreData.Hasher(),
 		Marshalizer:                          pcf.coreData.InternalMarshalizer(),
 		ShardCoordinator:                     pcf.bootstrapComponents.ShardCoordinator(),
-		Accounts:                             pcf.state.AccountsAdapter(),
+		Accounts:                             accounts,
 		MiniBlockPool:                        pcf.data.Datapool().MiniBlocks(),
 		RequestHandler:                       requestHandler,
 		PreProcessors:                        preProcContainer,
@@ -390,7 +401,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 	scheduledTxsExecutionHandler.SetTransactionCoordinator(txCoordinator)
 
 	accountsDb := make(map[state.AccountsDbIdentifier]state.AccountsAdapter)
-	accountsDb[state.UserAccountsState] = pcf.state.AccountsAdapter()
+	accountsDb[state.UserAccountsState] = accounts
 	accountsDb[state.PeerAccountsState] = pcf.state.PeerAccounts()
 
 	argumentsBaseProcessor := block.ArgBaseProcessor{
@@ -441,6 +452,50 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 	return blockProcessorComponents, nil
 }
 
+// createAccountsForShardProcessing returns the accounts used while processing blocks. If the state changes of the
+// executed transactions have to be saved, the accounts are wrapped by a state changes collector
+func (pcf *processComponentsFactory) createAccountsForShardProcessing() (state.AccountsAdapter, process.StateChangesCollector, error) {
+	if !pcf.isStateChangesRecordingEnabled() {
+		return pcf.state.AccountsAdapter(), nil, nil
+	}
+
+	argsCollector := stateChanges.ArgsAccountsStateChangesCollector{
+		Accounts:        pcf.state.AccountsAdapter(),
+		PubkeyConverter: pcf.coreData.AddressPubKeyConverter(),
+		Marshaller:      pcf.coreData.InternalMarshalizer(),
+	}
+	collector, err := stateChanges.NewAccountsStateChangesCollector(argsCollector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return collector, collector, nil
+}
+
+func (pcf *processComponentsFactory) createStateChangesTxProcessor(
+	txProcessor process.TransactionProcessor,
+	stateChangesCollector process.StateChangesCollector,
+) (process.TransactionProcessor, error) {
+	if !pcf.isStateChangesRecordingEnabled() {
+		return txProcessor, nil
+	}
+
+	argsStateChangesTxProcessor := transaction.ArgsStateChangesTxProcessor{
+		TxProcessor:           txProcessor,
+		StateChangesCollector: stateChangesCollector,
+		StateChangesRecorder:  pcf.historyRepo,
+		Marshalizer:           pcf.coreData.InternalMarshalizer(),
+		Hasher:                pcf.coreData.Hasher(),
+	}
+
+	return transaction.NewStateChangesTxProcessor(argsStateChangesTxProcessor)
+}
+
+func (pcf *processComponentsFactory) isStateChangesRecordingEnabled() bool {
+	dbLookupExtensionsConfig := pcf.config.DbLookupExtensions
+	return dbLookupExtensionsConfig.Enabled && dbLookupExtensionsConfig.StateChangesEnabled
+}
+
 func (pcf *processComponentsFactory) newMetaBlockProcessor(
 	requestHandler process.RequestHandler,
 	forkDetector process.ForkDetector,
@@ -896,10 +951,17 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	arwenChangeLocker common.Locker,
 	mapDNSAddresses map[string]struct{},
 ) (process.VirtualMachinesContainerFactory, error) {
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
+	argsSimulationAccountsDB := txsimulator.ArgsSimulationAccountsDB{
+		AccountsDB:      pcf.state.AccountsAdapterAPI(),
+		PubkeyConverter: pcf.coreData.AddressPubKeyConverter(),
+		Hasher:          pcf.coreData.Hasher(),
+		Marshalizer:     pcf.coreData.InternalMarshalizer(),
+	}
+	simulationAccountsDB, err := txsimulator.NewSimulationAccountsDB(argsSimulationAccountsDB)
 	if err != nil {
 		return nil, err
 	}
+	txSimulatorProcessorArgs.AccountsHandler = simulationAccountsDB
 
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		pcf.bootstrapComponents.ShardCoordinator(),
@@ -914,14 +976,14 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 		return nil, err
 	}
 
-	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(readOnlyAccountsDB, mapDNSAddresses)
+	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(simulationAccountsDB, mapDNSAddresses)
 	if err != nil {
 		return nil, err
 	}
 
 	smartContractStorageSimulate := pcf.config.SmartContractsStorageSimulate
 	vmFactory, err := pcf.createVMFactoryShard(
-		readOnlyAccountsDB,
+		simulationAccountsDB,
 		builtInFuncFactory.BuiltInFunctionContainer(),
 		esdtTransferParser,
 		arwenChangeLocker,
@@ -968,7 +1030,7 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 	txProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
-	scProcArgs.AccountsDB = readOnlyAccountsDB
+	scProcArgs.AccountsDB = simulationAccountsDB
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
@@ -976,7 +1038,7 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	}
 	txProcArgs.ScProcessor = scProcessor
 
-	txProcArgs.Accounts = readOnlyAccountsDB
+	txProcArgs.Accounts = simulationAccountsDB
 
 	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewTxProcessor(txProcArgs)
 	if err != nil {
@@ -1028,18 +1090,25 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
 
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
+	argsSimulationAccountsDB := txsimulator.ArgsSimulationAccountsDB{
+		AccountsDB:      pcf.state.AccountsAdapterAPI(),
+		PubkeyConverter: pcf.coreData.AddressPubKeyConverter(),
+		Hasher:          pcf.coreData.Hasher(),
+		Marshalizer:     pcf.coreData.InternalMarshalizer(),
+	}
+	simulationAccountsDB, err := txsimulator.NewSimulationAccountsDB(argsSimulationAccountsDB)
 	if err != nil {
 		return nil, err
 	}
+	txSimulatorProcessorArgs.AccountsHandler = simulationAccountsDB
 
-	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(readOnlyAccountsDB, make(map[string]struct{}))
+	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(simulationAccountsDB, make(map[string]struct{}))
 	if err != nil {
 		return nil, err
 	}
 
 	vmFactory, err := pcf.createVMFactoryMeta(
-		readOnlyAccountsDB,
+		simulationAccountsDB,
 		builtInFuncFactory.BuiltInFunctionContainer(),
 		pcf.config.SmartContractsStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
@@ -1065,7 +1134,7 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	argsNewMetaTx := transaction.ArgsNewMetaTxProcessor{
 		Hasher:                                pcf.coreData.Hasher(),
 		Marshalizer:                           pcf.coreData.InternalMarshalizer(),
-		Accounts:                              readOnlyAccountsDB,
+		Accounts:                              simulationAccountsDB,
 		PubkeyConv:                            pcf.coreData.AddressPubKeyConverter(),
 		ShardCoordinator:                      pcf.bootstrapComponents.ShardCoordinator(),
 		ScProcessor:                           scProcessor,
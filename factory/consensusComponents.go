@@ -7,13 +7,19 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/core/watchdog"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	mclMultiSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/multisig"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation"
+	disabledEquivocation "github.com/ElrondNetwork/elrond-go/consensus/equivocation/disabled"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
@@ -60,6 +66,7 @@ type consensusComponents struct {
 	bootstrapper       process.Bootstrapper
 	broadcastMessenger consensus.BroadcastMessenger
 	worker             ConsensusWorker
+	roundTracer        consensus.RoundTracer
 	consensusTopic     string
 	consensusGroupSize int
 }
@@ -164,6 +171,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		ccf.dataComponents.Datapool().Headers(),
 		ccf.processComponents.InterceptorsContainer(),
 		ccf.coreComponents.AlarmScheduler(),
+		ccf.cryptoComponents.ManagedPeersHolder(),
 	)
 	if err != nil {
 		return nil, err
@@ -175,6 +183,16 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		marshalizer = marshal.NewSizeCheckUnmarshalizer(marshalizer, sizeCheckDelta)
 	}
 
+	equivocationDetector, err := ccf.createEquivocationDetector(consensusService)
+	if err != nil {
+		return nil, err
+	}
+
+	cc.roundTracer, err = ccf.createRoundTracer(consensusService)
+	if err != nil {
+		return nil, err
+	}
+
 	workerArgs := &spos.WorkerArgs{
 		ConsensusService:         consensusService,
 		BlockChain:               ccf.dataComponents.Blockchain(),
@@ -200,6 +218,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		PublicKeySize:            ccf.config.ValidatorPubkeyConverter.Length,
 		AppStatusHandler:         ccf.coreComponents.StatusHandler(),
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
+		EquivocationDetector:     equivocationDetector,
+		RoundTracer:              cc.roundTracer,
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -242,6 +262,9 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		FallbackHeaderValidator:       ccf.processComponents.FallbackHeaderValidator(),
 		NodeRedundancyHandler:         ccf.processComponents.NodeRedundancyHandler(),
 		ScheduledProcessor:            ccf.scheduledProcessor,
+		ManagedPeersHolder:            ccf.cryptoComponents.ManagedPeersHolder(),
+		SigningHandler:                ccf.cryptoComponents.SigningHandler(),
+		RoundTracer:                   cc.roundTracer,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -331,6 +354,46 @@ func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyH
 	return chronologyHandler, nil
 }
 
+func (ccf *consensusComponentsFactory) createEquivocationDetector(consensusService spos.ConsensusService) (spos.EquivocationDetector, error) {
+	if !ccf.config.EquivocationDetection.Enabled {
+		return disabledEquivocation.NewDisabledEquivocationDetector(), nil
+	}
+
+	multiSigHasher, err := blake2b.NewBlake2bWithSize(multisig.BlsHashSize)
+	if err != nil {
+		return nil, err
+	}
+
+	args := equivocation.ArgsEquivocationDetector{
+		ConsensusService:       consensusService,
+		ShardCoordinator:       ccf.processComponents.ShardCoordinator(),
+		Hasher:                 ccf.coreComponents.Hasher(),
+		Marshaller:             ccf.coreComponents.InternalMarshalizer(),
+		NodesCoordinator:       ccf.processComponents.NodesCoordinator(),
+		KeyGenerator:           ccf.cryptoComponents.BlockSignKeyGen(),
+		SignatureShareVerifier: &mclMultiSig.BlsMultiSigner{Hasher: multiSigHasher},
+		Storer:                 ccf.dataComponents.StorageService().GetStorer(dataRetriever.EquivocationsUnit),
+		PeerHonestyHandler:     ccf.networkComponents.PeerHonestyHandler(),
+		OutportHandler:         ccf.statusComponents.OutportHandler(),
+		PenaltyUnits:           ccf.config.EquivocationDetection.PenaltyUnits,
+	}
+
+	return equivocation.NewEquivocationDetector(args)
+}
+
+func (ccf *consensusComponentsFactory) createRoundTracer(consensusService spos.ConsensusService) (consensus.RoundTracer, error) {
+	if !ccf.config.Debug.ConsensusRounds.Enabled {
+		return spos.NewDisabledRoundTracer(), nil
+	}
+
+	return spos.NewRoundTracer(spos.ArgsRoundTracer{
+		RoundHandler:     ccf.processComponents.RoundHandler(),
+		SyncTimer:        ccf.coreComponents.SyncTimer(),
+		ConsensusService: consensusService,
+		NumRoundsToKeep:  ccf.config.Debug.ConsensusRounds.NumRoundsToKeep,
+	})
+}
+
 func (ccf *consensusComponentsFactory) getEpoch() uint32 {
 	blockchain := ccf.dataComponents.Blockchain()
 	epoch := blockchain.GetGenesisHeader().GetEpoch()
@@ -365,7 +428,9 @@ func (ccf *consensusComponentsFactory) createConsensusState(epoch uint32, consen
 		eligibleNodesPubKeys,
 		// TODO: move the consensus data from nodesSetup json to config
 		consensusGroupSize,
-		string(selfId))
+		string(selfId),
+		ccf.cryptoComponents.ManagedPeersHolder(),
+	)
 
 	roundConsensus.ResetRoundState()
 
@@ -100,6 +100,18 @@ func (mcc *managedConsensusComponents) BroadcastMessenger() consensus.BroadcastM
 	return mcc.consensusComponents.broadcastMessenger
 }
 
+// RoundTracer returns the recorder of the consensus timeline of each round
+func (mcc *managedConsensusComponents) RoundTracer() consensus.RoundTracer {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.roundTracer
+}
+
 // ConsensusGroupSize returns the consensus group size
 func (mcc *managedConsensusComponents) ConsensusGroupSize() (int, error) {
 	mcc.mutConsensusComponents.RLock()
@@ -129,6 +141,9 @@ func (mcc *managedConsensusComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.broadcastMessenger) {
 		return errors.ErrNilBroadcastMessenger
 	}
+	if check.IfNil(mcc.roundTracer) {
+		return errors.ErrNilRoundTracer
+	}
 
 	return nil
 }
@@ -45,6 +45,7 @@ func TestManagedConsensusComponents_CreateShouldWork(t *testing.T) {
 	require.Nil(t, managedConsensusComponents.BroadcastMessenger())
 	require.Nil(t, managedConsensusComponents.Chronology())
 	require.Nil(t, managedConsensusComponents.ConsensusWorker())
+	require.Nil(t, managedConsensusComponents.RoundTracer())
 	require.Error(t, managedConsensusComponents.CheckSubcomponents())
 
 	err = managedConsensusComponents.Create()
@@ -52,6 +53,7 @@ func TestManagedConsensusComponents_CreateShouldWork(t *testing.T) {
 	require.NotNil(t, managedConsensusComponents.BroadcastMessenger())
 	require.NotNil(t, managedConsensusComponents.Chronology())
 	require.NotNil(t, managedConsensusComponents.ConsensusWorker())
+	require.NotNil(t, managedConsensusComponents.RoundTracer())
 	require.NoError(t, managedConsensusComponents.CheckSubcomponents())
 }
 
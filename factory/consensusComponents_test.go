@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
@@ -554,5 +555,7 @@ func getDefaultCryptoComponents() *mock.CryptoComponentsMock {
 		BlKeyGen:        &mock.KeyGenMock{},
 		TxKeyGen:        &mock.KeyGenMock{},
 		MsgSigVerifier:  &testscommon.MessageSignVerifierMock{},
+		ManagedPeers:    &cryptoMocks.ManagedPeersHolderStub{},
+		SigHandler:      &consensusMocks.SigningHandlerStub{},
 	}
 }
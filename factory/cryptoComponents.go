@@ -2,8 +2,12 @@ package factory
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
@@ -20,11 +24,14 @@ import (
 	mclMultiSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/multisig"
 	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/factory/peerSignatureHandler"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -36,6 +43,7 @@ const disabledSigChecking = "disabled"
 // CryptoComponentsFactoryArgs holds the arguments needed for creating crypto components
 type CryptoComponentsFactoryArgs struct {
 	ValidatorKeyPemFileName              string
+	AllValidatorKeysPemFileName          string
 	SkIndex                              int
 	Config                               config.Config
 	CoreComponentsHolder                 CoreComponentsHolder
@@ -48,6 +56,7 @@ type CryptoComponentsFactoryArgs struct {
 type cryptoComponentsFactory struct {
 	consensusType                        string
 	validatorKeyPemFileName              string
+	allValidatorKeysPemFileName          string
 	skIndex                              int
 	config                               config.Config
 	coreComponentsHolder                 CoreComponentsHolder
@@ -75,9 +84,19 @@ type cryptoComponents struct {
 	blockSignKeyGen     crypto.KeyGenerator
 	txSignKeyGen        crypto.KeyGenerator
 	messageSignVerifier vm.MessageSignVerifier
+	managedPeersHolder  common.ManagedPeersHolder
+	signingHandler      consensus.SigningHandler
+	remoteSignerConn    io.Closer
 	cryptoParams
 }
 
+// multiSignerCreator creates the multi signers used by the managed keys
+type multiSignerCreator struct {
+	ccf    *cryptoComponentsFactory
+	hasher hashing.Hasher
+	keyGen crypto.KeyGenerator
+}
+
 // NewCryptoComponentsFactory returns a new crypto components factory
 func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoComponentsFactory, error) {
 	if check.IfNil(args.CoreComponentsHolder) {
@@ -93,6 +112,7 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 	ccf := &cryptoComponentsFactory{
 		consensusType:                        args.Config.Consensus.Type,
 		validatorKeyPemFileName:              args.ValidatorKeyPemFileName,
+		allValidatorKeysPemFileName:          args.AllValidatorKeysPemFileName,
 		skIndex:                              args.SkIndex,
 		config:                               args.Config,
 		coreComponentsHolder:                 args.CoreComponentsHolder,
@@ -113,7 +133,12 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 	}
 
 	blockSignKeyGen := signing.NewKeyGenerator(suite)
-	cp, err := ccf.createCryptoParams(blockSignKeyGen)
+	remoteSignerConn, signerClient, err := ccf.createRemoteSignerClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := ccf.createCryptoParams(blockSignKeyGen, signerClient)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +155,11 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	interceptSingleSigner, err = ccf.wrapSingleSignerForRemoteKey(interceptSingleSigner, signerClient)
+	if err != nil {
+		return nil, err
+	}
+
 	multisigHasher, err := ccf.getMultiSigHasherFromConfig()
 	if err != nil {
 		return nil, err
@@ -164,6 +194,16 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	managedPeersHolder, err := ccf.createManagedPeersHolder(multisigHasher, cp, blockSignKeyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	signingHandler, err := ccf.createSigningHandler(cp, interceptSingleSigner, multiSigner, managedPeersHolder, signerClient)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debug("block sign pubkey", "value", cp.publicKeyString)
 
 	return &cryptoComponents{
@@ -174,10 +214,85 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		blockSignKeyGen:     blockSignKeyGen,
 		txSignKeyGen:        txSignKeyGen,
 		messageSignVerifier: messageSignVerifier,
+		managedPeersHolder:  managedPeersHolder,
+		signingHandler:      signingHandler,
+		remoteSignerConn:    remoteSignerConn,
 		cryptoParams:        *cp,
 	}, nil
 }
 
+func (ccf *cryptoComponentsFactory) isRemoteSignerEnabled() bool {
+	return ccf.config.RemoteSigner.Enabled && !ccf.isInImportMode
+}
+
+func (ccf *cryptoComponentsFactory) createRemoteSignerClient() (io.Closer, remoteSigner.SignerClient, error) {
+	if !ccf.isRemoteSignerEnabled() {
+		return nil, nil, nil
+	}
+
+	conn, err := remoteSigner.NewClientConnection(ccf.config.RemoteSigner)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while connecting to the remote signer at %s", err, ccf.config.RemoteSigner.Address)
+	}
+
+	client, err := remoteSigner.NewSignerClient(conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	log.Info("using remote signer for the BLS key", "address", ccf.config.RemoteSigner.Address)
+
+	return conn, client, nil
+}
+
+func (ccf *cryptoComponentsFactory) getRemoteSignerRequestTimeout() time.Duration {
+	return time.Duration(ccf.config.RemoteSigner.RequestTimeoutInSeconds) * time.Second
+}
+
+func (ccf *cryptoComponentsFactory) wrapSingleSignerForRemoteKey(
+	singleSigner crypto.SingleSigner,
+	signerClient remoteSigner.SignerClient,
+) (crypto.SingleSigner, error) {
+	if check.IfNil(signerClient) {
+		return singleSigner, nil
+	}
+
+	args := remoteSigner.ArgsSingleSigner{
+		Client:         signerClient,
+		SingleSigner:   singleSigner,
+		RequestTimeout: ccf.getRemoteSignerRequestTimeout(),
+	}
+
+	return remoteSigner.NewSingleSigner(args)
+}
+
+func (ccf *cryptoComponentsFactory) createSigningHandler(
+	cp *cryptoParams,
+	singleSigner crypto.SingleSigner,
+	multiSigner crypto.MultiSigner,
+	managedPeersHolder common.ManagedPeersHolder,
+	signerClient remoteSigner.SignerClient,
+) (consensus.SigningHandler, error) {
+	if check.IfNil(signerClient) {
+		args := keysManagement.ArgsLocalSigningHandler{
+			PrivateKey:         cp.privateKey,
+			SingleSigner:       singleSigner,
+			MultiSigner:        multiSigner,
+			ManagedPeersHolder: managedPeersHolder,
+		}
+
+		return keysManagement.NewLocalSigningHandler(args)
+	}
+
+	args := remoteSigner.ArgsSigningHandler{
+		Client:         signerClient,
+		RequestTimeout: ccf.getRemoteSignerRequestTimeout(),
+	}
+
+	return remoteSigner.NewSigningHandler(args)
+}
+
 func (ccf *cryptoComponentsFactory) createSingleSigner(importModeNoSigCheck bool) (crypto.SingleSigner, error) {
 	if importModeNoSigCheck {
 		log.Warn("using disabled single signer because the node is running in import-db 'turbo mode'")
@@ -236,6 +351,59 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 	}
 }
 
+func (ccf *cryptoComponentsFactory) createManagedPeersHolder(
+	hasher hashing.Hasher,
+	cp *cryptoParams,
+	blockSignKeyGen crypto.KeyGenerator,
+) (common.ManagedPeersHolder, error) {
+	args := keysManagement.ArgsManagedPeersHolder{
+		KeyGenerator: blockSignKeyGen,
+		MultiSignerCreator: &multiSignerCreator{
+			ccf:    ccf,
+			hasher: hasher,
+			keyGen: blockSignKeyGen,
+		},
+	}
+	managedPeersHolder, err := keysManagement.NewManagedPeersHolder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if ccf.isInImportMode || len(ccf.allValidatorKeysPemFileName) == 0 {
+		return managedPeersHolder, nil
+	}
+	if ccf.isRemoteSignerEnabled() {
+		log.Debug("managed validator keys are not loaded when using the remote signer, the node will run in single key mode")
+		return managedPeersHolder, nil
+	}
+
+	privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(ccf.allValidatorKeysPemFileName, blockSignKeyGen)
+	if os.IsNotExist(err) {
+		log.Debug("no managed validator keys file found, the node will run in single key mode",
+			"file", ccf.allValidatorKeysPemFileName)
+		return managedPeersHolder, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, privateKey := range privateKeys {
+		if publicKeys[i] == cp.publicKeyString {
+			log.Warn("managed validator key is the same as the node's own key, skipping", "public key", publicKeys[i])
+			continue
+		}
+
+		err = managedPeersHolder.AddManagedPeer(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w for managed key at index %d in %s", err, i, ccf.allValidatorKeysPemFileName)
+		}
+	}
+
+	log.Debug("loaded managed validator keys", "num keys", len(managedPeersHolder.GetManagedKeysByCurrentNode()))
+
+	return managedPeersHolder, nil
+}
+
 func (ccf *cryptoComponentsFactory) getSuite() (crypto.Suite, error) {
 	switch ccf.config.Consensus.Type {
 	case consensus.BlsConsensusType:
@@ -250,15 +418,70 @@ func (ccf *cryptoComponentsFactory) getSuite() (crypto.Suite, error) {
 
 func (ccf *cryptoComponentsFactory) createCryptoParams(
 	keygen crypto.KeyGenerator,
+	signerClient remoteSigner.SignerClient,
 ) (*cryptoParams, error) {
 
 	if ccf.isInImportMode {
 		return ccf.generateCryptoParams(keygen)
 	}
+	if !check.IfNil(signerClient) {
+		return ccf.readRemoteCryptoParams(keygen, signerClient)
+	}
 
 	return ccf.readCryptoParams(keygen)
 }
 
+func (ccf *cryptoComponentsFactory) readRemoteCryptoParams(
+	keygen crypto.KeyGenerator,
+	signerClient remoteSigner.SignerClient,
+) (*cryptoParams, error) {
+	pkBytes, err := hex.DecodeString(ccf.config.RemoteSigner.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the remote signer public key", err)
+	}
+
+	cp := &cryptoParams{
+		publicKeyBytes: pkBytes,
+	}
+	cp.publicKey, err = keygen.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the remote signer public key", err)
+	}
+
+	err = ccf.checkPublicKeyHeldByRemoteSigner(pkBytes, signerClient)
+	if err != nil {
+		return nil, err
+	}
+
+	cp.privateKey, err = remoteSigner.NewRemoteKey(cp.publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorKeyConverter := ccf.coreComponentsHolder.ValidatorPubKeyConverter()
+	cp.publicKeyString = validatorKeyConverter.Encode(cp.publicKeyBytes)
+
+	return cp, nil
+}
+
+func (ccf *cryptoComponentsFactory) checkPublicKeyHeldByRemoteSigner(pkBytes []byte, signerClient remoteSigner.SignerClient) error {
+	ctx, cancel := context.WithTimeout(context.Background(), ccf.getRemoteSignerRequestTimeout())
+	defer cancel()
+
+	response, err := signerClient.GetPublicKeys(ctx, &remoteSigner.PublicKeysRequest{})
+	if err != nil {
+		return fmt.Errorf("%w while fetching the public keys of the remote signer", err)
+	}
+
+	for _, publicKey := range response.PublicKeys {
+		if bytes.Equal(publicKey, pkBytes) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s is not held by the remote signer", remoteSigner.ErrUnknownPublicKey, ccf.config.RemoteSigner.PublicKey)
+}
+
 func (ccf *cryptoComponentsFactory) readCryptoParams(keygen crypto.KeyGenerator) (*cryptoParams, error) {
 	cp := &cryptoParams{}
 	sk, readPk, err := ccf.getSkPk()
@@ -326,7 +549,26 @@ func (ccf *cryptoComponentsFactory) getSkPk() ([]byte, []byte, error) {
 	return skBytes, pkBytes, nil
 }
 
+// CreateMultiSigner creates a multi signer bound to the provided managed key
+func (creator *multiSignerCreator) CreateMultiSigner(privateKey crypto.PrivateKey, publicKeyBytes []byte) (crypto.MultiSigner, error) {
+	cp := &cryptoParams{
+		privateKey:     privateKey,
+		publicKeyBytes: publicKeyBytes,
+	}
+
+	return creator.ccf.createMultiSigner(creator.hasher, cp, creator.keyGen, creator.ccf.importModeNoSigCheck)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (creator *multiSignerCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
+
 // Close closes all underlying components that need closing
 func (cc *cryptoComponents) Close() error {
+	if cc.remoteSignerConn != nil {
+		return cc.remoteSignerConn.Close()
+	}
+
 	return nil
 }
@@ -1,9 +1,18 @@
 package factory_test
 
 import (
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/require"
 )
@@ -43,6 +52,8 @@ func TestManagedCryptoComponents_CreateShouldWork(t *testing.T) {
 	require.Nil(t, managedCryptoComponents.BlockSignKeyGen())
 	require.Nil(t, managedCryptoComponents.TxSignKeyGen())
 	require.Nil(t, managedCryptoComponents.MessageSignVerifier())
+	require.Nil(t, managedCryptoComponents.ManagedPeersHolder())
+	require.Nil(t, managedCryptoComponents.SigningHandler())
 
 	err = managedCryptoComponents.Create()
 	require.NoError(t, err)
@@ -52,6 +63,80 @@ func TestManagedCryptoComponents_CreateShouldWork(t *testing.T) {
 	require.NotNil(t, managedCryptoComponents.BlockSignKeyGen())
 	require.NotNil(t, managedCryptoComponents.TxSignKeyGen())
 	require.NotNil(t, managedCryptoComponents.MessageSignVerifier())
+	require.NotNil(t, managedCryptoComponents.ManagedPeersHolder())
+	require.False(t, managedCryptoComponents.ManagedPeersHolder().IsMultiKeyMode())
+	require.NotNil(t, managedCryptoComponents.SigningHandler())
+}
+
+func TestManagedCryptoComponents_CreateWithManagedKeysShouldWork(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk, pk := keyGen.GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	pkBytes, _ := pk.ToByteArray()
+
+	// the node's own key is also present in the file and should be skipped
+	content := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY for " + dummyPk, Bytes: []byte(dummySk)})
+	content = append(content, pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY for " + hex.EncodeToString(pkBytes),
+		Bytes: []byte(hex.EncodeToString(skBytes)),
+	})...)
+	dir, err := ioutil.TempDir("", "managedKeys")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	allValidatorKeysPemFileName := filepath.Join(dir, "allValidatorsKeys.pem")
+	err = ioutil.WriteFile(allValidatorKeysPemFileName, content, 0644)
+	require.NoError(t, err)
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.AllValidatorKeysPemFileName = allValidatorKeysPemFileName
+	cryptoComponentsFactory, _ := factory.NewCryptoComponentsFactory(args)
+	managedCryptoComponents, err := factory.NewManagedCryptoComponents(cryptoComponentsFactory)
+	require.NoError(t, err)
+
+	err = managedCryptoComponents.Create()
+	require.NoError(t, err)
+
+	managedPeersHolder := managedCryptoComponents.ManagedPeersHolder()
+	require.True(t, managedPeersHolder.IsMultiKeyMode())
+	require.Len(t, managedPeersHolder.GetManagedKeysByCurrentNode(), 1)
+	require.True(t, managedPeersHolder.IsKeyManagedByCurrentNode(pkBytes))
+	require.False(t, managedPeersHolder.IsKeyManagedByCurrentNode(managedCryptoComponents.PublicKeyBytes()))
+}
+
+func TestManagedCryptoComponents_CreateWithInvalidManagedKeysFileShouldErr(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	dir, err := ioutil.TempDir("", "managedKeys")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	allValidatorKeysPemFileName := filepath.Join(dir, "allValidatorsKeys.pem")
+	err = ioutil.WriteFile(allValidatorKeysPemFileName, []byte("invalid"), 0644)
+	require.NoError(t, err)
+
+	coreComponents := getCoreComponents()
+	args := getCryptoArgs(coreComponents)
+	args.AllValidatorKeysPemFileName = allValidatorKeysPemFileName
+	cryptoComponentsFactory, _ := factory.NewCryptoComponentsFactory(args)
+	managedCryptoComponents, err := factory.NewManagedCryptoComponents(cryptoComponentsFactory)
+	require.NoError(t, err)
+
+	err = managedCryptoComponents.Create()
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), keysManagement.ErrInvalidPemFile.Error()))
+	require.Nil(t, managedCryptoComponents.ManagedPeersHolder())
 }
 
 func TestManagedCryptoComponents_CheckSubcomponents(t *testing.T) {
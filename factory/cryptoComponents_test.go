@@ -1,16 +1,20 @@
 package factory_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/config"
 	errErd "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/stretchr/testify/require"
 )
@@ -402,6 +406,63 @@ func TestCryptoComponentsFactory_CreateCryptoParamsOK(t *testing.T) {
 	require.NotNil(t, cryptoParams)
 }
 
+func TestCryptoComponentsFactory_CreateRemoteCryptoParams(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getCoreComponents()
+	blockSignKeyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	_, pk := blockSignKeyGen.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+
+	createArgs := func(publicKey string) factory.CryptoComponentsFactoryArgs {
+		args := getCryptoArgs(coreComponents)
+		args.Config.RemoteSigner = config.RemoteSignerConfig{
+			Enabled:                 true,
+			PublicKey:               publicKey,
+			RequestTimeoutInSeconds: 1,
+		}
+
+		return args
+	}
+
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		ccf, _ := factory.NewCryptoComponentsFactory(createArgs("not hex"))
+		publicKey, privateKey, err := ccf.CreateRemoteCryptoParams(blockSignKeyGen, &cryptoMocks.SignerClientStub{})
+		require.Nil(t, publicKey)
+		require.Nil(t, privateKey)
+		require.NotNil(t, err)
+	})
+	t.Run("public key not held by the remote signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ccf, _ := factory.NewCryptoComponentsFactory(createArgs(hex.EncodeToString(pkBytes)))
+		publicKey, privateKey, err := ccf.CreateRemoteCryptoParams(blockSignKeyGen, &cryptoMocks.SignerClientStub{})
+		require.Nil(t, publicKey)
+		require.Nil(t, privateKey)
+		require.True(t, errors.Is(err, remoteSigner.ErrUnknownPublicKey))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ccf, _ := factory.NewCryptoComponentsFactory(createArgs(hex.EncodeToString(pkBytes)))
+		signerClient := &cryptoMocks.SignerClientStub{
+			GetPublicKeysCalled: func(ctx context.Context, request *remoteSigner.PublicKeysRequest) (*remoteSigner.PublicKeysResponse, error) {
+				return &remoteSigner.PublicKeysResponse{PublicKeys: [][]byte{[]byte("other key"), pkBytes}}, nil
+			},
+		}
+		publicKey, privateKey, err := ccf.CreateRemoteCryptoParams(blockSignKeyGen, signerClient)
+		require.Nil(t, err)
+		recoveredPkBytes, _ := publicKey.ToByteArray()
+		require.Equal(t, pkBytes, recoveredPkBytes)
+		require.Equal(t, publicKey, privateKey.GeneratePublic())
+
+		_, err = privateKey.ToByteArray()
+		require.Equal(t, remoteSigner.ErrPrivateKeyNotAvailable, err)
+	})
+}
+
 func TestCryptoComponentsFactory_GetSkPkInvalidSkBytesShouldErr(t *testing.T) {
 	t.Parallel()
 	if testing.Short() {
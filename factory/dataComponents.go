@@ -9,10 +9,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/txsPersister"
 	dataRetrieverFactory "github.com/ElrondNetwork/elrond-go/dataRetriever/factory"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 )
 
@@ -43,6 +45,11 @@ type dataComponents struct {
 	store              dataRetriever.StorageService
 	datapool           dataRetriever.PoolsHolder
 	miniBlocksProvider MiniBlockProvider
+	txsPoolsSaver      txsPoolsSaver
+}
+
+type txsPoolsSaver interface {
+	Save() error
 }
 
 // NewDataComponentsFactory will return a new instance of dataComponentsFactory
@@ -116,14 +123,42 @@ func (dcf *dataComponentsFactory) Create() (*dataComponents, error) {
 		return nil, err
 	}
 
+	txsSaver, err := dcf.createTxsPoolsSaver(datapool, store)
+	if err != nil {
+		return nil, err
+	}
+
 	return &dataComponents{
 		blkc:               blkc,
 		store:              store,
 		datapool:           datapool,
 		miniBlocksProvider: miniBlocksProvider,
+		txsPoolsSaver:      txsSaver,
 	}, nil
 }
 
+func (dcf *dataComponentsFactory) createTxsPoolsSaver(
+	datapool dataRetriever.PoolsHolder,
+	store dataRetriever.StorageService,
+) (txsPoolsSaver, error) {
+	if !dcf.config.TxPoolPersistence.Enabled {
+		return nil, nil
+	}
+
+	return txsPersister.NewTxsPersister(txsPersister.ArgsTxsPersister{
+		FilePath:         TxPoolPersistenceFilePath(dcf.config, dcf.core.PathHandler(), dcf.shardCoordinator.SelfId()),
+		DataPool:         datapool,
+		Marshaller:       dcf.core.InternalMarshalizer(),
+		ShardCoordinator: dcf.shardCoordinator,
+		StorageService:   store,
+	})
+}
+
+// TxPoolPersistenceFilePath returns the path of the file in which the transactions pools are saved on close
+func TxPoolPersistenceFilePath(cfg config.Config, pathHandler storage.PathManagerHandler, shardID uint32) string {
+	return pathHandler.PathForStatic(core.GetShardIDString(shardID), cfg.TxPoolPersistence.FileName)
+}
+
 func (dcf *dataComponentsFactory) createBlockChainFromConfig() (data.ChainHandler, error) {
 	if dcf.shardCoordinator.SelfId() < dcf.shardCoordinator.NumberOfShards() {
 		blockChain, err := blockchain.NewBlockChain(dcf.core.StatusHandler())
@@ -179,6 +214,14 @@ func (cc *dataComponents) Close() error {
 		}
 	}
 
+	if cc.txsPoolsSaver != nil {
+		log.Debug("saving the transactions pools....")
+		err := cc.txsPoolsSaver.Save()
+		if err != nil {
+			log.Error("failed to save the transactions pools", "error", err.Error())
+		}
+	}
+
 	if !check.IfNil(cc.datapool) {
 		lastError = cc.datapool.Close()
 	}
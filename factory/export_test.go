@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -35,7 +36,20 @@ func (ccf *cryptoComponentsFactory) CreateDummyCryptoParams() *cryptoParams {
 
 // CreateCryptoParams -
 func (ccf *cryptoComponentsFactory) CreateCryptoParams(blockSignKeyGen crypto.KeyGenerator) (*cryptoParams, error) {
-	return ccf.createCryptoParams(blockSignKeyGen)
+	return ccf.createCryptoParams(blockSignKeyGen, nil)
+}
+
+// CreateRemoteCryptoParams -
+func (ccf *cryptoComponentsFactory) CreateRemoteCryptoParams(
+	blockSignKeyGen crypto.KeyGenerator,
+	signerClient remoteSigner.SignerClient,
+) (crypto.PublicKey, crypto.PrivateKey, error) {
+	cp, err := ccf.createCryptoParams(blockSignKeyGen, signerClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cp.publicKey, cp.privateKey, nil
 }
 
 // CreateMultiSigner -
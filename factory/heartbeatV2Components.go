@@ -161,6 +161,7 @@ func (hcf *heartbeatV2ComponentsFactory) Create() (*heartbeatV2Components, error
 		HardforkTimeBetweenSends:                    time.Second * time.Duration(cfg.HardforkTimeBetweenSendsInSec),
 		HardforkTriggerPubKey:                       hcf.coreComponents.HardforkTriggerPubKey(),
 		PeerTypeProvider:                            peerTypeProvider,
+		ManagedPeersHolder:                          hcf.cryptoComponents.ManagedPeersHolder(),
 	}
 	heartbeatV2Sender, err := sender.NewSender(argsSender)
 	if err != nil {
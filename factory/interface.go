@@ -161,6 +161,8 @@ type CryptoComponentsHolder interface {
 	BlockSignKeyGen() crypto.KeyGenerator
 	TxSignKeyGen() crypto.KeyGenerator
 	MessageSignVerifier() vm.MessageSignVerifier
+	ManagedPeersHolder() common.ManagedPeersHolder
+	SigningHandler() consensus.SigningHandler
 	Clone() interface{}
 	IsInterfaceNil() bool
 }
@@ -231,6 +233,7 @@ type NetworkComponentsHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxsBatch(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -420,6 +423,7 @@ type ConsensusComponentsHolder interface {
 	BroadcastMessenger() consensus.BroadcastMessenger
 	ConsensusGroupSize() (int, error)
 	Bootstrapper() process.Bootstrapper
+	RoundTracer() consensus.RoundTracer
 	IsInterfaceNil() bool
 }
 
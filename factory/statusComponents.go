@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 	"fmt"
+	"time"
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
@@ -13,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common/statistics"
 	"github.com/ElrondNetwork/elrond-go/common/statistics/softwareVersion/factory"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/errors"
@@ -212,6 +214,8 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 		ElasticIndexerFactoryArgs:  scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:   scf.makeEventNotifierArgs(),
 		CovalentIndexerFactoryArgs: scf.makeCovalentIndexerArgs(),
+		WebSocketDriverFactoryArgs: scf.makeWebSocketDriverArgs(),
+		OutboxFactoryArgs:          scf.makeOutboxArgs(),
 	}
 
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
@@ -268,6 +272,36 @@ func (scf *statusComponentsFactory) makeCovalentIndexerArgs() *covalentFactory.A
 	}
 }
 
+func (scf *statusComponentsFactory) makeWebSocketDriverArgs() *outportDriverFactory.WebSocketDriverFactoryArgs {
+	webSocketConfig := scf.externalConfig.WebSocketConnector
+	return &outportDriverFactory.WebSocketDriverFactoryArgs{
+		Enabled:            webSocketConfig.Enabled,
+		URL:                webSocketConfig.URL,
+		MaxNumClients:      webSocketConfig.MaxNumClients,
+		ClientBufferSize:   webSocketConfig.ClientBufferSize,
+		WithAcknowledge:    webSocketConfig.WithAcknowledge,
+		AcknowledgeTimeout: time.Duration(webSocketConfig.AcknowledgeTimeoutInSec) * time.Second,
+		FullBufferTimeout:  time.Duration(webSocketConfig.FullBufferTimeoutInMillisec) * time.Millisecond,
+		Marshaller:         scf.coreComponents.InternalMarshalizer(),
+		Hasher:             scf.coreComponents.Hasher(),
+		PubKeyConverter:    scf.coreComponents.AddressPubKeyConverter(),
+	}
+}
+
+func (scf *statusComponentsFactory) makeOutboxArgs() *outportDriverFactory.OutboxFactoryArgs {
+	if !scf.config.OutportOutbox.Enabled {
+		return &outportDriverFactory.OutboxFactoryArgs{}
+	}
+
+	return &outportDriverFactory.OutboxFactoryArgs{
+		Enabled:         true,
+		Storer:          scf.dataComponents.StorageService().GetStorer(dataRetriever.OutportOutboxUnit),
+		Marshaller:      scf.coreComponents.InternalMarshalizer(),
+		StatusHandler:   scf.coreComponents.StatusHandler(),
+		RetrialInterval: common.RetrialIntervalForOutportDriver,
+	}
+}
+
 func startStatisticsMonitor(
 	generalConfig *config.Config,
 	pathManager storage.PathManagerHandler,
@@ -23,12 +23,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 var _ ComponentHandler = (*managedStatusComponents)(nil)
 var _ StatusComponentsHolder = (*managedStatusComponents)(nil)
 var _ StatusComponentsHandler = (*managedStatusComponents)(nil)
 
+type txPoolReplacementStatisticsHandler interface {
+	GetReplacementStatistics() txcache.ReplacementStatistics
+}
+
 type managedStatusComponents struct {
 	*statusComponents
 	statusComponentsFactory *statusComponentsFactory
@@ -190,6 +195,11 @@ func (msc *managedStatusComponents) startStatusPolling(ctx context.Context) erro
 		return err
 	}
 
+	err = registerPollTxPoolReplacements(appStatusPollingHandler, msc.statusComponentsFactory.dataComponents)
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll(ctx)
 
 	return nil
@@ -242,6 +252,33 @@ func registerShardsInformation(
 	return nil
 }
 
+func registerPollTxPoolReplacements(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	dataComponents DataComponentsHolder,
+) error {
+
+	txPoolReplacementsHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
+		if check.IfNil(dataComponents) || check.IfNil(dataComponents.Datapool()) {
+			return
+		}
+		txPool, ok := dataComponents.Datapool().Transactions().(txPoolReplacementStatisticsHandler)
+		if !ok {
+			return
+		}
+
+		statistics := txPool.GetReplacementStatistics()
+		appStatusHandler.SetUInt64Value(common.MetricTxPoolNumReplacedTxs, statistics.NumReplacedTxs)
+		appStatusHandler.SetUInt64Value(common.MetricTxPoolNumRejectedReplacements, statistics.NumRejectedReplacements)
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(txPoolReplacementsHandlerFunc)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for the transactions pool replacements", err)
+	}
+
+	return nil
+}
+
 func computeNumConnectedPeers(
 	appStatusHandler core.AppStatusHandler,
 	netMessenger p2p.Messenger,
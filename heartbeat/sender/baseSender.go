@@ -102,9 +102,12 @@ func (bs *baseSender) computeRandomDuration(baseDuration time.Duration) time.Dur
 	return ret
 }
 
+func (bs *baseSender) shouldUseOriginalKeys() bool {
+	return !bs.redundancy.IsRedundancyNode() || (bs.redundancy.IsRedundancyNode() && !bs.redundancy.IsMainMachineActive())
+}
+
 func (bs *baseSender) getCurrentPrivateAndPublicKeys() (crypto.PrivateKey, crypto.PublicKey) {
-	shouldUseOriginalKeys := !bs.redundancy.IsRedundancyNode() || (bs.redundancy.IsRedundancyNode() && !bs.redundancy.IsMainMachineActive())
-	if shouldUseOriginalKeys {
+	if bs.shouldUseOriginalKeys() {
 		return bs.privKey, bs.publicKey
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/batch"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 )
 
@@ -19,6 +20,7 @@ type argPeerAuthenticationSender struct {
 	hardforkTrigger          heartbeat.HardforkTrigger
 	hardforkTimeBetweenSends time.Duration
 	hardforkTriggerPubKey    []byte
+	managedPeersHolder       common.ManagedPeersHolder
 }
 
 type peerAuthenticationSender struct {
@@ -28,6 +30,7 @@ type peerAuthenticationSender struct {
 	hardforkTrigger          heartbeat.HardforkTrigger
 	hardforkTimeBetweenSends time.Duration
 	hardforkTriggerPubKey    []byte
+	managedPeersHolder       common.ManagedPeersHolder
 }
 
 // newPeerAuthenticationSender will create a new instance of type peerAuthenticationSender
@@ -44,6 +47,7 @@ func newPeerAuthenticationSender(args argPeerAuthenticationSender) (*peerAuthent
 		hardforkTrigger:          args.hardforkTrigger,
 		hardforkTimeBetweenSends: args.hardforkTimeBetweenSends,
 		hardforkTriggerPubKey:    args.hardforkTriggerPubKey,
+		managedPeersHolder:       args.managedPeersHolder,
 	}
 
 	return sender, nil
@@ -69,6 +73,9 @@ func checkPeerAuthenticationSenderArgs(args argPeerAuthenticationSender) error {
 	if len(args.hardforkTriggerPubKey) == 0 {
 		return fmt.Errorf("%w hardfork trigger public key bytes length is 0", heartbeat.ErrInvalidValue)
 	}
+	if check.IfNil(args.managedPeersHolder) {
+		return heartbeat.ErrNilManagedPeersHolder
+	}
 
 	return nil
 }
@@ -80,20 +87,14 @@ func (sender *peerAuthenticationSender) Execute() {
 		sender.CreateNewTimer(duration)
 	}()
 
-	_, pk := sender.getCurrentPrivateAndPublicKeys()
-	pkBytes, err := pk.ToByteArray()
-	if err != nil {
-		duration = sender.timeBetweenSendsWhenError
-		return
-	}
-
-	if !sender.isValidator(pkBytes) && !sender.isHardforkSource(pkBytes) {
+	keys, err := sender.getKeysToSend()
+	if err != nil || len(keys) == 0 {
 		duration = sender.timeBetweenSendsWhenError
 		return
 	}
 
 	duration = sender.computeRandomDuration(sender.timeBetweenSends)
-	err, isHardforkTriggered := sender.execute()
+	err, isHardforkTriggered := sender.execute(keys)
 	if err != nil {
 		duration = sender.timeBetweenSendsWhenError
 		log.Error("error sending peer authentication message", "error", err, "is hardfork triggered", isHardforkTriggered, "next send will be in", duration)
@@ -104,16 +105,37 @@ func (sender *peerAuthenticationSender) Execute() {
 		duration = sender.computeRandomDuration(sender.hardforkTimeBetweenSends)
 	}
 
-	log.Debug("peer authentication message sent", "is hardfork triggered", isHardforkTriggered, "next send will be in", duration)
+	log.Debug("peer authentication message sent", "num keys", len(keys), "is hardfork triggered", isHardforkTriggered, "next send will be in", duration)
 }
 
-func (sender *peerAuthenticationSender) execute() (error, bool) {
+// getKeysToSend returns the private keys for which a peer authentication message should be sent: the current key,
+// if it is a validator or the hardfork source, and all the managed keys that are validators
+func (sender *peerAuthenticationSender) getKeysToSend() ([]crypto.PrivateKey, error) {
 	sk, pk := sender.getCurrentPrivateAndPublicKeys()
+	pkBytes, err := pk.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
 
-	msg := &heartbeat.PeerAuthentication{
-		Pid: sender.messenger.ID().Bytes(),
+	keys := make([]crypto.PrivateKey, 0)
+	if sender.isValidator(pkBytes) || sender.isHardforkSource(pkBytes) {
+		keys = append(keys, sk)
 	}
 
+	if !sender.shouldUseOriginalKeys() {
+		return keys, nil
+	}
+
+	for managedPk, managedSk := range sender.managedPeersHolder.GetManagedKeysByCurrentNode() {
+		if sender.isValidator([]byte(managedPk)) {
+			keys = append(keys, managedSk)
+		}
+	}
+
+	return keys, nil
+}
+
+func (sender *peerAuthenticationSender) execute(keys []crypto.PrivateKey) (error, bool) {
 	hardforkPayload, isTriggered := sender.getHardforkPayload()
 	payload := &heartbeat.Payload{
 		Timestamp:       time.Now().Unix(),
@@ -123,44 +145,59 @@ func (sender *peerAuthenticationSender) execute() (error, bool) {
 	if err != nil {
 		return err, isTriggered
 	}
-	msg.Payload = payloadBytes
-	msg.PayloadSignature, err = sender.messenger.Sign(payloadBytes)
+	payloadSignature, err := sender.messenger.Sign(payloadBytes)
 	if err != nil {
 		return err, isTriggered
 	}
 
-	msg.Pubkey, err = pk.ToByteArray()
-	if err != nil {
-		return err, isTriggered
-	}
-
-	msg.Signature, err = sender.peerSignatureHandler.GetPeerSignature(sk, msg.Pid)
-	if err != nil {
-		return err, isTriggered
+	b := &batch.Batch{
+		Data: make([][]byte, 0, len(keys)),
 	}
+	for _, sk := range keys {
+		msgBytes, errCreate := sender.createMessage(sk, payloadBytes, payloadSignature)
+		if errCreate != nil {
+			return errCreate, isTriggered
+		}
 
-	msgBytes, err := sender.marshaller.Marshal(msg)
-	if err != nil {
-		return err, isTriggered
+		b.Data = append(b.Data, msgBytes)
 	}
 
-	b := &batch.Batch{
-		Data: make([][]byte, 1),
-	}
-	b.Data[0] = msgBytes
 	data, err := sender.marshaller.Marshal(b)
 	if err != nil {
 		return err, isTriggered
 	}
 
-	log.Debug("sending peer authentication message",
-		"public key", msg.Pubkey, "pid", sender.messenger.ID().Pretty(),
+	log.Debug("sending peer authentication messages",
+		"num keys", len(keys), "pid", sender.messenger.ID().Pretty(),
 		"timestamp", payload.Timestamp)
 	sender.messenger.Broadcast(sender.topic, data)
 
 	return nil, isTriggered
 }
 
+func (sender *peerAuthenticationSender) createMessage(sk crypto.PrivateKey, payloadBytes []byte, payloadSignature []byte) ([]byte, error) {
+	msg := &heartbeat.PeerAuthentication{
+		Pid:              sender.messenger.ID().Bytes(),
+		Payload:          payloadBytes,
+		PayloadSignature: payloadSignature,
+	}
+
+	var err error
+	msg.Pubkey, err = sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Signature, err = sender.peerSignatureHandler.GetPeerSignature(sk, msg.Pid)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("created peer authentication message", "public key", msg.Pubkey)
+
+	return sender.marshaller.Marshal(msg)
+}
+
 // ShouldTriggerHardfork signals when hardfork message should be sent
 func (sender *peerAuthenticationSender) ShouldTriggerHardfork() <-chan struct{} {
 	return sender.hardforkTrigger.NotifyTriggerReceivedV2()
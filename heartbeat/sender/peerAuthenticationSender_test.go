@@ -37,6 +37,7 @@ func createMockPeerAuthenticationSenderArgs(argBase argBaseSender) argPeerAuthen
 		hardforkTrigger:          &testscommon.HardforkTriggerStub{},
 		hardforkTimeBetweenSends: time.Second,
 		hardforkTriggerPubKey:    providedHardforkPubKey,
+		managedPeersHolder:       &cryptoMocks.ManagedPeersHolderStub{},
 	}
 }
 
@@ -64,6 +65,7 @@ func createMockPeerAuthenticationSenderArgsSemiIntegrationTests(baseArg argBaseS
 		hardforkTrigger:          &testscommon.HardforkTriggerStub{},
 		hardforkTimeBetweenSends: time.Second,
 		hardforkTriggerPubKey:    providedHardforkPubKey,
+		managedPeersHolder:       &cryptoMocks.ManagedPeersHolderStub{},
 	}
 }
 
@@ -205,6 +207,16 @@ func TestNewPeerAuthenticationSender(t *testing.T) {
 		assert.True(t, check.IfNil(sender))
 		assert.Equal(t, heartbeat.ErrNilHardforkTrigger, err)
 	})
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPeerAuthenticationSenderArgs(createMockBaseArgs())
+		args.managedPeersHolder = nil
+		sender, err := newPeerAuthenticationSender(args)
+
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, heartbeat.ErrNilManagedPeersHolder, err)
+	})
 	t.Run("invalid time between hardforks should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -246,7 +258,8 @@ func TestPeerAuthenticationSender_execute(t *testing.T) {
 		args := createMockPeerAuthenticationSenderArgs(argsBase)
 		sender, _ := newPeerAuthenticationSender(args)
 
-		err, isHardforkTriggered := sender.execute()
+		keys, _ := sender.getKeysToSend()
+		err, isHardforkTriggered := sender.execute(keys)
 		assert.Equal(t, expectedErr, err)
 		assert.False(t, isHardforkTriggered)
 	})
@@ -268,7 +281,8 @@ func TestPeerAuthenticationSender_execute(t *testing.T) {
 		args := createMockPeerAuthenticationSenderArgs(argsBase)
 		sender, _ := newPeerAuthenticationSender(args)
 
-		err, isHardforkTriggered := sender.execute()
+		keys, _ := sender.getKeysToSend()
+		err, isHardforkTriggered := sender.execute(keys)
 		assert.Equal(t, expectedErr, err)
 		assert.False(t, isHardforkTriggered)
 	})
@@ -289,7 +303,8 @@ func TestPeerAuthenticationSender_execute(t *testing.T) {
 		}
 		sender, _ := newPeerAuthenticationSender(args)
 
-		err, isHardforkTriggered := sender.execute()
+		keys, _ := sender.getKeysToSend()
+		err, isHardforkTriggered := sender.execute(keys)
 		assert.Equal(t, expectedErr, err)
 		assert.False(t, isHardforkTriggered)
 	})
@@ -316,7 +331,8 @@ func TestPeerAuthenticationSender_execute(t *testing.T) {
 		args := createMockPeerAuthenticationSenderArgs(argsBase)
 		sender, _ := newPeerAuthenticationSender(args)
 
-		err, isHardforkTriggered := sender.execute()
+		keys, _ := sender.getKeysToSend()
+		err, isHardforkTriggered := sender.execute(keys)
 		assert.Equal(t, expectedErr, err)
 		assert.False(t, isHardforkTriggered)
 	})
@@ -335,7 +351,8 @@ func TestPeerAuthenticationSender_execute(t *testing.T) {
 		args := createMockPeerAuthenticationSenderArgs(argsBase)
 		sender, _ := newPeerAuthenticationSender(args)
 
-		err, isHardforkTriggered := sender.execute()
+		keys, _ := sender.getKeysToSend()
+		err, isHardforkTriggered := sender.execute(keys)
 		assert.Nil(t, err)
 		assert.True(t, broadcastCalled)
 		assert.False(t, isHardforkTriggered)
@@ -375,7 +392,8 @@ func TestPeerAuthenticationSender_execute(t *testing.T) {
 		args := createMockPeerAuthenticationSenderArgsSemiIntegrationTests(argsBase)
 		sender, _ := newPeerAuthenticationSender(args)
 
-		err, isHardforkTriggered := sender.execute()
+		keys, _ := sender.getKeysToSend()
+		err, isHardforkTriggered := sender.execute(keys)
 		assert.Nil(t, err)
 		assert.False(t, isHardforkTriggered)
 
@@ -545,6 +563,107 @@ func TestPeerAuthenticationSender_Execute(t *testing.T) {
 	})
 }
 
+func createManagedPrivateKeyStub(pkBytes []byte) *cryptoMocks.PrivateKeyStub {
+	return &cryptoMocks.PrivateKeyStub{
+		GeneratePublicStub: func() crypto.PublicKey {
+			return &cryptoMocks.PublicKeyStub{
+				ToByteArrayStub: func() ([]byte, error) {
+					return pkBytes, nil
+				},
+			}
+		},
+	}
+}
+
+func TestPeerAuthenticationSender_ManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	managedValidatorKey := createManagedPrivateKeyStub([]byte("managed validator"))
+	managedObserverKey := createManagedPrivateKeyStub([]byte("managed observer"))
+	createArgs := func() argPeerAuthenticationSender {
+		args := createMockPeerAuthenticationSenderArgs(createMockBaseArgs())
+		args.nodesCoordinator = &shardingMocks.NodesCoordinatorStub{
+			GetValidatorWithPublicKeyCalled: func(publicKey []byte) (validator nodesCoordinator.Validator, shardId uint32, err error) {
+				if string(publicKey) == "managed observer" {
+					return nil, 0, errors.New("observer")
+				}
+
+				return nil, 0, nil
+			},
+		}
+		args.managedPeersHolder = &cryptoMocks.ManagedPeersHolderStub{
+			GetManagedKeysByCurrentNodeCalled: func() map[string]crypto.PrivateKey {
+				return map[string]crypto.PrivateKey{
+					"managed validator": managedValidatorKey,
+					"managed observer":  managedObserverKey,
+				}
+			},
+		}
+
+		return args
+	}
+
+	t.Run("should return the current key and the managed validator keys", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		sender, _ := newPeerAuthenticationSender(args)
+
+		keys, err := sender.getKeysToSend()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(keys))
+		assert.True(t, keys[0] == args.privKey)        // pointer testing
+		assert.True(t, keys[1] == managedValidatorKey) // pointer testing
+	})
+	t.Run("redundancy node with active main machine should not return the managed keys", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		observerKey := createManagedPrivateKeyStub([]byte("observer"))
+		args.redundancyHandler = &mock.RedundancyHandlerStub{
+			IsRedundancyNodeCalled: func() bool {
+				return true
+			},
+			IsMainMachineActiveCalled: func() bool {
+				return true
+			},
+			ObserverPrivateKeyCalled: func() crypto.PrivateKey {
+				return observerKey
+			},
+		}
+		sender, _ := newPeerAuthenticationSender(args)
+
+		keys, err := sender.getKeysToSend()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(keys))
+		assert.True(t, keys[0] == observerKey) // pointer testing
+	})
+	t.Run("should send one message for each key in the same batch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		var buffResulted []byte
+		args.messenger = &p2pmocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				buffResulted = buff
+			},
+		}
+		sender, _ := newPeerAuthenticationSender(args)
+
+		sender.Execute()
+
+		recoveredBatch := batch.Batch{}
+		err := args.marshaller.Unmarshal(&recoveredBatch, buffResulted)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(recoveredBatch.Data))
+
+		recoveredMessage := &heartbeat.PeerAuthentication{}
+		err = args.marshaller.Unmarshal(recoveredMessage, recoveredBatch.Data[1])
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("managed validator"), recoveredMessage.Pubkey)
+	})
+}
+
 func TestPeerAuthenticationSender_getCurrentPrivateAndPublicKeys(t *testing.T) {
 	t.Parallel()
 
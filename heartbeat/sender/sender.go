@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 )
 
@@ -34,6 +35,7 @@ type ArgSender struct {
 	HardforkTimeBetweenSends                    time.Duration
 	HardforkTriggerPubKey                       []byte
 	PeerTypeProvider                            heartbeat.PeerTypeProviderHandler
+	ManagedPeersHolder                          common.ManagedPeersHolder
 }
 
 // sender defines the component which sends authentication and heartbeat messages
@@ -65,6 +67,7 @@ func NewSender(args ArgSender) (*sender, error) {
 		hardforkTrigger:          args.HardforkTrigger,
 		hardforkTimeBetweenSends: args.HardforkTimeBetweenSends,
 		hardforkTriggerPubKey:    args.HardforkTriggerPubKey,
+		managedPeersHolder:       args.ManagedPeersHolder,
 	})
 	if err != nil {
 		return nil, err
@@ -115,6 +118,7 @@ func checkSenderArgs(args ArgSender) error {
 		hardforkTrigger:          args.HardforkTrigger,
 		hardforkTimeBetweenSends: args.HardforkTimeBetweenSends,
 		hardforkTriggerPubKey:    args.HardforkTriggerPubKey,
+		managedPeersHolder:       args.ManagedPeersHolder,
 	}
 	err := checkPeerAuthenticationSenderArgs(pasArg)
 	if err != nil {
@@ -43,6 +43,7 @@ func createMockSenderArgs() ArgSender {
 		HardforkTimeBetweenSends:                    time.Second,
 		HardforkTriggerPubKey:                       providedHardforkPubKey,
 		PeerTypeProvider:                            &mock.PeerTypeProviderStub{},
+		ManagedPeersHolder:                          &cryptoMocks.ManagedPeersHolderStub{},
 	}
 }
 
@@ -228,6 +229,16 @@ func TestNewSender(t *testing.T) {
 		assert.Nil(t, senderInstance)
 		assert.Equal(t, heartbeat.ErrNilHardforkTrigger, err)
 	})
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSenderArgs()
+		args.ManagedPeersHolder = nil
+		senderInstance, err := NewSender(args)
+
+		assert.Nil(t, senderInstance)
+		assert.Equal(t, heartbeat.ErrNilManagedPeersHolder, err)
+	})
 	t.Run("invalid time between hardforks should error", func(t *testing.T) {
 		t.Parallel()
 
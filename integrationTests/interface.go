@@ -1,6 +1,7 @@
 package integrationTests
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -64,11 +65,18 @@ type Facade interface {
 	GetNFTTokenIDsRegisteredByAddress(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetESDTTokensPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error)
+	IterateESDTTokens(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error) (string, api.BlockInfo, error)
+	GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryApiResponse, error)
 	GetESDTsRoles(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error)
+	IterateKeyValuePairs(address string, pageOptions common.AccountStoragePageOptions, options api.AccountQueryOptions, ctx context.Context, handler func(key string, value string) error) (string, api.BlockInfo, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*dataApi.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*dataApi.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*dataApi.Block, error)
+	GetHyperblockByHash(hash string, options dataApi.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	GetHyperblockByNonce(nonce uint64, options dataApi.BlockQueryOptions) (*common.HyperblockApiResponse, error)
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 	GetTotalStakedValue() (*dataApi.StakeValues, error)
@@ -87,11 +95,19 @@ type Facade interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBatchExecution(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error)
+	GetTransactionFinality(tx *transaction.ApiTransactionResult) string
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetEquivocations(publicKey string) ([]*common.EquivocationEvidence, error)
+	GetConsensusRound(round int64) (*common.ConsensusRoundTrace, error)
+	GetConsensusRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error)
+	GetConsensusSchedule(startRound int64, endRound int64) (*common.ConsensusSchedule, error)
+	GetValidatorLeaderSchedule(publicKey string) (*common.ValidatorLeaderSchedule, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
@@ -105,5 +121,10 @@ type Facade interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error)
+	GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error)
+	GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error)
 	IsInterfaceNil() bool
 }
@@ -4,6 +4,8 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
@@ -21,6 +23,8 @@ type CryptoComponentsStub struct {
 	BlKeyGen        crypto.KeyGenerator
 	TxKeyGen        crypto.KeyGenerator
 	MsgSigVerifier  vm.MessageSignVerifier
+	ManagedPeers    common.ManagedPeersHolder
+	SigHandler      consensus.SigningHandler
 	mutMultiSig     sync.RWMutex
 }
 
@@ -114,6 +118,16 @@ func (ccs *CryptoComponentsStub) MessageSignVerifier() vm.MessageSignVerifier {
 	return ccs.MsgSigVerifier
 }
 
+// ManagedPeersHolder -
+func (ccs *CryptoComponentsStub) ManagedPeersHolder() common.ManagedPeersHolder {
+	return ccs.ManagedPeers
+}
+
+// SigningHandler -
+func (ccs *CryptoComponentsStub) SigningHandler() consensus.SigningHandler {
+	return ccs.SigHandler
+}
+
 // Clone -
 func (ccs *CryptoComponentsStub) Clone() interface{} {
 	return &CryptoComponentsStub{
@@ -129,6 +143,8 @@ func (ccs *CryptoComponentsStub) Clone() interface{} {
 		BlKeyGen:        ccs.BlKeyGen,
 		TxKeyGen:        ccs.TxKeyGen,
 		MsgSigVerifier:  ccs.MsgSigVerifier,
+		ManagedPeers:    ccs.ManagedPeers,
+		SigHandler:      ccs.SigHandler,
 		mutMultiSig:     sync.RWMutex{},
 	}
 }
@@ -40,6 +40,11 @@ func (nscm *networkShardingCollectorMock) UpdatePeerIDPublicKeyPair(pid core.Pee
 	nscm.mutMaps.Unlock()
 }
 
+// UpdatePeerIDPublicKeyPairFromBatch -
+func (nscm *networkShardingCollectorMock) UpdatePeerIDPublicKeyPairFromBatch(pid core.PeerID, pk []byte, _ []byte) {
+	nscm.UpdatePeerIDPublicKeyPair(pid, pk)
+}
+
 // UpdatePeerIDInfo -
 func (nscm *networkShardingCollectorMock) UpdatePeerIDInfo(pid core.PeerID, pk []byte, shardID uint32) {
 	nscm.mutMaps.Lock()
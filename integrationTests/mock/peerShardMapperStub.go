@@ -8,6 +8,8 @@ type PeerShardMapperStub struct {
 	UpdatePeerIDPublicKeyPairCalled func(pid core.PeerID, pk []byte)
 	PutPeerIdShardIdCalled          func(pid core.PeerID, shardID uint32)
 	PutPeerIdSubTypeCalled          func(pid core.PeerID, peerSubType core.P2PPeerSubType)
+
+	UpdatePeerIDPublicKeyPairFromBatchCalled func(pid core.PeerID, pk []byte, batchID []byte)
 }
 
 // UpdatePeerIDPublicKeyPair -
@@ -17,6 +19,13 @@ func (psms *PeerShardMapperStub) UpdatePeerIDPublicKeyPair(pid core.PeerID, pk [
 	}
 }
 
+// UpdatePeerIDPublicKeyPairFromBatch -
+func (psms *PeerShardMapperStub) UpdatePeerIDPublicKeyPairFromBatch(pid core.PeerID, pk []byte, batchID []byte) {
+	if psms.UpdatePeerIDPublicKeyPairFromBatchCalled != nil {
+		psms.UpdatePeerIDPublicKeyPairFromBatchCalled(pid, pk, batchID)
+	}
+}
+
 // PutPeerIdShardId -
 func (psms *PeerShardMapperStub) PutPeerIdShardId(pid core.PeerID, shardID uint32) {
 	if psms.PutPeerIdShardIdCalled != nil {
@@ -94,6 +94,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/bootstrapMocks"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	dblookupextMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
@@ -581,6 +582,7 @@ func NewTestProcessorNodeWithFullGenesis(
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -789,6 +791,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -839,6 +842,7 @@ func (tpn *TestProcessorNode) initTestNodeWithTrieDBAndGasModel(trieStore storag
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -1017,6 +1021,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -3146,6 +3151,8 @@ func GetDefaultCryptoComponents() *mock.CryptoComponentsStub {
 		BlKeyGen:        &mock.KeyGenMock{},
 		TxKeyGen:        &mock.KeyGenMock{},
 		MsgSigVerifier:  &testscommon.MessageSignVerifierMock{},
+		ManagedPeers:    &cryptoMocks.ManagedPeersHolderStub{},
+		SigHandler:      &consensusMocks.SigningHandlerStub{},
 	}
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
 )
@@ -154,6 +155,7 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
+	"github.com/ElrondNetwork/elrond-go/node/external/feeMarket"
 	"github.com/ElrondNetwork/elrond-go/node/external/transactionAPI"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
@@ -217,6 +218,8 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		TxTypeHandler:            txTypeHandler,
 		LogsFacade:               logsFacade,
 		DataFieldParser:          dataFieldParser,
+		ForkDetector:             tpn.ForkDetector,
+		BlockTracker:             tpn.BlockTracker,
 	}
 	apiTransactionHandler, err := transactionAPI.NewAPITransactionProcessor(argsApiTransactionProc)
 	log.LogIfError(err)
@@ -243,25 +246,43 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	apiInternalBlockProcessor, err := blockAPI.CreateAPIInternalBlockProcessor(argsBlockAPI)
 	log.LogIfError(err)
 
+	argsHyperblockAPI := &blockAPI.ArgAPIHyperblockProcessor{
+		ArgAPIBlockProcessor: argsBlockAPI,
+		ForkDetector:         tpn.ForkDetector,
+	}
+	apiHyperblockProcessor, err := blockAPI.CreateAPIHyperblockProcessor(argsHyperblockAPI)
+	log.LogIfError(err)
+
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:           tpn.SCQueryService,
-		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
-		TxCostHandler:            txCostHandler,
-		TotalStakedValueHandler:  totalStakedValueHandler,
-		DirectStakedListHandler:  directStakedListHandler,
-		DelegatedListHandler:     delegatedListHandler,
-		APITransactionHandler:    apiTransactionHandler,
-		APIBlockHandler:          blockAPIHandler,
-		APIInternalBlockHandler:  apiInternalBlockProcessor,
-		GenesisNodesSetupHandler: &mock.NodesSetupStub{},
-		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
-		AccountsParser:           &genesisMocks.AccountsParserStub{},
-		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
+		SCQueryService:            tpn.SCQueryService,
+		StatusMetricsHandler:      &testscommon.StatusMetricsStub{},
+		TxCostHandler:             txCostHandler,
+		TotalStakedValueHandler:   totalStakedValueHandler,
+		DirectStakedListHandler:   directStakedListHandler,
+		DelegatedListHandler:      delegatedListHandler,
+		APITransactionHandler:     apiTransactionHandler,
+		APIBlockHandler:           blockAPIHandler,
+		APIInternalBlockHandler:   apiInternalBlockProcessor,
+		APIHyperblockHandler:      apiHyperblockProcessor,
+		GenesisNodesSetupHandler:  &mock.NodesSetupStub{},
+		ValidatorPubKeyConverter:  &testscommon.PubkeyConverterMock{},
+		AccountsParser:            &genesisMocks.AccountsParserStub{},
+		GasScheduleNotifier:       &testscommon.GasScheduleNotifierMock{},
+		GasPriceSuggestionHandler: feeMarket.NewDisabledGasPriceEstimator(),
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
 	log.LogIfError(err)
 
+	argsSimulationAccountsDB := txsimulator.ArgsSimulationAccountsDB{
+		AccountsDB:      tpn.AccntState,
+		PubkeyConverter: TestAddressPubkeyConverter,
+		Hasher:          TestHasher,
+		Marshalizer:     TestMarshalizer,
+	}
+	simulationAccountsDB, err := txsimulator.NewSimulationAccountsDB(argsSimulationAccountsDB)
+	log.LogIfError(err)
+
 	argSimulator := txsimulator.ArgsTxSimulator{
 		TransactionProcessor:      tpn.TxProcessor,
 		IntermediateProcContainer: tpn.InterimProcContainer,
@@ -270,6 +291,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		Marshalizer:               TestMarshalizer,
 		Hasher:                    TestHasher,
 		VMOutputCacher:            &testscommon.CacherMock{},
+		AccountsHandler:           simulationAccountsDB,
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
@@ -308,6 +330,16 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["hardfork"] = hardforkGroup
 	}
 
+	hyperblockGroup, err := groups.NewHyperblockGroup(facade)
+	if err == nil {
+		groupsMap["hyperblock"] = hyperblockGroup
+	}
+
+	logsGroup, err := groups.NewLogsGroup(facade)
+	if err == nil {
+		groupsMap["logs"] = logsGroup
+	}
+
 	networkGroup, err := groups.NewNetworkGroup(facade)
 	if err == nil {
 		groupsMap["network"] = networkGroup
@@ -25,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
 )
@@ -148,6 +149,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
+		&cryptoMocks.ManagedPeersHolderStub{},
 	)
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
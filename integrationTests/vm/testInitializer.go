@@ -872,7 +872,13 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	// create transaction simulator
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accnts)
+	argsSimulationAccountsDB := txsimulator.ArgsSimulationAccountsDB{
+		AccountsDB:      accnts,
+		PubkeyConverter: pubkeyConv,
+		Hasher:          testHasher,
+		Marshalizer:     testMarshalizer,
+	}
+	simulationAccountsDB, err := txsimulator.NewSimulationAccountsDB(argsSimulationAccountsDB)
 	if err != nil {
 		return nil, err
 	}
@@ -918,7 +924,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	argsNewSCProcessor.TxFeeHandler = &processDisabled.FeeHandler{}
 	argsNewTxProcessor.TxFeeHandler = &processDisabled.FeeHandler{}
 
-	argsNewSCProcessor.AccountsDB = readOnlyAccountsDB
+	argsNewSCProcessor.AccountsDB = simulationAccountsDB
 
 	vmOutputCacher, _ := storageUnit.NewCache(storageUnit.CacheConfig{
 		Type:     storageUnit.LRUCache,
@@ -930,6 +936,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		VMOutputCacher:         vmOutputCacher,
 		Marshalizer:            testMarshalizer,
 		Hasher:                 testHasher,
+		AccountsHandler:        simulationAccountsDB,
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
@@ -940,7 +947,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 	argsNewTxProcessor.ScProcessor = scProcessorTxSim
 
-	argsNewTxProcessor.Accounts = readOnlyAccountsDB
+	argsNewTxProcessor.Accounts = simulationAccountsDB
 
 	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
@@ -0,0 +1,33 @@
+package keysManagement
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilMultiSignerCreator signals that a nil multi signer creator has been provided
+var ErrNilMultiSignerCreator = errors.New("nil multi signer creator")
+
+// ErrDuplicatedKey signals that a key is already managed by the node
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrMissingPublicKeyDefinition signals that a public key definition is missing
+var ErrMissingPublicKeyDefinition = errors.New("missing public key definition")
+
+// ErrInvalidPemFile signals that the PEM file is not valid
+var ErrInvalidPemFile = errors.New("invalid PEM file")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilMultiSigner signals that a nil multi signer has been provided
+var ErrNilMultiSigner = errors.New("nil multi signer")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrPublicKeyMismatch signals that the declared public key does not match the one derived from the private key
+var ErrPublicKeyMismatch = errors.New("public key mismatch between the declared one and the one derived from the private key")
@@ -0,0 +1,11 @@
+package keysManagement
+
+import (
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// MultiSignerCreator defines the component able to create the multi signer of a managed key
+type MultiSignerCreator interface {
+	CreateMultiSigner(privateKey crypto.PrivateKey, publicKeyBytes []byte) (crypto.MultiSigner, error)
+	IsInterfaceNil() bool
+}
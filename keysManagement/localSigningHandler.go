@@ -0,0 +1,80 @@
+package keysManagement
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ArgsLocalSigningHandler represents the argument for the local signing handler
+type ArgsLocalSigningHandler struct {
+	PrivateKey         crypto.PrivateKey
+	SingleSigner       crypto.SingleSigner
+	MultiSigner        crypto.MultiSigner
+	ManagedPeersHolder common.ManagedPeersHolder
+}
+
+type localSigningHandler struct {
+	privateKey         crypto.PrivateKey
+	singleSigner       crypto.SingleSigner
+	multiSigner        crypto.MultiSigner
+	managedPeersHolder common.ManagedPeersHolder
+}
+
+// NewLocalSigningHandler creates a consensus signing handler which signs with the keys loaded by the current node: its
+// own key and the keys it manages
+func NewLocalSigningHandler(args ArgsLocalSigningHandler) (*localSigningHandler, error) {
+	if check.IfNil(args.PrivateKey) {
+		return nil, ErrNilPrivateKey
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.MultiSigner) {
+		return nil, ErrNilMultiSigner
+	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return nil, ErrNilManagedPeersHolder
+	}
+
+	return &localSigningHandler{
+		privateKey:         args.PrivateKey,
+		singleSigner:       args.SingleSigner,
+		multiSigner:        args.MultiSigner,
+		managedPeersHolder: args.ManagedPeersHolder,
+	}, nil
+}
+
+// CreateSignatureShareForPublicKey creates the signature share of the provided public key. The node's own share is
+// created by the main multi signer, which also stores it
+func (handler *localSigningHandler) CreateSignatureShareForPublicKey(message []byte, publicKeyBytes []byte, _ []byte, _ uint32) ([]byte, error) {
+	if !handler.managedPeersHolder.IsKeyManagedByCurrentNode(publicKeyBytes) {
+		return handler.multiSigner.CreateSignatureShare(message, nil)
+	}
+
+	multiSigner, err := handler.managedPeersHolder.GetMultiSigner(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return multiSigner.CreateSignatureShare(message, nil)
+}
+
+// CreateBlockSignatureForPublicKey signs the provided marshalled block header with the key of the provided public key
+func (handler *localSigningHandler) CreateBlockSignatureForPublicKey(message []byte, publicKeyBytes []byte, _ uint32, _ int64) ([]byte, error) {
+	privateKey := handler.privateKey
+	if handler.managedPeersHolder.IsKeyManagedByCurrentNode(publicKeyBytes) {
+		var err error
+		privateKey, err = handler.managedPeersHolder.GetPrivateKey(publicKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return handler.singleSigner.Sign(privateKey, message)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *localSigningHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,130 @@
+package keysManagement_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var managedPk = []byte("managed pk")
+
+func createMockArgsLocalSigningHandler() keysManagement.ArgsLocalSigningHandler {
+	return keysManagement.ArgsLocalSigningHandler{
+		PrivateKey:   &cryptoMocks.PrivateKeyStub{},
+		SingleSigner: &cryptoMocks.SingleSignerStub{},
+		MultiSigner: &cryptoMocks.MultisignerStub{
+			CreateSignatureShareCalled: func(msg []byte, bitmap []byte) ([]byte, error) {
+				return []byte("own share"), nil
+			},
+		},
+		ManagedPeersHolder: &cryptoMocks.ManagedPeersHolderStub{
+			IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+				return string(pkBytes) == string(managedPk)
+			},
+		},
+	}
+}
+
+func TestNewLocalSigningHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.PrivateKey = nil
+		handler, err := keysManagement.NewLocalSigningHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, keysManagement.ErrNilPrivateKey, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.SingleSigner = nil
+		handler, err := keysManagement.NewLocalSigningHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, keysManagement.ErrNilSingleSigner, err)
+	})
+	t.Run("nil multi signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.MultiSigner = nil
+		handler, err := keysManagement.NewLocalSigningHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, keysManagement.ErrNilMultiSigner, err)
+	})
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalSigningHandler()
+		args.ManagedPeersHolder = nil
+		handler, err := keysManagement.NewLocalSigningHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, keysManagement.ErrNilManagedPeersHolder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := keysManagement.NewLocalSigningHandler(createMockArgsLocalSigningHandler())
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestLocalSigningHandler_CreateSignatureShareForPublicKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsLocalSigningHandler()
+	holder := args.ManagedPeersHolder.(*cryptoMocks.ManagedPeersHolderStub)
+	holder.GetMultiSignerCalled = func(pkBytes []byte) (crypto.MultiSigner, error) {
+		return &cryptoMocks.MultisignerStub{
+			CreateSignatureShareCalled: func(msg []byte, bitmap []byte) ([]byte, error) {
+				return []byte("managed share"), nil
+			},
+		}, nil
+	}
+	handler, _ := keysManagement.NewLocalSigningHandler(args)
+
+	share, err := handler.CreateSignatureShareForPublicKey([]byte("hash"), []byte("own pk"), []byte("header"), 0)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("own share"), share)
+
+	share, err = handler.CreateSignatureShareForPublicKey([]byte("hash"), managedPk, []byte("header"), 0)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("managed share"), share)
+}
+
+func TestLocalSigningHandler_CreateBlockSignatureForPublicKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsLocalSigningHandler()
+	managedKey := &cryptoMocks.PrivateKeyStub{}
+	holder := args.ManagedPeersHolder.(*cryptoMocks.ManagedPeersHolderStub)
+	holder.GetPrivateKeyCalled = func(pkBytes []byte) (crypto.PrivateKey, error) {
+		return managedKey, nil
+	}
+	var signingKey crypto.PrivateKey
+	args.SingleSigner = &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			signingKey = private
+			return []byte("signature"), nil
+		},
+	}
+	handler, _ := keysManagement.NewLocalSigningHandler(args)
+
+	signature, err := handler.CreateBlockSignatureForPublicKey([]byte("header"), []byte("own pk"), 0, 1)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("signature"), signature)
+	assert.True(t, signingKey == args.PrivateKey) // pointer testing
+
+	_, err = handler.CreateBlockSignatureForPublicKey([]byte("header"), managedPk, 0, 1)
+	require.Nil(t, err)
+	assert.True(t, signingKey == managedKey) // pointer testing
+}
@@ -0,0 +1,151 @@
+package keysManagement
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("keysManagement")
+
+// ArgsManagedPeersHolder represents the argument for the managed peers holder
+type ArgsManagedPeersHolder struct {
+	KeyGenerator       crypto.KeyGenerator
+	MultiSignerCreator MultiSignerCreator
+}
+
+type peerInfo struct {
+	privateKey  crypto.PrivateKey
+	multiSigner crypto.MultiSigner
+}
+
+type managedPeersHolder struct {
+	mut                sync.RWMutex
+	data               map[string]*peerInfo
+	keyGenerator       crypto.KeyGenerator
+	multiSignerCreator MultiSignerCreator
+}
+
+// NewManagedPeersHolder creates a new instance of a managed peers holder. It holds the validator keys that are
+// managed by the current node, besides its own key
+func NewManagedPeersHolder(args ArgsManagedPeersHolder) (*managedPeersHolder, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(args.MultiSignerCreator) {
+		return nil, ErrNilMultiSignerCreator
+	}
+
+	return &managedPeersHolder{
+		data:               make(map[string]*peerInfo),
+		keyGenerator:       args.KeyGenerator,
+		multiSignerCreator: args.MultiSignerCreator,
+	}, nil
+}
+
+// AddManagedPeer adds the private key to the keys managed by the current node
+func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
+	privateKey, err := holder.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return fmt.Errorf("%w for provided bytes %s", err, core.GetTrimmedPk(fmt.Sprintf("%x", privateKeyBytes)))
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	multiSigner, err := holder.multiSignerCreator.CreateMultiSigner(privateKey, publicKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	_, found := holder.data[string(publicKeyBytes)]
+	if found {
+		return fmt.Errorf("%w for public key %x", ErrDuplicatedKey, publicKeyBytes)
+	}
+
+	holder.data[string(publicKeyBytes)] = &peerInfo{
+		privateKey:  privateKey,
+		multiSigner: multiSigner,
+	}
+
+	log.Debug("added new key definition", "public key", publicKeyBytes)
+
+	return nil
+}
+
+func (holder *managedPeersHolder) getPeerInfo(pkBytes []byte) (*peerInfo, error) {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	pInfo, found := holder.data[string(pkBytes)]
+	if !found {
+		return nil, fmt.Errorf("%w for public key %x", ErrMissingPublicKeyDefinition, pkBytes)
+	}
+
+	return pInfo, nil
+}
+
+// GetPrivateKey returns the private key of the provided managed public key
+func (holder *managedPeersHolder) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	pInfo, err := holder.getPeerInfo(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return pInfo.privateKey, nil
+}
+
+// GetMultiSigner returns the multi signer of the provided managed public key
+func (holder *managedPeersHolder) GetMultiSigner(pkBytes []byte) (crypto.MultiSigner, error) {
+	pInfo, err := holder.getPeerInfo(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return pInfo.multiSigner, nil
+}
+
+// GetManagedKeysByCurrentNode returns the private keys managed by the current node, mapped by their public keys
+func (holder *managedPeersHolder) GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	managedKeys := make(map[string]crypto.PrivateKey, len(holder.data))
+	for pk, pInfo := range holder.data {
+		managedKeys[pk] = pInfo.privateKey
+	}
+
+	return managedKeys
+}
+
+// IsKeyManagedByCurrentNode returns true if the provided public key is managed by the current node
+func (holder *managedPeersHolder) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	_, found := holder.data[string(pkBytes)]
+
+	return found
+}
+
+// IsMultiKeyMode returns true if the current node manages other keys besides its own
+func (holder *managedPeersHolder) IsMultiKeyMode() bool {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	return len(holder.data) > 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *managedPeersHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
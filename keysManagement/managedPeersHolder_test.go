@@ -0,0 +1,157 @@
+package keysManagement_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsManagedPeersHolder() keysManagement.ArgsManagedPeersHolder {
+	return keysManagement.ArgsManagedPeersHolder{
+		KeyGenerator:       signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		MultiSignerCreator: &cryptoMocks.MultiSignerCreatorStub{},
+	}
+}
+
+func generateKey(t *testing.T, keyGen crypto.KeyGenerator) ([]byte, []byte) {
+	sk, pk := keyGen.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	require.Nil(t, err)
+	pkBytes, err := pk.ToByteArray()
+	require.Nil(t, err)
+
+	return skBytes, pkBytes
+}
+
+func TestNewManagedPeersHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+		args.KeyGenerator = nil
+		holder, err := keysManagement.NewManagedPeersHolder(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, keysManagement.ErrNilKeyGenerator, err)
+	})
+	t.Run("nil multi signer creator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+		args.MultiSignerCreator = nil
+		holder, err := keysManagement.NewManagedPeersHolder(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, keysManagement.ErrNilMultiSignerCreator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder, err := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		assert.False(t, check.IfNil(holder))
+		assert.Nil(t, err)
+		assert.False(t, holder.IsMultiKeyMode())
+	})
+}
+
+func TestManagedPeersHolder_AddManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		err := holder.AddManagedPeer([]byte("invalid"))
+		assert.NotNil(t, err)
+		assert.False(t, holder.IsMultiKeyMode())
+	})
+	t.Run("multi signer creation fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsManagedPeersHolder()
+		args.MultiSignerCreator = &cryptoMocks.MultiSignerCreatorStub{
+			CreateMultiSignerCalled: func(privateKey crypto.PrivateKey, publicKeyBytes []byte) (crypto.MultiSigner, error) {
+				return nil, expectedErr
+			},
+		}
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		skBytes, _ := generateKey(t, args.KeyGenerator)
+
+		err := holder.AddManagedPeer(skBytes)
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, holder.IsMultiKeyMode())
+	})
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		skBytes, _ := generateKey(t, args.KeyGenerator)
+
+		err := holder.AddManagedPeer(skBytes)
+		assert.Nil(t, err)
+
+		err = holder.AddManagedPeer(skBytes)
+		assert.True(t, errors.Is(err, keysManagement.ErrDuplicatedKey))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+		multiSigner := &cryptoMocks.MultisignerStub{}
+		var receivedPublicKeyBytes []byte
+		args.MultiSignerCreator = &cryptoMocks.MultiSignerCreatorStub{
+			CreateMultiSignerCalled: func(privateKey crypto.PrivateKey, publicKeyBytes []byte) (crypto.MultiSigner, error) {
+				receivedPublicKeyBytes = publicKeyBytes
+				return multiSigner, nil
+			},
+		}
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		skBytes, pkBytes := generateKey(t, args.KeyGenerator)
+
+		err := holder.AddManagedPeer(skBytes)
+		require.Nil(t, err)
+		assert.Equal(t, pkBytes, receivedPublicKeyBytes)
+		assert.True(t, holder.IsMultiKeyMode())
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+
+		privateKey, err := holder.GetPrivateKey(pkBytes)
+		require.Nil(t, err)
+		recoveredSkBytes, _ := privateKey.ToByteArray()
+		assert.Equal(t, skBytes, recoveredSkBytes)
+
+		recoveredMultiSigner, err := holder.GetMultiSigner(pkBytes)
+		require.Nil(t, err)
+		assert.True(t, recoveredMultiSigner == multiSigner) // pointer testing
+
+		managedKeys := holder.GetManagedKeysByCurrentNode()
+		require.Len(t, managedKeys, 1)
+		assert.Equal(t, privateKey, managedKeys[string(pkBytes)])
+	})
+}
+
+func TestManagedPeersHolder_MissingKeyShouldError(t *testing.T) {
+	t.Parallel()
+
+	holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+	missingPk := []byte("missing")
+
+	assert.False(t, holder.IsKeyManagedByCurrentNode(missingPk))
+
+	privateKey, err := holder.GetPrivateKey(missingPk)
+	assert.Nil(t, privateKey)
+	assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+
+	multiSigner, err := holder.GetMultiSigner(missingPk)
+	assert.Nil(t, multiSigner)
+	assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+}
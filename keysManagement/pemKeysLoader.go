@@ -0,0 +1,70 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// LoadAllKeysFromPemFile reads all the private keys from the provided PEM file, along with their encoded public keys,
+// as written by the keygenerator tool. Each declared public key is checked against the one derived from its private key
+func LoadAllKeysFromPemFile(path string, keyGen crypto.KeyGenerator) ([][]byte, []string, error) {
+	if check.IfNil(keyGen) {
+		return nil, nil, ErrNilKeyGenerator
+	}
+
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKeys := make([][]byte, 0)
+	publicKeys := make([]string, 0)
+	for len(strings.TrimSpace(string(buff))) > 0 {
+		var block *pem.Block
+		block, buff = pem.Decode(buff)
+		if block == nil {
+			return nil, nil, fmt.Errorf("%w: %s, block index %d", ErrInvalidPemFile, path, len(privateKeys))
+		}
+
+		privateKey, errDecode := hex.DecodeString(string(block.Bytes))
+		if errDecode != nil {
+			return nil, nil, fmt.Errorf("%w for encoded secret key in %s, block index %d", errDecode, path, len(privateKeys))
+		}
+
+		typeParts := strings.Split(block.Type, " ")
+		publicKey := typeParts[len(typeParts)-1]
+		err = checkDeclaredPublicKey(keyGen, privateKey, publicKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w in %s, block index %d", err, path, len(privateKeys))
+		}
+
+		privateKeys = append(privateKeys, privateKey)
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return privateKeys, publicKeys, nil
+}
+
+func checkDeclaredPublicKey(keyGen crypto.KeyGenerator, privateKeyBytes []byte, declaredPublicKey string) error {
+	privateKey, err := keyGen.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	if hex.EncodeToString(publicKeyBytes) != strings.ToLower(declaredPublicKey) {
+		return ErrPublicKeyMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package keysManagement_test
+
+import (
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePemFile(t *testing.T, content []byte) string {
+	dir, err := ioutil.TempDir("", "pemKeysLoader")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	path := filepath.Join(dir, "allValidatorsKeys.pem")
+	err = ioutil.WriteFile(path, content, 0644)
+	require.Nil(t, err)
+
+	return path
+}
+
+func generatePemBlock(t *testing.T, keyGen crypto.KeyGenerator) ([]byte, []byte, string) {
+	sk, pk := keyGen.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	require.Nil(t, err)
+	pkBytes, err := pk.ToByteArray()
+	require.Nil(t, err)
+
+	pkHex := hex.EncodeToString(pkBytes)
+	block := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY for " + pkHex, Bytes: []byte(hex.EncodeToString(skBytes))})
+
+	return block, skBytes, pkHex
+}
+
+func TestLoadAllKeysFromPemFile(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile("missing.pem", nil)
+		assert.Equal(t, keysManagement.ErrNilKeyGenerator, err)
+		assert.Nil(t, privateKeys)
+		assert.Nil(t, publicKeys)
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile("missing.pem", keyGen)
+		assert.NotNil(t, err)
+		assert.Nil(t, privateKeys)
+		assert.Nil(t, publicKeys)
+	})
+	t.Run("invalid content should error", func(t *testing.T) {
+		t.Parallel()
+
+		path := writePemFile(t, []byte("not a PEM file"))
+		_, _, err := keysManagement.LoadAllKeysFromPemFile(path, keyGen)
+		assert.True(t, errors.Is(err, keysManagement.ErrInvalidPemFile))
+	})
+	t.Run("invalid secret key encoding should error", func(t *testing.T) {
+		t.Parallel()
+
+		content := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY for pk0", Bytes: []byte("not hex")})
+		path := writePemFile(t, content)
+		_, _, err := keysManagement.LoadAllKeysFromPemFile(path, keyGen)
+		assert.NotNil(t, err)
+	})
+	t.Run("empty file should return no keys", func(t *testing.T) {
+		t.Parallel()
+
+		path := writePemFile(t, []byte("\n"))
+		privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(path, keyGen)
+		assert.Nil(t, err)
+		assert.Empty(t, privateKeys)
+		assert.Empty(t, publicKeys)
+	})
+	t.Run("mismatched public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		block0, _, _ := generatePemBlock(t, keyGen)
+		_, sk1, _ := generatePemBlock(t, keyGen)
+		_, _, pk2 := generatePemBlock(t, keyGen)
+		content := append(block0, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY for " + pk2, Bytes: []byte(hex.EncodeToString(sk1))})...)
+		path := writePemFile(t, content)
+
+		privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(path, keyGen)
+		assert.True(t, errors.Is(err, keysManagement.ErrPublicKeyMismatch))
+		assert.Nil(t, privateKeys)
+		assert.Nil(t, publicKeys)
+	})
+	t.Run("should load all keys", func(t *testing.T) {
+		t.Parallel()
+
+		block0, sk0, pk0 := generatePemBlock(t, keyGen)
+		block1, sk1, pk1 := generatePemBlock(t, keyGen)
+		path := writePemFile(t, append(block0, block1...))
+
+		privateKeys, publicKeys, err := keysManagement.LoadAllKeysFromPemFile(path, keyGen)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{sk0, sk1}, privateKeys)
+		assert.Equal(t, []string{pk0, pk1}, publicKeys)
+	})
+}
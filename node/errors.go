@@ -127,8 +127,35 @@ var ErrCannotCastAccountHandlerToUserAccountHandler = errors.New("cannot cast ac
 // ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler signals that an user account handler cannot be cast to vm common user account handler
 var ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler = errors.New("cannot cast user account handler to vm common user account handler")
 
+// ErrFullArchiveOnlyEndpoint signals that an endpoint was called, but it is only available for full archive nodes
+var ErrFullArchiveOnlyEndpoint = errors.New("the endpoint is only available on full archive nodes")
+
+// ErrInvalidAccountHistoryRange signals that an invalid blocks range has been provided for the account history
+var ErrInvalidAccountHistoryRange = errors.New("invalid account history range")
+
 // ErrTrieOperationsTimeout signals that a trie operation took too long
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
 
 // ErrNilStorer signals the using of a nil storer
 var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidPageLimit signals that an invalid page limit has been provided
+var ErrInvalidPageLimit = errors.New("invalid page limit")
+
+// ErrInvalidPublicKey signals that an invalid public key has been provided
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
+// ErrEquivocationDetectionDisabled signals that the equivocations were requested, but the detection is disabled
+var ErrEquivocationDetectionDisabled = errors.New("equivocation detection is disabled")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrInvalidRoundsRange signals that an invalid range of rounds has been provided
+var ErrInvalidRoundsRange = errors.New("invalid rounds range")
+
+// ErrValidatorNotEligible signals that the provided validator is not eligible in the current epoch
+var ErrValidatorNotEligible = errors.New("validator is not eligible")
+
+// ErrNilBlockHeader signals that a nil block header has been provided
+var ErrNilBlockHeader = errors.New("nil block header")
@@ -2,6 +2,7 @@ package blockAPI
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/batch"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
@@ -41,6 +42,27 @@ func CreateAPIInternalBlockProcessor(arg *ArgAPIBlockProcessor) (APIInternalBloc
 	return newInternalBlockProcessor(arg, emptyReceiptsHash), nil
 }
 
+// CreateAPIHyperblockProcessor will create a new instance of APIHyperblockHandler
+func CreateAPIHyperblockProcessor(arg *ArgAPIHyperblockProcessor) (APIHyperblockHandler, error) {
+	if arg == nil {
+		return nil, errNilArgAPIBlockProcessor
+	}
+	err := checkNilArg(arg.ArgAPIBlockProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.ForkDetector) {
+		return nil, errNilForkDetector
+	}
+
+	emptyReceiptsHash, err := computeEmptyReceiptsHash(arg.Marshalizer, arg.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return newHyperblockApiProcessor(arg, emptyReceiptsHash), nil
+}
+
 func computeEmptyReceiptsHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher) ([]byte, error) {
 	allReceiptsHashes := make([][]byte, 0)
 
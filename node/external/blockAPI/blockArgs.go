@@ -24,3 +24,9 @@ type ArgAPIBlockProcessor struct {
 	LogsFacade               logsFacade
 	ReceiptsRepository       receiptsRepository
 }
+
+// ArgAPIHyperblockProcessor is structure that store components that are needed to create an api hyperblock processor
+type ArgAPIHyperblockProcessor struct {
+	*ArgAPIBlockProcessor
+	ForkDetector forkDetector
+}
@@ -12,6 +12,7 @@ var (
 	errNilTransactionHandler   = errors.New("nil API transaction handler")
 	errNilLogsFacade           = errors.New("nil logs facade")
 	errNilReceiptsRepository   = errors.New("nil receipts repository")
+	errNilForkDetector         = errors.New("nil fork detector")
 )
 
 func checkNilArg(arg *ArgAPIBlockProcessor) error {
@@ -0,0 +1,335 @@
+package blockAPI
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
+)
+
+// maxDestinationNotarizationLookahead is the maximum number of metachain blocks, following a hyperblock, searched for
+// the notarization at destination of its cross shard miniblocks
+const maxDestinationNotarizationLookahead = 100
+
+type hyperblockAPIProcessor struct {
+	*baseAPIBlockProcessor
+	forkDetector forkDetector
+}
+
+type metaNotarization struct {
+	nonce uint64
+	hash  []byte
+}
+
+type hyperblockMiniBlock struct {
+	header                     *block.MiniBlockHeader
+	apiMiniBlock               *common.HyperblockMiniBlock
+	notarizedAtSourceHash      []byte
+	notarizedAtDestinationHash []byte
+}
+
+// newHyperblockApiProcessor will create a new instance of hyperblock api processor
+func newHyperblockApiProcessor(arg *ArgAPIHyperblockProcessor, emptyReceiptsHash []byte) *hyperblockAPIProcessor {
+	hasDbLookupExtensions := arg.HistoryRepo.IsEnabled()
+
+	return &hyperblockAPIProcessor{
+		baseAPIBlockProcessor: &baseAPIBlockProcessor{
+			hasDbLookupExtensions:    hasDbLookupExtensions,
+			selfShardID:              arg.SelfShardID,
+			store:                    arg.Store,
+			marshalizer:              arg.Marshalizer,
+			uint64ByteSliceConverter: arg.Uint64ByteSliceConverter,
+			historyRepo:              arg.HistoryRepo,
+			apiTransactionHandler:    arg.APITransactionHandler,
+			txStatusComputer:         arg.StatusComputer,
+			hasher:                   arg.Hasher,
+			addressPubKeyConverter:   arg.AddressPubkeyConverter,
+			emptyReceiptsHash:        emptyReceiptsHash,
+			logsFacade:               arg.LogsFacade,
+			receiptsRepository:       arg.ReceiptsRepository,
+		},
+		forkDetector: arg.ForkDetector,
+	}
+}
+
+// GetHyperblockByNonce will return the hyperblock of the metachain block with the given nonce
+func (hbp *hyperblockAPIProcessor) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	if hbp.selfShardID != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	headerHash, metaBlock, err := hbp.getMetaBlockByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return hbp.createHyperblock(headerHash, metaBlock, BlockStatusOnChain, options), nil
+}
+
+// GetHyperblockByHash will return the hyperblock of the metachain block with the given hash
+func (hbp *hyperblockAPIProcessor) GetHyperblockByHash(hash []byte, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	if hbp.selfShardID != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	metaBlock, err := hbp.getMetaBlockByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalHash, _, err := hbp.getMetaBlockByNonce(metaBlock.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	status := BlockStatusOnChain
+	if !bytes.Equal(canonicalHash, hash) {
+		status = BlockStatusReverted
+	}
+
+	return hbp.createHyperblock(hash, metaBlock, status, options), nil
+}
+
+func (hbp *hyperblockAPIProcessor) getMetaBlockByNonce(nonce uint64) ([]byte, *block.MetaBlock, error) {
+	nonceToByteSlice := hbp.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := hbp.store.Get(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metaBlock, err := hbp.getMetaBlockByHash(headerHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return headerHash, metaBlock, nil
+}
+
+func (hbp *hyperblockAPIProcessor) getMetaBlockByHash(hash []byte) (*block.MetaBlock, error) {
+	blockBytes, err := hbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = hbp.marshalizer.Unmarshal(metaBlock, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+func (hbp *hyperblockAPIProcessor) createHyperblock(
+	hash []byte,
+	metaBlock *block.MetaBlock,
+	status string,
+	options api.BlockQueryOptions,
+) *common.HyperblockApiResponse {
+	highestFinalMetaNonce := hbp.forkDetector.GetHighestFinalBlockNonce()
+	isOnChain := status == BlockStatusOnChain
+
+	hyperblock := &common.HyperblockApiResponse{
+		Hash:                  hex.EncodeToString(hash),
+		PrevBlockHash:         hex.EncodeToString(metaBlock.PrevHash),
+		Nonce:                 metaBlock.Nonce,
+		Round:                 metaBlock.Round,
+		Epoch:                 metaBlock.Epoch,
+		Timestamp:             int64(metaBlock.TimeStamp),
+		Status:                status,
+		IsFinal:               isOnChain && metaBlock.Nonce <= highestFinalMetaNonce,
+		HighestFinalMetaNonce: highestFinalMetaNonce,
+		MetaMiniBlocks:        make([]*common.HyperblockMiniBlock, 0),
+		ShardBlocks:           make([]*common.HyperblockShardBlockHeader, 0, len(metaBlock.ShardInfo)),
+	}
+
+	// each miniblock is listed only in the hyperblock notarizing it at source
+	miniBlocks := make([]*hyperblockMiniBlock, 0)
+	for _, mbHeader := range metaBlock.MiniBlockHeaders {
+		if mbHeader.Type == block.PeerBlock || mbHeader.SenderShardID != core.MetachainShardId {
+			continue
+		}
+
+		miniBlock := newHyperblockMiniBlock(mbHeader)
+		hyperblock.MetaMiniBlocks = append(hyperblock.MetaMiniBlocks, miniBlock.apiMiniBlock)
+		miniBlocks = append(miniBlocks, miniBlock)
+	}
+
+	for _, shardData := range metaBlock.ShardInfo {
+		shardBlock := &common.HyperblockShardBlockHeader{
+			Hash:       hex.EncodeToString(shardData.HeaderHash),
+			Nonce:      shardData.Nonce,
+			Round:      shardData.Round,
+			Shard:      shardData.ShardID,
+			MiniBlocks: make([]*common.HyperblockMiniBlock, 0, len(shardData.ShardMiniBlockHeaders)),
+		}
+
+		for _, mbHeader := range shardData.ShardMiniBlockHeaders {
+			if mbHeader.SenderShardID != shardData.ShardID {
+				continue
+			}
+
+			miniBlock := newHyperblockMiniBlock(mbHeader)
+			shardBlock.MiniBlocks = append(shardBlock.MiniBlocks, miniBlock.apiMiniBlock)
+			miniBlocks = append(miniBlocks, miniBlock)
+		}
+
+		hyperblock.ShardBlocks = append(hyperblock.ShardBlocks, shardBlock)
+	}
+
+	if isOnChain {
+		hbp.setNotarizations(hash, metaBlock.Nonce, miniBlocks)
+	}
+
+	for _, miniBlock := range miniBlocks {
+		apiMiniBlock := miniBlock.apiMiniBlock
+		apiMiniBlock.Finality = string(txstatus.ComputeFinality(
+			apiMiniBlock.NotarizedAtSourceInMetaNonce,
+			apiMiniBlock.NotarizedAtDestinationInMetaNonce,
+			highestFinalMetaNonce,
+		))
+		hyperblock.NumTxs += apiMiniBlock.TxCount
+
+		if options.WithTransactions {
+			hbp.attachTransactions(miniBlock, metaBlock.Epoch, options)
+		}
+	}
+
+	return hyperblock
+}
+
+func newHyperblockMiniBlock(mbHeader block.MiniBlockHeader) *hyperblockMiniBlock {
+	return &hyperblockMiniBlock{
+		header: &mbHeader,
+		apiMiniBlock: &common.HyperblockMiniBlock{
+			Hash:             hex.EncodeToString(mbHeader.Hash),
+			Type:             mbHeader.Type.String(),
+			SourceShard:      mbHeader.SenderShardID,
+			DestinationShard: mbHeader.ReceiverShardID,
+			TxCount:          mbHeader.TxCount,
+		},
+	}
+}
+
+func (hbp *hyperblockAPIProcessor) setNotarizations(hash []byte, nonce uint64, miniBlocks []*hyperblockMiniBlock) {
+	pendingAtDestination := make(map[string]*hyperblockMiniBlock)
+	for _, miniBlock := range miniBlocks {
+		miniBlock.apiMiniBlock.NotarizedAtSourceInMetaNonce = nonce
+		miniBlock.notarizedAtSourceHash = hash
+
+		if isExecutedAtSource(miniBlock.header) {
+			miniBlock.apiMiniBlock.NotarizedAtDestinationInMetaNonce = nonce
+			miniBlock.notarizedAtDestinationHash = hash
+			continue
+		}
+
+		pendingAtDestination[string(miniBlock.header.Hash)] = miniBlock
+	}
+
+	destinationNotarizations := hbp.findDestinationNotarizations(nonce, pendingAtDestination)
+	for mbHash, notarization := range destinationNotarizations {
+		miniBlock := pendingAtDestination[mbHash]
+		miniBlock.apiMiniBlock.NotarizedAtDestinationInMetaNonce = notarization.nonce
+		miniBlock.notarizedAtDestinationHash = notarization.hash
+	}
+}
+
+func isExecutedAtSource(mbHeader *block.MiniBlockHeader) bool {
+	return mbHeader.SenderShardID == mbHeader.ReceiverShardID || mbHeader.ReceiverShardID == core.AllShardId
+}
+
+// findDestinationNotarizations searches the metachain blocks following the given nonce for the notarization at
+// destination of the provided miniblocks, stopping when all of them are found or when the lookahead is exhausted
+func (hbp *hyperblockAPIProcessor) findDestinationNotarizations(
+	nonce uint64,
+	miniBlocks map[string]*hyperblockMiniBlock,
+) map[string]*metaNotarization {
+	notarizations := make(map[string]*metaNotarization)
+	for nextNonce := nonce + 1; nextNonce <= nonce+maxDestinationNotarizationLookahead; nextNonce++ {
+		if len(notarizations) == len(miniBlocks) {
+			break
+		}
+
+		nextHash, nextMetaBlock, err := hbp.getMetaBlockByNonce(nextNonce)
+		if err != nil {
+			// the following metachain blocks are not yet committed
+			break
+		}
+
+		notarization := &metaNotarization{
+			nonce: nextNonce,
+			hash:  nextHash,
+		}
+		for _, mbHeader := range nextMetaBlock.MiniBlockHeaders {
+			markDestinationNotarization(miniBlocks, notarizations, mbHeader.Hash, core.MetachainShardId, notarization)
+		}
+		for _, shardData := range nextMetaBlock.ShardInfo {
+			for _, mbHeader := range shardData.ShardMiniBlockHeaders {
+				markDestinationNotarization(miniBlocks, notarizations, mbHeader.Hash, shardData.ShardID, notarization)
+			}
+		}
+	}
+
+	return notarizations
+}
+
+func markDestinationNotarization(
+	miniBlocks map[string]*hyperblockMiniBlock,
+	notarizations map[string]*metaNotarization,
+	mbHash []byte,
+	shardID uint32,
+	notarization *metaNotarization,
+) {
+	miniBlock, ok := miniBlocks[string(mbHash)]
+	if !ok || miniBlock.header.ReceiverShardID != shardID {
+		return
+	}
+
+	_, alreadyNotarized := notarizations[string(mbHash)]
+	if alreadyNotarized {
+		return
+	}
+
+	notarizations[string(mbHash)] = notarization
+}
+
+// attachTransactions attaches the miniblock transactions if the node has them in storage, otherwise it only
+// flags them as unavailable, since metachain nodes do not usually store the shard transactions
+func (hbp *hyperblockAPIProcessor) attachTransactions(miniBlock *hyperblockMiniBlock, epoch uint32, options api.BlockQueryOptions) {
+	apiMiniBlock := &api.MiniBlock{}
+	err := hbp.getAndAttachTxsToMb(miniBlock.header, epoch, apiMiniBlock, options)
+	if err != nil {
+		log.Trace("hyperblockAPIProcessor.attachTransactions: cannot load transactions",
+			"miniblock", miniBlock.apiMiniBlock.Hash, "error", err)
+		miniBlock.apiMiniBlock.TransactionsUnavailable = true
+		return
+	}
+
+	numLoaded := len(apiMiniBlock.Transactions) + len(apiMiniBlock.Receipts)
+	miniBlock.apiMiniBlock.TransactionsUnavailable = numLoaded < int(miniBlock.header.TxCount)
+
+	txs := make([]*common.HyperblockTransaction, 0, len(apiMiniBlock.Transactions))
+	for _, tx := range apiMiniBlock.Transactions {
+		tx.NotarizedAtSourceInMetaNonce = miniBlock.apiMiniBlock.NotarizedAtSourceInMetaNonce
+		tx.NotarizedAtSourceInMetaHash = hex.EncodeToString(miniBlock.notarizedAtSourceHash)
+		tx.NotarizedAtDestinationInMetaNonce = miniBlock.apiMiniBlock.NotarizedAtDestinationInMetaNonce
+		tx.NotarizedAtDestinationInMetaHash = hex.EncodeToString(miniBlock.notarizedAtDestinationHash)
+		tx.Status, _ = hbp.txStatusComputer.ComputeStatusWhenInStorageKnowingMiniblock(miniBlock.header.Type, tx)
+
+		txs = append(txs, &common.HyperblockTransaction{
+			ApiTransactionResult: tx,
+			Finality:             miniBlock.apiMiniBlock.Finality,
+		})
+	}
+	miniBlock.apiMiniBlock.Transactions = txs
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (hbp *hyperblockAPIProcessor) IsInterfaceNil() bool {
+	return hbp == nil
+}
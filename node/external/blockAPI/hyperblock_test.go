@@ -0,0 +1,272 @@
+package blockAPI
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsAPIHyperblockProc(selfShardID uint32, store *genericMocks.ChainStorerMock, highestFinalNonce uint64) *ArgAPIHyperblockProcessor {
+	uint64Converter := mock.NewNonceHashConverterMock()
+	statusComputer, _ := txstatus.NewStatusComputer(selfShardID, uint64Converter, store)
+
+	return &ArgAPIHyperblockProcessor{
+		ArgAPIBlockProcessor: &ArgAPIBlockProcessor{
+			SelfShardID:              selfShardID,
+			Store:                    store,
+			Marshalizer:              &mock.MarshalizerFake{},
+			Uint64ByteSliceConverter: uint64Converter,
+			HistoryRepo:              &dblookupext.HistoryRepositoryStub{},
+			APITransactionHandler: &mock.TransactionAPIHandlerStub{
+				UnmarshalTransactionCalled: func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
+					return &transaction.ApiTransactionResult{Tx: &transaction.Transaction{}, Data: txBytes}, nil
+				},
+			},
+			StatusComputer:         statusComputer,
+			Hasher:                 &mock.HasherMock{},
+			AddressPubkeyConverter: &mock.PubkeyConverterMock{},
+			LogsFacade:             &testscommon.LogsFacadeStub{},
+			ReceiptsRepository:     &testscommon.ReceiptsRepositoryStub{},
+		},
+		ForkDetector: &mock.ForkDetectorMock{
+			GetHighestFinalBlockNonceCalled: func() uint64 {
+				return highestFinalNonce
+			},
+		},
+	}
+}
+
+func putMetaBlock(t *testing.T, store *genericMocks.ChainStorerMock, hash string, metaBlock *block.MetaBlock, isCanonical bool) {
+	marshalizer := &mock.MarshalizerFake{}
+	buff, err := marshalizer.Marshal(metaBlock)
+	require.Nil(t, err)
+
+	_ = store.Metablocks.Put([]byte(hash), buff)
+	if isCanonical {
+		nonceBytes := mock.NewNonceHashConverterMock().ToByteSlice(metaBlock.Nonce)
+		_ = store.MetaHdrNonce.Put(nonceBytes, []byte(hash))
+	}
+}
+
+func createMiniBlockHeader(hash string, sender uint32, receiver uint32, txCount uint32, mbType block.Type) block.MiniBlockHeader {
+	return block.MiniBlockHeader{
+		Hash:            []byte(hash),
+		SenderShardID:   sender,
+		ReceiverShardID: receiver,
+		TxCount:         txCount,
+		Type:            mbType,
+	}
+}
+
+func createHyperblockChain(t *testing.T) *genericMocks.ChainStorerMock {
+	store := genericMocks.NewChainStorerMock(0)
+
+	putMetaBlock(t, store, "meta10", &block.MetaBlock{
+		Nonce: 10,
+		Round: 11,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			createMiniBlockHeader("mbRewards", core.MetachainShardId, 0, 2, block.RewardsBlock),
+			createMiniBlockHeader("mbPeer", core.MetachainShardId, core.AllShardId, 1, block.PeerBlock),
+			createMiniBlockHeader("mbToMeta", 1, core.MetachainShardId, 1, block.TxBlock),
+		},
+		ShardInfo: []block.ShardData{
+			{
+				HeaderHash: []byte("shard0Header"),
+				ShardID:    0,
+				Nonce:      7,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					createMiniBlockHeader("mbIntra", 0, 0, 1, block.TxBlock),
+					createMiniBlockHeader("mbCross", 0, 1, 3, block.TxBlock),
+					createMiniBlockHeader("mbIncoming", 1, 0, 1, block.TxBlock),
+				},
+			},
+		},
+	}, true)
+	putMetaBlock(t, store, "meta11", &block.MetaBlock{
+		Nonce: 11,
+		ShardInfo: []block.ShardData{
+			{
+				ShardID: 1,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					createMiniBlockHeader("mbCross", 0, 1, 3, block.TxBlock),
+				},
+			},
+		},
+	}, true)
+	putMetaBlock(t, store, "meta12", &block.MetaBlock{
+		Nonce: 12,
+		ShardInfo: []block.ShardData{
+			{
+				ShardID: 0,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					createMiniBlockHeader("mbRewards", core.MetachainShardId, 0, 2, block.RewardsBlock),
+				},
+			},
+		},
+	}, true)
+
+	return store
+}
+
+func getHyperblockMiniBlocks(hyperblock *common.HyperblockApiResponse) map[string]*common.HyperblockMiniBlock {
+	miniBlocks := make(map[string]*common.HyperblockMiniBlock)
+	for _, mb := range hyperblock.MetaMiniBlocks {
+		miniBlocks[mb.Hash] = mb
+	}
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		for _, mb := range shardBlock.MiniBlocks {
+			miniBlocks[mb.Hash] = mb
+		}
+	}
+
+	return miniBlocks
+}
+
+func TestCreateAPIHyperblockProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil arg should error", func(t *testing.T) {
+		processor, err := CreateAPIHyperblockProcessor(nil)
+		require.True(t, check.IfNil(processor))
+		require.Equal(t, errNilArgAPIBlockProcessor, err)
+	})
+	t.Run("nil fork detector should error", func(t *testing.T) {
+		args := createMockArgsAPIHyperblockProc(core.MetachainShardId, genericMocks.NewChainStorerMock(0), 0)
+		args.ForkDetector = nil
+		processor, err := CreateAPIHyperblockProcessor(args)
+		require.True(t, check.IfNil(processor))
+		require.Equal(t, errNilForkDetector, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		processor, err := CreateAPIHyperblockProcessor(createMockArgsAPIHyperblockProc(core.MetachainShardId, genericMocks.NewChainStorerMock(0), 0))
+		require.False(t, check.IfNil(processor))
+		require.Nil(t, err)
+	})
+}
+
+func TestHyperblockAPIProcessor_ShardNodeShouldError(t *testing.T) {
+	t.Parallel()
+
+	processor, _ := CreateAPIHyperblockProcessor(createMockArgsAPIHyperblockProc(0, createHyperblockChain(t), 11))
+
+	hyperblock, err := processor.GetHyperblockByNonce(10, api.BlockQueryOptions{})
+	require.Nil(t, hyperblock)
+	require.Equal(t, ErrMetachainOnlyEndpoint, err)
+
+	hyperblock, err = processor.GetHyperblockByHash([]byte("meta10"), api.BlockQueryOptions{})
+	require.Nil(t, hyperblock)
+	require.Equal(t, ErrMetachainOnlyEndpoint, err)
+}
+
+func TestHyperblockAPIProcessor_GetHyperblockByNonce(t *testing.T) {
+	t.Parallel()
+
+	store := createHyperblockChain(t)
+	processor, _ := CreateAPIHyperblockProcessor(createMockArgsAPIHyperblockProc(core.MetachainShardId, store, 11))
+
+	hyperblock, err := processor.GetHyperblockByNonce(10, api.BlockQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("meta10")), hyperblock.Hash)
+	require.Equal(t, BlockStatusOnChain, hyperblock.Status)
+	require.True(t, hyperblock.IsFinal)
+	require.Equal(t, uint64(11), hyperblock.HighestFinalMetaNonce)
+	require.Equal(t, uint32(6), hyperblock.NumTxs)
+	require.Len(t, hyperblock.MetaMiniBlocks, 1)
+	require.Len(t, hyperblock.ShardBlocks, 1)
+	require.Len(t, hyperblock.ShardBlocks[0].MiniBlocks, 2)
+
+	miniBlocks := getHyperblockMiniBlocks(hyperblock)
+
+	intra := miniBlocks[hex.EncodeToString([]byte("mbIntra"))]
+	require.Equal(t, uint64(10), intra.NotarizedAtSourceInMetaNonce)
+	require.Equal(t, uint64(10), intra.NotarizedAtDestinationInMetaNonce)
+	require.Equal(t, string(txstatus.TxFinalityFinal), intra.Finality)
+
+	cross := miniBlocks[hex.EncodeToString([]byte("mbCross"))]
+	require.Equal(t, uint64(11), cross.NotarizedAtDestinationInMetaNonce)
+	require.Equal(t, string(txstatus.TxFinalityFinal), cross.Finality)
+
+	rewards := miniBlocks[hex.EncodeToString([]byte("mbRewards"))]
+	require.Equal(t, uint64(12), rewards.NotarizedAtDestinationInMetaNonce)
+	require.Equal(t, string(txstatus.TxFinalityFinalAtSource), rewards.Finality)
+
+	hyperblock, err = processor.GetHyperblockByNonce(13, api.BlockQueryOptions{})
+	require.Nil(t, hyperblock)
+	require.NotNil(t, err)
+}
+
+func TestHyperblockAPIProcessor_GetHyperblockByHashOfRevertedBlock(t *testing.T) {
+	t.Parallel()
+
+	store := createHyperblockChain(t)
+	putMetaBlock(t, store, "meta10-fork", &block.MetaBlock{
+		Nonce: 10,
+		ShardInfo: []block.ShardData{
+			{
+				ShardID: 0,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					createMiniBlockHeader("mbIntra", 0, 0, 1, block.TxBlock),
+				},
+			},
+		},
+	}, false)
+	processor, _ := CreateAPIHyperblockProcessor(createMockArgsAPIHyperblockProc(core.MetachainShardId, store, 11))
+
+	hyperblock, err := processor.GetHyperblockByHash([]byte("meta10-fork"), api.BlockQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, BlockStatusReverted, hyperblock.Status)
+	require.False(t, hyperblock.IsFinal)
+	require.Equal(t, string(txstatus.TxFinalityNotFinal), hyperblock.ShardBlocks[0].MiniBlocks[0].Finality)
+
+	hyperblock, err = processor.GetHyperblockByHash([]byte("meta10"), api.BlockQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, BlockStatusOnChain, hyperblock.Status)
+}
+
+func TestHyperblockAPIProcessor_GetHyperblockWithTransactions(t *testing.T) {
+	t.Parallel()
+
+	store := createHyperblockChain(t)
+	marshalizer := &mock.MarshalizerFake{}
+	miniBlockBytes, _ := marshalizer.Marshal(&block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx1")},
+		SenderShardID:   0,
+		ReceiverShardID: 0,
+		Type:            block.TxBlock,
+	})
+	_ = store.Miniblocks.Put([]byte("mbIntra"), miniBlockBytes)
+	_ = store.Transactions.Put([]byte("tx1"), []byte("tx1 data"))
+
+	processor, _ := CreateAPIHyperblockProcessor(createMockArgsAPIHyperblockProc(core.MetachainShardId, store, 11))
+
+	hyperblock, err := processor.GetHyperblockByNonce(10, api.BlockQueryOptions{WithTransactions: true})
+	require.Nil(t, err)
+
+	miniBlocks := getHyperblockMiniBlocks(hyperblock)
+
+	intra := miniBlocks[hex.EncodeToString([]byte("mbIntra"))]
+	require.False(t, intra.TransactionsUnavailable)
+	require.Len(t, intra.Transactions, 1)
+	tx := intra.Transactions[0]
+	require.Equal(t, hex.EncodeToString([]byte("tx1")), tx.Hash)
+	require.Equal(t, string(txstatus.TxFinalityFinal), tx.Finality)
+	require.Equal(t, uint64(10), tx.NotarizedAtSourceInMetaNonce)
+	require.Equal(t, hex.EncodeToString([]byte("meta10")), tx.NotarizedAtDestinationInMetaHash)
+	require.Equal(t, transaction.TxStatusSuccess, tx.Status)
+
+	cross := miniBlocks[hex.EncodeToString([]byte("mbCross"))]
+	require.True(t, cross.TransactionsUnavailable)
+	require.Empty(t, cross.Transactions)
+}
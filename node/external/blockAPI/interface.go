@@ -36,6 +36,18 @@ type APIInternalBlockHandler interface {
 	IsInterfaceNil() bool
 }
 
+// APIHyperblockHandler defines the behaviour of a component able to return hyperblocks
+type APIHyperblockHandler interface {
+	GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	GetHyperblockByHash(hash []byte, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+type forkDetector interface {
+	GetHighestFinalBlockNonce() uint64
+	IsInterfaceNil() bool
+}
+
 type logsFacade interface {
 	IncludeLogsInTransactions(txs []*transaction.ApiTransactionResult, logsKeys [][]byte, epoch uint32) error
 	IsInterfaceNil() bool
@@ -23,6 +23,9 @@ var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 // ErrNilAPITransactionHandler signals that a nil api transaction handler has been provided
 var ErrNilAPITransactionHandler = errors.New("nil api transaction handler")
 
+// ErrNilAPIHyperblockHandler signals that a nil api hyperblock handler has been provided
+var ErrNilAPIHyperblockHandler = errors.New("nil api hyperblock handler")
+
 // ErrNilAPIBlockHandler signals that a nil api block handler has been provided
 var ErrNilAPIBlockHandler = errors.New("nil api block handler")
 
@@ -40,3 +43,6 @@ var ErrNilAccountsParser = errors.New("nil accounts parser")
 
 // ErrNilGasScheduler signals that a nil gas scheduler has been provided
 var ErrNilGasScheduler = errors.New("nil gas scheduler")
+
+// ErrNilGasPriceSuggestionHandler signals that a nil gas price suggestion handler has been provided
+var ErrNilGasPriceSuggestionHandler = errors.New("nil gas price suggestion handler")
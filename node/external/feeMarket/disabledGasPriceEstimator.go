@@ -0,0 +1,21 @@
+package feeMarket
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+type disabledGasPriceEstimator struct {
+}
+
+// NewDisabledGasPriceEstimator returns a gas price estimator used when the gas price suggestion is disabled
+func NewDisabledGasPriceEstimator() *disabledGasPriceEstimator {
+	return &disabledGasPriceEstimator{}
+}
+
+// GetGasPriceSuggestion returns ErrGasPriceSuggestionDisabled
+func (dgpe *disabledGasPriceEstimator) GetGasPriceSuggestion(_ int) (*common.GasPriceSuggestionApiResponse, error) {
+	return nil, ErrGasPriceSuggestionDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dgpe *disabledGasPriceEstimator) IsInterfaceNil() bool {
+	return dgpe == nil
+}
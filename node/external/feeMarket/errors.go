@@ -0,0 +1,21 @@
+package feeMarket
+
+import "errors"
+
+// ErrNilEconomicsHandler signals that a nil economics handler has been provided
+var ErrNilEconomicsHandler = errors.New("nil economics handler")
+
+// ErrInvalidNumBlocks signals that an invalid number of blocks has been provided
+var ErrInvalidNumBlocks = errors.New("invalid number of blocks")
+
+// ErrInvalidPercentile signals that an invalid percentile has been provided
+var ErrInvalidPercentile = errors.New("invalid percentile")
+
+// ErrInvalidCongestionThreshold signals that an invalid congestion threshold has been provided
+var ErrInvalidCongestionThreshold = errors.New("invalid congestion threshold")
+
+// ErrGasPriceSuggestionDisabled signals that the gas price suggestion is disabled
+var ErrGasPriceSuggestionDisabled = errors.New("gas price suggestion is disabled")
+
+// ErrNoBlocksRecorded signals that no block has been recorded yet
+var ErrNoBlocksRecorded = errors.New("no blocks recorded yet")
@@ -0,0 +1,263 @@
+package feeMarket
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+const maxPercentage = 100
+
+var _ outport.Driver = (*gasPriceEstimator)(nil)
+
+// ArgsGasPriceEstimator holds the arguments needed to create a new gas price estimator
+type ArgsGasPriceEstimator struct {
+	EconomicsHandler              EconomicsHandler
+	NumBlocks                     uint32
+	Percentiles                   []uint32
+	SuggestionPercentile          uint32
+	CongestionThresholdPercentage uint32
+}
+
+type blockRecord struct {
+	nonce       uint64
+	shardID     uint32
+	gasPrices   []uint64
+	gasProvided uint64
+	maxGas      uint64
+}
+
+// gasPriceEstimator is an outport driver that records the gas prices of the transactions included in the committed
+// blocks, together with the blocks fullness, and computes gas price suggestions over the latest blocks
+type gasPriceEstimator struct {
+	economicsHandler              EconomicsHandler
+	numBlocks                     int
+	percentiles                   []uint32
+	suggestionPercentile          uint32
+	congestionThresholdPercentage uint32
+
+	mutBlocks sync.RWMutex
+	blocks    []*blockRecord
+}
+
+// NewGasPriceEstimator creates a new gas price estimator
+func NewGasPriceEstimator(args ArgsGasPriceEstimator) (*gasPriceEstimator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gasPriceEstimator{
+		economicsHandler:              args.EconomicsHandler,
+		numBlocks:                     int(args.NumBlocks),
+		percentiles:                   args.Percentiles,
+		suggestionPercentile:          args.SuggestionPercentile,
+		congestionThresholdPercentage: args.CongestionThresholdPercentage,
+		blocks:                        make([]*blockRecord, 0, args.NumBlocks),
+	}, nil
+}
+
+func checkArgs(args ArgsGasPriceEstimator) error {
+	if check.IfNil(args.EconomicsHandler) {
+		return ErrNilEconomicsHandler
+	}
+	if args.NumBlocks == 0 {
+		return ErrInvalidNumBlocks
+	}
+	for _, percentile := range args.Percentiles {
+		if percentile == 0 || percentile > maxPercentage {
+			return fmt.Errorf("%w: %d", ErrInvalidPercentile, percentile)
+		}
+	}
+	if args.SuggestionPercentile == 0 || args.SuggestionPercentile > maxPercentage {
+		return fmt.Errorf("%w for the suggestion: %d", ErrInvalidPercentile, args.SuggestionPercentile)
+	}
+	if args.CongestionThresholdPercentage > maxPercentage {
+		return fmt.Errorf("%w: %d", ErrInvalidCongestionThreshold, args.CongestionThresholdPercentage)
+	}
+
+	return nil
+}
+
+// SaveBlock records the gas prices of the transactions included in the block and the block fullness
+func (gpe *gasPriceEstimator) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil || check.IfNil(args.Header) {
+		return nil
+	}
+
+	record := &blockRecord{
+		nonce:       args.Header.GetNonce(),
+		shardID:     args.Header.GetShardID(),
+		gasPrices:   make([]uint64, 0),
+		gasProvided: args.HeaderGasConsumption.GasProvided,
+		maxGas:      args.HeaderGasConsumption.MaxGasPerBlock,
+	}
+	if args.TransactionsPool != nil {
+		for _, tx := range args.TransactionsPool.Txs {
+			if check.IfNil(tx) {
+				continue
+			}
+
+			record.gasPrices = append(record.gasPrices, tx.GetGasPrice())
+		}
+	}
+
+	gpe.mutBlocks.Lock()
+	defer gpe.mutBlocks.Unlock()
+
+	gpe.removeBlocksFromNonce(record.nonce)
+	gpe.blocks = append(gpe.blocks, record)
+	if len(gpe.blocks) > gpe.numBlocks {
+		gpe.blocks = gpe.blocks[len(gpe.blocks)-gpe.numBlocks:]
+	}
+
+	return nil
+}
+
+// RevertIndexedBlock drops the records of the reverted block and of the blocks following it
+func (gpe *gasPriceEstimator) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	gpe.mutBlocks.Lock()
+	gpe.removeBlocksFromNonce(header.GetNonce())
+	gpe.mutBlocks.Unlock()
+
+	return nil
+}
+
+func (gpe *gasPriceEstimator) removeBlocksFromNonce(nonce uint64) {
+	for len(gpe.blocks) > 0 && gpe.blocks[len(gpe.blocks)-1].nonce >= nonce {
+		gpe.blocks = gpe.blocks[:len(gpe.blocks)-1]
+	}
+}
+
+// GetGasPriceSuggestion computes the gas price percentiles of the transactions included in the latest numBlocks
+// blocks, together with the blocks fullness. A value outside (0, configured window] selects the whole window.
+// The suggested gas price is the minimum one, unless the blocks are congested, in which case it is the configured
+// percentile of the included transactions gas prices
+func (gpe *gasPriceEstimator) GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+	if numBlocks <= 0 || numBlocks > gpe.numBlocks {
+		numBlocks = gpe.numBlocks
+	}
+
+	gpe.mutBlocks.RLock()
+	if len(gpe.blocks) == 0 {
+		gpe.mutBlocks.RUnlock()
+		return nil, ErrNoBlocksRecorded
+	}
+	if numBlocks > len(gpe.blocks) {
+		numBlocks = len(gpe.blocks)
+	}
+	blocks := make([]*blockRecord, numBlocks)
+	copy(blocks, gpe.blocks[len(gpe.blocks)-numBlocks:])
+	gpe.mutBlocks.RUnlock()
+
+	gasPrices := make([]uint64, 0)
+	fullnessSum := float64(0)
+	for _, block := range blocks {
+		gasPrices = append(gasPrices, block.gasPrices...)
+		fullnessSum += block.fullness()
+	}
+	sort.Slice(gasPrices, func(i, j int) bool {
+		return gasPrices[i] < gasPrices[j]
+	})
+
+	lastBlock := blocks[len(blocks)-1]
+	minGasPrice := gpe.economicsHandler.MinGasPrice()
+	response := &common.GasPriceSuggestionApiResponse{
+		ShardID:              lastBlock.shardID,
+		NumBlocks:            len(blocks),
+		LastBlockNonce:       lastBlock.nonce,
+		NumTransactions:      len(gasPrices),
+		MinGasPrice:          minGasPrice,
+		SuggestedGasPrice:    minGasPrice,
+		GasPricePercentiles:  make(map[string]uint64, len(gpe.percentiles)),
+		AverageBlockFullness: fullnessSum / float64(len(blocks)),
+		LastBlockFullness:    lastBlock.fullness(),
+	}
+
+	for _, percentile := range gpe.percentiles {
+		response.GasPricePercentiles[strconv.Itoa(int(percentile))] = computePercentile(gasPrices, percentile, minGasPrice)
+	}
+
+	isCongested := response.AverageBlockFullness >= float64(gpe.congestionThresholdPercentage)
+	if isCongested {
+		response.SuggestedGasPrice = computePercentile(gasPrices, gpe.suggestionPercentile, minGasPrice)
+	}
+
+	return response, nil
+}
+
+// fullness returns the percentage of the maximum gas per block consumed by the block
+func (br *blockRecord) fullness() float64 {
+	if br.maxGas == 0 {
+		return 0
+	}
+
+	return float64(br.gasProvided) * maxPercentage / float64(br.maxGas)
+}
+
+// computePercentile returns the nearest-rank percentile of the sorted gas prices, but not lower than the minimum
+// gas price
+func computePercentile(sortedGasPrices []uint64, percentile uint32, minGasPrice uint64) uint64 {
+	if len(sortedGasPrices) == 0 {
+		return minGasPrice
+	}
+
+	rank := int(math.Ceil(float64(percentile) * float64(len(sortedGasPrices)) / maxPercentage))
+	if rank < 1 {
+		rank = 1
+	}
+
+	gasPrice := sortedGasPrices[rank-1]
+	if gasPrice < minGasPrice {
+		return minGasPrice
+	}
+
+	return gasPrice
+}
+
+// SaveRoundsInfo returns nil
+func (gpe *gasPriceEstimator) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys returns nil
+func (gpe *gasPriceEstimator) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveValidatorsRating returns nil
+func (gpe *gasPriceEstimator) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveAccounts returns nil
+func (gpe *gasPriceEstimator) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// FinalizedBlock returns nil
+func (gpe *gasPriceEstimator) FinalizedBlock(_ []byte) error {
+	return nil
+}
+
+// Close returns nil
+func (gpe *gasPriceEstimator) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gpe *gasPriceEstimator) IsInterfaceNil() bool {
+	return gpe == nil
+}
@@ -0,0 +1,180 @@
+package feeMarket
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/require"
+)
+
+const minGasPrice = uint64(1000)
+
+func createArgsGasPriceEstimator() ArgsGasPriceEstimator {
+	return ArgsGasPriceEstimator{
+		EconomicsHandler: &economicsmocks.EconomicsHandlerStub{
+			MinGasPriceCalled: func() uint64 {
+				return minGasPrice
+			},
+		},
+		NumBlocks:                     3,
+		Percentiles:                   []uint32{25, 50, 90},
+		SuggestionPercentile:          90,
+		CongestionThresholdPercentage: 80,
+	}
+}
+
+func createBlockData(nonce uint64, gasProvided uint64, gasPrices ...uint64) *indexer.ArgsSaveBlockData {
+	txs := make(map[string]data.TransactionHandler)
+	for i, gasPrice := range gasPrices {
+		txs[string(rune('a'+i))] = &transaction.Transaction{GasPrice: gasPrice}
+	}
+
+	return &indexer.ArgsSaveBlockData{
+		Header: &block.Header{Nonce: nonce, ShardID: 1},
+		HeaderGasConsumption: indexer.HeaderGasConsumption{
+			GasProvided:    gasProvided,
+			MaxGasPerBlock: 1000,
+		},
+		TransactionsPool: &indexer.Pool{Txs: txs},
+	}
+}
+
+func TestNewGasPriceEstimator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil economics handler should error", func(t *testing.T) {
+		args := createArgsGasPriceEstimator()
+		args.EconomicsHandler = nil
+		estimator, err := NewGasPriceEstimator(args)
+		require.True(t, check.IfNil(estimator))
+		require.Equal(t, ErrNilEconomicsHandler, err)
+	})
+	t.Run("zero blocks should error", func(t *testing.T) {
+		args := createArgsGasPriceEstimator()
+		args.NumBlocks = 0
+		estimator, err := NewGasPriceEstimator(args)
+		require.True(t, check.IfNil(estimator))
+		require.Equal(t, ErrInvalidNumBlocks, err)
+	})
+	t.Run("invalid percentile should error", func(t *testing.T) {
+		args := createArgsGasPriceEstimator()
+		args.Percentiles = []uint32{50, 101}
+		estimator, err := NewGasPriceEstimator(args)
+		require.True(t, check.IfNil(estimator))
+		require.True(t, errors.Is(err, ErrInvalidPercentile))
+	})
+	t.Run("invalid suggestion percentile should error", func(t *testing.T) {
+		args := createArgsGasPriceEstimator()
+		args.SuggestionPercentile = 0
+		estimator, err := NewGasPriceEstimator(args)
+		require.True(t, check.IfNil(estimator))
+		require.True(t, errors.Is(err, ErrInvalidPercentile))
+	})
+	t.Run("invalid congestion threshold should error", func(t *testing.T) {
+		args := createArgsGasPriceEstimator()
+		args.CongestionThresholdPercentage = 101
+		estimator, err := NewGasPriceEstimator(args)
+		require.True(t, check.IfNil(estimator))
+		require.True(t, errors.Is(err, ErrInvalidCongestionThreshold))
+	})
+	t.Run("should work", func(t *testing.T) {
+		estimator, err := NewGasPriceEstimator(createArgsGasPriceEstimator())
+		require.False(t, check.IfNil(estimator))
+		require.Nil(t, err)
+	})
+}
+
+func TestGasPriceEstimator_GetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no blocks should error", func(t *testing.T) {
+		estimator, _ := NewGasPriceEstimator(createArgsGasPriceEstimator())
+		suggestion, err := estimator.GetGasPriceSuggestion(0)
+		require.Nil(t, suggestion)
+		require.Equal(t, ErrNoBlocksRecorded, err)
+	})
+	t.Run("not congested blocks should suggest the minimum gas price", func(t *testing.T) {
+		estimator, _ := NewGasPriceEstimator(createArgsGasPriceEstimator())
+		require.Nil(t, estimator.SaveBlock(createBlockData(1, 200, 1000, 2000)))
+		require.Nil(t, estimator.SaveBlock(createBlockData(2, 400, 3000, 4000)))
+
+		suggestion, err := estimator.GetGasPriceSuggestion(0)
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), suggestion.ShardID)
+		require.Equal(t, 2, suggestion.NumBlocks)
+		require.Equal(t, uint64(2), suggestion.LastBlockNonce)
+		require.Equal(t, 4, suggestion.NumTransactions)
+		require.Equal(t, minGasPrice, suggestion.MinGasPrice)
+		require.Equal(t, minGasPrice, suggestion.SuggestedGasPrice)
+		require.Equal(t, map[string]uint64{"25": 1000, "50": 2000, "90": 4000}, suggestion.GasPricePercentiles)
+		require.Equal(t, float64(30), suggestion.AverageBlockFullness)
+		require.Equal(t, float64(40), suggestion.LastBlockFullness)
+	})
+	t.Run("congested blocks should suggest the configured percentile", func(t *testing.T) {
+		estimator, _ := NewGasPriceEstimator(createArgsGasPriceEstimator())
+		require.Nil(t, estimator.SaveBlock(createBlockData(1, 100, 1000)))
+		require.Nil(t, estimator.SaveBlock(createBlockData(2, 900, 5000, 2000)))
+		require.Nil(t, estimator.SaveBlock(createBlockData(3, 1000, 3000, 6000)))
+
+		suggestion, err := estimator.GetGasPriceSuggestion(2)
+		require.Nil(t, err)
+		require.Equal(t, 2, suggestion.NumBlocks)
+		require.Equal(t, float64(95), suggestion.AverageBlockFullness)
+		require.Equal(t, uint64(6000), suggestion.SuggestedGasPrice)
+
+		// the whole window is not congested
+		suggestion, err = estimator.GetGasPriceSuggestion(10)
+		require.Nil(t, err)
+		require.Equal(t, 3, suggestion.NumBlocks)
+		require.Equal(t, minGasPrice, suggestion.SuggestedGasPrice)
+	})
+	t.Run("should keep only the configured window and drop the reverted blocks", func(t *testing.T) {
+		estimator, _ := NewGasPriceEstimator(createArgsGasPriceEstimator())
+		for nonce := uint64(1); nonce <= 5; nonce++ {
+			require.Nil(t, estimator.SaveBlock(createBlockData(nonce, 0, nonce*1000)))
+		}
+
+		suggestion, _ := estimator.GetGasPriceSuggestion(0)
+		require.Equal(t, 3, suggestion.NumBlocks)
+		require.Equal(t, uint64(3000), suggestion.GasPricePercentiles["25"])
+
+		require.Nil(t, estimator.RevertIndexedBlock(&block.Header{Nonce: 5}, &block.Body{}))
+		suggestion, _ = estimator.GetGasPriceSuggestion(0)
+		require.Equal(t, 2, suggestion.NumBlocks)
+		require.Equal(t, uint64(4), suggestion.LastBlockNonce)
+
+		// a block with the same nonce replaces the recorded one
+		require.Nil(t, estimator.SaveBlock(createBlockData(4, 0, 9000)))
+		suggestion, _ = estimator.GetGasPriceSuggestion(0)
+		require.Equal(t, 2, suggestion.NumBlocks)
+		require.Equal(t, uint64(9000), suggestion.GasPricePercentiles["90"])
+	})
+}
+
+func TestComputePercentile(t *testing.T) {
+	t.Parallel()
+
+	gasPrices := []uint64{500, 1000, 2000, 3000, 4000}
+	require.Equal(t, minGasPrice, computePercentile(nil, 50, minGasPrice))
+	require.Equal(t, minGasPrice, computePercentile(gasPrices, 10, minGasPrice))
+	require.Equal(t, uint64(2000), computePercentile(gasPrices, 50, minGasPrice))
+	require.Equal(t, uint64(4000), computePercentile(gasPrices, 90, minGasPrice))
+	require.Equal(t, uint64(4000), computePercentile(gasPrices, 100, minGasPrice))
+}
+
+func TestDisabledGasPriceEstimator_GetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	estimator := NewDisabledGasPriceEstimator()
+	require.False(t, check.IfNil(estimator))
+
+	suggestion, err := estimator.GetGasPriceSuggestion(10)
+	require.Nil(t, suggestion)
+	require.Equal(t, ErrGasPriceSuggestionDisabled, err)
+}
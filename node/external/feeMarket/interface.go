@@ -0,0 +1,7 @@
+package feeMarket
+
+// EconomicsHandler defines the economics data needed by the gas price estimator
+type EconomicsHandler interface {
+	MinGasPrice() uint64
+	IsInterfaceNil() bool
+}
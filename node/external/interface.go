@@ -37,6 +37,12 @@ type TransactionCostHandler interface {
 	IsInterfaceNil() bool
 }
 
+// GasPriceSuggestionHandler defines the actions which should be handled by a gas price estimator
+type GasPriceSuggestionHandler interface {
+	GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error)
+	IsInterfaceNil() bool
+}
+
 // TotalStakedValueHandler defines the behavior of a component able to return total staked value
 type TotalStakedValueHandler interface {
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
@@ -58,10 +64,16 @@ type DelegatedListHandler interface {
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error)
+	GetTransactionFinality(tx *transaction.ApiTransactionResult) string
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error)
+	GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error)
+	GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	PopulateComputedFields(tx *transaction.ApiTransactionResult)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
@@ -20,36 +20,40 @@ import (
 
 // ArgNodeApiResolver represents the DTO structure used in the NewNodeApiResolver constructor
 type ArgNodeApiResolver struct {
-	SCQueryService           SCQueryService
-	StatusMetricsHandler     StatusMetricsHandler
-	TxCostHandler            TransactionCostHandler
-	TotalStakedValueHandler  TotalStakedValueHandler
-	DirectStakedListHandler  DirectStakedListHandler
-	DelegatedListHandler     DelegatedListHandler
-	APITransactionHandler    APITransactionHandler
-	APIBlockHandler          blockAPI.APIBlockHandler
-	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
-	GenesisNodesSetupHandler sharding.GenesisNodesSetupHandler
-	ValidatorPubKeyConverter core.PubkeyConverter
-	AccountsParser           genesis.AccountsParser
-	GasScheduleNotifier      common.GasScheduleNotifierAPI
+	SCQueryService            SCQueryService
+	StatusMetricsHandler      StatusMetricsHandler
+	TxCostHandler             TransactionCostHandler
+	TotalStakedValueHandler   TotalStakedValueHandler
+	DirectStakedListHandler   DirectStakedListHandler
+	DelegatedListHandler      DelegatedListHandler
+	APITransactionHandler     APITransactionHandler
+	APIBlockHandler           blockAPI.APIBlockHandler
+	APIInternalBlockHandler   blockAPI.APIInternalBlockHandler
+	APIHyperblockHandler      blockAPI.APIHyperblockHandler
+	GenesisNodesSetupHandler  sharding.GenesisNodesSetupHandler
+	ValidatorPubKeyConverter  core.PubkeyConverter
+	AccountsParser            genesis.AccountsParser
+	GasScheduleNotifier       common.GasScheduleNotifierAPI
+	GasPriceSuggestionHandler GasPriceSuggestionHandler
 }
 
 // nodeApiResolver can resolve API requests
 type nodeApiResolver struct {
-	scQueryService           SCQueryService
-	statusMetricsHandler     StatusMetricsHandler
-	txCostHandler            TransactionCostHandler
-	totalStakedValueHandler  TotalStakedValueHandler
-	directStakedListHandler  DirectStakedListHandler
-	delegatedListHandler     DelegatedListHandler
-	apiTransactionHandler    APITransactionHandler
-	apiBlockHandler          blockAPI.APIBlockHandler
-	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
-	genesisNodesSetupHandler sharding.GenesisNodesSetupHandler
-	validatorPubKeyConverter core.PubkeyConverter
-	accountsParser           genesis.AccountsParser
-	gasScheduleNotifier      common.GasScheduleNotifierAPI
+	scQueryService            SCQueryService
+	statusMetricsHandler      StatusMetricsHandler
+	txCostHandler             TransactionCostHandler
+	totalStakedValueHandler   TotalStakedValueHandler
+	directStakedListHandler   DirectStakedListHandler
+	delegatedListHandler      DelegatedListHandler
+	apiTransactionHandler     APITransactionHandler
+	apiBlockHandler           blockAPI.APIBlockHandler
+	apiInternalBlockHandler   blockAPI.APIInternalBlockHandler
+	apiHyperblockHandler      blockAPI.APIHyperblockHandler
+	genesisNodesSetupHandler  sharding.GenesisNodesSetupHandler
+	validatorPubKeyConverter  core.PubkeyConverter
+	accountsParser            genesis.AccountsParser
+	gasScheduleNotifier       common.GasScheduleNotifierAPI
+	gasPriceSuggestionHandler GasPriceSuggestionHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -81,6 +85,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.APIInternalBlockHandler) {
 		return nil, ErrNilAPIInternalBlockHandler
 	}
+	if check.IfNil(arg.APIHyperblockHandler) {
+		return nil, ErrNilAPIHyperblockHandler
+	}
 	if check.IfNil(arg.GenesisNodesSetupHandler) {
 		return nil, ErrNilGenesisNodesSetupHandler
 	}
@@ -93,21 +100,26 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.GasScheduleNotifier) {
 		return nil, ErrNilGasScheduler
 	}
+	if check.IfNil(arg.GasPriceSuggestionHandler) {
+		return nil, ErrNilGasPriceSuggestionHandler
+	}
 
 	return &nodeApiResolver{
-		scQueryService:           arg.SCQueryService,
-		statusMetricsHandler:     arg.StatusMetricsHandler,
-		txCostHandler:            arg.TxCostHandler,
-		totalStakedValueHandler:  arg.TotalStakedValueHandler,
-		directStakedListHandler:  arg.DirectStakedListHandler,
-		delegatedListHandler:     arg.DelegatedListHandler,
-		apiBlockHandler:          arg.APIBlockHandler,
-		apiTransactionHandler:    arg.APITransactionHandler,
-		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
-		genesisNodesSetupHandler: arg.GenesisNodesSetupHandler,
-		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
-		accountsParser:           arg.AccountsParser,
-		gasScheduleNotifier:      arg.GasScheduleNotifier,
+		scQueryService:            arg.SCQueryService,
+		statusMetricsHandler:      arg.StatusMetricsHandler,
+		txCostHandler:             arg.TxCostHandler,
+		totalStakedValueHandler:   arg.TotalStakedValueHandler,
+		directStakedListHandler:   arg.DirectStakedListHandler,
+		delegatedListHandler:      arg.DelegatedListHandler,
+		apiBlockHandler:           arg.APIBlockHandler,
+		apiTransactionHandler:     arg.APITransactionHandler,
+		apiInternalBlockHandler:   arg.APIInternalBlockHandler,
+		apiHyperblockHandler:      arg.APIHyperblockHandler,
+		genesisNodesSetupHandler:  arg.GenesisNodesSetupHandler,
+		validatorPubKeyConverter:  arg.ValidatorPubKeyConverter,
+		accountsParser:            arg.AccountsParser,
+		gasScheduleNotifier:       arg.GasScheduleNotifier,
+		gasPriceSuggestionHandler: arg.GasPriceSuggestionHandler,
 	}, nil
 }
 
@@ -151,6 +163,16 @@ func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*tran
 	return nar.apiTransactionHandler.GetTransaction(hash, withResults)
 }
 
+// GetTransactionStateChanges returns the changes done on the accounts by the given executed transaction
+func (nar *nodeApiResolver) GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error) {
+	return nar.apiTransactionHandler.GetTransactionStateChanges(txHash)
+}
+
+// GetTransactionFinality returns the finality of the given transaction, as seen from the metachain notarization state
+func (nar *nodeApiResolver) GetTransactionFinality(tx *transaction.ApiTransactionResult) string {
+	return nar.apiTransactionHandler.GetTransactionFinality(tx)
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
 func (nar *nodeApiResolver) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
 	return nar.apiTransactionHandler.GetTransactionsPool(fields)
@@ -171,6 +193,31 @@ func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string)
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender)
 }
 
+// GetTransactionPoolHistory will return the recorded pool removals and rejections of the given transaction
+func (nar *nodeApiResolver) GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionPoolHistory(txHash)
+}
+
+// GetTransactionsPoolEvictions will return the latest recorded pool evictions and rejections
+func (nar *nodeApiResolver) GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolEvictions(maxNumEvictions)
+}
+
+// GetGasPriceSuggestion returns the gas price suggestion computed over the latest committed blocks
+func (nar *nodeApiResolver) GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+	return nar.gasPriceSuggestionHandler.GetGasPriceSuggestion(numBlocks)
+}
+
+// GetTransactionsByAddress will return, newest first, the transactions the given address took part in
+func (nar *nodeApiResolver) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsByAddress(address, from, size)
+}
+
+// GetEvents returns the events logged in the requested blocks range, matching the query filters
+func (nar *nodeApiResolver) GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+	return nar.apiTransactionHandler.GetEvents(options)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -191,6 +238,21 @@ func (nar *nodeApiResolver) GetBlockByRound(round uint64, options api.BlockQuery
 	return nar.apiBlockHandler.GetBlockByRound(round, options)
 }
 
+// GetHyperblockByHash will return the hyperblock of the metachain block with the given hash
+func (nar *nodeApiResolver) GetHyperblockByHash(hash string, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return nar.apiHyperblockHandler.GetHyperblockByHash(decodedHash, options)
+}
+
+// GetHyperblockByNonce will return the hyperblock of the metachain block with the given nonce
+func (nar *nodeApiResolver) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	return nar.apiHyperblockHandler.GetHyperblockByNonce(nonce, options)
+}
+
 // GetInternalMetaBlockByHash will return a meta block by hash
 func (nar *nodeApiResolver) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	decodedHash, err := hex.DecodeString(hash)
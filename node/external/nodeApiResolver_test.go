@@ -27,19 +27,21 @@ import (
 
 func createMockArgs() external.ArgNodeApiResolver {
 	return external.ArgNodeApiResolver{
-		SCQueryService:           &mock.SCQueryServiceStub{},
-		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
-		TxCostHandler:            &mock.TransactionCostEstimatorMock{},
-		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
-		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
-		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
-		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
-		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
-		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
-		GenesisNodesSetupHandler: &testscommon.NodesSetupStub{},
-		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
-		AccountsParser:           &genesisMocks.AccountsParserStub{},
-		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
+		SCQueryService:            &mock.SCQueryServiceStub{},
+		StatusMetricsHandler:      &testscommon.StatusMetricsStub{},
+		TxCostHandler:             &mock.TransactionCostEstimatorMock{},
+		TotalStakedValueHandler:   &mock.StakeValuesProcessorStub{},
+		DirectStakedListHandler:   &mock.DirectStakedListProcessorStub{},
+		DelegatedListHandler:      &mock.DelegatedListProcessorStub{},
+		APIBlockHandler:           &mock.BlockAPIHandlerStub{},
+		APITransactionHandler:     &mock.TransactionAPIHandlerStub{},
+		APIInternalBlockHandler:   &mock.InternalBlockApiHandlerStub{},
+		APIHyperblockHandler:      &mock.HyperblockAPIHandlerStub{},
+		GenesisNodesSetupHandler:  &testscommon.NodesSetupStub{},
+		ValidatorPubKeyConverter:  &testscommon.PubkeyConverterMock{},
+		AccountsParser:            &genesisMocks.AccountsParserStub{},
+		GasScheduleNotifier:       &testscommon.GasScheduleNotifierMock{},
+		GasPriceSuggestionHandler: &mock.GasPriceSuggestionHandlerStub{},
 	}
 }
 
@@ -120,6 +122,28 @@ func TestNewNodeApiResolver_NilGasSchedules(t *testing.T) {
 	assert.Equal(t, external.ErrNilGasScheduler, err)
 }
 
+func TestNewNodeApiResolver_NilGasPriceSuggestionHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GasPriceSuggestionHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGasPriceSuggestionHandler, err)
+}
+
+func TestNewNodeApiResolver_NilAPIHyperblockHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.APIHyperblockHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAPIHyperblockHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -676,3 +700,56 @@ func TestNodeApiResolver_GetGasConfigs(t *testing.T) {
 	_ = nar.GetGasConfigs()
 	require.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	expectedSuggestion := &common.GasPriceSuggestionApiResponse{SuggestedGasPrice: 1500000000}
+	args := createMockArgs()
+	args.GasPriceSuggestionHandler = &mock.GasPriceSuggestionHandlerStub{
+		GetGasPriceSuggestionCalled: func(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+			require.Equal(t, 10, numBlocks)
+			return expectedSuggestion, nil
+		},
+	}
+
+	nar, err := external.NewNodeApiResolver(args)
+	require.Nil(t, err)
+
+	suggestion, err := nar.GetGasPriceSuggestion(10)
+	require.Nil(t, err)
+	require.Equal(t, expectedSuggestion, suggestion)
+}
+
+func TestNodeApiResolver_GetHyperblock(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := &common.HyperblockApiResponse{Nonce: 37}
+	args := createMockArgs()
+	args.APIHyperblockHandler = &mock.HyperblockAPIHandlerStub{
+		GetHyperblockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+			require.Equal(t, uint64(37), nonce)
+			require.True(t, options.WithTransactions)
+			return expectedHyperblock, nil
+		},
+		GetHyperblockByHashCalled: func(hash []byte, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+			require.Equal(t, []byte("hash"), hash)
+			return expectedHyperblock, nil
+		},
+	}
+
+	nar, err := external.NewNodeApiResolver(args)
+	require.Nil(t, err)
+
+	hyperblock, err := nar.GetHyperblockByNonce(37, api.BlockQueryOptions{WithTransactions: true})
+	require.Nil(t, err)
+	require.Equal(t, expectedHyperblock, hyperblock)
+
+	hyperblock, err = nar.GetHyperblockByHash(hex.EncodeToString([]byte("hash")), api.BlockQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, expectedHyperblock, hyperblock)
+
+	hyperblock, err = nar.GetHyperblockByHash("not hex", api.BlockQueryOptions{})
+	require.Nil(t, hyperblock)
+	require.NotNil(t, err)
+}
@@ -27,4 +27,6 @@ type ArgAPITransactionProcessor struct {
 	TxTypeHandler            process.TxTypeHandler
 	LogsFacade               LogsFacade
 	DataFieldParser          DataFieldParser
+	ForkDetector             forkDetector
+	BlockTracker             blockTracker
 }
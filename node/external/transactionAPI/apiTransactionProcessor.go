@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	rewardTxData "github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
@@ -41,6 +42,8 @@ type apiTransactionProcessor struct {
 	txUnmarshaller              *txUnmarshaller
 	transactionResultsProcessor *apiTransactionResultsProcessor
 	refundDetector              *refundDetector
+	forkDetector                forkDetector
+	blockTracker                blockTracker
 }
 
 // NewAPITransactionProcessor will create a new instance of apiTransactionProcessor
@@ -79,6 +82,8 @@ func NewAPITransactionProcessor(args *ArgAPITransactionProcessor) (*apiTransacti
 		txUnmarshaller:              txUnmarshalerAndPreparer,
 		transactionResultsProcessor: txResultsProc,
 		refundDetector:              refundDetector,
+		forkDetector:                args.ForkDetector,
+		blockTracker:                args.BlockTracker,
 	}, nil
 }
 
@@ -117,6 +122,38 @@ func (atp *apiTransactionProcessor) doGetTransaction(hash []byte, withResults bo
 	return atp.getTransactionFromStorage(hash)
 }
 
+// GetTransactionFinality returns the finality of the given transaction, computed from the metachain nonces notarizing it
+// against the highest final metachain nonce known by the node. An empty string is returned when the database lookup
+// extensions are disabled, since the notarizations of the transactions are not tracked
+func (atp *apiTransactionProcessor) GetTransactionFinality(tx *transaction.ApiTransactionResult) string {
+	if !atp.historyRepository.IsEnabled() {
+		return ""
+	}
+
+	finality := txstatus.ComputeFinality(
+		tx.NotarizedAtSourceInMetaNonce,
+		tx.NotarizedAtDestinationInMetaNonce,
+		atp.getHighestFinalMetaNonce(),
+	)
+
+	return string(finality)
+}
+
+// getHighestFinalMetaNonce returns the highest final metachain nonce. The shard nodes only know the last metachain
+// block notarized by their own shard, which is final from their point of view
+func (atp *apiTransactionProcessor) getHighestFinalMetaNonce() uint64 {
+	if atp.shardCoordinator.SelfId() == core.MetachainShardId {
+		return atp.forkDetector.GetHighestFinalBlockNonce()
+	}
+
+	lastMetaHeader, _, err := atp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
+	if err != nil || check.IfNil(lastMetaHeader) {
+		return 0
+	}
+
+	return lastMetaHeader.GetNonce()
+}
+
 // PopulateComputedFields populates (computes) transaction fields such as processing type(s), initially paid fee etc.
 func (atp *apiTransactionProcessor) PopulateComputedFields(tx *transaction.ApiTransactionResult) {
 	atp.populateComputedFieldsProcessingType(tx)
@@ -241,6 +278,157 @@ func (atp *apiTransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender
 	}, nil
 }
 
+// GetTransactionPoolHistory will return the recorded pool removals and rejections of the given transaction
+func (atp *apiTransactionProcessor) GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	poolJournal := atp.dataPool.PoolJournal()
+	if !poolJournal.IsEnabled() {
+		return nil, ErrPoolJournalDisabled
+	}
+
+	entries := poolJournal.GetEntriesForTx(hash)
+
+	return &common.TransactionPoolHistoryApiResponse{
+		TxHash:  txHash,
+		Entries: atp.convertPoolJournalEntries(entries),
+	}, nil
+}
+
+// GetTransactionsPoolEvictions will return the latest recorded pool evictions and rejections, newest first
+func (atp *apiTransactionProcessor) GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+	poolJournal := atp.dataPool.PoolJournal()
+	if !poolJournal.IsEnabled() {
+		return nil, ErrPoolJournalDisabled
+	}
+
+	entries := poolJournal.GetLatestEvictions(maxNumEvictions)
+
+	return &common.TransactionsPoolEvictionsApiResponse{
+		Evictions: atp.convertPoolJournalEntries(entries),
+	}, nil
+}
+
+// GetTransactionStateChanges will return the changes done on the accounts by the given executed transaction, as
+// recorded by the history repository
+func (atp *apiTransactionProcessor) GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return atp.historyRepository.GetStateChangesByTxHash(hash)
+}
+
+// GetTransactionsByAddress will return, newest first, at most "size" transactions the given address took part in,
+// skipping the newest "from" ones
+func (atp *apiTransactionProcessor) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error) {
+	addressBytes, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	txsHashes, total, err := atp.historyRepository.GetTransactionsHashesByAddress(addressBytes, from, size)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*transaction.ApiTransactionResult, 0, len(txsHashes))
+	for _, txHash := range txsHashes {
+		tx, errGet := atp.GetTransaction(hex.EncodeToString(txHash), false)
+		if errGet != nil {
+			log.Debug("apiTransactionProcessor.GetTransactionsByAddress: cannot get transaction",
+				"txHash", txHash, "error", errGet)
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return &common.AddressTransactionsApiResponse{
+		Address:      address,
+		Transactions: txs,
+		Total:        total,
+	}, nil
+}
+
+// GetEvents will return, oldest first, at most "size" events logged in the requested blocks range and matching the
+// query filters, skipping the first "from" ones
+func (atp *apiTransactionProcessor) GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+	query := &dblookupext.EventsQuery{
+		FromNonce:  options.FromNonce,
+		ToNonce:    options.ToNonce,
+		Identifier: []byte(options.Identifier),
+		Topics:     make([][]byte, 0, len(options.Topics)),
+		From:       options.From,
+		Size:       options.Size,
+	}
+
+	if len(options.Address) > 0 {
+		addressBytes, err := atp.addressPubKeyConverter.Decode(options.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+		}
+
+		query.Address = addressBytes
+	}
+
+	for _, topic := range options.Topics {
+		topicBytes, err := hex.DecodeString(topic)
+		if err != nil {
+			return nil, fmt.Errorf("%s, %w", ErrInvalidEventTopic.Error(), err)
+		}
+
+		query.Topics = append(query.Topics, topicBytes)
+	}
+
+	events, hasMore, err := atp.historyRepository.GetEvents(query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.EventsApiResponse{
+		Events:  make([]*common.EventApiResponse, 0, len(events)),
+		HasMore: hasMore,
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, &common.EventApiResponse{
+			TxHash:     hex.EncodeToString(event.TxHash),
+			BlockNonce: event.BlockNonce,
+			BlockHash:  hex.EncodeToString(event.BlockHash),
+			Address:    atp.addressPubKeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	return response, nil
+}
+
+func (atp *apiTransactionProcessor) convertPoolJournalEntries(entries []*txcache.PoolJournalEntry) []common.PoolJournalEntryApiResponse {
+	result := make([]common.PoolJournalEntryApiResponse, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, common.PoolJournalEntryApiResponse{
+			TxHash:      hex.EncodeToString(entry.TxHash),
+			Sender:      atp.addressPubKeyConverter.Encode(entry.Sender),
+			Nonce:       entry.Nonce,
+			GasPrice:    entry.GasPrice,
+			CacheID:     entry.CacheID,
+			Reason:      string(entry.Reason),
+			Details:     entry.Details,
+			Timestamp:   entry.Timestamp,
+			SenderScore: entry.SenderScore,
+			PoolSize:    entry.PoolSize,
+		})
+	}
+
+	return result
+}
+
 func (atp *apiTransactionProcessor) extractRequestedTxInfoFromObj(txObj interface{}, txType transaction.TxType, txHash []byte, requestedFieldsHandler fieldsHandler) (common.Transaction, error) {
 	txResult, err := atp.getApiResultFromObj(txObj, txType)
 	if err != nil {
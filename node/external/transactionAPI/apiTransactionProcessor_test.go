@@ -8,6 +8,7 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	processMocks "github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -58,6 +60,8 @@ func createMockArgAPITransactionProcessor() *ArgAPITransactionProcessor {
 				return &datafield.ResponseParseData{}
 			},
 		},
+		ForkDetector: &mock.ForkDetectorMock{},
+		BlockTracker: &mock.BlockTrackerStub{},
 	}
 }
 
@@ -180,6 +184,26 @@ func TestNewAPITransactionProcessor(t *testing.T) {
 		_, err := NewAPITransactionProcessor(arguments)
 		require.Equal(t, ErrNilDataFieldParser, err)
 	})
+
+	t.Run("NilForkDetector", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgAPITransactionProcessor()
+		arguments.ForkDetector = nil
+
+		_, err := NewAPITransactionProcessor(arguments)
+		require.Equal(t, process.ErrNilForkDetector, err)
+	})
+
+	t.Run("NilBlockTracker", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgAPITransactionProcessor()
+		arguments.BlockTracker = nil
+
+		_, err := NewAPITransactionProcessor(arguments)
+		require.Equal(t, process.ErrNilBlockTracker, err)
+	})
 }
 
 func TestNode_GetTransactionInvalidHashShouldErr(t *testing.T) {
@@ -426,6 +450,8 @@ func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 				return &datafield.ResponseParseData{}
 			},
 		},
+		ForkDetector: &mock.ForkDetectorMock{},
+		BlockTracker: &mock.BlockTrackerStub{},
 	}
 	apiTransactionProc, _ := NewAPITransactionProcessor(args)
 
@@ -954,6 +980,256 @@ func TestApiTransactionProcessor_GetTransactionsPoolNonceGapsForSender(t *testin
 	}, res)
 }
 
+func TestApiTransactionProcessor_GetTransactionPoolHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgAPITransactionProcessor())
+		res, err := atp.GetTransactionPoolHistory("not hex")
+		require.Nil(t, res)
+		require.NotNil(t, err)
+	})
+	t.Run("disabled journal should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{}
+		atp, _ := NewAPITransactionProcessor(args)
+		res, err := atp.GetTransactionPoolHistory(hex.EncodeToString([]byte("txHash")))
+		require.Nil(t, res)
+		require.Equal(t, ErrPoolJournalDisabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txHash := []byte("txHash")
+		journal, _ := txcache.NewPoolJournal(10)
+		journal.Record(&txcache.PoolJournalEntry{
+			TxHash:      txHash,
+			Sender:      []byte("alice"),
+			Nonce:       7,
+			GasPrice:    1000,
+			CacheID:     "0",
+			Reason:      txcache.RemovalReasonEvicted,
+			Timestamp:   1234,
+			SenderScore: 42,
+			PoolSize:    100,
+		})
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			PoolJournalCalled: func() txcache.PoolJournalHandler {
+				return journal
+			},
+		}
+		args.AddressPubKeyConverter = &mock.PubkeyConverterStub{
+			EncodeCalled: func(pkBytes []byte) string {
+				return string(pkBytes)
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		expectedResponse := &common.TransactionPoolHistoryApiResponse{
+			TxHash: hex.EncodeToString(txHash),
+			Entries: []common.PoolJournalEntryApiResponse{
+				{
+					TxHash:      hex.EncodeToString(txHash),
+					Sender:      "alice",
+					Nonce:       7,
+					GasPrice:    1000,
+					CacheID:     "0",
+					Reason:      string(txcache.RemovalReasonEvicted),
+					Timestamp:   1234,
+					SenderScore: 42,
+					PoolSize:    100,
+				},
+			},
+		}
+		res, err := atp.GetTransactionPoolHistory(hex.EncodeToString(txHash))
+		require.NoError(t, err)
+		require.Equal(t, expectedResponse, res)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolEvictions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled journal should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{}
+		atp, _ := NewAPITransactionProcessor(args)
+		res, err := atp.GetTransactionsPoolEvictions(10)
+		require.Nil(t, res)
+		require.Equal(t, ErrPoolJournalDisabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		journal, _ := txcache.NewPoolJournal(10)
+		journal.Record(&txcache.PoolJournalEntry{TxHash: []byte("txHash0"), Reason: txcache.RemovalReasonEvicted})
+		journal.Record(&txcache.PoolJournalEntry{TxHash: []byte("txHash1"), Reason: txcache.RemovalReasonIncluded})
+		journal.Record(&txcache.PoolJournalEntry{TxHash: []byte("txHash2"), Reason: txcache.RemovalReasonRejected, Details: "bad nonce"})
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			PoolJournalCalled: func() txcache.PoolJournalHandler {
+				return journal
+			},
+		}
+		args.AddressPubKeyConverter = &mock.PubkeyConverterStub{
+			EncodeCalled: func(pkBytes []byte) string {
+				return string(pkBytes)
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolEvictions(10)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(res.Evictions))
+		require.Equal(t, hex.EncodeToString([]byte("txHash2")), res.Evictions[0].TxHash)
+		require.Equal(t, "bad nonce", res.Evictions[0].Details)
+		require.Equal(t, hex.EncodeToString([]byte("txHash0")), res.Evictions[1].TxHash)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, true)
+		res, err := atp.GetTransactionsByAddress("not hex", 0, 10)
+		require.Nil(t, res)
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		atp, _, _, historyRepo := createAPITransactionProc(t, 42, true)
+		historyRepo.GetTransactionsHashesByAddressCalled = func(address []byte, from uint64, size uint64) ([][]byte, uint64, error) {
+			return nil, 0, expectedErr
+		}
+
+		res, err := atp.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), 0, 10)
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, dataPool, historyRepo := createAPITransactionProc(t, 42, false)
+		txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+		dataPool.Transactions().AddData([]byte("a"), txA, 42, "1")
+		historyRepo.GetTransactionsHashesByAddressCalled = func(address []byte, from uint64, size uint64) ([][]byte, uint64, error) {
+			require.Equal(t, []byte("alice"), address)
+			require.Equal(t, uint64(1), from)
+			require.Equal(t, uint64(5), size)
+
+			// the second transaction cannot be found, thus it is skipped
+			return [][]byte{[]byte("a"), []byte("missing")}, 3, nil
+		}
+
+		address := hex.EncodeToString([]byte("alice"))
+		res, err := atp.GetTransactionsByAddress(address, 1, 5)
+		require.Nil(t, err)
+		require.Equal(t, address, res.Address)
+		require.Equal(t, uint64(3), res.Total)
+		require.Equal(t, 1, len(res.Transactions))
+		require.Equal(t, hex.EncodeToString([]byte("a")), res.Transactions[0].Hash)
+		require.Equal(t, txA.Nonce, res.Transactions[0].Nonce)
+	})
+}
+
+func TestApiTransactionProcessor_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, true)
+		res, err := atp.GetEvents(common.EventsQueryOptions{Address: "not hex"})
+		require.Nil(t, res)
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+	})
+	t.Run("invalid topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, true)
+		res, err := atp.GetEvents(common.EventsQueryOptions{Topics: []string{"not hex"}})
+		require.Nil(t, res)
+		require.True(t, strings.Contains(err.Error(), ErrInvalidEventTopic.Error()))
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		atp, _, _, historyRepo := createAPITransactionProc(t, 42, true)
+		historyRepo.GetEventsCalled = func(query *dblookupext.EventsQuery) ([]*dblookupext.IndexedEvent, bool, error) {
+			return nil, false, expectedErr
+		}
+
+		res, err := atp.GetEvents(common.EventsQueryOptions{})
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, historyRepo := createAPITransactionProc(t, 42, true)
+		historyRepo.GetEventsCalled = func(query *dblookupext.EventsQuery) ([]*dblookupext.IndexedEvent, bool, error) {
+			expectedQuery := &dblookupext.EventsQuery{
+				FromNonce:  3,
+				ToNonce:    7,
+				Address:    []byte("alice"),
+				Identifier: []byte("ESDTTransfer"),
+				Topics:     [][]byte{[]byte("TKN"), {}},
+				From:       1,
+				Size:       5,
+			}
+			require.Equal(t, expectedQuery, query)
+
+			return []*dblookupext.IndexedEvent{
+				{
+					TxHash:     []byte("txA"),
+					BlockNonce: 4,
+					BlockHash:  []byte("block"),
+					Address:    []byte("alice"),
+					Identifier: []byte("ESDTTransfer"),
+					Topics:     [][]byte{[]byte("TKN")},
+				},
+			}, true, nil
+		}
+
+		res, err := atp.GetEvents(common.EventsQueryOptions{
+			FromNonce:  3,
+			ToNonce:    7,
+			Address:    hex.EncodeToString([]byte("alice")),
+			Identifier: "ESDTTransfer",
+			Topics:     []string{hex.EncodeToString([]byte("TKN")), ""},
+			From:       1,
+			Size:       5,
+		})
+		require.Nil(t, err)
+		require.True(t, res.HasMore)
+		require.Equal(t, []*common.EventApiResponse{
+			{
+				TxHash:     hex.EncodeToString([]byte("txA")),
+				BlockNonce: 4,
+				BlockHash:  hex.EncodeToString([]byte("block")),
+				Address:    hex.EncodeToString([]byte("alice")),
+				Identifier: "ESDTTransfer",
+				Topics:     [][]byte{[]byte("TKN")},
+			},
+		}, res.Events)
+	})
+}
+
 func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool) (*apiTransactionProcessor, *genericMocks.ChainStorerMock, *dataRetrieverMock.PoolsHolderMock, *dblookupextMock.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := dataRetrieverMock.NewPoolsHolderMock()
@@ -983,6 +1259,8 @@ func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool)
 		TxTypeHandler:            &testscommon.TxTypeHandlerMock{},
 		LogsFacade:               &testscommon.LogsFacadeStub{},
 		DataFieldParser:          dataFieldParser,
+		ForkDetector:             &mock.ForkDetectorMock{},
+		BlockTracker:             &mock.BlockTrackerStub{},
 	}
 	apiTransactionProc, err := NewAPITransactionProcessor(args)
 	require.Nil(t, err)
@@ -1031,6 +1309,111 @@ func setupGetMiniblockMetadataByTxHash(
 	}
 }
 
+func TestApiTransactionProcessor_GetTransactionStateChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgAPITransactionProcessor())
+		stateChanges, err := atp.GetTransactionStateChanges("not hex")
+		require.Nil(t, stateChanges)
+		require.NotNil(t, err)
+	})
+	t.Run("should return the recorded state changes", func(t *testing.T) {
+		t.Parallel()
+
+		txHash := []byte("txHash")
+		expectedStateChanges := []*common.AccountStateChange{{Address: "address", BalanceBefore: "10", BalanceAfter: "5"}}
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetStateChangesByTxHashCalled: func(hash []byte) ([]*common.AccountStateChange, error) {
+				require.Equal(t, txHash, hash)
+				return expectedStateChanges, nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		stateChanges, err := atp.GetTransactionStateChanges(hex.EncodeToString(txHash))
+		require.Nil(t, err)
+		require.Equal(t, expectedStateChanges, stateChanges)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionFinality(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.ApiTransactionResult{
+		NotarizedAtSourceInMetaNonce:      10,
+		NotarizedAtDestinationInMetaNonce: 12,
+	}
+
+	t.Run("db lookup extensions disabled should return empty", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		require.Equal(t, "", atp.GetTransactionFinality(tx))
+	})
+	t.Run("shard node should use the last metachain block notarized by its shard", func(t *testing.T) {
+		t.Parallel()
+
+		lastMetaNonce := uint64(11)
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		args.BlockTracker = &mock.BlockTrackerStub{
+			GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+				require.Equal(t, core.MetachainShardId, shardID)
+				return &block.MetaBlock{Nonce: lastMetaNonce}, []byte("hash"), nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		require.Equal(t, string(txstatus.TxFinalityFinalAtSource), atp.GetTransactionFinality(tx))
+
+		lastMetaNonce = 12
+		require.Equal(t, string(txstatus.TxFinalityFinal), atp.GetTransactionFinality(tx))
+
+		lastMetaNonce = 9
+		require.Equal(t, string(txstatus.TxFinalityNotFinal), atp.GetTransactionFinality(tx))
+	})
+	t.Run("metachain node should use the highest final block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.ShardCoordinator = &mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		args.ForkDetector = &mock.ForkDetectorMock{
+			GetHighestFinalBlockNonceCalled: func() uint64 {
+				return 12
+			},
+		}
+		args.BlockTracker = &mock.BlockTrackerStub{
+			GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil, nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		require.Equal(t, string(txstatus.TxFinalityFinal), atp.GetTransactionFinality(tx))
+	})
+}
+
 func TestPrepareUnsignedTx(t *testing.T) {
 	t.Parallel()
 	addrSize := 32
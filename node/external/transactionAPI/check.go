@@ -42,6 +42,12 @@ func checkNilArgs(arg *ArgAPITransactionProcessor) error {
 	if check.IfNilReflect(arg.DataFieldParser) {
 		return ErrNilDataFieldParser
 	}
+	if check.IfNil(arg.ForkDetector) {
+		return process.ErrNilForkDetector
+	}
+	if check.IfNil(arg.BlockTracker) {
+		return process.ErrNilBlockTracker
+	}
 
 	return nil
 }
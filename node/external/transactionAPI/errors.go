@@ -32,5 +32,11 @@ var ErrCannotRetrieveTransactions = errors.New("transactions cannot be retrieved
 // ErrInvalidAddress signals that the address is invalid
 var ErrInvalidAddress = errors.New("invalid address")
 
+// ErrInvalidEventTopic signals that an event topic is invalid
+var ErrInvalidEventTopic = errors.New("invalid event topic")
+
 // ErrCannotRetrieveNonce signals that nonce cannot be retrieved
 var ErrCannotRetrieveNonce = errors.New("nonce cannot be retrieved")
+
+// ErrPoolJournalDisabled signals that the transactions pool journal is disabled
+var ErrPoolJournalDisabled = errors.New("transactions pool journal is disabled")
@@ -3,6 +3,7 @@ package transactionAPI
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	datafield "github.com/ElrondNetwork/elrond-vm-common/parsers/dataField"
 )
@@ -12,6 +13,16 @@ type feeComputer interface {
 	IsInterfaceNil() bool
 }
 
+type forkDetector interface {
+	GetHighestFinalBlockNonce() uint64
+	IsInterfaceNil() bool
+}
+
+type blockTracker interface {
+	GetLastCrossNotarizedHeader(shardID uint32) (data.HeaderHandler, []byte, error)
+	IsInterfaceNil() bool
+}
+
 // LogsFacade defines the interface of a logs facade
 type LogsFacade interface {
 	GetLog(logKey []byte, epoch uint32) (*transaction.ApiLogs, error)
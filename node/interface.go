@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -82,3 +83,8 @@ type HealthService interface {
 	io.Closer
 	RegisterComponent(component interface{})
 }
+
+type txReplacementChecker interface {
+	CheckTxReplacement(tx *txcache.WrappedTransaction) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// HyperblockAPIHandlerStub -
+type HyperblockAPIHandlerStub struct {
+	GetHyperblockByNonceCalled func(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+	GetHyperblockByHashCalled  func(hash []byte, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error)
+}
+
+// GetHyperblockByNonce -
+func (stub *HyperblockAPIHandlerStub) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	if stub.GetHyperblockByNonceCalled != nil {
+		return stub.GetHyperblockByNonceCalled(nonce, options)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByHash -
+func (stub *HyperblockAPIHandlerStub) GetHyperblockByHash(hash []byte, options api.BlockQueryOptions) (*common.HyperblockApiResponse, error) {
+	if stub.GetHyperblockByHashCalled != nil {
+		return stub.GetHyperblockByHashCalled(hash, options)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *HyperblockAPIHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
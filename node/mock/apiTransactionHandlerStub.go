@@ -8,10 +8,16 @@ import (
 // TransactionAPIHandlerStub -
 type TransactionAPIHandlerStub struct {
 	GetTransactionCalled                        func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateChangesCalled            func(txHash string) ([]*common.AccountStateChange, error)
+	GetTransactionFinalityCalled                func(tx *transaction.ApiTransactionResult) string
 	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionPoolHistoryCalled             func(txHash string) (*common.TransactionPoolHistoryApiResponse, error)
+	GetTransactionsPoolEvictionsCalled          func(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetTransactionsByAddressCalled              func(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error)
+	GetEventsCalled                             func(options common.EventsQueryOptions) (*common.EventsApiResponse, error)
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
@@ -26,6 +32,24 @@ func (tas *TransactionAPIHandlerStub) GetTransaction(hash string, withResults bo
 	return nil, nil
 }
 
+// GetTransactionStateChanges -
+func (tas *TransactionAPIHandlerStub) GetTransactionStateChanges(txHash string) ([]*common.AccountStateChange, error) {
+	if tas.GetTransactionStateChangesCalled != nil {
+		return tas.GetTransactionStateChangesCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionFinality -
+func (tas *TransactionAPIHandlerStub) GetTransactionFinality(tx *transaction.ApiTransactionResult) string {
+	if tas.GetTransactionFinalityCalled != nil {
+		return tas.GetTransactionFinalityCalled(tx)
+	}
+
+	return ""
+}
+
 // GetTransactionsPool -
 func (tas *TransactionAPIHandlerStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
 	if tas.GetTransactionsPoolCalled != nil {
@@ -62,6 +86,42 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(send
 	return nil, nil
 }
 
+// GetTransactionPoolHistory -
+func (tas *TransactionAPIHandlerStub) GetTransactionPoolHistory(txHash string) (*common.TransactionPoolHistoryApiResponse, error) {
+	if tas.GetTransactionPoolHistoryCalled != nil {
+		return tas.GetTransactionPoolHistoryCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolEvictions -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolEvictions(maxNumEvictions int) (*common.TransactionsPoolEvictionsApiResponse, error) {
+	if tas.GetTransactionsPoolEvictionsCalled != nil {
+		return tas.GetTransactionsPoolEvictionsCalled(maxNumEvictions)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (tas *TransactionAPIHandlerStub) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsApiResponse, error) {
+	if tas.GetTransactionsByAddressCalled != nil {
+		return tas.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (tas *TransactionAPIHandlerStub) GetEvents(options common.EventsQueryOptions) (*common.EventsApiResponse, error) {
+	if tas.GetEventsCalled != nil {
+		return tas.GetEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
@@ -4,6 +4,8 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
@@ -21,6 +23,8 @@ type CryptoComponentsMock struct {
 	BlKeyGen        crypto.KeyGenerator
 	TxKeyGen        crypto.KeyGenerator
 	MsgSigVerifier  vm.MessageSignVerifier
+	ManagedPeers    common.ManagedPeersHolder
+	SigHandler      consensus.SigningHandler
 	mutMultiSig     sync.RWMutex
 }
 
@@ -114,6 +118,16 @@ func (ccm *CryptoComponentsMock) MessageSignVerifier() vm.MessageSignVerifier {
 	return ccm.MsgSigVerifier
 }
 
+// ManagedPeersHolder -
+func (ccm *CryptoComponentsMock) ManagedPeersHolder() common.ManagedPeersHolder {
+	return ccm.ManagedPeers
+}
+
+// SigningHandler -
+func (ccm *CryptoComponentsMock) SigningHandler() consensus.SigningHandler {
+	return ccm.SigHandler
+}
+
 // Clone -
 func (ccm *CryptoComponentsMock) Clone() interface{} {
 	return &CryptoComponentsMock{
@@ -129,6 +143,8 @@ func (ccm *CryptoComponentsMock) Clone() interface{} {
 		BlKeyGen:        ccm.BlKeyGen,
 		TxKeyGen:        ccm.TxKeyGen,
 		MsgSigVerifier:  ccm.MsgSigVerifier,
+		ManagedPeers:    ccm.ManagedPeers,
+		SigHandler:      ccm.SigHandler,
 		mutMultiSig:     sync.RWMutex{},
 	}
 }
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// GasPriceSuggestionHandlerStub -
+type GasPriceSuggestionHandlerStub struct {
+	GetGasPriceSuggestionCalled func(numBlocks int) (*common.GasPriceSuggestionApiResponse, error)
+}
+
+// GetGasPriceSuggestion -
+func (stub *GasPriceSuggestionHandlerStub) GetGasPriceSuggestion(numBlocks int) (*common.GasPriceSuggestionApiResponse, error) {
+	if stub.GetGasPriceSuggestionCalled != nil {
+		return stub.GetGasPriceSuggestionCalled(numBlocks)
+	}
+
+	return &common.GasPriceSuggestionApiResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *GasPriceSuggestionHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/trie"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
@@ -95,6 +96,7 @@ type Node struct {
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
 	isInImportMode            bool
+	isFullArchive             bool
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -483,7 +485,6 @@ func (n *Node) GetAllESDTTokens(address string, options api.AccountQueryOptions,
 	}
 
 	esdtPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
-	lenESDTPrefix := len(esdtPrefix)
 
 	rootHash, err := userAccount.DataTrie().RootHash()
 	if err != nil {
@@ -501,29 +502,17 @@ func (n *Node) GetAllESDTTokens(address string, options api.AccountQueryOptions,
 			continue
 		}
 
-		tokenKey := leaf.Key()
-		tokenName := string(tokenKey[lenESDTPrefix:])
-		esdtToken := &esdt.ESDigitalToken{Value: big.NewInt(0)}
-
 		userAccountVmCommon, ok := userAccount.(vmcommon.UserAccountHandler)
 		if !ok {
 			return nil, api.BlockInfo{}, ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler
 		}
 
-		tokenID, nonce := common.ExtractTokenIDAndNonceFromTokenStorageKey([]byte(tokenName))
-
-		esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
-		esdtToken, _, err = n.esdtStorageHandler.GetESDTNFTTokenOnDestination(userAccountVmCommon, esdtTokenKey, nonce)
-		if err != nil {
-			log.Warn("cannot get ESDT token", "token name", tokenName, "error", err)
+		tokenName, esdtToken, errGet := n.getESDTTokenFromKey(userAccountVmCommon, leaf.Key())
+		if errGet != nil {
+			log.Warn("cannot get ESDT token", "token name", tokenName, "error", errGet)
 			continue
 		}
 
-		if esdtToken.TokenMetaData != nil {
-			esdtToken.TokenMetaData.Creator = []byte(n.coreComponents.AddressPubKeyConverter().Encode(esdtToken.TokenMetaData.Creator))
-			tokenName = adjustNftTokenIdentifier(tokenName, esdtToken.TokenMetaData.Nonce)
-		}
-
 		allESDTs[tokenName] = esdtToken
 	}
 
@@ -534,6 +523,26 @@ func (n *Node) GetAllESDTTokens(address string, options api.AccountQueryOptions,
 	return allESDTs, blockInfo, nil
 }
 
+// getESDTTokenFromKey returns the ESDT token stored under the provided data trie key, together with its identifier
+func (n *Node) getESDTTokenFromKey(userAccount vmcommon.UserAccountHandler, tokenKey []byte) (string, *esdt.ESDigitalToken, error) {
+	lenESDTPrefix := len(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	tokenName := string(tokenKey[lenESDTPrefix:])
+	tokenID, nonce := common.ExtractTokenIDAndNonceFromTokenStorageKey([]byte(tokenName))
+
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	esdtToken, _, err := n.esdtStorageHandler.GetESDTNFTTokenOnDestination(userAccount, esdtTokenKey, nonce)
+	if err != nil {
+		return tokenName, nil, err
+	}
+
+	if esdtToken.TokenMetaData != nil {
+		esdtToken.TokenMetaData.Creator = []byte(n.coreComponents.AddressPubKeyConverter().Encode(esdtToken.TokenMetaData.Creator))
+		tokenName = adjustNftTokenIdentifier(tokenName, esdtToken.TokenMetaData.Nonce)
+	}
+
+	return tokenName, esdtToken, nil
+}
+
 func adjustNftTokenIdentifier(token string, nonce uint64) string {
 	splitToken := strings.Split(token, "-")
 	if len(splitToken) < 2 {
@@ -594,7 +603,38 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 		return err
 	}
 
-	return txValidator.CheckTxValidity(intTx)
+	err = txValidator.CheckTxValidity(intTx)
+	if err != nil {
+		return err
+	}
+
+	return n.checkTxReplacement(tx)
+}
+
+// checkTxReplacement verifies that the transaction will not be rejected by the replace-by-fee policy of the transactions
+// pool, as an underpriced replacement of a pending transaction
+func (n *Node) checkTxReplacement(tx *transaction.Transaction) error {
+	if check.IfNil(n.dataComponents) || check.IfNil(n.dataComponents.Datapool()) {
+		return nil
+	}
+	txPool, ok := n.dataComponents.Datapool().Transactions().(txReplacementChecker)
+	if !ok || check.IfNil(txPool) {
+		return nil
+	}
+
+	txHash, err := core.CalculateHash(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher(), tx)
+	if err != nil {
+		return err
+	}
+
+	// the pending transaction having the same nonce might be held by the cache of any of the sender's destination shards
+	shardCoordinator := n.bootstrapComponents.ShardCoordinator()
+	return txPool.CheckTxReplacement(&txcache.WrappedTransaction{
+		Tx:              tx,
+		TxHash:          txHash,
+		SenderShardID:   shardCoordinator.SelfId(),
+		ReceiverShardID: shardCoordinator.ComputeId(tx.RcvAddr),
+	})
 }
 
 // ValidateTransactionForSimulation will validate a transaction for use in transaction simulation process
@@ -0,0 +1,228 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/holders"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+type accountHistoryState struct {
+	balance      string
+	nonce        uint64
+	esdtBalances map[string]string
+}
+
+// accountHistoryWalker computes the state of an account at consecutive blocks. Blocks sharing the state root hash
+// share the recreated trie, while blocks where the account leaf did not change reuse the previous state, without
+// decoding the account or reading its data trie again.
+type accountHistoryWalker struct {
+	node             *Node
+	address          []byte
+	tokens           []string
+	templateTrie     common.Trie
+	lastRootHash     []byte
+	lastLeafHash     []byte
+	lastDataRootHash []byte
+	lastState        *accountHistoryState
+}
+
+// GetAccountHistory returns the balance, the nonce and the selected ESDT balances of an account at each of the
+// requested blocks. It is available only on full archive nodes, as it needs the state of the old blocks.
+func (n *Node) GetAccountHistory(address string, options common.AccountHistoryQueryOptions, ctx context.Context) (*common.AccountHistoryApiResponse, error) {
+	if !n.isFullArchive {
+		return nil, ErrFullArchiveOnlyEndpoint
+	}
+	if options.Step == 0 || options.FromNonce > options.ToNonce {
+		return nil, ErrInvalidAccountHistoryRange
+	}
+
+	pubKey, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// recreating a trie from the empty root hash returns a new trie using the same storage, marshaller and hasher
+	templateTrie, err := n.stateComponents.AccountsAdapterAPI().GetTrie(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	walker := &accountHistoryWalker{
+		node:         n,
+		address:      pubKey,
+		tokens:       options.Tokens,
+		templateTrie: templateTrie,
+	}
+
+	history := make([]*common.AccountHistoryPoint, 0)
+	for nonce := options.FromNonce; ; nonce += options.Step {
+		if ctx.Err() != nil {
+			return nil, ErrTrieOperationsTimeout
+		}
+
+		point, errPoint := walker.getPointAtNonce(nonce)
+		if errPoint != nil {
+			return nil, errPoint
+		}
+		history = append(history, point)
+
+		if options.ToNonce-nonce < options.Step {
+			break
+		}
+	}
+
+	return &common.AccountHistoryApiResponse{
+		Address: address,
+		History: history,
+	}, nil
+}
+
+func (walker *accountHistoryWalker) getPointAtNonce(nonce uint64) (*common.AccountHistoryPoint, error) {
+	header, headerHash, err := walker.node.getBlockHeaderByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := walker.node.getBlockRootHash(headerHash, header)
+	accountState, err := walker.getState(rootHash, header.GetEpoch())
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.AccountHistoryPoint{
+		BlockNonce:    header.GetNonce(),
+		BlockHash:     hex.EncodeToString(headerHash),
+		BlockRootHash: hex.EncodeToString(rootHash),
+		Balance:       accountState.balance,
+		Nonce:         accountState.nonce,
+		ESDTBalances:  accountState.esdtBalances,
+	}, nil
+}
+
+func (walker *accountHistoryWalker) getState(rootHash []byte, epoch uint32) (*accountHistoryState, error) {
+	if walker.lastState != nil && bytes.Equal(rootHash, walker.lastRootHash) {
+		return walker.lastState, nil
+	}
+
+	mainTrie, err := walker.recreateTrie(rootHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	leaf, err := mainTrie.Get(walker.address)
+	if err != nil {
+		return nil, err
+	}
+	walker.lastRootHash = rootHash
+
+	if len(leaf) == 0 {
+		walker.lastLeafHash = nil
+		walker.lastDataRootHash = nil
+		walker.lastState = walker.emptyState()
+		return walker.lastState, nil
+	}
+
+	leafHash := walker.node.coreComponents.Hasher().Compute(string(leaf))
+	if walker.lastState != nil && bytes.Equal(leafHash, walker.lastLeafHash) {
+		return walker.lastState, nil
+	}
+
+	account := state.NewEmptyUserAccount()
+	err = walker.node.coreComponents.InternalMarshalizer().Unmarshal(account, leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	accountState := &accountHistoryState{
+		balance: "0",
+		nonce:   account.Nonce,
+	}
+	if account.Balance != nil {
+		accountState.balance = account.Balance.String()
+	}
+
+	canReuseESDTBalances := walker.lastState != nil && len(walker.lastLeafHash) > 0 && bytes.Equal(account.RootHash, walker.lastDataRootHash)
+	if canReuseESDTBalances {
+		accountState.esdtBalances = walker.lastState.esdtBalances
+	} else {
+		accountState.esdtBalances, err = walker.getESDTBalances(account.RootHash, epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	walker.lastLeafHash = leafHash
+	walker.lastDataRootHash = account.RootHash
+	walker.lastState = accountState
+
+	return accountState, nil
+}
+
+func (walker *accountHistoryWalker) getESDTBalances(dataTrieRootHash []byte, epoch uint32) (map[string]string, error) {
+	if len(walker.tokens) == 0 {
+		return nil, nil
+	}
+
+	esdtBalances := walker.emptyState().esdtBalances
+	if len(dataTrieRootHash) == 0 {
+		return esdtBalances, nil
+	}
+
+	dataTrie, err := walker.recreateTrie(dataTrieRootHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range walker.tokens {
+		esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + token)
+		value, errGet := dataTrie.Get(esdtTokenKey)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		// the values saved in the data tries have the key and the address appended
+		tailLength := len(esdtTokenKey) + len(walker.address)
+		if len(value) <= tailLength {
+			continue
+		}
+
+		esdtToken := &esdt.ESDigitalToken{}
+		err = walker.node.coreComponents.InternalMarshalizer().Unmarshal(esdtToken, value[:len(value)-tailLength])
+		if err != nil {
+			return nil, err
+		}
+		if esdtToken.Value != nil {
+			esdtBalances[token] = esdtToken.Value.String()
+		}
+	}
+
+	return esdtBalances, nil
+}
+
+func (walker *accountHistoryWalker) recreateTrie(rootHash []byte, epoch uint32) (common.Trie, error) {
+	options := holders.NewRootHashHolder(rootHash, core.OptionalUint32{Value: epoch, HasValue: true})
+
+	return walker.templateTrie.RecreateFromEpoch(options)
+}
+
+func (walker *accountHistoryWalker) emptyState() *accountHistoryState {
+	accountState := &accountHistoryState{
+		balance: "0",
+	}
+	if len(walker.tokens) == 0 {
+		return accountState
+	}
+
+	accountState.esdtBalances = make(map[string]string, len(walker.tokens))
+	for _, token := range walker.tokens {
+		accountState.esdtBalances[token] = "0"
+	}
+
+	return accountState
+}
@@ -0,0 +1,178 @@
+package node_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/require"
+)
+
+type recreateCounterTrie struct {
+	common.Trie
+	numRecreates int
+}
+
+// RecreateFromEpoch -
+func (tr *recreateCounterTrie) RecreateFromEpoch(options common.RootHashHolder) (common.Trie, error) {
+	tr.numRecreates++
+	return tr.Trie.RecreateFromEpoch(options)
+}
+
+func TestNode_GetAccountHistoryNotFullArchiveShouldError(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	options := common.AccountHistoryQueryOptions{FromNonce: 1, ToNonce: 2, Step: 1}
+	response, err := n.GetAccountHistory(testscommon.TestAddressAlice, options, context.Background())
+	require.Nil(t, response)
+	require.Equal(t, node.ErrFullArchiveOnlyEndpoint, err)
+}
+
+func TestNode_GetAccountHistoryInvalidRangeShouldError(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithFullArchive(true))
+
+	options := common.AccountHistoryQueryOptions{FromNonce: 1, ToNonce: 2, Step: 0}
+	response, err := n.GetAccountHistory(testscommon.TestAddressAlice, options, context.Background())
+	require.Nil(t, response)
+	require.Equal(t, node.ErrInvalidAccountHistoryRange, err)
+
+	options = common.AccountHistoryQueryOptions{FromNonce: 3, ToNonce: 2, Step: 1}
+	response, err = n.GetAccountHistory(testscommon.TestAddressAlice, options, context.Background())
+	require.Nil(t, response)
+	require.Equal(t, node.ErrInvalidAccountHistoryRange, err)
+}
+
+func TestNode_GetAccountHistoryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.Hash = &hashingMocks.HasherMock{}
+	stateComponents := getDefaultStateComponents()
+	dataComponents := getDefaultDataComponents()
+	processComponents := getDefaultProcessComponents()
+	marshaller := coreComponents.InternalMarshalizer()
+
+	args, _ := storage.GetStorageManagerArgsAndOptions()
+	trieStorage, _ := trie.NewTrieStorageManager(args)
+	newTrie := func() common.Trie {
+		tr, _ := trie.NewTrie(trieStorage, args.Marshalizer, args.Hasher, 5)
+		return tr
+	}
+
+	alice := testscommon.TestPubKeyAlice
+	bob := testscommon.TestPubKeyBob
+	token := "TKN-abcdef"
+
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + token)
+	esdtTokenBytes, _ := marshaller.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(5)})
+	dataTrie := newTrie()
+	_ = dataTrie.Update(esdtTokenKey, append(append(esdtTokenBytes, esdtTokenKey...), alice...))
+	_ = dataTrie.Commit()
+	dataTrieRootHash, _ := dataTrie.RootHash()
+
+	mainTrie := newTrie()
+	saveAccount := func(address []byte, nonce uint64, balance int64, dataRootHash []byte) {
+		accountBytes, _ := marshaller.Marshal(&state.UserAccountData{
+			Nonce:    nonce,
+			Balance:  big.NewInt(balance),
+			RootHash: dataRootHash,
+		})
+		_ = mainTrie.Update(address, accountBytes)
+		_ = mainTrie.Commit()
+	}
+
+	saveAccount(alice, 1, 100, dataTrieRootHash)
+	saveAccount(bob, 1, 10, nil)
+	rootHash1, _ := mainTrie.RootHash()
+	saveAccount(bob, 2, 20, nil)
+	rootHash2, _ := mainTrie.RootHash()
+	saveAccount(alice, 2, 150, dataTrieRootHash)
+	rootHash3, _ := mainTrie.RootHash()
+
+	// block 2 has the same state as block 1, block 3 changes only another account
+	rootHashes := [][]byte{rootHash1, rootHash1, rootHash2, rootHash3}
+	chainStorerMock := genericMocks.NewChainStorerMock(0)
+	for i, rootHash := range rootHashes {
+		nonce := uint64(i + 1)
+		headerHash := []byte{byte(nonce)}
+		headerBytes, _ := marshaller.Marshal(&block.Header{Nonce: nonce, RootHash: rootHash})
+		_ = chainStorerMock.BlockHeaders.PutInEpoch(headerHash, headerBytes, 0)
+		_ = chainStorerMock.ShardHdrNonce.PutInEpoch(coreComponents.Uint64ByteSliceConverter().ToByteSlice(nonce), headerHash, 0)
+	}
+	dataComponents.Store = chainStorerMock
+
+	processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return false
+		},
+	}
+	processComponents.ScheduledTxsExecutionHandlerInternal = &testscommon.ScheduledTxsExecutionStub{
+		GetScheduledRootHashForHeaderWithEpochCalled: func(headerHash []byte, epoch uint32) ([]byte, error) {
+			return nil, errors.New("missing")
+		},
+	}
+
+	templateTrie := &recreateCounterTrie{Trie: newTrie()}
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return templateTrie, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithFullArchive(true),
+	)
+
+	options := common.AccountHistoryQueryOptions{
+		FromNonce: 1,
+		ToNonce:   4,
+		Step:      1,
+		Tokens:    []string{token, "OTHER-123456"},
+	}
+	response, err := n.GetAccountHistory(testscommon.TestAddressAlice, options, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, testscommon.TestAddressAlice, response.Address)
+	require.Equal(t, 4, len(response.History))
+
+	expectedBalances := []string{"100", "100", "100", "150"}
+	expectedNonces := []uint64{1, 1, 1, 2}
+	for i, point := range response.History {
+		require.Equal(t, uint64(i+1), point.BlockNonce)
+		require.Equal(t, expectedBalances[i], point.Balance)
+		require.Equal(t, expectedNonces[i], point.Nonce)
+		require.Equal(t, map[string]string{token: "5", "OTHER-123456": "0"}, point.ESDTBalances)
+	}
+
+	// the main trie is recreated for blocks 1, 3 and 4, while the data trie is read only once
+	require.Equal(t, 4, templateTrie.numRecreates)
+
+	options = common.AccountHistoryQueryOptions{FromNonce: 1, ToNonce: 4, Step: 3}
+	response, err = n.GetAccountHistory(testscommon.TestAddressBob, options, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, len(response.History))
+	require.Equal(t, "10", response.History[0].Balance)
+	require.Equal(t, "20", response.History[1].Balance)
+	require.Nil(t, response.History[1].ESDTBalances)
+}
@@ -0,0 +1,204 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const maxScannedLeavesPerFilteredPage = 10000
+
+// IterateKeyValuePairs calls the handler, in the data trie order, with the hex encoded key-value pairs of the address,
+// starting with the page start key. When the page limit is reached, the iteration stops and the hex encoded key of the
+// next pair is returned, so that the iteration can be resumed from it
+func (n *Node) IterateKeyValuePairs(
+	address string,
+	pageOptions common.AccountStoragePageOptions,
+	options api.AccountQueryOptions,
+	ctx context.Context,
+	handler func(key string, value string) error,
+) (string, api.BlockInfo, error) {
+	userAccount, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	if err != nil {
+		return "", api.BlockInfo{}, err
+	}
+
+	nextKey, err := iterateDataTrieLeaves(userAccount, pageOptions, nil, ctx, func(leaf core.KeyValueHolder) error {
+		suffix := append(leaf.Key(), userAccount.AddressBytes()...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			log.Warn("cannot get value without suffix", "error", errVal, "key", leaf.Key())
+			return nil
+		}
+
+		return handler(hex.EncodeToString(leaf.Key()), hex.EncodeToString(value))
+	})
+	if err != nil {
+		return "", api.BlockInfo{}, err
+	}
+
+	return nextKey, blockInfo, nil
+}
+
+// IterateESDTTokens calls the handler, in the data trie order, with the ESDT tokens of the address, starting with the
+// page start key. The handler receives the hex encoded data trie key of each token, which can be used to resume the
+// iteration. When the page limit is reached, the iteration stops and the hex encoded key of the next token is returned.
+// As the ESDT keys are spread across the data trie, a page can also end, with fewer tokens, after scanning
+// maxScannedLeavesPerFilteredPage leaves
+func (n *Node) IterateESDTTokens(
+	address string,
+	pageOptions common.AccountStoragePageOptions,
+	options api.AccountQueryOptions,
+	ctx context.Context,
+	handler func(key string, tokenIdentifier string, token *esdt.ESDigitalToken) error,
+) (string, api.BlockInfo, error) {
+	userAccount, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	if err != nil {
+		return "", api.BlockInfo{}, err
+	}
+
+	userAccountVmCommon, ok := userAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return "", api.BlockInfo{}, ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler
+	}
+
+	esdtPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	nextKey, err := iterateDataTrieLeaves(userAccount, pageOptions, esdtPrefix, ctx, func(leaf core.KeyValueHolder) error {
+		tokenIdentifier, esdtToken, errGet := n.getESDTTokenFromKey(userAccountVmCommon, leaf.Key())
+		if errGet != nil {
+			log.Warn("cannot get ESDT token", "token name", tokenIdentifier, "error", errGet)
+			return nil
+		}
+
+		return handler(hex.EncodeToString(leaf.Key()), tokenIdentifier, esdtToken)
+	})
+	if err != nil {
+		return "", api.BlockInfo{}, err
+	}
+
+	return nextKey, blockInfo, nil
+}
+
+// GetKeyValuePairsPage returns a page of the hex encoded key-value pairs of the address
+func (n *Node) GetKeyValuePairsPage(
+	address string,
+	pageOptions common.AccountStoragePageOptions,
+	options api.AccountQueryOptions,
+	ctx context.Context,
+) (*common.KeyValuePairsPageApiResponse, api.BlockInfo, error) {
+	pairs := make(map[string]string)
+	nextKey, blockInfo, err := n.IterateKeyValuePairs(address, pageOptions, options, ctx, func(key string, value string) error {
+		pairs[key] = value
+		return nil
+	})
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	return &common.KeyValuePairsPageApiResponse{
+		Pairs:   pairs,
+		NextKey: nextKey,
+	}, blockInfo, nil
+}
+
+// GetESDTTokensPage returns a page of the ESDT tokens of the address
+func (n *Node) GetESDTTokensPage(
+	address string,
+	pageOptions common.AccountStoragePageOptions,
+	options api.AccountQueryOptions,
+	ctx context.Context,
+) (*common.ESDTTokensPageApiResponse, api.BlockInfo, error) {
+	tokens := make(map[string]*esdt.ESDigitalToken)
+	nextKey, blockInfo, err := n.IterateESDTTokens(address, pageOptions, options, ctx, func(_ string, tokenIdentifier string, token *esdt.ESDigitalToken) error {
+		tokens[tokenIdentifier] = token
+		return nil
+	})
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	return &common.ESDTTokensPageApiResponse{
+		Tokens:  tokens,
+		NextKey: nextKey,
+	}, blockInfo, nil
+}
+
+// iterateDataTrieLeaves calls the leaf handler with the leaves of the account data trie having the provided key
+// prefix, starting with the page start key. When the page limit is reached, the leaves iteration is interrupted and the
+// hex encoded key of the next matching leaf is returned. The trie order follows the reversed key nibbles, so the keys
+// sharing a prefix are not contiguous: a filtered iteration is also interrupted after scanning
+// maxScannedLeavesPerFilteredPage leaves, returning the key of the next leaf to be scanned
+func iterateDataTrieLeaves(
+	userAccount state.UserAccountHandler,
+	pageOptions common.AccountStoragePageOptions,
+	keyPrefix []byte,
+	ctx context.Context,
+	leafHandler func(leaf core.KeyValueHolder) error,
+) (string, error) {
+	startKey, err := hex.DecodeString(pageOptions.StartKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid start key: %w", err)
+	}
+	if pageOptions.Limit < 0 {
+		return "", fmt.Errorf("%w: %d", ErrInvalidPageLimit, pageOptions.Limit)
+	}
+
+	dataTrie := userAccount.DataTrie()
+	if check.IfNil(dataTrie) {
+		return "", nil
+	}
+
+	rootHash, err := dataTrie.RootHash()
+	if err != nil {
+		return "", err
+	}
+
+	// the iteration context is cancelled when the page is full, so that the trie stops adding leaves on the channel
+	iterationCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err = dataTrie.GetLeavesOnChannelFromKey(chLeaves, iterationCtx, rootHash, startKey)
+	if err != nil {
+		return "", err
+	}
+
+	numHandledLeaves := 0
+	numScannedLeaves := 0
+	isFiltered := len(keyPrefix) > 0
+	for leaf := range chLeaves {
+		if isFiltered && numScannedLeaves == maxScannedLeavesPerFilteredPage {
+			return hex.EncodeToString(leaf.Key()), nil
+		}
+		numScannedLeaves++
+
+		if !bytes.HasPrefix(leaf.Key(), keyPrefix) {
+			continue
+		}
+
+		if pageOptions.Limit > 0 && numHandledLeaves == pageOptions.Limit {
+			return hex.EncodeToString(leaf.Key()), nil
+		}
+
+		err = leafHandler(leaf)
+		if err != nil {
+			return "", err
+		}
+		numHandledLeaves++
+	}
+
+	if common.IsContextDone(ctx) {
+		return "", ErrTrieOperationsTimeout
+	}
+
+	return "", nil
+}
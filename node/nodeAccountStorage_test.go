@@ -0,0 +1,229 @@
+package node_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createNodeWithDataTrieKeys creates a node holding an account whose data trie emits the provided keys, in order,
+// starting with the requested start key
+func createNodeWithDataTrieKeys(t *testing.T, keys [][]byte, receivedStartKey *[]byte) *node.Node {
+	acc, _ := state.NewUserAccount(testscommon.TestPubKeyAlice)
+	acc.DataTrieTracker().SetDataTrie(
+		&trieMock.TrieStub{
+			GetLeavesOnChannelFromKeyCalled: func(ch chan core.KeyValueHolder, ctx context.Context, rootHash []byte, startKey []byte) error {
+				*receivedStartKey = startKey
+				go func() {
+					defer close(ch)
+
+					started := len(startKey) == 0
+					for _, key := range keys {
+						started = started || string(key) == string(startKey)
+						if !started {
+							continue
+						}
+
+						value := append([]byte("value-"+string(key)), append(key, acc.AddressBytes()...)...)
+						select {
+						case ch <- keyValStorage.NewKeyValStorage(key, value):
+						case <-ctx.Done():
+							return
+						}
+					}
+				}()
+
+				return nil
+			},
+			RootCalled: func() ([]byte, error) {
+				return nil, nil
+			},
+		})
+
+	accDB := &stateMock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+		GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			return acc, nil, nil
+		},
+	}
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+	stateComponents := getDefaultStateComponents()
+	args := state.ArgsAccountsRepository{
+		FinalStateAccountsWrapper:      accDB,
+		CurrentStateAccountsWrapper:    accDB,
+		HistoricalStateAccountsWrapper: accDB,
+	}
+	stateComponents.AccountsRepo, _ = state.NewAccountsRepository(args)
+
+	esdtStorageStub := &mock.EsdtStorageHandlerStub{
+		GetESDTNFTTokenOnDestinationCalled: func(acnt vmcommon.UserAccountHandler, esdtTokenKey []byte, nonce uint64) (*esdt.ESDigitalToken, bool, error) {
+			return &esdt.ESDigitalToken{Value: big.NewInt(int64(len(esdtTokenKey)))}, false, nil
+		},
+	}
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(getDefaultDataComponents()),
+		node.WithESDTNFTStorageHandler(esdtStorageStub),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetKeyValuePairsPage(t *testing.T) {
+	t.Parallel()
+
+	keys := [][]byte{[]byte("key1"), []byte("key2"), []byte("key3")}
+
+	t.Run("invalid start key should error", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedStartKey []byte
+		n := createNodeWithDataTrieKeys(t, keys, &receivedStartKey)
+
+		pageOptions := common.AccountStoragePageOptions{StartKey: "not hex", Limit: 2}
+		page, _, err := n.GetKeyValuePairsPage(testscommon.TestAddressAlice, pageOptions, api.AccountQueryOptions{}, context.Background())
+		require.Nil(t, page)
+		require.NotNil(t, err)
+	})
+
+	t.Run("negative limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedStartKey []byte
+		n := createNodeWithDataTrieKeys(t, keys, &receivedStartKey)
+
+		pageOptions := common.AccountStoragePageOptions{Limit: -1}
+		page, _, err := n.GetKeyValuePairsPage(testscommon.TestAddressAlice, pageOptions, api.AccountQueryOptions{}, context.Background())
+		require.Nil(t, page)
+		require.True(t, errors.Is(err, node.ErrInvalidPageLimit))
+	})
+
+	t.Run("pages should cover all the pairs", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedStartKey []byte
+		n := createNodeWithDataTrieKeys(t, keys, &receivedStartKey)
+
+		pageOptions := common.AccountStoragePageOptions{Limit: 2}
+		page, _, err := n.GetKeyValuePairsPage(testscommon.TestAddressAlice, pageOptions, api.AccountQueryOptions{}, context.Background())
+		require.Nil(t, err)
+		assert.Empty(t, receivedStartKey)
+		assert.Equal(t, map[string]string{
+			hex.EncodeToString([]byte("key1")): hex.EncodeToString([]byte("value-key1")),
+			hex.EncodeToString([]byte("key2")): hex.EncodeToString([]byte("value-key2")),
+		}, page.Pairs)
+		assert.Equal(t, hex.EncodeToString([]byte("key3")), page.NextKey)
+
+		pageOptions.StartKey = page.NextKey
+		page, _, err = n.GetKeyValuePairsPage(testscommon.TestAddressAlice, pageOptions, api.AccountQueryOptions{}, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, []byte("key3"), receivedStartKey)
+		assert.Equal(t, map[string]string{
+			hex.EncodeToString([]byte("key3")): hex.EncodeToString([]byte("value-key3")),
+		}, page.Pairs)
+		assert.Empty(t, page.NextKey)
+	})
+}
+
+func TestNode_IterateKeyValuePairsHandlerErrorShouldStop(t *testing.T) {
+	t.Parallel()
+
+	var receivedStartKey []byte
+	n := createNodeWithDataTrieKeys(t, [][]byte{[]byte("key1"), []byte("key2")}, &receivedStartKey)
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	handler := func(key string, value string) error {
+		numCalls++
+		return expectedErr
+	}
+	_, _, err := n.IterateKeyValuePairs(testscommon.TestAddressAlice, common.AccountStoragePageOptions{}, api.AccountQueryOptions{}, context.Background(), handler)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestNode_GetESDTTokensPageShouldStopAfterTheMaxScannedLeaves(t *testing.T) {
+	t.Parallel()
+
+	esdtPrefix := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+	keys := make([][]byte, 0)
+	for i := 0; i < 10000; i++ {
+		keys = append(keys, []byte(fmt.Sprintf("key%05d", i)))
+	}
+	keys = append(keys, []byte(esdtPrefix+"TKN-001"))
+
+	var receivedStartKey []byte
+	n := createNodeWithDataTrieKeys(t, keys, &receivedStartKey)
+
+	pageOptions := common.AccountStoragePageOptions{Limit: 10}
+	page, _, err := n.GetESDTTokensPage(testscommon.TestAddressAlice, pageOptions, api.AccountQueryOptions{}, context.Background())
+	require.Nil(t, err)
+	assert.Empty(t, page.Tokens)
+	assert.Equal(t, hex.EncodeToString(keys[10000]), page.NextKey)
+
+	pageOptions.StartKey = page.NextKey
+	page, _, err = n.GetESDTTokensPage(testscommon.TestAddressAlice, pageOptions, api.AccountQueryOptions{}, context.Background())
+	require.Nil(t, err)
+	require.Len(t, page.Tokens, 1)
+	assert.Contains(t, page.Tokens, "TKN-001")
+	assert.Empty(t, page.NextKey)
+}
+
+func TestNode_GetESDTTokensPageShouldSkipTheNonESDTKeys(t *testing.T) {
+	t.Parallel()
+
+	esdtPrefix := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+	keys := [][]byte{
+		[]byte(esdtPrefix + "TKN-001"),
+		[]byte("key1"),
+		[]byte(esdtPrefix + "TKN-002"),
+		[]byte("key2"),
+		[]byte(esdtPrefix + "TKN-003"),
+	}
+
+	var receivedStartKey []byte
+	n := createNodeWithDataTrieKeys(t, keys, &receivedStartKey)
+
+	pageOptions := common.AccountStoragePageOptions{Limit: 2}
+	page, _, err := n.GetESDTTokensPage(testscommon.TestAddressAlice, pageOptions, api.AccountQueryOptions{}, context.Background())
+	require.Nil(t, err)
+	require.Len(t, page.Tokens, 2)
+	assert.Contains(t, page.Tokens, "TKN-001")
+	assert.Contains(t, page.Tokens, "TKN-002")
+	assert.Equal(t, hex.EncodeToString(keys[4]), page.NextKey)
+
+	pageOptions.StartKey = page.NextKey
+	page, _, err = n.GetESDTTokensPage(testscommon.TestAddressAlice, pageOptions, api.AccountQueryOptions{}, context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, keys[4], receivedStartKey)
+	require.Len(t, page.Tokens, 1)
+	assert.Contains(t, page.Tokens, "TKN-003")
+	assert.Empty(t, page.NextKey)
+}
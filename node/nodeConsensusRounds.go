@@ -0,0 +1,40 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// GetConsensusRound returns the consensus timeline recorded by this node for the provided round
+func (n *Node) GetConsensusRound(round int64) (*common.ConsensusRoundTrace, error) {
+	roundTracer, err := n.getRoundTracer()
+	if err != nil {
+		return nil, err
+	}
+
+	return roundTracer.GetRound(round)
+}
+
+// GetConsensusRounds returns the consensus timelines recorded by this node for the rounds within the provided range
+func (n *Node) GetConsensusRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error) {
+	roundTracer, err := n.getRoundTracer()
+	if err != nil {
+		return nil, err
+	}
+
+	return roundTracer.GetRounds(startRound, endRound)
+}
+
+func (n *Node) getRoundTracer() (consensus.RoundTracer, error) {
+	if check.IfNil(n.consensusComponents) {
+		return nil, ErrNilRoundTracer
+	}
+
+	roundTracer := n.consensusComponents.RoundTracer()
+	if check.IfNil(roundTracer) {
+		return nil, ErrNilRoundTracer
+	}
+
+	return roundTracer, nil
+}
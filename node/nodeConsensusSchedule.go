@@ -0,0 +1,373 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+const (
+	defaultNumScheduledRounds = 10
+	maxNumScheduledRounds     = 100
+)
+
+const (
+	// RandomnessSourceBlockchain signals that the randomness was taken from the current block of the node's own shard
+	RandomnessSourceBlockchain = "blockchain"
+	// RandomnessSourceHeadersPool signals that the randomness was taken from the highest header of the shard found in
+	// the headers pool, which may not be final or may belong to a fork
+	RandomnessSourceHeadersPool = "headers pool"
+	// RandomnessSourceUnknown signals that no header of the shard is known, as it happens for the other shards on a
+	// shard node, which only receives the metachain headers
+	RandomnessSourceUnknown = "unknown"
+)
+
+type validatorShard struct {
+	shardID          uint32
+	epoch            uint32
+	header           data.HeaderHandler
+	randomnessSource string
+	eligible         [][]byte
+}
+
+// GetConsensusSchedule returns, for each shard, the consensus groups of the upcoming rounds within the provided range.
+// Since each committed block changes the randomness, only the next round is knowable, computed with the randomness and
+// in the epoch of the last known header of each shard. The other shards' headers come from the headers pool, so their
+// consensus groups are estimates, as flagged by the randomness source of each shard. A zero start round defaults to
+// the next round and a zero end round defaults to a range of defaultNumScheduledRounds rounds
+func (n *Node) GetConsensusSchedule(startRound int64, endRound int64) (*common.ConsensusSchedule, error) {
+	currentRound := n.processComponents.RoundHandler().Index()
+	if startRound == 0 {
+		startRound = currentRound + 1
+	}
+	if endRound == 0 {
+		endRound = startRound + defaultNumScheduledRounds - 1
+	}
+	err := checkScheduledRoundsRange(currentRound, startRound, endRound)
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch, err := n.getCurrentEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &common.ConsensusSchedule{
+		Epoch:        currentEpoch,
+		CurrentRound: currentRound,
+		Shards:       make([]*common.ShardConsensusSchedule, 0),
+	}
+	for _, shardID := range n.getAllShardIDs() {
+		shardSchedule, errCompute := n.computeShardConsensusSchedule(shardID, currentEpoch, currentRound, startRound, endRound)
+		if errCompute != nil {
+			return nil, errCompute
+		}
+
+		schedule.Shards = append(schedule.Shards, shardSchedule)
+	}
+
+	return schedule, nil
+}
+
+// GetValidatorLeaderSchedule returns the next knowable round in which the validator with the provided hex encoded BLS
+// public key is the consensus leader, if any, along with the expected number of rounds between its leaderships. The
+// validator's shard is the one listing it as eligible in the epoch of the shard's last known header
+func (n *Node) GetValidatorLeaderSchedule(publicKey string) (*common.ValidatorLeaderSchedule, error) {
+	publicKeyBytes, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	if len(publicKeyBytes) == 0 {
+		return nil, ErrInvalidPublicKey
+	}
+
+	currentEpoch, err := n.getCurrentEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	validatorShard, err := n.findValidatorShard(publicKeyBytes, currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+	if validatorShard == nil {
+		return nil, fmt.Errorf("%w, public key %s, epoch %d", ErrValidatorNotEligible, publicKey, currentEpoch)
+	}
+
+	expectedRounds, err := n.computeExpectedRoundsBetweenLeaderships(publicKeyBytes, validatorShard.eligible)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRound := n.processComponents.RoundHandler().Index()
+	leaderSchedule := &common.ValidatorLeaderSchedule{
+		PublicKey:                        publicKey,
+		ShardID:                          validatorShard.shardID,
+		Epoch:                            validatorShard.epoch,
+		RandomnessSource:                 validatorShard.randomnessSource,
+		CurrentRound:                     currentRound,
+		LastKnowableRound:                currentRound,
+		ExpectedRoundsBetweenLeaderships: expectedRounds,
+	}
+
+	nextRound := currentRound + 1
+	consensusGroup, err := n.computeKnowableConsensusGroup(validatorShard.header, nextRound, validatorShard.shardID)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusGroup) > 0 {
+		leaderSchedule.LastKnowableRound = nextRound
+		if bytes.Equal(consensusGroup[0], publicKeyBytes) {
+			leaderSchedule.NextLeaderRound = nextRound
+		}
+	}
+
+	return leaderSchedule, nil
+}
+
+// findValidatorShard returns the shard listing the validator as eligible in the epoch of the shard's last known header,
+// or nil if there is none. The current epoch is used for the shards without a known header
+func (n *Node) findValidatorShard(publicKey []byte, currentEpoch uint32) (*validatorShard, error) {
+	eligibleByEpoch := make(map[uint32]map[uint32][][]byte)
+	for _, shardID := range n.getAllShardIDs() {
+		header, randomnessSource := n.getLastKnownHeader(shardID)
+		epoch := getHeaderEpoch(header, currentEpoch)
+
+		eligible, found := eligibleByEpoch[epoch]
+		if !found {
+			var err error
+			eligible, err = n.processComponents.NodesCoordinator().GetAllEligibleValidatorsPublicKeys(epoch)
+			if err != nil {
+				return nil, err
+			}
+			eligibleByEpoch[epoch] = eligible
+		}
+
+		if containsPublicKey(eligible[shardID], publicKey) {
+			return &validatorShard{
+				shardID:          shardID,
+				epoch:            epoch,
+				header:           header,
+				randomnessSource: randomnessSource,
+				eligible:         eligible[shardID],
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func checkScheduledRoundsRange(currentRound int64, startRound int64, endRound int64) error {
+	if startRound <= currentRound {
+		return fmt.Errorf("%w, start round %d is not after the current round %d", ErrInvalidRoundsRange, startRound, currentRound)
+	}
+	if startRound > endRound {
+		return fmt.Errorf("%w, start round %d is greater than end round %d", ErrInvalidRoundsRange, startRound, endRound)
+	}
+	if endRound-startRound >= maxNumScheduledRounds {
+		return fmt.Errorf("%w, at most %d rounds can be requested", ErrInvalidRoundsRange, maxNumScheduledRounds)
+	}
+
+	return nil
+}
+
+func (n *Node) computeShardConsensusSchedule(
+	shardID uint32,
+	currentEpoch uint32,
+	currentRound int64,
+	startRound int64,
+	endRound int64,
+) (*common.ShardConsensusSchedule, error) {
+	header, randomnessSource := n.getLastKnownHeader(shardID)
+	shardSchedule := &common.ShardConsensusSchedule{
+		ShardID:          shardID,
+		Epoch:            getHeaderEpoch(header, currentEpoch),
+		RandomnessSource: randomnessSource,
+		Rounds:           make([]*common.ConsensusScheduleRound, 0, endRound-startRound+1),
+	}
+	if !check.IfNil(header) {
+		shardSchedule.RandomnessSourceRound = header.GetRound()
+	}
+
+	for round := startRound; round <= endRound; round++ {
+		scheduleRound := &common.ConsensusScheduleRound{
+			Round: round,
+		}
+		shardSchedule.Rounds = append(shardSchedule.Rounds, scheduleRound)
+
+		if round != currentRound+1 {
+			continue
+		}
+
+		consensusGroup, err := n.computeKnowableConsensusGroup(header, round, shardID)
+		if err != nil {
+			return nil, err
+		}
+		if len(consensusGroup) == 0 {
+			continue
+		}
+
+		scheduleRound.IsKnowable = true
+		scheduleRound.ConsensusGroup = make([]string, 0, len(consensusGroup))
+		for _, publicKey := range consensusGroup {
+			scheduleRound.ConsensusGroup = append(scheduleRound.ConsensusGroup, hex.EncodeToString(publicKey))
+		}
+		scheduleRound.Leader = scheduleRound.ConsensusGroup[0]
+	}
+
+	return shardSchedule, nil
+}
+
+// computeKnowableConsensusGroup returns the public keys of the consensus group of the provided round, computed with the
+// randomness and in the epoch of the provided header, as the consensus does, or nil if the randomness is not known
+func (n *Node) computeKnowableConsensusGroup(header data.HeaderHandler, round int64, shardID uint32) ([][]byte, error) {
+	if check.IfNil(header) || len(header.GetRandSeed()) == 0 {
+		return nil, nil
+	}
+
+	validators, err := n.processComponents.NodesCoordinator().ComputeConsensusGroup(
+		header.GetRandSeed(),
+		uint64(round),
+		shardID,
+		header.GetEpoch(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([][]byte, 0, len(validators))
+	for _, validator := range validators {
+		publicKeys = append(publicKeys, validator.PubKey())
+	}
+
+	return publicKeys, nil
+}
+
+// computeExpectedRoundsBetweenLeaderships returns the inverse of the probability of the validator to be selected as
+// leader, which is its share of the chances of the eligible validators in its shard. It returns 0 if the validator
+// has no chances
+func (n *Node) computeExpectedRoundsBetweenLeaderships(publicKey []byte, shardEligible [][]byte) (float64, error) {
+	nodesCoordinator := n.processComponents.NodesCoordinator()
+
+	validatorChances := uint64(0)
+	totalChances := uint64(0)
+	for _, eligiblePublicKey := range shardEligible {
+		validator, _, err := nodesCoordinator.GetValidatorWithPublicKey(eligiblePublicKey)
+		if err != nil {
+			return 0, err
+		}
+
+		totalChances += uint64(validator.Chances())
+		if bytes.Equal(eligiblePublicKey, publicKey) {
+			validatorChances = uint64(validator.Chances())
+		}
+	}
+
+	if validatorChances == 0 {
+		return 0, nil
+	}
+
+	return float64(totalChances) / float64(validatorChances), nil
+}
+
+// getLastKnownHeader returns the current block header for the self shard and the header with the highest nonce found
+// in the headers pool for the other shards, along with the source of the header. The pool only holds the headers
+// received by the node, which may not be final or may belong to a fork, and a shard node only receives the metachain
+// headers besides its own shard's
+func (n *Node) getLastKnownHeader(shardID uint32) (data.HeaderHandler, string) {
+	if shardID == n.processComponents.ShardCoordinator().SelfId() {
+		header := n.getCurrentBlockHeader()
+		if check.IfNil(header) {
+			return nil, RandomnessSourceUnknown
+		}
+
+		return header, RandomnessSourceBlockchain
+	}
+
+	header := n.getHighestPoolHeader(shardID)
+	if check.IfNil(header) {
+		return nil, RandomnessSourceUnknown
+	}
+
+	return header, RandomnessSourceHeadersPool
+}
+
+func (n *Node) getHighestPoolHeader(shardID uint32) data.HeaderHandler {
+	headersPool := n.dataComponents.Datapool().Headers()
+	highestNonce := uint64(0)
+	nonces := headersPool.Nonces(shardID)
+	if len(nonces) == 0 {
+		return nil
+	}
+	for _, nonce := range nonces {
+		if nonce > highestNonce {
+			highestNonce = nonce
+		}
+	}
+
+	headers, _, err := headersPool.GetHeadersByNonceAndShardId(highestNonce, shardID)
+	if err != nil {
+		return nil
+	}
+
+	var lastHeader data.HeaderHandler
+	for _, header := range headers {
+		if check.IfNil(lastHeader) || header.GetRound() > lastHeader.GetRound() {
+			lastHeader = header
+		}
+	}
+
+	return lastHeader
+}
+
+func (n *Node) getCurrentBlockHeader() data.HeaderHandler {
+	header := n.dataComponents.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		return n.dataComponents.Blockchain().GetGenesisHeader()
+	}
+
+	return header
+}
+
+func (n *Node) getCurrentEpoch() (uint32, error) {
+	header := n.getCurrentBlockHeader()
+	if check.IfNil(header) {
+		return 0, ErrNilBlockHeader
+	}
+
+	return header.GetEpoch(), nil
+}
+
+func (n *Node) getAllShardIDs() []uint32 {
+	numShards := n.processComponents.ShardCoordinator().NumberOfShards()
+	shardIDs := make([]uint32, 0, numShards+1)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	return append(shardIDs, core.MetachainShardId)
+}
+
+func getHeaderEpoch(header data.HeaderHandler, defaultEpoch uint32) uint32 {
+	if check.IfNil(header) {
+		return defaultEpoch
+	}
+
+	return header.GetEpoch()
+}
+
+func containsPublicKey(publicKeys [][]byte, publicKey []byte) bool {
+	for _, pk := range publicKeys {
+		if bytes.Equal(pk, publicKey) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,273 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scheduleCurrentRound = int64(100)
+
+var scheduleEligibleByEpoch = map[uint32]map[uint32][][]byte{
+	2: {
+		0:                     {[]byte("pk0"), []byte("pk1"), []byte("pk2")},
+		core.MetachainShardId: {[]byte("pkold")},
+	},
+	3: {
+		0:                     {[]byte("pk0"), []byte("pk1"), []byte("pk2")},
+		core.MetachainShardId: {[]byte("pkm")},
+	},
+}
+
+func createNodeForConsensusSchedule(t *testing.T, metaHeaders []data.HeaderHandler) *node.Node {
+	chances := map[string]uint32{"pk0": 10, "pk1": 20, "pk2": 10, "pkm": 10, "pkold": 10}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.RoundHandlerField = &testscommon.RoundHandlerMock{
+		IndexCalled: func() int64 {
+			return scheduleCurrentRound
+		},
+	}
+	processComponents.NodesCoord = &shardingMocks.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+			assert.Equal(t, uint64(scheduleCurrentRound+1), round)
+
+			eligible := scheduleEligibleByEpoch[epoch][shardId]
+			validators := make([]nodesCoordinator.Validator, 0, len(eligible))
+			for i := range eligible {
+				// the rotation by the first randomness byte replaces the selection
+				publicKey := eligible[(i+int(randomness[0]))%len(eligible)]
+				validators = append(validators, shardingMocks.NewValidatorMock(publicKey, chances[string(publicKey)], 0))
+			}
+			return validators, nil
+		},
+		GetAllEligibleValidatorsPublicKeysCalled: func(epoch uint32) (map[uint32][][]byte, error) {
+			return scheduleEligibleByEpoch[epoch], nil
+		},
+		GetValidatorWithPublicKeyCalled: func(publicKey []byte) (nodesCoordinator.Validator, uint32, error) {
+			return shardingMocks.NewValidatorMock(publicKey, chances[string(publicKey)], 0), 0, nil
+		},
+	}
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.BlockChain = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Round: 99, Epoch: 3, RandSeed: []byte{1}}
+		},
+	}
+	dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		HeadersCalled: func() dataRetriever.HeadersPool {
+			return &mock.HeadersCacherStub{
+				NoncesCalled: func(shardId uint32) []uint64 {
+					if shardId == core.MetachainShardId && len(metaHeaders) > 0 {
+						return []uint64{7, 8}
+					}
+					return nil
+				},
+				GetHeaderByNonceAndShardIdCalled: func(hdrNonce uint64, shardId uint32) ([]data.HeaderHandler, [][]byte, error) {
+					assert.Equal(t, uint64(8), hdrNonce)
+					return metaHeaders, nil, nil
+				},
+			}
+		},
+	}
+
+	n, err := node.NewNode(
+		node.WithProcessComponents(processComponents),
+		node.WithDataComponents(dataComponents),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetConsensusSchedule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid rounds range should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForConsensusSchedule(t, nil)
+
+		schedule, err := n.GetConsensusSchedule(scheduleCurrentRound, 0)
+		assert.Nil(t, schedule)
+		assert.True(t, errors.Is(err, node.ErrInvalidRoundsRange))
+
+		schedule, err = n.GetConsensusSchedule(scheduleCurrentRound+5, scheduleCurrentRound+4)
+		assert.Nil(t, schedule)
+		assert.True(t, errors.Is(err, node.ErrInvalidRoundsRange))
+
+		schedule, err = n.GetConsensusSchedule(scheduleCurrentRound+1, scheduleCurrentRound+101)
+		assert.Nil(t, schedule)
+		assert.True(t, errors.Is(err, node.ErrInvalidRoundsRange))
+	})
+	t.Run("only the next round should be knowable", func(t *testing.T) {
+		t.Parallel()
+
+		metaHeaders := []data.HeaderHandler{
+			&block.MetaBlock{Round: 97, Epoch: 3, RandSeed: []byte{0}},
+			&block.MetaBlock{Round: 98, Epoch: 3, RandSeed: []byte{0}},
+		}
+		n := createNodeForConsensusSchedule(t, metaHeaders)
+
+		schedule, err := n.GetConsensusSchedule(0, 0)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(3), schedule.Epoch)
+		assert.Equal(t, scheduleCurrentRound, schedule.CurrentRound)
+		require.Equal(t, 2, len(schedule.Shards))
+
+		shardSchedule := schedule.Shards[0]
+		assert.Equal(t, uint32(0), shardSchedule.ShardID)
+		assert.Equal(t, uint32(3), shardSchedule.Epoch)
+		assert.Equal(t, node.RandomnessSourceBlockchain, shardSchedule.RandomnessSource)
+		assert.Equal(t, uint64(99), shardSchedule.RandomnessSourceRound)
+		require.Equal(t, 10, len(shardSchedule.Rounds))
+		assert.Equal(t, scheduleCurrentRound+1, shardSchedule.Rounds[0].Round)
+		assert.True(t, shardSchedule.Rounds[0].IsKnowable)
+		assert.Equal(t, hex.EncodeToString([]byte("pk1")), shardSchedule.Rounds[0].Leader)
+		assert.Equal(t, 3, len(shardSchedule.Rounds[0].ConsensusGroup))
+		for _, round := range shardSchedule.Rounds[1:] {
+			assert.False(t, round.IsKnowable)
+			assert.Empty(t, round.Leader)
+			assert.Nil(t, round.ConsensusGroup)
+		}
+		assert.Equal(t, scheduleCurrentRound+10, shardSchedule.Rounds[9].Round)
+
+		metaSchedule := schedule.Shards[1]
+		assert.Equal(t, core.MetachainShardId, metaSchedule.ShardID)
+		assert.Equal(t, node.RandomnessSourceHeadersPool, metaSchedule.RandomnessSource)
+		assert.Equal(t, uint64(98), metaSchedule.RandomnessSourceRound)
+		assert.True(t, metaSchedule.Rounds[0].IsKnowable)
+		assert.Equal(t, hex.EncodeToString([]byte("pkm")), metaSchedule.Rounds[0].Leader)
+	})
+	t.Run("each shard should use the epoch of its last known header", func(t *testing.T) {
+		t.Parallel()
+
+		metaHeaders := []data.HeaderHandler{
+			&block.MetaBlock{Round: 98, Epoch: 2, RandSeed: []byte{0}},
+		}
+		n := createNodeForConsensusSchedule(t, metaHeaders)
+
+		schedule, err := n.GetConsensusSchedule(0, 0)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(3), schedule.Epoch)
+		require.Equal(t, 2, len(schedule.Shards))
+		assert.Equal(t, uint32(3), schedule.Shards[0].Epoch)
+		assert.Equal(t, hex.EncodeToString([]byte("pk1")), schedule.Shards[0].Rounds[0].Leader)
+
+		metaSchedule := schedule.Shards[1]
+		assert.Equal(t, uint32(2), metaSchedule.Epoch)
+		assert.True(t, metaSchedule.Rounds[0].IsKnowable)
+		assert.Equal(t, hex.EncodeToString([]byte("pkold")), metaSchedule.Rounds[0].Leader)
+	})
+	t.Run("unknown randomness should flag all rounds as unknowable", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForConsensusSchedule(t, nil)
+
+		schedule, err := n.GetConsensusSchedule(scheduleCurrentRound+1, scheduleCurrentRound+2)
+		require.Nil(t, err)
+
+		metaSchedule := schedule.Shards[1]
+		assert.Equal(t, node.RandomnessSourceUnknown, metaSchedule.RandomnessSource)
+		assert.Equal(t, uint64(0), metaSchedule.RandomnessSourceRound)
+		require.Equal(t, 2, len(metaSchedule.Rounds))
+		for _, round := range metaSchedule.Rounds {
+			assert.False(t, round.IsKnowable)
+		}
+	})
+}
+
+func TestNode_GetValidatorLeaderSchedule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForConsensusSchedule(t, nil)
+
+		leaderSchedule, err := n.GetValidatorLeaderSchedule("not hex")
+		assert.Nil(t, leaderSchedule)
+		assert.True(t, errors.Is(err, node.ErrInvalidPublicKey))
+	})
+	t.Run("not eligible validator should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForConsensusSchedule(t, nil)
+
+		leaderSchedule, err := n.GetValidatorLeaderSchedule(hex.EncodeToString([]byte("waiting")))
+		assert.Nil(t, leaderSchedule)
+		assert.True(t, errors.Is(err, node.ErrValidatorNotEligible))
+	})
+	t.Run("leader in the next round", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForConsensusSchedule(t, nil)
+
+		leaderSchedule, err := n.GetValidatorLeaderSchedule(hex.EncodeToString([]byte("pk1")))
+		require.Nil(t, err)
+		assert.Equal(t, uint32(0), leaderSchedule.ShardID)
+		assert.Equal(t, uint32(3), leaderSchedule.Epoch)
+		assert.Equal(t, node.RandomnessSourceBlockchain, leaderSchedule.RandomnessSource)
+		assert.Equal(t, scheduleCurrentRound+1, leaderSchedule.LastKnowableRound)
+		assert.Equal(t, scheduleCurrentRound+1, leaderSchedule.NextLeaderRound)
+		assert.Equal(t, float64(2), leaderSchedule.ExpectedRoundsBetweenLeaderships)
+	})
+	t.Run("not leader in the knowable rounds", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForConsensusSchedule(t, nil)
+
+		leaderSchedule, err := n.GetValidatorLeaderSchedule(hex.EncodeToString([]byte("pk0")))
+		require.Nil(t, err)
+		assert.Equal(t, scheduleCurrentRound+1, leaderSchedule.LastKnowableRound)
+		assert.Equal(t, int64(0), leaderSchedule.NextLeaderRound)
+		assert.Equal(t, float64(4), leaderSchedule.ExpectedRoundsBetweenLeaderships)
+	})
+	t.Run("unknown randomness should not have knowable rounds", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForConsensusSchedule(t, nil)
+
+		leaderSchedule, err := n.GetValidatorLeaderSchedule(hex.EncodeToString([]byte("pkm")))
+		require.Nil(t, err)
+		assert.Equal(t, core.MetachainShardId, leaderSchedule.ShardID)
+		assert.Equal(t, node.RandomnessSourceUnknown, leaderSchedule.RandomnessSource)
+		assert.Equal(t, scheduleCurrentRound, leaderSchedule.LastKnowableRound)
+		assert.Equal(t, int64(0), leaderSchedule.NextLeaderRound)
+		assert.Equal(t, float64(1), leaderSchedule.ExpectedRoundsBetweenLeaderships)
+	})
+	t.Run("eligibility should be checked in the epoch of the shard's last known header", func(t *testing.T) {
+		t.Parallel()
+
+		metaHeaders := []data.HeaderHandler{
+			&block.MetaBlock{Round: 98, Epoch: 2, RandSeed: []byte{0}},
+		}
+		n := createNodeForConsensusSchedule(t, metaHeaders)
+
+		leaderSchedule, err := n.GetValidatorLeaderSchedule(hex.EncodeToString([]byte("pkold")))
+		require.Nil(t, err)
+		assert.Equal(t, core.MetachainShardId, leaderSchedule.ShardID)
+		assert.Equal(t, uint32(2), leaderSchedule.Epoch)
+		assert.Equal(t, node.RandomnessSourceHeadersPool, leaderSchedule.RandomnessSource)
+		assert.Equal(t, scheduleCurrentRound+1, leaderSchedule.LastKnowableRound)
+		assert.Equal(t, scheduleCurrentRound+1, leaderSchedule.NextLeaderRound)
+
+		leaderSchedule, err = n.GetValidatorLeaderSchedule(hex.EncodeToString([]byte("pkm")))
+		assert.Nil(t, leaderSchedule)
+		assert.True(t, errors.Is(err, node.ErrValidatorNotEligible))
+	})
+}
@@ -0,0 +1,29 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// GetEquivocations returns the equivocation evidences recorded by this node, sorted by round. If the hex encoded
+// public key is not empty, only the evidences of that validator are returned
+func (n *Node) GetEquivocations(publicKey string) ([]*common.EquivocationEvidence, error) {
+	if len(publicKey) > 0 {
+		_, err := hex.DecodeString(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+		}
+	}
+
+	storer := n.dataComponents.StorageService().GetStorer(dataRetriever.EquivocationsUnit)
+	if check.IfNil(storer) {
+		return nil, ErrEquivocationDetectionDisabled
+	}
+
+	return equivocation.LoadEvidences(storer, publicKey)
+}
@@ -0,0 +1,68 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	storagePackage "github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetEquivocations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithDataComponents(getDefaultDataComponents()))
+
+		evidences, err := n.GetEquivocations("not hex")
+		assert.Nil(t, evidences)
+		assert.True(t, errors.Is(err, node.ErrInvalidPublicKey))
+	})
+	t.Run("detection disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataComponents := getDefaultDataComponents()
+		dataComponents.Store = &mock.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storagePackage.Storer {
+				return nil
+			},
+		}
+		n, _ := node.NewNode(node.WithDataComponents(dataComponents))
+
+		evidences, err := n.GetEquivocations("")
+		assert.Nil(t, evidences)
+		assert.Equal(t, node.ErrEquivocationDetectionDisabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		evidence := []byte(`{"type":"signature","publicKey":"abcd","round":7}`)
+		storer := testscommon.CreateMemUnit()
+		_ = storer.Put([]byte("abcd_7_signature"), evidence)
+		_ = storer.Put([]byte("ef01_8_signature"), evidence)
+
+		dataComponents := getDefaultDataComponents()
+		dataComponents.Store = &mock.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storagePackage.Storer {
+				if unitType == dataRetriever.EquivocationsUnit {
+					return storer
+				}
+				return nil
+			},
+		}
+		n, _ := node.NewNode(node.WithDataComponents(dataComponents))
+
+		evidences, err := n.GetEquivocations("ABCD")
+		require.Nil(t, err)
+		require.Equal(t, 1, len(evidences))
+		assert.Equal(t, "abcd", evidences[0].PublicKey)
+		assert.Equal(t, int64(7), evidences[0].Round)
+	})
+}
@@ -50,6 +50,7 @@ func CreateNode(
 	epochConfig config.EpochConfig,
 	bootstrapRoundIndex uint64,
 	isInImportMode bool,
+	isFullArchive bool,
 ) (*Node, error) {
 	prepareOpenTopics(networkComponents.InputAntiFloodHandler(), processComponents.ShardCoordinator())
 
@@ -112,6 +113,7 @@ func CreateNode(
 		WithPublicKeySize(config.ValidatorPubkeyConverter.Length),
 		WithNodeStopChannel(coreComponents.ChanStopNodeProcess()),
 		WithImportMode(isInImportMode),
+		WithFullArchive(isFullArchive),
 		WithESDTNFTStorageHandler(esdtNftStorage),
 	)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/gin"
+	"github.com/ElrondNetwork/elrond-go/api/grpcapi"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
@@ -29,7 +30,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/txsPersister"
 	dbLookupFactory "github.com/ElrondNetwork/elrond-go/dblookupext/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart/snapshot"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/facade/initial"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
@@ -280,6 +283,11 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	grpcServerHandler, err := nr.createGrpcServer(managedCoreComponents)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("creating bootstrap components")
 	managedBootstrapComponents, err := nr.CreateManagedBootstrapComponents(managedCoreComponents, managedCryptoComponents, managedNetworkComponents)
 	if err != nil {
@@ -407,6 +415,16 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	err = nr.registerStateSnapshotExporter(
+		managedCoreComponents,
+		managedDataComponents,
+		managedStateComponents,
+		managedProcessComponents,
+	)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("starting node... executeOneComponentCreationCycle")
 
 	managedConsensusComponents, err := nr.CreateManagedConsensusComponents(
@@ -422,6 +440,15 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	// the accounts state is restored from storage when the consensus components start syncing blocks, so the
+	// persisted transactions can only be checked against the sender nonces afterwards
+	nr.loadPersistedTxsPools(
+		managedCoreComponents,
+		managedDataComponents,
+		managedStateComponents,
+		managedBootstrapComponents,
+	)
+
 	managedHeartbeatComponents, err := nr.CreateManagedHeartbeatComponents(
 		managedCoreComponents,
 		managedNetworkComponents,
@@ -465,6 +492,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		*configs.EpochConfig,
 		flagsConfig.BootstrapRoundIndex,
 		configs.ImportDbConfig.IsImportDBMode,
+		configs.PreferencesConfig.Preferences.FullArchive,
 	)
 	if err != nil {
 		return true, err
@@ -483,7 +511,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	allowExternalVMQueriesChan := make(chan struct{})
 
 	log.Debug("updating the API service after creating the node facade")
-	ef, err := nr.createApiFacade(currentNode, webServerHandler, gasScheduleNotifier, allowExternalVMQueriesChan)
+	ef, err := nr.createApiFacade(currentNode, webServerHandler, grpcServerHandler, gasScheduleNotifier, allowExternalVMQueriesChan)
 	if err != nil {
 		return true, err
 	}
@@ -506,6 +534,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		healthService,
 		ef,
 		webServerHandler,
+		grpcServerHandler,
 		currentNode,
 		goRoutinesNumberStart,
 	)
@@ -519,6 +548,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 func (nr *nodeRunner) createApiFacade(
 	currentNode *Node,
 	upgradableHttpServer shared.UpgradeableHttpServerHandler,
+	upgradableGrpcServer shared.UpgradeableGrpcServerHandler,
 	gasScheduleNotifier common.GasScheduleNotifierAPI,
 	allowVMQueriesChan chan struct{},
 ) (closing.Closer, error) {
@@ -534,6 +564,7 @@ func (nr *nodeRunner) createApiFacade(
 		BootstrapComponents: currentNode.bootstrapComponents,
 		CryptoComponents:    currentNode.cryptoComponents,
 		ProcessComponents:   currentNode.processComponents,
+		StatusComponents:    currentNode.statusComponents,
 		GasScheduleNotifier: gasScheduleNotifier,
 		Bootstrapper:        currentNode.consensusComponents.Bootstrapper(),
 		AllowVMQueriesChan:  allowVMQueriesChan,
@@ -576,6 +607,11 @@ func (nr *nodeRunner) createApiFacade(
 		return nil, err
 	}
 
+	err = upgradableGrpcServer.UpdateFacade(ef)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debug("updated node facade")
 
 	log.Trace("starting background services")
@@ -603,6 +639,29 @@ func (nr *nodeRunner) createHttpServer() (shared.UpgradeableHttpServerHandler, e
 	return httpServerWrapper, nil
 }
 
+func (nr *nodeRunner) createGrpcServer(coreComponents mainFactory.CoreComponentsHolder) (shared.UpgradeableGrpcServerHandler, error) {
+	grpcServerArgs := grpcapi.ArgsNewNodeApiServer{
+		Facade:               initial.NewInitialNodeFacade(nr.configs.FlagsConfig.RestApiInterface, nr.configs.FlagsConfig.EnablePprof),
+		Config:               nr.configs.ApiRoutesConfig.Grpc,
+		AuthenticationConfig: nr.configs.ApiRoutesConfig.Authentication,
+		AntiFloodConfig:      nr.configs.GeneralConfig.Antiflood.WebServer,
+		Marshalizer:          coreComponents.InternalMarshalizer(),
+		Hasher:               coreComponents.Hasher(),
+	}
+
+	grpcServer, err := grpcapi.NewNodeApiServer(grpcServerArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = grpcServer.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpcServer, nil
+}
+
 func (nr *nodeRunner) createMetrics(
 	coreComponents mainFactory.CoreComponentsHolder,
 	cryptoComponents mainFactory.CryptoComponentsHolder,
@@ -647,6 +706,75 @@ func (nr *nodeRunner) createHealthService(flagsConfig *config.ContextFlagsConfig
 	return healthService
 }
 
+func (nr *nodeRunner) registerStateSnapshotExporter(
+	coreComponents mainFactory.CoreComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	stateComponents mainFactory.StateComponentsHolder,
+	processComponents mainFactory.ProcessComponentsHolder,
+) error {
+	snapshotConfig := nr.configs.GeneralConfig.StateSnapshotExport
+	if !snapshotConfig.Enabled {
+		return nil
+	}
+
+	args := snapshot.ArgsExporter{
+		Directory:                      filepath.Join(nr.configs.FlagsConfig.WorkingDir, snapshotConfig.Directory),
+		MaxChunkSize:                   int64(snapshotConfig.ChunkSizeInMB) * 1024 * 1024,
+		NumSnapshotsToKeep:             snapshotConfig.NumSnapshotsToKeep,
+		ChainID:                        string(coreComponents.ChainID()),
+		ShardCoordinator:               processComponents.ShardCoordinator(),
+		Marshaller:                     coreComponents.InternalMarshalizer(),
+		Hasher:                         coreComponents.Hasher(),
+		StorageService:                 dataComponents.StorageService(),
+		HeadersPool:                    dataComponents.Datapool().Headers(),
+		TrieStorageManagers:            stateComponents.TrieStorageManagers(),
+		ScheduledMiniBlocksEnableEpoch: nr.configs.EpochConfig.EnableEpochs.ScheduledMiniBlocksEnableEpoch,
+	}
+	exporter, err := snapshot.NewExporter(args)
+	if err != nil {
+		return fmt.Errorf("%w when creating the state snapshot exporter", err)
+	}
+
+	processComponents.EpochStartNotifier().RegisterHandler(exporter)
+	log.Info("state snapshot export enabled", "directory", args.Directory)
+
+	return nil
+}
+
+// loadPersistedTxsPools adds back in the pools the transactions saved when the node was closed, if any. Errors are
+// not critical, the pools get filled again from the network
+func (nr *nodeRunner) loadPersistedTxsPools(
+	coreComponents mainFactory.CoreComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	stateComponents mainFactory.StateComponentsHolder,
+	bootstrapComponents mainFactory.BootstrapComponentsHolder,
+) {
+	if !nr.configs.GeneralConfig.TxPoolPersistence.Enabled || nr.configs.ImportDbConfig.IsImportDBMode {
+		return
+	}
+
+	shardCoordinator := bootstrapComponents.ShardCoordinator()
+	persister, err := txsPersister.NewTxsPersister(txsPersister.ArgsTxsPersister{
+		FilePath:         mainFactory.TxPoolPersistenceFilePath(*nr.configs.GeneralConfig, coreComponents.PathHandler(), shardCoordinator.SelfId()),
+		DataPool:         dataComponents.Datapool(),
+		Marshaller:       coreComponents.InternalMarshalizer(),
+		ShardCoordinator: shardCoordinator,
+		StorageService:   dataComponents.StorageService(),
+	})
+	if err != nil {
+		log.Warn("cannot create the transactions pools persister", "error", err)
+		return
+	}
+
+	stats, err := persister.Load(stateComponents.AccountsAdapter())
+	if err != nil {
+		log.Warn("cannot load the persisted transactions pools", "error", err)
+		return
+	}
+
+	log.Info("loaded the persisted transactions pools", "num loaded", stats.NumLoaded, "num dropped", stats.NumDropped)
+}
+
 func (nr *nodeRunner) registerDataComponentsInHealthService(healthService HealthService, dataComponents mainFactory.DataComponentsHolder) {
 	healthService.RegisterComponent(dataComponents.Datapool().Transactions())
 	healthService.RegisterComponent(dataComponents.Datapool().UnsignedTransactions())
@@ -793,6 +921,7 @@ func waitForSignal(
 	healthService closing.Closer,
 	ef closing.Closer,
 	httpServer shared.UpgradeableHttpServerHandler,
+	grpcServer shared.UpgradeableGrpcServerHandler,
 	currentNode *Node,
 	goRoutinesNumberStart int,
 ) error {
@@ -817,7 +946,7 @@ func waitForSignal(
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(healthService, ef, httpServer, currentNode, chanCloseComponents)
+		closeAllComponents(healthService, ef, httpServer, grpcServer, currentNode, chanCloseComponents)
 	}()
 
 	select {
@@ -1310,6 +1439,7 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 	validatorKeyPemFileName := configs.ConfigurationPathsHolder.ValidatorKey
 	cryptoComponentsHandlerArgs := mainFactory.CryptoComponentsFactoryArgs{
 		ValidatorKeyPemFileName:              validatorKeyPemFileName,
+		AllValidatorKeysPemFileName:          configs.ConfigurationPathsHolder.AllValidatorKeys,
 		SkIndex:                              configs.FlagsConfig.ValidatorKeyIndex,
 		Config:                               *configs.GeneralConfig,
 		CoreComponentsHolder:                 coreComponents,
@@ -1341,6 +1471,7 @@ func closeAllComponents(
 	healthService io.Closer,
 	facade mainFactory.Closer,
 	httpServer shared.UpgradeableHttpServerHandler,
+	grpcServer shared.UpgradeableGrpcServerHandler,
 	node *Node,
 	chanCloseComponents chan struct{},
 ) {
@@ -1351,6 +1482,9 @@ func closeAllComponents(
 	log.Debug("closing http server")
 	log.LogIfError(httpServer.Close())
 
+	log.Debug("closing gRPC server")
+	log.LogIfError(grpcServer.Close())
+
 	log.Debug("closing facade")
 	log.LogIfError(facade.Close())
 
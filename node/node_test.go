@@ -32,6 +32,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/holders"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	factoryMock "github.com/ElrondNetwork/elrond-go/factory/mock"
@@ -43,6 +44,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/state"
 	storagePackage "github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/bootstrapMocks"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
@@ -56,6 +59,7 @@ import (
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txsSenderMock"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -2190,6 +2194,112 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_ValidateTransactionShouldErrOnUnderpricedReplacement(t *testing.T) {
+	t.Parallel()
+
+	version := uint32(1)
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.TxMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+	coreComponents.TxVersionCheckHandler = versioning.NewTxVersionChecker(version)
+	coreComponents.AddrPubKeyConv = &mock.PubkeyConverterStub{
+		DecodeCalled: func(hexAddress string) ([]byte, error) {
+			return []byte(hexAddress), nil
+		},
+		EncodeCalled: func(pkBytes []byte) string {
+			return string(pkBytes)
+		},
+		LenCalled: func() int {
+			return 3
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return state.NewUserAccount([]byte("address"))
+		},
+	}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.EpochTrigger = &mock.EpochStartTriggerStub{
+		EpochCalled: func() uint32 {
+			return 1
+		},
+	}
+	processComponents.ShardCoord = &testscommon.ShardsCoordinatorMock{
+		NoShards: 2,
+		ComputeIdCalled: func(address []byte) uint32 {
+			if bytes.Equal(address, []byte("rcv")) {
+				return 1
+			}
+			return 0
+		},
+	}
+
+	txPool, _ := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        100,
+			SizeInBytes:          1024 * 1024,
+			SizeInBytesPerSender: 1024 * 1024,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      10,
+			GasProcessingDivisor: 1,
+		},
+		PoolJournal: txcache.NewDisabledPoolJournal(),
+		Replacement: txcache.ReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+		},
+		NumberOfShards: 2,
+	})
+	// the pending transaction is a cross-shard one, while the replacement is a self-addressed (cancelling) one
+	pendingTx := &transaction.Transaction{Nonce: 0, SndAddr: []byte("snd"), RcvAddr: []byte("rcv"), GasPrice: 10}
+	txPool.AddData([]byte("pending tx hash"), pendingTx, 128, process.ShardCacherIdentifier(0, 1))
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return txPool
+		},
+	}
+
+	networkComponents := getDefaultNetworkComponents()
+	cryptoComponents := getDefaultCryptoComponents()
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = processComponents.ShardCoordinator()
+	bootstrapComponents.HdrIntegrityVerifier = processComponents.HeaderIntegrVerif
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithNetworkComponents(networkComponents),
+		node.WithCryptoComponents(cryptoComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithAddressSignatureSize(10),
+	)
+
+	signature := hex.EncodeToString(bytes.Repeat([]byte{0}, 10))
+	tx, _, err := n.CreateTransaction(
+		0, "10", "snd", nil, "snd", nil, 10, 20, []byte("-"),
+		signature, coreComponents.ChainID(), coreComponents.MinTransactionVersion(), 0,
+	)
+	require.Nil(t, err)
+
+	err = n.ValidateTransaction(tx)
+	require.True(t, errors.Is(err, storagePackage.ErrUnderpricedReplacement))
+
+	tx.GasPrice = 11
+	err = n.ValidateTransaction(tx)
+	require.Nil(t, err)
+}
+
 func TestCreateTransaction_TxSignedWithHashShouldErrVersionShoudBe2(t *testing.T) {
 	t.Parallel()
 
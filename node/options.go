@@ -324,6 +324,14 @@ func WithImportMode(importMode bool) Option {
 	}
 }
 
+// WithFullArchive sets up the flag if the node is a full archive node
+func WithFullArchive(isFullArchive bool) Option {
+	return func(n *Node) error {
+		n.isFullArchive = isFullArchive
+		return nil
+	}
+}
+
 // WithESDTNFTStorageHandler sets the esdt nft storage handler
 func WithESDTNFTStorageHandler(storageHandler vmcommon.ESDTNFTStorageHandler) Option {
 	return func(node *Node) error {
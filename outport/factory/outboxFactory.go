@@ -0,0 +1,36 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/outbox"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// OutboxFactoryArgs defines the args needed for wrapping the outport drivers in persistent outboxes
+type OutboxFactoryArgs struct {
+	Enabled         bool
+	Storer          storage.Storer
+	Marshaller      marshal.Marshalizer
+	StatusHandler   core.AppStatusHandler
+	RetrialInterval time.Duration
+}
+
+// wrapInOutboxIfNeeded will wrap the provided driver in a persistent outbox, if the outbox is enabled
+func wrapInOutboxIfNeeded(driverName string, driver outport.Driver, args *OutboxFactoryArgs) (outport.Driver, error) {
+	if args == nil || !args.Enabled {
+		return driver, nil
+	}
+
+	return outbox.NewOutboxDriver(outbox.ArgsOutboxDriver{
+		Name:            driverName,
+		Driver:          driver,
+		Storer:          args.Storer,
+		Marshaller:      args.Marshaller,
+		StatusHandler:   args.StatusHandler,
+		RetrialInterval: args.RetrialInterval,
+	})
+}
@@ -14,6 +14,8 @@ type OutportFactoryArgs struct {
 	ElasticIndexerFactoryArgs  *indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs   *EventNotifierFactoryArgs
 	CovalentIndexerFactoryArgs *covalentFactory.ArgsCovalentIndexerFactory
+	WebSocketDriverFactoryArgs *WebSocketDriverFactoryArgs
+	OutboxFactoryArgs          *OutboxFactoryArgs
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -37,17 +39,22 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 }
 
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
-	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
+	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs, args.OutboxFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs)
+	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs, args.OutboxFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeCovalentDriverIfNeeded(outport, args.CovalentIndexerFactoryArgs)
+	err = createAndSubscribeCovalentDriverIfNeeded(outport, args.CovalentIndexerFactoryArgs, args.OutboxFactoryArgs)
+	if err != nil {
+		return err
+	}
+
+	err = createAndSubscribeWebSocketDriverIfNeeded(outport, args.WebSocketDriverFactoryArgs, args.OutboxFactoryArgs)
 	if err != nil {
 		return err
 	}
@@ -58,6 +65,7 @@ func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFact
 func createAndSubscribeCovalentDriverIfNeeded(
 	outport outport.OutportHandler,
 	args *covalentFactory.ArgsCovalentIndexerFactory,
+	outboxArgs *OutboxFactoryArgs,
 ) error {
 	if !args.Enabled {
 		return nil
@@ -68,12 +76,13 @@ func createAndSubscribeCovalentDriverIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(covalentDriver)
+	return subscribeDriver(outport, "covalent", covalentDriver, outboxArgs)
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
 	outport outport.OutportHandler,
 	args *indexerFactory.ArgsIndexerFactory,
+	outboxArgs *OutboxFactoryArgs,
 ) error {
 	if !args.Enabled {
 		return nil
@@ -84,12 +93,13 @@ func createAndSubscribeElasticDriverIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(elasticDriver)
+	return subscribeDriver(outport, "elasticSearch", elasticDriver, outboxArgs)
 }
 
 func createAndSubscribeEventNotifierIfNeeded(
 	outport outport.OutportHandler,
 	args *EventNotifierFactoryArgs,
+	outboxArgs *OutboxFactoryArgs,
 ) error {
 	if !args.Enabled {
 		return nil
@@ -100,7 +110,39 @@ func createAndSubscribeEventNotifierIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(eventNotifier)
+	return subscribeDriver(outport, "eventNotifier", eventNotifier, outboxArgs)
+}
+
+func createAndSubscribeWebSocketDriverIfNeeded(
+	outport outport.OutportHandler,
+	args *WebSocketDriverFactoryArgs,
+	outboxArgs *OutboxFactoryArgs,
+) error {
+	if !args.Enabled {
+		return nil
+	}
+
+	webSocketDriver, err := CreateWebSocketDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return subscribeDriver(outport, "webSocket", webSocketDriver, outboxArgs)
+}
+
+func subscribeDriver(
+	outport outport.OutportHandler,
+	driverName string,
+	driver outport.Driver,
+	outboxArgs *OutboxFactoryArgs,
+) error {
+	wrappedDriver, err := wrapInOutboxIfNeeded(driverName, driver, outboxArgs)
+	if err != nil {
+		_ = driver.Close()
+		return err
+	}
+
+	return outport.SubscribeDriver(wrappedDriver)
 }
 
 func checkArguments(args *OutportFactoryArgs) error {
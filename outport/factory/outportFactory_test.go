@@ -10,13 +10,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/factory"
 	notifierFactory "github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/outbox"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
 
 func createMockArgsOutportHandler(indexerEnabled, notifierEnabled, covalentEnabled bool) *factory.OutportFactoryArgs {
+	return createMockArgsOutportHandlerWithWebSocket(indexerEnabled, notifierEnabled, covalentEnabled, false)
+}
+
+func createMockArgsOutportHandlerWithWebSocket(indexerEnabled, notifierEnabled, covalentEnabled, webSocketEnabled bool) *factory.OutportFactoryArgs {
 	mockElasticArgs := &indexerFactory.ArgsIndexerFactory{
 		Enabled: indexerEnabled,
 	}
@@ -26,11 +33,15 @@ func createMockArgsOutportHandler(indexerEnabled, notifierEnabled, covalentEnabl
 	mockCovalentArgs := &covalentFactory.ArgsCovalentIndexerFactory{
 		Enabled: covalentEnabled,
 	}
+	mockWebSocketArgs := &factory.WebSocketDriverFactoryArgs{
+		Enabled: webSocketEnabled,
+	}
 	return &factory.OutportFactoryArgs{
 		RetrialInterval:            time.Second,
 		ElasticIndexerFactoryArgs:  mockElasticArgs,
 		EventNotifierFactoryArgs:   mockNotifierArgs,
 		CovalentIndexerFactoryArgs: mockCovalentArgs,
+		WebSocketDriverFactoryArgs: mockWebSocketArgs,
 	}
 }
 
@@ -94,6 +105,11 @@ func TestCreateOutport_EnabledDriversNilMockArgsExpectErrorSubscribingDrivers(t
 				return createMockArgsOutportHandler(false, false, true)
 			},
 		},
+		{
+			argsFunc: func() *factory.OutportFactoryArgs {
+				return createMockArgsOutportHandlerWithWebSocket(false, false, false, true)
+			},
+		},
 	}
 
 	for _, currTest := range tests {
@@ -136,3 +152,50 @@ func TestCreateOutport_SubscribeNotifierDriver(t *testing.T) {
 	require.True(t, outPort.HasDrivers())
 	require.Nil(t, err)
 }
+
+func TestCreateOutport_SubscribeWebSocketDriver(t *testing.T) {
+	args := createMockArgsOutportHandlerWithWebSocket(false, false, false, true)
+
+	args.WebSocketDriverFactoryArgs.URL = "localhost:0"
+	args.WebSocketDriverFactoryArgs.MaxNumClients = 1
+	args.WebSocketDriverFactoryArgs.ClientBufferSize = 1
+	args.WebSocketDriverFactoryArgs.FullBufferTimeout = time.Second
+	args.WebSocketDriverFactoryArgs.Marshaller = &mock.MarshalizerMock{}
+	args.WebSocketDriverFactoryArgs.Hasher = &hashingMocks.HasherMock{}
+	args.WebSocketDriverFactoryArgs.PubKeyConverter = &mock.PubkeyConverterMock{}
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_SubscribeNotifierDriverWithOutbox(t *testing.T) {
+	args := createMockArgsOutportHandler(false, true, false)
+
+	args.EventNotifierFactoryArgs.Marshaller = &mock.MarshalizerMock{}
+	args.EventNotifierFactoryArgs.Hasher = &hashingMocks.HasherMock{}
+	args.EventNotifierFactoryArgs.PubKeyConverter = &mock.PubkeyConverterMock{}
+	args.OutboxFactoryArgs = &factory.OutboxFactoryArgs{
+		Enabled:         true,
+		Marshaller:      &mock.MarshalizerMock{},
+		StatusHandler:   statusHandler.NewAppStatusHandlerMock(),
+		RetrialInterval: time.Second,
+	}
+	outPort, err := factory.CreateOutport(args)
+	require.True(t, errors.Is(err, outbox.ErrNilStorer))
+	require.Nil(t, outPort)
+
+	args.OutboxFactoryArgs.Storer = testscommon.CreateMemUnit()
+	outPort, err = factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
@@ -0,0 +1,40 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/wsdriver"
+)
+
+// WebSocketDriverFactoryArgs defines the args needed for web socket driver creation
+type WebSocketDriverFactoryArgs struct {
+	Enabled            bool
+	URL                string
+	MaxNumClients      int
+	ClientBufferSize   int
+	WithAcknowledge    bool
+	AcknowledgeTimeout time.Duration
+	FullBufferTimeout  time.Duration
+	Marshaller         marshal.Marshalizer
+	Hasher             hashing.Hasher
+	PubKeyConverter    core.PubkeyConverter
+}
+
+// CreateWebSocketDriver will create a new web socket driver instance
+func CreateWebSocketDriver(args *WebSocketDriverFactoryArgs) (outport.Driver, error) {
+	return wsdriver.NewWebSocketDriver(wsdriver.ArgsWebSocketDriver{
+		URL:                args.URL,
+		MaxNumClients:      args.MaxNumClients,
+		ClientBufferSize:   args.ClientBufferSize,
+		WithAcknowledge:    args.WithAcknowledge,
+		AcknowledgeTimeout: args.AcknowledgeTimeout,
+		FullBufferTimeout:  args.FullBufferTimeout,
+		Marshaller:         args.Marshaller,
+		Hasher:             args.Hasher,
+		PubKeyConverter:    args.PubKeyConverter,
+	})
+}
@@ -3,6 +3,7 @@ package outport
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 // Driver is an interface for saving node specific data to other storage.
@@ -19,6 +20,11 @@ type Driver interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationsDriver defines a driver able to save the equivocations evidences. The drivers implement it optionally
+type EquivocationsDriver interface {
+	SaveEquivocation(evidence *common.EquivocationEvidence) error
+}
+
 // OutportHandler is interface that defines what a proxy implementation should be able to do
 // The node is able to talk only with this interface
 type OutportHandler interface {
@@ -29,6 +35,7 @@ type OutportHandler interface {
 	SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo)
 	SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler)
 	FinalizedBlock(headerHash []byte)
+	SaveEquivocation(evidence *common.EquivocationEvidence)
 	SubscribeDriver(driver Driver) error
 	HasDrivers() bool
 	Close() error
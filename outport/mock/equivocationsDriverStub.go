@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// EquivocationsDriverStub -
+type EquivocationsDriverStub struct {
+	DriverStub
+	SaveEquivocationCalled func(evidence *common.EquivocationEvidence) error
+}
+
+// SaveEquivocation -
+func (d *EquivocationsDriverStub) SaveEquivocation(evidence *common.EquivocationEvidence) error {
+	if d.SaveEquivocationCalled != nil {
+		return d.SaveEquivocationCalled(evidence)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (d *EquivocationsDriverStub) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,326 @@
+package outbox
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+)
+
+const (
+	entryTypeSaveBlock      = "saveBlock"
+	entryTypeRevertBlock    = "revertBlock"
+	entryTypeFinalizedBlock = "finalizedBlock"
+
+	headerTypeShardV1 = "shardV1"
+	headerTypeShardV2 = "shardV2"
+	headerTypeMeta    = "meta"
+)
+
+// entry is the persisted form of an outport call. Headers, bodies and pool components are kept in their
+// internal (binary) form so that they can be restored as the same concrete types when the entry is replayed.
+// The hashes are kept as byte slices, since the JSON encoding of a string would replace their invalid UTF-8 bytes.
+type entry struct {
+	Type                 string                       `json:"type"`
+	HeaderHash           []byte                       `json:"headerHash,omitempty"`
+	HeaderType           string                       `json:"headerType,omitempty"`
+	Header               []byte                       `json:"header,omitempty"`
+	Body                 []byte                       `json:"body,omitempty"`
+	SignersIndexes       []uint64                     `json:"signersIndexes,omitempty"`
+	HeaderGasConsumption indexer.HeaderGasConsumption `json:"headerGasConsumption"`
+	Pool                 *entryPool                   `json:"pool,omitempty"`
+}
+
+type entryPool struct {
+	Txs      []*entryTx  `json:"txs,omitempty"`
+	Scrs     []*entryTx  `json:"scrs,omitempty"`
+	Rewards  []*entryTx  `json:"rewards,omitempty"`
+	Invalid  []*entryTx  `json:"invalid,omitempty"`
+	Receipts []*entryTx  `json:"receipts,omitempty"`
+	Logs     []*entryLog `json:"logs,omitempty"`
+}
+
+type entryTx struct {
+	Hash []byte `json:"hash"`
+	Tx   []byte `json:"tx"`
+}
+
+type entryLog struct {
+	TxHash []byte `json:"txHash"`
+	Log    []byte `json:"log"`
+}
+
+// entryCodec converts outport calls into entries and back
+type entryCodec struct {
+	marshaller marshal.Marshalizer
+}
+
+func (ec *entryCodec) encodeSaveBlock(args *indexer.ArgsSaveBlockData) (*entry, error) {
+	if args == nil {
+		return nil, ErrNilSaveBlockData
+	}
+
+	e, err := ec.encodeHeaderAndBody(entryTypeSaveBlock, args.Header, args.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	e.HeaderHash = args.HeaderHash
+	e.SignersIndexes = args.SignersIndexes
+	e.HeaderGasConsumption = args.HeaderGasConsumption
+	if args.TransactionsPool == nil {
+		return e, nil
+	}
+
+	e.Pool, err = ec.encodePool(args.TransactionsPool)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (ec *entryCodec) encodeRevertBlock(header data.HeaderHandler, body data.BodyHandler) (*entry, error) {
+	return ec.encodeHeaderAndBody(entryTypeRevertBlock, header, body)
+}
+
+func (ec *entryCodec) encodeFinalizedBlock(headerHash []byte) *entry {
+	return &entry{
+		Type:       entryTypeFinalizedBlock,
+		HeaderHash: headerHash,
+	}
+}
+
+func (ec *entryCodec) encodeHeaderAndBody(entryType string, header data.HeaderHandler, body data.BodyHandler) (*entry, error) {
+	if check.IfNil(header) {
+		return nil, ErrNilHeader
+	}
+
+	headerType, err := getHeaderType(header)
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := ec.marshaller.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &entry{
+		Type:       entryType,
+		HeaderType: headerType,
+		Header:     headerBytes,
+	}
+	if check.IfNil(body) {
+		return e, nil
+	}
+
+	e.Body, err = ec.marshaller.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func getHeaderType(header data.HeaderHandler) (string, error) {
+	switch header.(type) {
+	case *block.Header:
+		return headerTypeShardV1, nil
+	case *block.HeaderV2:
+		return headerTypeShardV2, nil
+	case *block.MetaBlock:
+		return headerTypeMeta, nil
+	default:
+		return "", fmt.Errorf("%w: %T", ErrUnknownHeaderType, header)
+	}
+}
+
+func (ec *entryCodec) encodePool(pool *indexer.Pool) (*entryPool, error) {
+	var err error
+	encoded := &entryPool{}
+
+	encoded.Txs, err = ec.encodeTransactions(pool.Txs)
+	if err != nil {
+		return nil, err
+	}
+	encoded.Scrs, err = ec.encodeTransactions(pool.Scrs)
+	if err != nil {
+		return nil, err
+	}
+	encoded.Rewards, err = ec.encodeTransactions(pool.Rewards)
+	if err != nil {
+		return nil, err
+	}
+	encoded.Invalid, err = ec.encodeTransactions(pool.Invalid)
+	if err != nil {
+		return nil, err
+	}
+	encoded.Receipts, err = ec.encodeTransactions(pool.Receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded.Logs = make([]*entryLog, 0, len(pool.Logs))
+	for _, logData := range pool.Logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		logBytes, errMarshal := ec.marshaller.Marshal(logData.LogHandler)
+		if errMarshal != nil {
+			return nil, errMarshal
+		}
+
+		encoded.Logs = append(encoded.Logs, &entryLog{
+			TxHash: []byte(logData.TxHash),
+			Log:    logBytes,
+		})
+	}
+
+	return encoded, nil
+}
+
+func (ec *entryCodec) encodeTransactions(txs map[string]data.TransactionHandler) ([]*entryTx, error) {
+	encoded := make([]*entryTx, 0, len(txs))
+	for hash, tx := range txs {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		txBytes, err := ec.marshaller.Marshal(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, &entryTx{
+			Hash: []byte(hash),
+			Tx:   txBytes,
+		})
+	}
+
+	return encoded, nil
+}
+
+func (ec *entryCodec) decodeSaveBlock(e *entry) (*indexer.ArgsSaveBlockData, error) {
+	header, body, err := ec.decodeHeaderAndBody(e)
+	if err != nil {
+		return nil, err
+	}
+
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:           e.HeaderHash,
+		Body:                 body,
+		Header:               header,
+		SignersIndexes:       e.SignersIndexes,
+		HeaderGasConsumption: e.HeaderGasConsumption,
+	}
+	if e.Pool == nil {
+		return args, nil
+	}
+
+	args.TransactionsPool, err = ec.decodePool(e.Pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return args, nil
+}
+
+func (ec *entryCodec) decodeHeaderAndBody(e *entry) (data.HeaderHandler, data.BodyHandler, error) {
+	var header data.HeaderHandler
+	switch e.HeaderType {
+	case headerTypeShardV1:
+		header = &block.Header{}
+	case headerTypeShardV2:
+		header = &block.HeaderV2{}
+	case headerTypeMeta:
+		header = &block.MetaBlock{}
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnknownHeaderType, e.HeaderType)
+	}
+
+	err := ec.marshaller.Unmarshal(header, e.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := &block.Body{}
+	if len(e.Body) == 0 {
+		return header, body, nil
+	}
+
+	err = ec.marshaller.Unmarshal(body, e.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, body, nil
+}
+
+func (ec *entryCodec) decodePool(encoded *entryPool) (*indexer.Pool, error) {
+	var err error
+	pool := &indexer.Pool{}
+
+	pool.Txs, err = ec.decodeTransactions(encoded.Txs, func() data.TransactionHandler { return &transaction.Transaction{} })
+	if err != nil {
+		return nil, err
+	}
+	pool.Scrs, err = ec.decodeTransactions(encoded.Scrs, func() data.TransactionHandler { return &smartContractResult.SmartContractResult{} })
+	if err != nil {
+		return nil, err
+	}
+	pool.Rewards, err = ec.decodeTransactions(encoded.Rewards, func() data.TransactionHandler { return &rewardTx.RewardTx{} })
+	if err != nil {
+		return nil, err
+	}
+	pool.Invalid, err = ec.decodeTransactions(encoded.Invalid, func() data.TransactionHandler { return &transaction.Transaction{} })
+	if err != nil {
+		return nil, err
+	}
+	pool.Receipts, err = ec.decodeTransactions(encoded.Receipts, func() data.TransactionHandler { return &receipt.Receipt{} })
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Logs = make([]*data.LogData, 0, len(encoded.Logs))
+	for _, encodedLog := range encoded.Logs {
+		txLog := &transaction.Log{}
+		err = ec.marshaller.Unmarshal(txLog, encodedLog.Log)
+		if err != nil {
+			return nil, err
+		}
+
+		pool.Logs = append(pool.Logs, &data.LogData{
+			LogHandler: txLog,
+			TxHash:     string(encodedLog.TxHash),
+		})
+	}
+
+	return pool, nil
+}
+
+func (ec *entryCodec) decodeTransactions(
+	encoded []*entryTx,
+	newTx func() data.TransactionHandler,
+) (map[string]data.TransactionHandler, error) {
+	txs := make(map[string]data.TransactionHandler, len(encoded))
+	for _, encodedTx := range encoded {
+		tx := newTx()
+		err := ec.marshaller.Unmarshal(tx, encodedTx.Tx)
+		if err != nil {
+			return nil, err
+		}
+
+		txs[string(encodedTx.Hash)] = tx
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,33 @@
+package outbox
+
+import "errors"
+
+// ErrEmptyDriverName signals that an empty driver name has been provided
+var ErrEmptyDriverName = errors.New("empty driver name")
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrInvalidRetrialInterval signals that an invalid retrial interval has been provided
+var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
+
+// ErrNilSaveBlockData signals that a nil save block data has been provided
+var ErrNilSaveBlockData = errors.New("nil save block data")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrUnknownHeaderType signals that the header type is not known by the outbox
+var ErrUnknownHeaderType = errors.New("unknown header type")
+
+// ErrUnknownEntryType signals that a persisted entry has an unknown type
+var ErrUnknownEntryType = errors.New("unknown entry type")
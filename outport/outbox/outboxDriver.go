@@ -0,0 +1,387 @@
+package outbox
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("outport/outbox")
+
+const (
+	minimumRetrialInterval = time.Millisecond * 10
+	cursorKeySuffix        = "_cursor"
+	lastKeySuffix          = "_last"
+	entryKeyInfix          = "_entry_"
+)
+
+// ArgsOutboxDriver holds the arguments needed for creating a new outbox driver
+type ArgsOutboxDriver struct {
+	Name            string
+	Driver          outport.Driver
+	Storer          storage.Storer
+	Marshaller      marshal.Marshalizer
+	StatusHandler   core.AppStatusHandler
+	RetrialInterval time.Duration
+}
+
+// outboxDriver decorates an outport driver with a persistent outbox. The SaveBlock, RevertIndexedBlock and
+// FinalizedBlock calls are appended to the storer and are delivered, in order, by a dedicated go routine which
+// retries each entry until the wrapped driver accepts it. The delivery cursor is persisted as well, so the entries
+// not yet acknowledged by the driver are replayed after a node restart. The rest of the calls are forwarded as they are.
+type outboxDriver struct {
+	name            string
+	driver          outport.Driver
+	storer          storage.Storer
+	jsonMarshaller  marshal.Marshalizer
+	codec           *entryCodec
+	statusHandler   core.AppStatusHandler
+	retrialInterval time.Duration
+	lagMetric       string
+
+	mutex     sync.RWMutex
+	cursor    uint64
+	last      uint64
+	newEntry  chan struct{}
+	cancel    func()
+	closeOnce sync.Once
+}
+
+// NewOutboxDriver creates a new outbox driver instance and starts delivering the entries found in the storer
+func NewOutboxDriver(args ArgsOutboxDriver) (*outboxDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	od := &outboxDriver{
+		name:            args.Name,
+		driver:          args.Driver,
+		storer:          args.Storer,
+		jsonMarshaller:  &marshal.JsonMarshalizer{},
+		codec:           &entryCodec{marshaller: args.Marshaller},
+		statusHandler:   args.StatusHandler,
+		retrialInterval: args.RetrialInterval,
+		lagMetric:       fmt.Sprintf("%s_%s", common.MetricOutportOutboxLag, args.Name),
+		newEntry:        make(chan struct{}, 1),
+	}
+
+	od.cursor, od.last = od.loadCounters()
+	od.updateLagMetric()
+	if od.last >= od.cursor {
+		log.Info("outbox: replaying undelivered entries", "driver", od.name, "num entries", od.last-od.cursor+1)
+	}
+
+	var ctx context.Context
+	ctx, od.cancel = context.WithCancel(context.Background())
+	go od.deliverLoop(ctx)
+
+	return od, nil
+}
+
+func checkArgs(args ArgsOutboxDriver) error {
+	if len(args.Name) == 0 {
+		return ErrEmptyDriverName
+	}
+	if check.IfNil(args.Driver) {
+		return ErrNilDriver
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if args.RetrialInterval < minimumRetrialInterval {
+		return fmt.Errorf("%w, provided: %d, minimum: %d", ErrInvalidRetrialInterval, args.RetrialInterval, minimumRetrialInterval)
+	}
+
+	return nil
+}
+
+// loadCounters returns the sequence number of the next entry to be delivered and the sequence number of the last
+// persisted entry. Entries are numbered starting from 1.
+func (od *outboxDriver) loadCounters() (uint64, uint64) {
+	cursor := od.loadCounter(od.cursorKey())
+	if cursor == 0 {
+		cursor = 1
+	}
+
+	return cursor, od.loadCounter(od.lastKey())
+}
+
+func (od *outboxDriver) loadCounter(key []byte) uint64 {
+	buff, err := od.storer.Get(key)
+	if err != nil || len(buff) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(buff)
+}
+
+func (od *outboxDriver) saveCounter(key []byte, value uint64) error {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return od.storer.Put(key, buff)
+}
+
+func (od *outboxDriver) cursorKey() []byte {
+	return []byte(od.name + cursorKeySuffix)
+}
+
+func (od *outboxDriver) lastKey() []byte {
+	return []byte(od.name + lastKeySuffix)
+}
+
+func (od *outboxDriver) entryKey(seq uint64) []byte {
+	prefix := od.name + entryKeyInfix
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], seq)
+
+	return key
+}
+
+// SaveBlock appends the block to the outbox
+func (od *outboxDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	e, err := od.codec.encodeSaveBlock(args)
+	if err != nil {
+		return err
+	}
+
+	return od.append(e)
+}
+
+// RevertIndexedBlock appends the revert of the block to the outbox
+func (od *outboxDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	e, err := od.codec.encodeRevertBlock(header, body)
+	if err != nil {
+		return err
+	}
+
+	return od.append(e)
+}
+
+// FinalizedBlock appends the finalized block hash to the outbox
+func (od *outboxDriver) FinalizedBlock(headerHash []byte) error {
+	return od.append(od.codec.encodeFinalizedBlock(headerHash))
+}
+
+func (od *outboxDriver) append(e *entry) error {
+	buff, err := od.jsonMarshaller.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	od.mutex.Lock()
+	seq := od.last + 1
+	err = od.storer.Put(od.entryKey(seq), buff)
+	if err == nil {
+		err = od.saveCounter(od.lastKey(), seq)
+	}
+	if err == nil {
+		od.last = seq
+	}
+	od.mutex.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	od.updateLagMetric()
+
+	select {
+	case od.newEntry <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (od *outboxDriver) deliverLoop(ctx context.Context) {
+	for {
+		od.mutex.RLock()
+		cursor, last := od.cursor, od.last
+		od.mutex.RUnlock()
+
+		if cursor > last {
+			select {
+			case <-ctx.Done():
+				return
+			case <-od.newEntry:
+				continue
+			}
+		}
+
+		delivered := od.deliverBlocking(ctx, cursor)
+		if !delivered {
+			return
+		}
+
+		od.advanceCursor(cursor)
+	}
+}
+
+// deliverBlocking calls the wrapped driver for the provided entry until it succeeds. It returns false if the
+// outbox was closed in the meantime.
+func (od *outboxDriver) deliverBlocking(ctx context.Context, seq uint64) bool {
+	for {
+		err := od.deliver(seq)
+		if err == nil {
+			return true
+		}
+
+		log.Error("outbox: error delivering entry, will retry",
+			"driver", od.name,
+			"entry", seq,
+			"retrial in", od.retrialInterval,
+			"error", err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(od.retrialInterval):
+		}
+	}
+}
+
+func (od *outboxDriver) deliver(seq uint64) error {
+	e, err := od.loadEntry(seq)
+	if err != nil {
+		// a corrupted or missing entry can not be delivered no matter how many times it is retried
+		log.Error("outbox: skipping unreadable entry", "driver", od.name, "entry", seq, "error", err)
+		return nil
+	}
+
+	switch e.Type {
+	case entryTypeSaveBlock:
+		args, errDecode := od.codec.decodeSaveBlock(e)
+		if errDecode != nil {
+			log.Error("outbox: skipping undecodable entry", "driver", od.name, "entry", seq, "error", errDecode)
+			return nil
+		}
+
+		return od.driver.SaveBlock(args)
+	case entryTypeRevertBlock:
+		header, body, errDecode := od.codec.decodeHeaderAndBody(e)
+		if errDecode != nil {
+			log.Error("outbox: skipping undecodable entry", "driver", od.name, "entry", seq, "error", errDecode)
+			return nil
+		}
+
+		return od.driver.RevertIndexedBlock(header, body)
+	case entryTypeFinalizedBlock:
+		return od.driver.FinalizedBlock(e.HeaderHash)
+	default:
+		log.Error("outbox: skipping entry", "driver", od.name, "entry", seq, "error", fmt.Errorf("%w: %s", ErrUnknownEntryType, e.Type))
+		return nil
+	}
+}
+
+func (od *outboxDriver) loadEntry(seq uint64) (*entry, error) {
+	buff, err := od.storer.Get(od.entryKey(seq))
+	if err != nil {
+		return nil, err
+	}
+
+	e := &entry{}
+	err = od.jsonMarshaller.Unmarshal(e, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (od *outboxDriver) advanceCursor(delivered uint64) {
+	od.mutex.Lock()
+	od.cursor = delivered + 1
+	err := od.saveCounter(od.cursorKey(), od.cursor)
+	od.mutex.Unlock()
+
+	if err != nil {
+		// the entry will be delivered once again after a restart
+		log.Warn("outbox: cannot persist cursor", "driver", od.name, "cursor", delivered+1, "error", err)
+	}
+
+	err = od.storer.Remove(od.entryKey(delivered))
+	if err != nil {
+		log.Debug("outbox: cannot remove delivered entry", "driver", od.name, "entry", delivered, "error", err)
+	}
+
+	od.updateLagMetric()
+}
+
+// Lag returns the number of entries not yet delivered to the wrapped driver
+func (od *outboxDriver) Lag() uint64 {
+	od.mutex.RLock()
+	defer od.mutex.RUnlock()
+
+	if od.last < od.cursor {
+		return 0
+	}
+
+	return od.last - od.cursor + 1
+}
+
+func (od *outboxDriver) updateLagMetric() {
+	od.statusHandler.SetUInt64Value(od.lagMetric, od.Lag())
+}
+
+// SaveRoundsInfo forwards the call to the wrapped driver
+func (od *outboxDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	return od.driver.SaveRoundsInfo(roundsInfos)
+}
+
+// SaveValidatorsPubKeys forwards the call to the wrapped driver
+func (od *outboxDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	return od.driver.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+}
+
+// SaveValidatorsRating forwards the call to the wrapped driver
+func (od *outboxDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	return od.driver.SaveValidatorsRating(indexID, infoRating)
+}
+
+// SaveAccounts forwards the call to the wrapped driver
+func (od *outboxDriver) SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler) error {
+	return od.driver.SaveAccounts(blockTimestamp, acc)
+}
+
+// SaveEquivocation forwards the call to the wrapped driver, if it supports the equivocations evidences
+func (od *outboxDriver) SaveEquivocation(evidence *common.EquivocationEvidence) error {
+	equivocationsDriver, ok := od.driver.(outport.EquivocationsDriver)
+	if !ok {
+		return nil
+	}
+
+	return equivocationsDriver.SaveEquivocation(evidence)
+}
+
+// Close stops the delivery and closes the wrapped driver. The undelivered entries remain in the storer.
+func (od *outboxDriver) Close() error {
+	od.closeOnce.Do(od.cancel)
+
+	return od.driver.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (od *outboxDriver) IsInterfaceNil() bool {
+	return od == nil
+}
@@ -0,0 +1,391 @@
+package outbox
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDriverName = "test"
+
+var lagMetric = common.MetricOutportOutboxLag + "_" + testDriverName
+
+func createMockArgsOutboxDriver(driver *mock.DriverStub, storer storage.Storer) ArgsOutboxDriver {
+	return ArgsOutboxDriver{
+		Name:            testDriverName,
+		Driver:          driver,
+		Storer:          storer,
+		Marshaller:      &testscommon.MarshalizerMock{},
+		StatusHandler:   statusHandler.NewAppStatusHandlerMock(),
+		RetrialInterval: minimumRetrialInterval,
+	}
+}
+
+func waitCondition(t *testing.T, condition func() bool) {
+	timeout := time.After(time.Second * 5)
+	for !condition() {
+		select {
+		case <-timeout:
+			require.Fail(t, "timeout waiting for condition")
+		case <-time.After(time.Millisecond * 5):
+		}
+	}
+}
+
+func TestNewOutboxDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		args := createMockArgsOutboxDriver(&mock.DriverStub{}, testscommon.CreateMemUnit())
+		args.Name = ""
+
+		od, err := NewOutboxDriver(args)
+		assert.Equal(t, ErrEmptyDriverName, err)
+		assert.True(t, check.IfNil(od))
+	})
+	t.Run("nil driver should error", func(t *testing.T) {
+		args := createMockArgsOutboxDriver(&mock.DriverStub{}, testscommon.CreateMemUnit())
+		args.Driver = nil
+
+		od, err := NewOutboxDriver(args)
+		assert.Equal(t, ErrNilDriver, err)
+		assert.True(t, check.IfNil(od))
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		args := createMockArgsOutboxDriver(&mock.DriverStub{}, nil)
+
+		od, err := NewOutboxDriver(args)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(od))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		args := createMockArgsOutboxDriver(&mock.DriverStub{}, testscommon.CreateMemUnit())
+		args.Marshaller = nil
+
+		od, err := NewOutboxDriver(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.True(t, check.IfNil(od))
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		args := createMockArgsOutboxDriver(&mock.DriverStub{}, testscommon.CreateMemUnit())
+		args.StatusHandler = nil
+
+		od, err := NewOutboxDriver(args)
+		assert.Equal(t, ErrNilStatusHandler, err)
+		assert.True(t, check.IfNil(od))
+	})
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		args := createMockArgsOutboxDriver(&mock.DriverStub{}, testscommon.CreateMemUnit())
+		args.RetrialInterval = 0
+
+		od, err := NewOutboxDriver(args)
+		assert.True(t, errors.Is(err, ErrInvalidRetrialInterval))
+		assert.True(t, check.IfNil(od))
+	})
+	t.Run("should work", func(t *testing.T) {
+		od, err := NewOutboxDriver(createMockArgsOutboxDriver(&mock.DriverStub{}, testscommon.CreateMemUnit()))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(od))
+		_ = od.Close()
+	})
+}
+
+func TestOutboxDriver_SaveBlockShouldDeliverTheSameData(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	var delivered *indexer.ArgsSaveBlockData
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			mut.Lock()
+			delivered = args
+			mut.Unlock()
+			return nil
+		},
+	}
+	od, _ := NewOutboxDriver(createMockArgsOutboxDriver(driver, testscommon.CreateMemUnit()))
+	defer func() {
+		_ = od.Close()
+	}()
+
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:     []byte("hash"),
+		Body:           &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx")}}}},
+		Header:         &block.HeaderV2{Header: &block.Header{Nonce: 7, Round: 8}},
+		SignersIndexes: []uint64{1, 2},
+		HeaderGasConsumption: indexer.HeaderGasConsumption{
+			GasProvided: 10,
+		},
+		TransactionsPool: &indexer.Pool{
+			Txs:      map[string]data.TransactionHandler{"tx": &transaction.Transaction{Nonce: 3, Value: big.NewInt(4)}},
+			Scrs:     map[string]data.TransactionHandler{"scr": &smartContractResult.SmartContractResult{Nonce: 5, Value: big.NewInt(6)}},
+			Receipts: map[string]data.TransactionHandler{"receipt": &receipt.Receipt{Value: big.NewInt(1), TxHash: []byte("tx")}},
+			Logs: []*data.LogData{
+				{
+					TxHash:     "tx",
+					LogHandler: &transaction.Log{Address: []byte("addr"), Events: []*transaction.Event{{Identifier: []byte("id")}}},
+				},
+			},
+		},
+	}
+
+	err := od.SaveBlock(args)
+	require.Nil(t, err)
+
+	waitCondition(t, func() bool {
+		mut.Lock()
+		defer mut.Unlock()
+		return delivered != nil
+	})
+
+	mut.Lock()
+	defer mut.Unlock()
+	assert.Equal(t, args.HeaderHash, delivered.HeaderHash)
+	assert.Equal(t, args.Header, delivered.Header)
+	assert.Equal(t, args.Body, delivered.Body)
+	assert.Equal(t, args.SignersIndexes, delivered.SignersIndexes)
+	assert.Equal(t, args.HeaderGasConsumption, delivered.HeaderGasConsumption)
+	assert.Equal(t, args.TransactionsPool.Txs, delivered.TransactionsPool.Txs)
+	assert.Equal(t, args.TransactionsPool.Scrs, delivered.TransactionsPool.Scrs)
+	assert.Equal(t, args.TransactionsPool.Receipts, delivered.TransactionsPool.Receipts)
+	assert.Equal(t, args.TransactionsPool.Logs, delivered.TransactionsPool.Logs)
+}
+
+func TestOutboxDriver_InvalidCallsShouldError(t *testing.T) {
+	t.Parallel()
+
+	od, _ := NewOutboxDriver(createMockArgsOutboxDriver(&mock.DriverStub{}, testscommon.CreateMemUnit()))
+	defer func() {
+		_ = od.Close()
+	}()
+
+	err := od.SaveBlock(nil)
+	assert.Equal(t, ErrNilSaveBlockData, err)
+
+	err = od.SaveBlock(&indexer.ArgsSaveBlockData{})
+	assert.Equal(t, ErrNilHeader, err)
+
+	err = od.RevertIndexedBlock(&testscommon.HeaderHandlerStub{}, &block.Body{})
+	assert.True(t, errors.Is(err, ErrUnknownHeaderType))
+	assert.Zero(t, od.Lag())
+}
+
+func TestOutboxDriver_ShouldRetryAndKeepTheOrder(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	calls := make([]string, 0)
+	numSaveBlockCalls := 0
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			mut.Lock()
+			defer mut.Unlock()
+
+			numSaveBlockCalls++
+			if numSaveBlockCalls < 3 {
+				return errors.New("consumer unavailable")
+			}
+			calls = append(calls, "save")
+			return nil
+		},
+		RevertBlockCalled: func(header data.HeaderHandler, body data.BodyHandler) error {
+			mut.Lock()
+			calls = append(calls, "revert")
+			mut.Unlock()
+			return nil
+		},
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			mut.Lock()
+			calls = append(calls, "finalized "+string(headerHash))
+			mut.Unlock()
+			return nil
+		},
+	}
+	args := createMockArgsOutboxDriver(driver, testscommon.CreateMemUnit())
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	args.StatusHandler = appStatusHandler
+	od, _ := NewOutboxDriver(args)
+	defer func() {
+		_ = od.Close()
+	}()
+
+	_ = od.SaveBlock(&indexer.ArgsSaveBlockData{Header: &block.Header{}})
+	_ = od.RevertIndexedBlock(&block.MetaBlock{}, nil)
+	_ = od.FinalizedBlock([]byte("hash"))
+
+	waitCondition(t, func() bool {
+		return od.Lag() == 0
+	})
+
+	mut.Lock()
+	defer mut.Unlock()
+	assert.Equal(t, []string{"save", "revert", "finalized hash"}, calls)
+	assert.Equal(t, 3, numSaveBlockCalls)
+	assert.Zero(t, appStatusHandler.GetUint64(lagMetric))
+}
+
+func TestOutboxDriver_ShouldReplayUndeliveredEntriesAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	storer := testscommon.CreateMemUnit()
+	failingDriver := &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			return errors.New("consumer unavailable")
+		},
+	}
+	args := createMockArgsOutboxDriver(failingDriver, storer)
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	args.StatusHandler = appStatusHandler
+	od, _ := NewOutboxDriver(args)
+
+	_ = od.FinalizedBlock([]byte("hash1"))
+	_ = od.FinalizedBlock([]byte("hash2"))
+	assert.Equal(t, uint64(2), od.Lag())
+	assert.Equal(t, uint64(2), appStatusHandler.GetUint64(lagMetric))
+	_ = od.Close()
+
+	mut := sync.Mutex{}
+	delivered := make([]string, 0)
+	driver := &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			mut.Lock()
+			delivered = append(delivered, string(headerHash))
+			mut.Unlock()
+			return nil
+		},
+	}
+	od, _ = NewOutboxDriver(createMockArgsOutboxDriver(driver, storer))
+	defer func() {
+		_ = od.Close()
+	}()
+
+	waitCondition(t, func() bool {
+		return od.Lag() == 0
+	})
+	_ = od.FinalizedBlock([]byte("hash3"))
+	waitCondition(t, func() bool {
+		return od.Lag() == 0
+	})
+
+	mut.Lock()
+	defer mut.Unlock()
+	assert.Equal(t, []string{"hash1", "hash2", "hash3"}, delivered)
+
+	_, err := storer.Get(od.entryKey(1))
+	assert.NotNil(t, err)
+}
+
+func TestOutboxDriver_ReplayShouldKeepBinaryHashes(t *testing.T) {
+	t.Parallel()
+
+	storer := testscommon.CreateMemUnit()
+	failingDriver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			return errors.New("consumer unavailable")
+		},
+	}
+	od, _ := NewOutboxDriver(createMockArgsOutboxDriver(failingDriver, storer))
+
+	// 32 bytes hashes which are not valid UTF-8 strings
+	txHash := bytes.Repeat([]byte{0xff, 0xfe, 0x80, 0x01}, 8)
+	scrHash := bytes.Repeat([]byte{0xc3, 0x28}, 16)
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash: bytes.Repeat([]byte{0xa0}, 32),
+		Header:     &block.Header{Nonce: 7},
+		TransactionsPool: &indexer.Pool{
+			Txs:  map[string]data.TransactionHandler{string(txHash): &transaction.Transaction{Nonce: 3, Value: big.NewInt(4)}},
+			Scrs: map[string]data.TransactionHandler{string(scrHash): &smartContractResult.SmartContractResult{Nonce: 5, Value: big.NewInt(6)}},
+			Logs: []*data.LogData{
+				{
+					TxHash:     string(txHash),
+					LogHandler: &transaction.Log{Address: []byte("addr")},
+				},
+			},
+		},
+	}
+	_ = od.SaveBlock(args)
+	_ = od.Close()
+
+	mut := sync.Mutex{}
+	var delivered *indexer.ArgsSaveBlockData
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			mut.Lock()
+			delivered = args
+			mut.Unlock()
+			return nil
+		},
+	}
+	od, _ = NewOutboxDriver(createMockArgsOutboxDriver(driver, storer))
+	defer func() {
+		_ = od.Close()
+	}()
+
+	waitCondition(t, func() bool {
+		return od.Lag() == 0
+	})
+
+	mut.Lock()
+	defer mut.Unlock()
+	require.NotNil(t, delivered)
+	assert.Equal(t, args.HeaderHash, delivered.HeaderHash)
+	assert.Equal(t, args.TransactionsPool.Txs, delivered.TransactionsPool.Txs)
+	assert.Equal(t, args.TransactionsPool.Scrs, delivered.TransactionsPool.Scrs)
+	require.Equal(t, 1, len(delivered.TransactionsPool.Logs))
+	assert.Equal(t, string(txHash), delivered.TransactionsPool.Logs[0].TxHash)
+}
+
+func TestOutboxDriver_OtherCallsShouldBeForwarded(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	driver := &mock.DriverStub{
+		SaveRoundsInfoCalled: func(roundsInfos []*indexer.RoundInfo) error {
+			numCalls++
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+			numCalls++
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+			numCalls++
+			return nil
+		},
+		SaveAccountsCalled: func(timestamp uint64, acc []data.UserAccountHandler) error {
+			numCalls++
+			return nil
+		},
+		CloseCalled: func() error {
+			numCalls++
+			return nil
+		},
+	}
+	od, _ := NewOutboxDriver(createMockArgsOutboxDriver(driver, testscommon.CreateMemUnit()))
+
+	_ = od.SaveRoundsInfo(nil)
+	_ = od.SaveValidatorsPubKeys(nil, 0)
+	_ = od.SaveValidatorsRating("", nil)
+	_ = od.SaveAccounts(0, nil)
+	_ = od.Close()
+
+	assert.Equal(t, 5, numCalls)
+}
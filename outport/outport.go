@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 var log = logger.GetOrCreate("outport")
@@ -241,6 +242,39 @@ func (o *outport) finalizedBlockBlocking(headerHash []byte, driver Driver) {
 	}
 }
 
+// SaveEquivocation will save the equivocation evidence for every driver supporting it
+func (o *outport) SaveEquivocation(evidence *common.EquivocationEvidence) {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	for _, driver := range o.drivers {
+		equivocationsDriver, ok := driver.(EquivocationsDriver)
+		if !ok {
+			continue
+		}
+
+		o.saveEquivocationBlocking(evidence, equivocationsDriver, driver)
+	}
+}
+
+func (o *outport) saveEquivocationBlocking(evidence *common.EquivocationEvidence, equivocationsDriver EquivocationsDriver, driver Driver) {
+	for {
+		err := equivocationsDriver.SaveEquivocation(evidence)
+		if err == nil {
+			return
+		}
+
+		log.Error("error calling SaveEquivocation, will retry",
+			"driver", driverString(driver),
+			"retrial in", o.retrialInterval,
+			"error", err)
+
+		if o.shouldTerminate() {
+			return
+		}
+	}
+}
+
 // Close will close all the drivers that are in outport
 func (o *outport) Close() error {
 	close(o.chanClose)
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -255,6 +256,34 @@ func TestOutport_FinalizedBlock(t *testing.T) {
 	assert.Equal(t, 1, numCalled2)
 }
 
+func TestOutport_SaveEquivocation(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	numCalled := 0
+	var savedEvidence *common.EquivocationEvidence
+	driver1 := &mock.DriverStub{}
+	driver2 := &mock.EquivocationsDriverStub{
+		SaveEquivocationCalled: func(evidence *common.EquivocationEvidence) error {
+			numCalled++
+			if numCalled < 10 {
+				return expectedError
+			}
+
+			savedEvidence = evidence
+			return nil
+		},
+	}
+	outportHandler, _ := NewOutport(minimumRetrialInterval)
+	_ = outportHandler.SubscribeDriver(driver1)
+	_ = outportHandler.SubscribeDriver(driver2)
+
+	evidence := &common.EquivocationEvidence{Round: 10}
+	outportHandler.SaveEquivocation(evidence)
+	assert.Equal(t, 10, numCalled)
+	assert.True(t, evidence == savedEvidence) // pointer testing
+}
+
 func TestOutport_SubscribeDriver(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,30 @@
+package wsdriver
+
+import "errors"
+
+// ErrEmptyURL signals that an empty listening URL has been provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidMaxNumClients signals that an invalid maximum number of clients has been provided
+var ErrInvalidMaxNumClients = errors.New("invalid maximum number of clients")
+
+// ErrInvalidClientBufferSize signals that an invalid client buffer size has been provided
+var ErrInvalidClientBufferSize = errors.New("invalid client buffer size")
+
+// ErrInvalidAcknowledgeTimeout signals that an invalid acknowledge timeout has been provided
+var ErrInvalidAcknowledgeTimeout = errors.New("invalid acknowledge timeout")
+
+// ErrInvalidFullBufferTimeout signals that an invalid full buffer timeout has been provided
+var ErrInvalidFullBufferTimeout = errors.New("invalid full buffer timeout")
+
+// ErrTooManyClients signals that the maximum number of connected clients has been reached
+var ErrTooManyClients = errors.New("too many clients")
+
+// ErrUnknownTopic signals that an unknown topic has been requested
+var ErrUnknownTopic = errors.New("unknown topic")
+
+// ErrNilSaveBlockData signals that a nil save block data has been provided
+var ErrNilSaveBlockData = errors.New("nil save block data")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
@@ -0,0 +1,539 @@
+package wsdriver
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/gorilla/websocket"
+)
+
+var log = logger.GetOrCreate("outport/wsdriver")
+
+const (
+	// TopicSaveBlock is the topic of the messages holding committed blocks
+	TopicSaveBlock = "saveBlock"
+	// TopicRevertBlock is the topic of the messages holding reverted blocks
+	TopicRevertBlock = "revertBlock"
+	// TopicFinalizedBlock is the topic of the messages holding finalized blocks
+	TopicFinalizedBlock = "finalizedBlock"
+	// TopicRoundsInfo is the topic of the messages holding rounds information
+	TopicRoundsInfo = "roundsInfo"
+	// TopicValidatorsRating is the topic of the messages holding the validators rating
+	TopicValidatorsRating = "validatorsRating"
+	// TopicEquivocation is the topic of the messages holding equivocation evidences
+	TopicEquivocation = "equivocation"
+
+	urlParamTopics = "topics"
+)
+
+var knownTopics = map[string]struct{}{
+	TopicSaveBlock:        {},
+	TopicRevertBlock:      {},
+	TopicFinalizedBlock:   {},
+	TopicRoundsInfo:       {},
+	TopicValidatorsRating: {},
+	TopicEquivocation:     {},
+}
+
+// Message is the structure sent to the connected clients
+type Message struct {
+	ID      uint64      `json:"id"`
+	Topic   string      `json:"topic"`
+	Payload interface{} `json:"payload"`
+}
+
+// Acknowledge is the structure expected from the clients, for each received message, when acknowledgement is enabled
+type Acknowledge struct {
+	ID uint64 `json:"ack"`
+}
+
+// BlockData holds the data of a committed block
+type BlockData struct {
+	Hash      string                  `json:"hash"`
+	Nonce     uint64                  `json:"nonce"`
+	Round     uint64                  `json:"round"`
+	Epoch     uint32                  `json:"epoch"`
+	ShardID   uint32                  `json:"shardID"`
+	Timestamp uint64                  `json:"timestamp"`
+	Txs       map[string]*Transaction `json:"txs"`
+	Scrs      map[string]*Transaction `json:"scrs"`
+	LogEvents []Event                 `json:"events"`
+}
+
+// Transaction holds the data of a transaction or of a smart contract result. The map holding it is keyed by the hex
+// encoded hash and the addresses are encoded with the pub key converter, as the events addresses are
+type Transaction struct {
+	Nonce          uint64 `json:"nonce"`
+	Value          string `json:"value"`
+	Sender         string `json:"sender"`
+	Receiver       string `json:"receiver"`
+	GasPrice       uint64 `json:"gasPrice"`
+	GasLimit       uint64 `json:"gasLimit"`
+	Data           []byte `json:"data,omitempty"`
+	OriginalTxHash string `json:"originalTxHash,omitempty"`
+	PrevTxHash     string `json:"prevTxHash,omitempty"`
+}
+
+// Event holds event data
+type Event struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	TxHash     string   `json:"txHash"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// RevertBlock holds the data of a reverted block
+type RevertBlock struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Epoch uint32 `json:"epoch"`
+}
+
+// FinalizedBlock holds the data of a finalized block
+type FinalizedBlock struct {
+	Hash string `json:"hash"`
+}
+
+// ValidatorsRating holds the validators rating data
+type ValidatorsRating struct {
+	IndexID string                         `json:"indexID"`
+	Ratings []*indexer.ValidatorRatingInfo `json:"ratings"`
+}
+
+// ArgsWebSocketDriver holds the arguments needed for creating a new web socket driver
+type ArgsWebSocketDriver struct {
+	URL                string
+	MaxNumClients      int
+	ClientBufferSize   int
+	WithAcknowledge    bool
+	AcknowledgeTimeout time.Duration
+	FullBufferTimeout  time.Duration
+	Marshaller         marshal.Marshalizer
+	Hasher             hashing.Hasher
+	PubKeyConverter    core.PubkeyConverter
+}
+
+// webSocketDriver is an outport driver which streams the received data, JSON encoded, to the clients connected through web sockets.
+// Each client can subscribe to a subset of topics and has its own bounded buffer. A client not able to keep up
+// (its buffer stays full for more than the configured timeout) is disconnected, so it cannot stall the node. The
+// clients are served in parallel, so a broadcast waits at most the configured timeout, however many clients are slow.
+type webSocketDriver struct {
+	marshaller         marshal.Marshalizer
+	jsonMarshaller     marshal.Marshalizer
+	hasher             hashing.Hasher
+	pubKeyConverter    core.PubkeyConverter
+	maxNumClients      int
+	clientBufferSize   int
+	withAcknowledge    bool
+	acknowledgeTimeout time.Duration
+	fullBufferTimeout  time.Duration
+	upgrader           websocket.Upgrader
+	server             *http.Server
+
+	mutClients    sync.RWMutex
+	clients       map[uint64]*wsClient
+	lastClientID  uint64
+	lastMessageID uint64
+}
+
+// NewWebSocketDriver creates a new web socket driver and starts listening for clients on the provided URL
+func NewWebSocketDriver(args ArgsWebSocketDriver) (*webSocketDriver, error) {
+	wsd, err := newWebSocketDriver(args)
+	if err != nil {
+		return nil, err
+	}
+
+	wsd.server = &http.Server{
+		Addr:    args.URL,
+		Handler: wsd,
+	}
+	go wsd.startListening()
+
+	return wsd, nil
+}
+
+func newWebSocketDriver(args ArgsWebSocketDriver) (*webSocketDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webSocketDriver{
+		marshaller:         args.Marshaller,
+		jsonMarshaller:     &marshal.JsonMarshalizer{},
+		hasher:             args.Hasher,
+		pubKeyConverter:    args.PubKeyConverter,
+		maxNumClients:      args.MaxNumClients,
+		clientBufferSize:   args.ClientBufferSize,
+		withAcknowledge:    args.WithAcknowledge,
+		acknowledgeTimeout: args.AcknowledgeTimeout,
+		fullBufferTimeout:  args.FullBufferTimeout,
+		upgrader: websocket.Upgrader{
+			// the driver is meant to be consumed by services, not by browsers
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+		clients: make(map[uint64]*wsClient),
+	}, nil
+}
+
+func checkArgs(args ArgsWebSocketDriver) error {
+	if len(args.URL) == 0 {
+		return ErrEmptyURL
+	}
+	if args.MaxNumClients < 1 {
+		return ErrInvalidMaxNumClients
+	}
+	if args.ClientBufferSize < 1 {
+		return ErrInvalidClientBufferSize
+	}
+	if args.WithAcknowledge && args.AcknowledgeTimeout <= 0 {
+		return ErrInvalidAcknowledgeTimeout
+	}
+	if args.FullBufferTimeout <= 0 {
+		return ErrInvalidFullBufferTimeout
+	}
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return outport.ErrNilPubKeyConverter
+	}
+
+	return nil
+}
+
+func (wsd *webSocketDriver) startListening() {
+	log.Debug("web socket driver: started listening", "URL", wsd.server.Addr)
+
+	err := wsd.server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		log.Error("web socket driver: cannot listen", "URL", wsd.server.Addr, "error", err)
+	}
+}
+
+// ServeHTTP upgrades the incoming request to a web socket connection and registers the new client.
+// The topics the client is subscribed to can be specified as a comma separated list in the "topics" URL parameter.
+func (wsd *webSocketDriver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	topics, err := parseTopics(r.URL.Query().Get(urlParamTopics))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := wsd.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debug("web socket driver: cannot upgrade connection", "remote", r.RemoteAddr, "error", err)
+		return
+	}
+
+	client, err := wsd.addClient(conn, topics)
+	if err != nil {
+		log.Debug("web socket driver: client rejected", "remote", r.RemoteAddr, "error", err)
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()))
+		_ = conn.Close()
+		return
+	}
+
+	log.Debug("web socket driver: client connected", "client", client.id, "remote", r.RemoteAddr)
+	client.start()
+}
+
+func parseTopics(topicsParam string) (map[string]struct{}, error) {
+	topics := make(map[string]struct{})
+	if len(topicsParam) == 0 {
+		return topics, nil
+	}
+
+	for _, topic := range strings.Split(topicsParam, ",") {
+		topic = strings.TrimSpace(topic)
+		_, isKnown := knownTopics[topic]
+		if !isKnown {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+		}
+
+		topics[topic] = struct{}{}
+	}
+
+	return topics, nil
+}
+
+func (wsd *webSocketDriver) addClient(conn wsConn, topics map[string]struct{}) (*wsClient, error) {
+	wsd.mutClients.Lock()
+	defer wsd.mutClients.Unlock()
+
+	if len(wsd.clients) >= wsd.maxNumClients {
+		return nil, ErrTooManyClients
+	}
+
+	wsd.lastClientID++
+	client := newWSClient(argsWSClient{
+		id:         wsd.lastClientID,
+		conn:       conn,
+		topics:     topics,
+		marshaller: wsd.jsonMarshaller,
+		withAck:    wsd.withAcknowledge,
+		ackTimeout: wsd.acknowledgeTimeout,
+		bufferSize: wsd.clientBufferSize,
+		onClose:    wsd.removeClient,
+	})
+	wsd.clients[client.id] = client
+
+	return client, nil
+}
+
+func (wsd *webSocketDriver) removeClient(client *wsClient) {
+	wsd.mutClients.Lock()
+	delete(wsd.clients, client.id)
+	wsd.mutClients.Unlock()
+
+	log.Debug("web socket driver: client disconnected", "client", client.id)
+}
+
+func (wsd *webSocketDriver) getClientsForTopic(topic string) []*wsClient {
+	wsd.mutClients.RLock()
+	defer wsd.mutClients.RUnlock()
+
+	clients := make([]*wsClient, 0, len(wsd.clients))
+	for _, client := range wsd.clients {
+		if client.isSubscribed(topic) {
+			clients = append(clients, client)
+		}
+	}
+
+	return clients
+}
+
+func (wsd *webSocketDriver) broadcast(topic string, payload interface{}) error {
+	clients := wsd.getClientsForTopic(topic)
+	if len(clients) == 0 {
+		return nil
+	}
+
+	msg := &Message{
+		ID:      atomic.AddUint64(&wsd.lastMessageID, 1),
+		Topic:   topic,
+		Payload: payload,
+	}
+	buff, err := wsd.jsonMarshaller.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("%w in webSocketDriver.broadcast for topic %s", err, topic)
+	}
+
+	wrappedMsg := &wsMessage{
+		id:   msg.ID,
+		buff: buff,
+	}
+
+	// waiting for all the clients keeps the messages order, each client receiving the next message only after
+	// the current one was buffered
+	wg := &sync.WaitGroup{}
+	wg.Add(len(clients))
+	for _, client := range clients {
+		go func(client *wsClient) {
+			defer wg.Done()
+
+			if client.enqueue(wrappedMsg, wsd.fullBufferTimeout) {
+				return
+			}
+
+			log.Warn("web socket driver: client cannot keep up, disconnecting", "client", client.id, "topic", topic)
+			client.close()
+		}(client)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// SaveBlock streams the block data to the subscribed clients
+func (wsd *webSocketDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil {
+		return ErrNilSaveBlockData
+	}
+	if check.IfNil(args.Header) {
+		return ErrNilHeader
+	}
+
+	blockData := &BlockData{
+		Hash:      hex.EncodeToString(args.HeaderHash),
+		Nonce:     args.Header.GetNonce(),
+		Round:     args.Header.GetRound(),
+		Epoch:     args.Header.GetEpoch(),
+		ShardID:   args.Header.GetShardID(),
+		Timestamp: args.Header.GetTimeStamp(),
+	}
+	if args.TransactionsPool != nil {
+		blockData.Txs = wsd.convertTransactions(args.TransactionsPool.Txs)
+		blockData.Scrs = wsd.convertTransactions(args.TransactionsPool.Scrs)
+		blockData.LogEvents = wsd.getLogEvents(args.TransactionsPool.Logs)
+	}
+
+	return wsd.broadcast(TopicSaveBlock, blockData)
+}
+
+func (wsd *webSocketDriver) convertTransactions(txs map[string]data.TransactionHandler) map[string]*Transaction {
+	converted := make(map[string]*Transaction, len(txs))
+	for txHash, tx := range txs {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		value := "0"
+		if tx.GetValue() != nil {
+			value = tx.GetValue().String()
+		}
+
+		convertedTx := &Transaction{
+			Nonce:    tx.GetNonce(),
+			Value:    value,
+			Sender:   wsd.pubKeyConverter.Encode(tx.GetSndAddr()),
+			Receiver: wsd.pubKeyConverter.Encode(tx.GetRcvAddr()),
+			GasPrice: tx.GetGasPrice(),
+			GasLimit: tx.GetGasLimit(),
+			Data:     tx.GetData(),
+		}
+		scr, isScr := tx.(*smartContractResult.SmartContractResult)
+		if isScr {
+			convertedTx.OriginalTxHash = hex.EncodeToString(scr.OriginalTxHash)
+			convertedTx.PrevTxHash = hex.EncodeToString(scr.PrevTxHash)
+		}
+
+		converted[hex.EncodeToString([]byte(txHash))] = convertedTx
+	}
+
+	return converted
+}
+
+func (wsd *webSocketDriver) getLogEvents(logs []*data.LogData) []Event {
+	events := make([]Event, 0)
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		txHash := hex.EncodeToString([]byte(logData.TxHash))
+		for _, eventHandler := range logData.LogHandler.GetLogEvents() {
+			if check.IfNil(eventHandler) {
+				continue
+			}
+
+			events = append(events, Event{
+				Address:    wsd.pubKeyConverter.Encode(eventHandler.GetAddress()),
+				Identifier: string(eventHandler.GetIdentifier()),
+				TxHash:     txHash,
+				Topics:     eventHandler.GetTopics(),
+				Data:       eventHandler.GetData(),
+			})
+		}
+	}
+
+	return events
+}
+
+// RevertIndexedBlock streams the reverted block data to the subscribed clients
+func (wsd *webSocketDriver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	blockHash, err := core.CalculateHash(wsd.marshaller, wsd.hasher, header)
+	if err != nil {
+		return fmt.Errorf("%w in webSocketDriver.RevertIndexedBlock while computing the block hash", err)
+	}
+
+	revertBlock := &RevertBlock{
+		Hash:  hex.EncodeToString(blockHash),
+		Nonce: header.GetNonce(),
+		Round: header.GetRound(),
+		Epoch: header.GetEpoch(),
+	}
+
+	return wsd.broadcast(TopicRevertBlock, revertBlock)
+}
+
+// FinalizedBlock streams the finalized block hash to the subscribed clients
+func (wsd *webSocketDriver) FinalizedBlock(headerHash []byte) error {
+	finalizedBlock := &FinalizedBlock{
+		Hash: hex.EncodeToString(headerHash),
+	}
+
+	return wsd.broadcast(TopicFinalizedBlock, finalizedBlock)
+}
+
+// SaveRoundsInfo streams the rounds information to the subscribed clients
+func (wsd *webSocketDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	return wsd.broadcast(TopicRoundsInfo, roundsInfos)
+}
+
+// SaveValidatorsRating streams the validators rating to the subscribed clients
+func (wsd *webSocketDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	validatorsRating := &ValidatorsRating{
+		IndexID: indexID,
+		Ratings: infoRating,
+	}
+
+	return wsd.broadcast(TopicValidatorsRating, validatorsRating)
+}
+
+// SaveEquivocation streams the equivocation evidence to the subscribed clients
+func (wsd *webSocketDriver) SaveEquivocation(evidence *common.EquivocationEvidence) error {
+	return wsd.broadcast(TopicEquivocation, evidence)
+}
+
+// SaveValidatorsPubKeys returns nil
+func (wsd *webSocketDriver) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveAccounts returns nil
+func (wsd *webSocketDriver) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// Close stops listening and disconnects all the clients
+func (wsd *webSocketDriver) Close() error {
+	var err error
+	if wsd.server != nil {
+		err = wsd.server.Close()
+	}
+
+	wsd.mutClients.RLock()
+	clients := make([]*wsClient, 0, len(wsd.clients))
+	for _, client := range wsd.clients {
+		clients = append(clients, client)
+	}
+	wsd.mutClients.RUnlock()
+
+	for _, client := range clients {
+		client.close()
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wsd *webSocketDriver) IsInterfaceNil() bool {
+	return wsd == nil
+}
@@ -0,0 +1,452 @@
+package wsdriver
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+type receivedMessage struct {
+	ID      uint64          `json:"id"`
+	Topic   string          `json:"topic"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+func createMockArgsWebSocketDriver() ArgsWebSocketDriver {
+	return ArgsWebSocketDriver{
+		URL:                "localhost:22111",
+		MaxNumClients:      10,
+		ClientBufferSize:   10,
+		WithAcknowledge:    false,
+		AcknowledgeTimeout: time.Second,
+		FullBufferTimeout:  100 * time.Millisecond,
+		Marshaller:         &testscommon.MarshalizerMock{},
+		Hasher:             &hashingMocks.HasherMock{},
+		PubKeyConverter:    &testscommon.PubkeyConverterMock{},
+	}
+}
+
+func startTestServer(t *testing.T, args ArgsWebSocketDriver) (*webSocketDriver, *httptest.Server) {
+	wsd, err := newWebSocketDriver(args)
+	require.Nil(t, err)
+
+	server := httptest.NewServer(wsd)
+	t.Cleanup(func() {
+		_ = wsd.Close()
+		server.Close()
+	})
+
+	return wsd, server
+}
+
+func connectClient(t *testing.T, server *httptest.Server, topics string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	if len(topics) > 0 {
+		url += "?topics=" + topics
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func waitForNumClients(t *testing.T, wsd *webSocketDriver, numClients int) {
+	deadline := time.Now().Add(testTimeout)
+	for time.Now().Before(deadline) {
+		wsd.mutClients.RLock()
+		currentNumClients := len(wsd.clients)
+		wsd.mutClients.RUnlock()
+
+		if currentNumClients == numClients {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Fail(t, "timeout waiting for clients")
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) *receivedMessage {
+	_ = conn.SetReadDeadline(time.Now().Add(testTimeout))
+	_, buff, err := conn.ReadMessage()
+	require.Nil(t, err)
+
+	msg := &receivedMessage{}
+	err = json.Unmarshal(buff, msg)
+	require.Nil(t, err)
+
+	return msg
+}
+
+func TestNewWebSocketDriver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		argsFunc    func() ArgsWebSocketDriver
+		expectedErr error
+	}{
+		{
+			name: "empty URL",
+			argsFunc: func() ArgsWebSocketDriver {
+				args := createMockArgsWebSocketDriver()
+				args.URL = ""
+				return args
+			},
+			expectedErr: ErrEmptyURL,
+		},
+		{
+			name: "invalid max num clients",
+			argsFunc: func() ArgsWebSocketDriver {
+				args := createMockArgsWebSocketDriver()
+				args.MaxNumClients = 0
+				return args
+			},
+			expectedErr: ErrInvalidMaxNumClients,
+		},
+		{
+			name: "invalid client buffer size",
+			argsFunc: func() ArgsWebSocketDriver {
+				args := createMockArgsWebSocketDriver()
+				args.ClientBufferSize = 0
+				return args
+			},
+			expectedErr: ErrInvalidClientBufferSize,
+		},
+		{
+			name: "invalid acknowledge timeout",
+			argsFunc: func() ArgsWebSocketDriver {
+				args := createMockArgsWebSocketDriver()
+				args.WithAcknowledge = true
+				args.AcknowledgeTimeout = 0
+				return args
+			},
+			expectedErr: ErrInvalidAcknowledgeTimeout,
+		},
+		{
+			name: "invalid full buffer timeout",
+			argsFunc: func() ArgsWebSocketDriver {
+				args := createMockArgsWebSocketDriver()
+				args.FullBufferTimeout = 0
+				return args
+			},
+			expectedErr: ErrInvalidFullBufferTimeout,
+		},
+		{
+			name: "nil marshaller",
+			argsFunc: func() ArgsWebSocketDriver {
+				args := createMockArgsWebSocketDriver()
+				args.Marshaller = nil
+				return args
+			},
+			expectedErr: core.ErrNilMarshalizer,
+		},
+		{
+			name: "nil hasher",
+			argsFunc: func() ArgsWebSocketDriver {
+				args := createMockArgsWebSocketDriver()
+				args.Hasher = nil
+				return args
+			},
+			expectedErr: core.ErrNilHasher,
+		},
+		{
+			name: "nil pub key converter",
+			argsFunc: func() ArgsWebSocketDriver {
+				args := createMockArgsWebSocketDriver()
+				args.PubKeyConverter = nil
+				return args
+			},
+			expectedErr: outport.ErrNilPubKeyConverter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wsd, err := NewWebSocketDriver(tt.argsFunc())
+			require.True(t, check.IfNil(wsd))
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		args := createMockArgsWebSocketDriver()
+		args.URL = "localhost:0"
+		wsd, err := NewWebSocketDriver(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(wsd))
+		require.Nil(t, wsd.Close())
+	})
+}
+
+func TestWebSocketDriver_SaveBlockShouldStreamToClients(t *testing.T) {
+	t.Parallel()
+
+	wsd, server := startTestServer(t, createMockArgsWebSocketDriver())
+	conn := connectClient(t, server, "")
+	waitForNumClients(t, wsd, 1)
+
+	txHash := bytes.Repeat([]byte{0xff, 0x01}, 16)
+	scrHash := bytes.Repeat([]byte{0xfe}, 32)
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("hash"),
+		Header:     &block.Header{Nonce: 5, Round: 6, Epoch: 1, ShardID: 2},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				string(txHash): &transaction.Transaction{
+					Nonce:   3,
+					Value:   big.NewInt(10),
+					SndAddr: []byte("sender"),
+					RcvAddr: []byte("receiver"),
+				},
+			},
+			Scrs: map[string]data.TransactionHandler{
+				string(scrHash): &smartContractResult.SmartContractResult{
+					Nonce:          4,
+					SndAddr:        []byte("sc"),
+					RcvAddr:        []byte("sender"),
+					OriginalTxHash: txHash,
+				},
+			},
+			Logs: []*data.LogData{
+				{
+					TxHash: string(txHash),
+					LogHandler: &transaction.Log{
+						Events: []*transaction.Event{
+							{Address: []byte("addr"), Identifier: []byte("ESDTTransfer")},
+						},
+					},
+				},
+				nil,
+			},
+		},
+	}
+	err := wsd.SaveBlock(args)
+	require.Nil(t, err)
+
+	msg := readMessage(t, conn)
+	assert.Equal(t, TopicSaveBlock, msg.Topic)
+	assert.Equal(t, uint64(1), msg.ID)
+
+	blockData := &BlockData{}
+	err = json.Unmarshal(msg.Payload, blockData)
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), blockData.Hash)
+	assert.Equal(t, uint64(5), blockData.Nonce)
+	assert.Equal(t, uint32(2), blockData.ShardID)
+	require.Equal(t, 1, len(blockData.LogEvents))
+	assert.Equal(t, "ESDTTransfer", blockData.LogEvents[0].Identifier)
+	assert.Equal(t, hex.EncodeToString(txHash), blockData.LogEvents[0].TxHash)
+
+	require.Equal(t, 1, len(blockData.Txs))
+	tx := blockData.Txs[hex.EncodeToString(txHash)]
+	require.NotNil(t, tx)
+	assert.Equal(t, uint64(3), tx.Nonce)
+	assert.Equal(t, "10", tx.Value)
+	assert.Equal(t, hex.EncodeToString([]byte("sender")), tx.Sender)
+	assert.Equal(t, hex.EncodeToString([]byte("receiver")), tx.Receiver)
+
+	require.Equal(t, 1, len(blockData.Scrs))
+	scr := blockData.Scrs[hex.EncodeToString(scrHash)]
+	require.NotNil(t, scr)
+	assert.Equal(t, "0", scr.Value)
+	assert.Equal(t, hex.EncodeToString([]byte("sc")), scr.Sender)
+	assert.Equal(t, hex.EncodeToString(txHash), scr.OriginalTxHash)
+}
+
+func TestWebSocketDriver_ShouldFilterByTopics(t *testing.T) {
+	t.Parallel()
+
+	wsd, server := startTestServer(t, createMockArgsWebSocketDriver())
+	finalizedConn := connectClient(t, server, TopicFinalizedBlock)
+	roundsConn := connectClient(t, server, TopicRoundsInfo+","+TopicValidatorsRating)
+	waitForNumClients(t, wsd, 2)
+
+	require.Nil(t, wsd.SaveRoundsInfo([]*indexer.RoundInfo{{}}))
+	require.Nil(t, wsd.FinalizedBlock([]byte("final")))
+	require.Nil(t, wsd.SaveValidatorsRating("0_1", nil))
+	require.Nil(t, wsd.RevertIndexedBlock(&block.Header{Nonce: 2}, nil))
+
+	msg := readMessage(t, finalizedConn)
+	assert.Equal(t, TopicFinalizedBlock, msg.Topic)
+	finalizedBlock := &FinalizedBlock{}
+	_ = json.Unmarshal(msg.Payload, finalizedBlock)
+	assert.Equal(t, hex.EncodeToString([]byte("final")), finalizedBlock.Hash)
+
+	msg = readMessage(t, roundsConn)
+	assert.Equal(t, TopicRoundsInfo, msg.Topic)
+	msg = readMessage(t, roundsConn)
+	assert.Equal(t, TopicValidatorsRating, msg.Topic)
+}
+
+func TestWebSocketDriver_UnknownTopicShouldReject(t *testing.T) {
+	t.Parallel()
+
+	_, server := startTestServer(t, createMockArgsWebSocketDriver())
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "?topics=unknown"
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NotNil(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 400, resp.StatusCode)
+}
+
+func TestWebSocketDriver_TooManyClientsShouldReject(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.MaxNumClients = 1
+	wsd, server := startTestServer(t, args)
+	_ = connectClient(t, server, "")
+	waitForNumClients(t, wsd, 1)
+
+	rejectedConn := connectClient(t, server, "")
+	_ = rejectedConn.SetReadDeadline(time.Now().Add(testTimeout))
+	_, _, err := rejectedConn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseTryAgainLater))
+	waitForNumClients(t, wsd, 1)
+}
+
+func TestWebSocketDriver_WithAcknowledgeShouldSendAfterAck(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.WithAcknowledge = true
+	wsd, server := startTestServer(t, args)
+	conn := connectClient(t, server, "")
+	waitForNumClients(t, wsd, 1)
+
+	require.Nil(t, wsd.FinalizedBlock([]byte("first")))
+	require.Nil(t, wsd.FinalizedBlock([]byte("second")))
+
+	first := readMessage(t, conn)
+
+	// the second message is not sent until the first one is acknowledged
+	time.Sleep(100 * time.Millisecond)
+	wsd.mutClients.RLock()
+	for _, client := range wsd.clients {
+		assert.Equal(t, 1, len(client.messages))
+	}
+	wsd.mutClients.RUnlock()
+
+	ackBuff, _ := json.Marshal(&Acknowledge{ID: first.ID})
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, ackBuff))
+
+	second := readMessage(t, conn)
+	assert.Equal(t, first.ID+1, second.ID)
+}
+
+func TestWebSocketDriver_AcknowledgeTimeoutShouldDisconnect(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.WithAcknowledge = true
+	args.AcknowledgeTimeout = 50 * time.Millisecond
+	wsd, server := startTestServer(t, args)
+	conn := connectClient(t, server, "")
+	waitForNumClients(t, wsd, 1)
+
+	require.Nil(t, wsd.FinalizedBlock([]byte("first")))
+	_ = readMessage(t, conn)
+
+	waitForNumClients(t, wsd, 0)
+}
+
+func TestWebSocketDriver_SlowClientShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.WithAcknowledge = true
+	args.AcknowledgeTimeout = time.Minute
+	args.ClientBufferSize = 1
+	args.FullBufferTimeout = 10 * time.Millisecond
+	wsd, server := startTestServer(t, args)
+	_ = connectClient(t, server, "")
+	waitForNumClients(t, wsd, 1)
+
+	// the client never acknowledges, so its buffer fills up
+	for i := 0; i < 5; i++ {
+		require.Nil(t, wsd.FinalizedBlock([]byte("hash")))
+	}
+
+	waitForNumClients(t, wsd, 0)
+}
+
+func TestWebSocketDriver_SlowClientsShouldNotAddUpTheirTimeouts(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.WithAcknowledge = true
+	args.AcknowledgeTimeout = time.Minute
+	args.ClientBufferSize = 1
+	args.FullBufferTimeout = 300 * time.Millisecond
+	wsd, server := startTestServer(t, args)
+	numClients := 5
+	for i := 0; i < numClients; i++ {
+		_ = connectClient(t, server, "")
+	}
+	waitForNumClients(t, wsd, numClients)
+
+	// the clients never acknowledge: the first message is written, the second one fills the buffers
+	require.Nil(t, wsd.FinalizedBlock([]byte("first")))
+	time.Sleep(100 * time.Millisecond)
+	require.Nil(t, wsd.FinalizedBlock([]byte("second")))
+
+	start := time.Now()
+	require.Nil(t, wsd.FinalizedBlock([]byte("third")))
+	assert.True(t, time.Since(start) < 2*args.FullBufferTimeout)
+
+	waitForNumClients(t, wsd, 0)
+}
+
+func TestWebSocketDriver_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wsd, _ := newWebSocketDriver(createMockArgsWebSocketDriver())
+
+	assert.Equal(t, ErrNilSaveBlockData, wsd.SaveBlock(nil))
+	assert.Equal(t, ErrNilHeader, wsd.SaveBlock(&indexer.ArgsSaveBlockData{}))
+	assert.Equal(t, ErrNilHeader, wsd.RevertIndexedBlock(nil, nil))
+}
+
+func TestWebSocketDriver_RevertIndexedBlockMarshalErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsWebSocketDriver()
+	args.Marshaller = &testscommon.MarshalizerStub{
+		MarshalCalled: func(obj interface{}) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	wsd, _ := newWebSocketDriver(args)
+
+	err := wsd.RevertIndexedBlock(&block.Header{}, nil)
+	assert.True(t, errors.Is(err, expectedErr))
+}
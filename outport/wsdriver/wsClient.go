@@ -0,0 +1,172 @@
+package wsdriver
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/gorilla/websocket"
+)
+
+type wsMessage struct {
+	id   uint64
+	buff []byte
+}
+
+// wsClient handles a connected client. Messages are buffered in a bounded channel and written, in order,
+// by a dedicated go routine. When the acknowledgement is enabled, a message is written only after the
+// previous one was acknowledged by the client.
+type wsClient struct {
+	id         uint64
+	conn       wsConn
+	topics     map[string]struct{}
+	marshaller marshal.Marshalizer
+	withAck    bool
+	ackTimeout time.Duration
+	messages   chan *wsMessage
+	acks       chan uint64
+	closeChan  chan struct{}
+	closeOnce  sync.Once
+	onClose    func(client *wsClient)
+}
+
+type argsWSClient struct {
+	id         uint64
+	conn       wsConn
+	topics     map[string]struct{}
+	marshaller marshal.Marshalizer
+	withAck    bool
+	ackTimeout time.Duration
+	bufferSize int
+	onClose    func(client *wsClient)
+}
+
+func newWSClient(args argsWSClient) *wsClient {
+	return &wsClient{
+		id:         args.id,
+		conn:       args.conn,
+		topics:     args.topics,
+		marshaller: args.marshaller,
+		withAck:    args.withAck,
+		ackTimeout: args.ackTimeout,
+		messages:   make(chan *wsMessage, args.bufferSize),
+		acks:       make(chan uint64, args.bufferSize),
+		closeChan:  make(chan struct{}),
+		onClose:    args.onClose,
+	}
+}
+
+func (client *wsClient) start() {
+	go client.writeLoop()
+	go client.readLoop()
+}
+
+// isSubscribed returns true if the client is subscribed to the given topic. No topic means all topics.
+func (client *wsClient) isSubscribed(topic string) bool {
+	if len(client.topics) == 0 {
+		return true
+	}
+
+	_, ok := client.topics[topic]
+	return ok
+}
+
+// enqueue tries to add the message in the client's buffer, waiting at most "timeout" if the buffer is full.
+// It returns false if the message could not be buffered.
+func (client *wsClient) enqueue(msg *wsMessage, timeout time.Duration) bool {
+	select {
+	case client.messages <- msg:
+		return true
+	case <-client.closeChan:
+		return false
+	default:
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case client.messages <- msg:
+		return true
+	case <-client.closeChan:
+		return false
+	case <-timer.C:
+		return false
+	}
+}
+
+func (client *wsClient) writeLoop() {
+	defer client.close()
+
+	for {
+		select {
+		case <-client.closeChan:
+			return
+		case msg := <-client.messages:
+			err := client.conn.WriteMessage(websocket.TextMessage, msg.buff)
+			if err != nil {
+				log.Debug("wsClient.writeLoop: cannot write message", "client", client.id, "error", err)
+				return
+			}
+
+			if client.withAck && !client.waitAck(msg.id) {
+				return
+			}
+		}
+	}
+}
+
+func (client *wsClient) waitAck(messageID uint64) bool {
+	timer := time.NewTimer(client.ackTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case ackID := <-client.acks:
+			if ackID == messageID {
+				return true
+			}
+		case <-timer.C:
+			log.Debug("wsClient.waitAck: acknowledge timeout", "client", client.id, "message", messageID)
+			return false
+		case <-client.closeChan:
+			return false
+		}
+	}
+}
+
+func (client *wsClient) readLoop() {
+	defer client.close()
+
+	for {
+		_, buff, err := client.conn.ReadMessage()
+		if err != nil {
+			log.Debug("wsClient.readLoop: connection ended", "client", client.id, "reason", err)
+			return
+		}
+		if !client.withAck {
+			continue
+		}
+
+		ack := &Acknowledge{}
+		err = client.marshaller.Unmarshal(ack, buff)
+		if err != nil {
+			log.Debug("wsClient.readLoop: cannot unmarshal acknowledge", "client", client.id, "error", err)
+			continue
+		}
+
+		select {
+		case client.acks <- ack.ID:
+		case <-client.closeChan:
+			return
+		}
+	}
+}
+
+func (client *wsClient) close() {
+	client.closeOnce.Do(func() {
+		close(client.closeChan)
+		_ = client.conn.Close()
+		client.onClose(client)
+	})
+}
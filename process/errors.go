@@ -1142,3 +1142,9 @@ var ErrNilCrawlerAllowedAddress = errors.New("nil crawler allowed address")
 
 // ErrNilPayloadValidator signals that a nil payload validator was provided
 var ErrNilPayloadValidator = errors.New("nil payload validator")
+
+// ErrNilStateChangesCollector signals that a nil state changes collector was provided
+var ErrNilStateChangesCollector = errors.New("nil state changes collector")
+
+// ErrNilStateChangesRecorder signals that a nil state changes recorder was provided
+var ErrNilStateChangesRecorder = errors.New("nil state changes recorder")
@@ -3,6 +3,7 @@ package processor
 import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 // ArgTxInterceptorProcessor is the argument for the interceptor processor used for transactions
@@ -10,4 +11,5 @@ import (
 type ArgTxInterceptorProcessor struct {
 	ShardedDataCache dataRetriever.ShardedDataCacherNotifier
 	TxValidator      process.TxValidator
+	PoolJournal      txcache.PoolJournalHandler
 }
@@ -93,7 +93,8 @@ func (paip *peerAuthenticationInterceptorProcessor) updatePeerInfo(message inter
 
 	pidBytes := peerAuthenticationData.GetPid()
 	paip.peerAuthenticationCacher.Put(peerAuthenticationData.Pubkey, message, messageSize)
-	paip.peerShardMapper.UpdatePeerIDPublicKeyPair(core.PeerID(pidBytes), peerAuthenticationData.GetPubkey())
+	// the messages of the same batch share the payload signature, as they are sent together by a node managing several keys
+	paip.peerShardMapper.UpdatePeerIDPublicKeyPairFromBatch(core.PeerID(pidBytes), peerAuthenticationData.GetPubkey(), peerAuthenticationData.GetPayloadSignature())
 
 	log.Trace("PeerAuthentication message saved")
 
@@ -133,7 +133,7 @@ func TestPeerAuthenticationInterceptorProcessor_Save(t *testing.T) {
 		wasCalled := false
 		args := createPeerAuthenticationInterceptorProcessArg()
 		args.PeerShardMapper = &p2pmocks.NetworkShardingCollectorStub{
-			UpdatePeerIDPublicKeyPairCalled: func(pid core.PeerID, pk []byte) {
+			UpdatePeerIDPublicKeyPairFromBatchCalled: func(pid core.PeerID, pk []byte, batchID []byte) {
 				wasCalled = true
 			},
 		}
@@ -202,10 +202,11 @@ func TestPeerAuthenticationInterceptorProcessor_Save(t *testing.T) {
 		}
 		wasUpdatePeerIDPublicKeyPairCalled := false
 		arg.PeerShardMapper = &p2pmocks.NetworkShardingCollectorStub{
-			UpdatePeerIDPublicKeyPairCalled: func(pid core.PeerID, pk []byte) {
+			UpdatePeerIDPublicKeyPairFromBatchCalled: func(pid core.PeerID, pk []byte, batchID []byte) {
 				wasUpdatePeerIDPublicKeyPairCalled = true
 				assert.Equal(t, providedIPAMessage.Pid, pid.Bytes())
 				assert.Equal(t, providedIPAMessage.Pubkey, pk)
+				assert.Equal(t, providedIPAMessage.PayloadSignature, batchID)
 			},
 		}
 
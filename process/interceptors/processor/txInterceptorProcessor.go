@@ -1,10 +1,14 @@
 package processor
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 var _ process.InterceptorProcessor = (*TxInterceptorProcessor)(nil)
@@ -15,6 +19,7 @@ var txLog = logger.GetOrCreate("process/interceptors/processor/txlog")
 type TxInterceptorProcessor struct {
 	shardedPool ShardedPool
 	txValidator process.TxValidator
+	poolJournal txcache.PoolJournalHandler
 }
 
 // NewTxInterceptorProcessor creates a new TxInterceptorProcessor instance
@@ -28,10 +33,14 @@ func NewTxInterceptorProcessor(argument *ArgTxInterceptorProcessor) (*TxIntercep
 	if check.IfNil(argument.TxValidator) {
 		return nil, process.ErrNilTxValidator
 	}
+	if check.IfNil(argument.PoolJournal) {
+		return nil, storage.ErrNilPoolJournal
+	}
 
 	return &TxInterceptorProcessor{
 		shardedPool: argument.ShardedDataCache,
 		txValidator: argument.TxValidator,
+		poolJournal: argument.PoolJournal,
 	}, nil
 }
 
@@ -42,7 +51,29 @@ func (txip *TxInterceptorProcessor) Validate(data process.InterceptedData, _ cor
 		return process.ErrWrongTypeAssertion
 	}
 
-	return txip.txValidator.CheckTxValidity(interceptedTx)
+	err := txip.txValidator.CheckTxValidity(interceptedTx)
+	if err != nil {
+		txip.recordRejection(data.Hash(), interceptedTx, err)
+	}
+
+	return err
+}
+
+func (txip *TxInterceptorProcessor) recordRejection(txHash []byte, interceptedTx InterceptedTransactionHandler, err error) {
+	if !txip.poolJournal.IsEnabled() {
+		return
+	}
+
+	txip.poolJournal.Record(&txcache.PoolJournalEntry{
+		TxHash:    txHash,
+		Sender:    interceptedTx.SenderAddress(),
+		Nonce:     interceptedTx.Nonce(),
+		GasPrice:  interceptedTx.Transaction().GetGasPrice(),
+		CacheID:   process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId()),
+		Reason:    txcache.RemovalReasonRejected,
+		Details:   err.Error(),
+		Timestamp: time.Now().Unix(),
+	})
 }
 
 // Save will save the received data into the cacher
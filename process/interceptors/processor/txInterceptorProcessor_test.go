@@ -11,6 +11,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
@@ -19,6 +21,7 @@ func createMockTxArgument() *processor.ArgTxInterceptorProcessor {
 	return &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: testscommon.NewShardedDataStub(),
 		TxValidator:      &mock.TxValidatorStub{},
+		PoolJournal:      txcache.NewDisabledPoolJournal(),
 	}
 }
 
@@ -53,6 +56,17 @@ func TestNewTxInterceptorProcessor_NilTxValidatorShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilTxValidator, err)
 }
 
+func TestNewTxInterceptorProcessor_NilPoolJournalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockTxArgument()
+	arg.PoolJournal = nil
+	txip, err := processor.NewTxInterceptorProcessor(arg)
+
+	assert.Nil(t, txip)
+	assert.Equal(t, storage.ErrNilPoolJournal, err)
+}
+
 func TestNewTxInterceptorProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -96,6 +110,60 @@ func TestTxInterceptorProcessor_ValidateReturnsFalseShouldErr(t *testing.T) {
 	assert.True(t, strings.Contains(err.Error(), expectedErr.Error()))
 }
 
+func TestTxInterceptorProcessor_ValidateReturnsFalseShouldRecordRejection(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("tx validation error")
+	journal, _ := txcache.NewPoolJournal(10)
+	arg := createMockTxArgument()
+	arg.PoolJournal = journal
+	arg.TxValidator = &mock.TxValidatorStub{
+		CheckTxValidityCalled: func(txValidatorHandler process.TxValidatorHandler) error {
+			return expectedErr
+		},
+	}
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	txHash := []byte("hash")
+	txInterceptedData := &struct {
+		testscommon.InterceptedDataStub
+		mock.InterceptedTxHandlerStub
+	}{
+		InterceptedDataStub: testscommon.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return txHash
+			},
+		},
+		InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+			SenderShardIdCalled: func() uint32 {
+				return 0
+			},
+			ReceiverShardIdCalled: func() uint32 {
+				return 1
+			},
+			NonceCalled: func() uint64 {
+				return 7
+			},
+			SenderAddressCalled: func() []byte {
+				return []byte("sender")
+			},
+			TransactionCalled: func() data.TransactionHandler {
+				return &transaction.Transaction{GasPrice: 1000}
+			},
+		},
+	}
+	_ = txip.Validate(txInterceptedData, "")
+
+	entries := journal.GetEntriesForTx(txHash)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, txcache.RemovalReasonRejected, entries[0].Reason)
+	assert.Equal(t, expectedErr.Error(), entries[0].Details)
+	assert.Equal(t, []byte("sender"), entries[0].Sender)
+	assert.Equal(t, uint64(7), entries[0].Nonce)
+	assert.Equal(t, uint64(1000), entries[0].GasPrice)
+	assert.Equal(t, process.ShardCacherIdentifier(0, 1), entries[0].CacheID)
+}
+
 func TestTxInterceptorProcessor_ValidateReturnsTrueShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -709,6 +709,7 @@ type PeerBlackListCacher interface {
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	UpdatePeerIDPublicKeyPair(pid core.PeerID, pk []byte)
+	UpdatePeerIDPublicKeyPairFromBatch(pid core.PeerID, pk []byte, batchID []byte)
 	PutPeerIdShardId(pid core.PeerID, shardID uint32)
 	PutPeerIdSubType(pid core.PeerID, peerSubType core.P2PPeerSubType)
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -1246,3 +1247,16 @@ type PeerAuthenticationPayloadValidator interface {
 	ValidateTimestamp(payloadTimestamp int64) error
 	IsInterfaceNil() bool
 }
+
+// StateChangesCollector defines the component able to collect the changes done on the accounts between two calls
+type StateChangesCollector interface {
+	StartTracking()
+	StopTracking() []*common.AccountStateChange
+	IsInterfaceNil() bool
+}
+
+// StateChangesRecorder defines the component able to keep the state changes of the executed transactions
+type StateChangesRecorder interface {
+	RecordStateChanges(txHash []byte, stateChanges []*common.AccountStateChange)
+	IsInterfaceNil() bool
+}
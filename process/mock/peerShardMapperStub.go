@@ -11,6 +11,8 @@ type PeerShardMapperStub struct {
 	PutPeerIdShardIdCalled          func(pid core.PeerID, shardId uint32)
 	UpdatePeerIDPublicKeyPairCalled func(pid core.PeerID, pk []byte)
 	PutPeerIdSubTypeCalled          func(pid core.PeerID, peerSubType core.P2PPeerSubType)
+
+	UpdatePeerIDPublicKeyPairFromBatchCalled func(pid core.PeerID, pk []byte, batchID []byte)
 }
 
 // GetLastKnownPeerID -
@@ -38,6 +40,13 @@ func (psms *PeerShardMapperStub) UpdatePeerIDPublicKeyPair(pid core.PeerID, pk [
 	}
 }
 
+// UpdatePeerIDPublicKeyPairFromBatch -
+func (psms *PeerShardMapperStub) UpdatePeerIDPublicKeyPairFromBatch(pid core.PeerID, pk []byte, batchID []byte) {
+	if psms.UpdatePeerIDPublicKeyPairFromBatchCalled != nil {
+		psms.UpdatePeerIDPublicKeyPairFromBatchCalled(pid, pk, batchID)
+	}
+}
+
 // UpdatePeerIdPublicKey -
 func (psms *PeerShardMapperStub) UpdatePeerIdPublicKey(pid core.PeerID, pk []byte) {
 	if psms.UpdatePeerIdPublicKeyCalled != nil {
@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// SimulationAccountsHandlerStub -
+type SimulationAccountsHandlerStub struct {
+	StartBatchCalled          func() error
+	JournalLenCalled          func() int
+	RevertToSnapshotCalled    func(snapshot int) error
+	CollectStateChangesCalled func() ([]*common.AccountStateChange, error)
+	EndBatchCalled            func()
+}
+
+// StartBatch -
+func (stub *SimulationAccountsHandlerStub) StartBatch() error {
+	if stub.StartBatchCalled != nil {
+		return stub.StartBatchCalled()
+	}
+
+	return nil
+}
+
+// JournalLen -
+func (stub *SimulationAccountsHandlerStub) JournalLen() int {
+	if stub.JournalLenCalled != nil {
+		return stub.JournalLenCalled()
+	}
+
+	return 0
+}
+
+// RevertToSnapshot -
+func (stub *SimulationAccountsHandlerStub) RevertToSnapshot(snapshot int) error {
+	if stub.RevertToSnapshotCalled != nil {
+		return stub.RevertToSnapshotCalled(snapshot)
+	}
+
+	return nil
+}
+
+// CollectStateChanges -
+func (stub *SimulationAccountsHandlerStub) CollectStateChanges() ([]*common.AccountStateChange, error) {
+	if stub.CollectStateChangesCalled != nil {
+		return stub.CollectStateChangesCalled()
+	}
+
+	return nil, nil
+}
+
+// EndBatch -
+func (stub *SimulationAccountsHandlerStub) EndBatch() {
+	if stub.EndBatchCalled != nil {
+		stub.EndBatchCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *SimulationAccountsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
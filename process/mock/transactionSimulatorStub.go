@@ -7,7 +7,8 @@ import (
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled       func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxsBatchCalled func(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +20,15 @@ func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*tx
 	return nil, nil
 }
 
+// ProcessTxsBatch -
+func (tss *TransactionSimulatorStub) ProcessTxsBatch(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error) {
+	if tss.ProcessTxsBatchCalled != nil {
+		return tss.ProcessTxsBatchCalled(txs)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
 	return tss == nil
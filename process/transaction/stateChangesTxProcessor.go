@@ -0,0 +1,89 @@
+package transaction
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.TransactionProcessor = (*stateChangesTxProcessor)(nil)
+
+// ArgsStateChangesTxProcessor holds the arguments needed to create a new state changes transaction processor
+type ArgsStateChangesTxProcessor struct {
+	TxProcessor           process.TransactionProcessor
+	StateChangesCollector process.StateChangesCollector
+	StateChangesRecorder  process.StateChangesRecorder
+	Marshalizer           marshal.Marshalizer
+	Hasher                hashing.Hasher
+}
+
+// stateChangesTxProcessor is a wrapper over a transaction processor that collects the changes done on the accounts
+// by each successfully processed transaction and hands them over to the state changes recorder
+type stateChangesTxProcessor struct {
+	process.TransactionProcessor
+	stateChangesCollector process.StateChangesCollector
+	stateChangesRecorder  process.StateChangesRecorder
+	marshalizer           marshal.Marshalizer
+	hasher                hashing.Hasher
+}
+
+// NewStateChangesTxProcessor creates a new state changes transaction processor
+func NewStateChangesTxProcessor(args ArgsStateChangesTxProcessor) (*stateChangesTxProcessor, error) {
+	if check.IfNil(args.TxProcessor) {
+		return nil, process.ErrNilTxProcessor
+	}
+	if check.IfNil(args.StateChangesCollector) {
+		return nil, process.ErrNilStateChangesCollector
+	}
+	if check.IfNil(args.StateChangesRecorder) {
+		return nil, process.ErrNilStateChangesRecorder
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+
+	return &stateChangesTxProcessor{
+		TransactionProcessor:  args.TxProcessor,
+		stateChangesCollector: args.StateChangesCollector,
+		stateChangesRecorder:  args.StateChangesRecorder,
+		marshalizer:           args.Marshalizer,
+		hasher:                args.Hasher,
+	}, nil
+}
+
+// ProcessTransaction processes the transaction and records the changes it did on the accounts. The failed transactions
+// are included in blocks with their fee charged and their nonce incremented, so their changes are recorded as well.
+// The changes of the transactions failing with any other error are not recorded, as these are not included in blocks
+func (sctp *stateChangesTxProcessor) ProcessTransaction(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+	sctp.stateChangesCollector.StartTracking()
+	returnCode, err := sctp.TransactionProcessor.ProcessTransaction(tx)
+	stateChanges := sctp.stateChangesCollector.StopTracking()
+	isTxIncluded := err == nil || errors.Is(err, process.ErrFailedTransaction)
+	if !isTxIncluded {
+		return returnCode, err
+	}
+
+	txHash, errHash := core.CalculateHash(sctp.marshalizer, sctp.hasher, tx)
+	if errHash != nil {
+		log.Warn("stateChangesTxProcessor.ProcessTransaction: cannot compute the transaction hash", "error", errHash)
+		return returnCode, err
+	}
+
+	sctp.stateChangesRecorder.RecordStateChanges(txHash, stateChanges)
+
+	return returnCode, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sctp *stateChangesTxProcessor) IsInterfaceNil() bool {
+	return sctp == nil
+}
@@ -0,0 +1,172 @@
+package transaction_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsStateChangesTxProcessor() txproc.ArgsStateChangesTxProcessor {
+	return txproc.ArgsStateChangesTxProcessor{
+		TxProcessor:           &testscommon.TxProcessorStub{},
+		StateChangesCollector: &testscommon.StateChangesCollectorStub{},
+		StateChangesRecorder:  &dblookupext.HistoryRepositoryStub{},
+		Marshalizer:           &mock.MarshalizerMock{},
+		Hasher:                &hashingMocks.HasherMock{},
+	}
+}
+
+func TestNewStateChangesTxProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tx processor should error", func(t *testing.T) {
+		args := createArgsStateChangesTxProcessor()
+		args.TxProcessor = nil
+		sctp, err := txproc.NewStateChangesTxProcessor(args)
+		require.True(t, check.IfNil(sctp))
+		require.Equal(t, process.ErrNilTxProcessor, err)
+	})
+	t.Run("nil state changes collector should error", func(t *testing.T) {
+		args := createArgsStateChangesTxProcessor()
+		args.StateChangesCollector = nil
+		sctp, err := txproc.NewStateChangesTxProcessor(args)
+		require.True(t, check.IfNil(sctp))
+		require.Equal(t, process.ErrNilStateChangesCollector, err)
+	})
+	t.Run("nil state changes recorder should error", func(t *testing.T) {
+		args := createArgsStateChangesTxProcessor()
+		args.StateChangesRecorder = nil
+		sctp, err := txproc.NewStateChangesTxProcessor(args)
+		require.True(t, check.IfNil(sctp))
+		require.Equal(t, process.ErrNilStateChangesRecorder, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createArgsStateChangesTxProcessor()
+		args.Marshalizer = nil
+		sctp, err := txproc.NewStateChangesTxProcessor(args)
+		require.True(t, check.IfNil(sctp))
+		require.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createArgsStateChangesTxProcessor()
+		args.Hasher = nil
+		sctp, err := txproc.NewStateChangesTxProcessor(args)
+		require.True(t, check.IfNil(sctp))
+		require.Equal(t, process.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		sctp, err := txproc.NewStateChangesTxProcessor(createArgsStateChangesTxProcessor())
+		require.False(t, check.IfNil(sctp))
+		require.Nil(t, err)
+	})
+}
+
+func TestStateChangesTxProcessor_ProcessTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedStateChanges := []*common.AccountStateChange{{Address: "address", NonceAfter: 1}}
+	tx := &transaction.Transaction{Nonce: 37}
+
+	t.Run("processing error should not record", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		numStopTrackingCalls := 0
+		args := createArgsStateChangesTxProcessor()
+		args.TxProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return vmcommon.UserError, expectedErr
+			},
+		}
+		args.StateChangesCollector = &testscommon.StateChangesCollectorStub{
+			StopTrackingCalled: func() []*common.AccountStateChange {
+				numStopTrackingCalls++
+				return expectedStateChanges
+			},
+		}
+		args.StateChangesRecorder = &dblookupext.HistoryRepositoryStub{
+			RecordStateChangesCalled: func(_ []byte, _ []*common.AccountStateChange) {
+				require.Fail(t, "should have not been called")
+			},
+		}
+		sctp, _ := txproc.NewStateChangesTxProcessor(args)
+
+		returnCode, err := sctp.ProcessTransaction(tx)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, vmcommon.UserError, returnCode)
+		require.Equal(t, 1, numStopTrackingCalls)
+	})
+	t.Run("failed transaction should record", func(t *testing.T) {
+		numRecordCalls := 0
+		args := createArgsStateChangesTxProcessor()
+		args.TxProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return vmcommon.UserError, process.ErrFailedTransaction
+			},
+		}
+		args.StateChangesCollector = &testscommon.StateChangesCollectorStub{
+			StopTrackingCalled: func() []*common.AccountStateChange {
+				return expectedStateChanges
+			},
+		}
+		args.StateChangesRecorder = &dblookupext.HistoryRepositoryStub{
+			RecordStateChangesCalled: func(_ []byte, stateChanges []*common.AccountStateChange) {
+				numRecordCalls++
+				require.Equal(t, expectedStateChanges, stateChanges)
+			},
+		}
+		sctp, _ := txproc.NewStateChangesTxProcessor(args)
+
+		returnCode, err := sctp.ProcessTransaction(tx)
+		require.Equal(t, process.ErrFailedTransaction, err)
+		require.Equal(t, vmcommon.UserError, returnCode)
+		require.Equal(t, 1, numRecordCalls)
+	})
+	t.Run("should record the state changes under the transaction hash", func(t *testing.T) {
+		isTracking := false
+		var recordedTxHash []byte
+		var recordedStateChanges []*common.AccountStateChange
+		args := createArgsStateChangesTxProcessor()
+		args.TxProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.True(t, isTracking)
+				return vmcommon.Ok, nil
+			},
+		}
+		args.StateChangesCollector = &testscommon.StateChangesCollectorStub{
+			StartTrackingCalled: func() {
+				isTracking = true
+			},
+			StopTrackingCalled: func() []*common.AccountStateChange {
+				isTracking = false
+				return expectedStateChanges
+			},
+		}
+		args.StateChangesRecorder = &dblookupext.HistoryRepositoryStub{
+			RecordStateChangesCalled: func(txHash []byte, stateChanges []*common.AccountStateChange) {
+				recordedTxHash = txHash
+				recordedStateChanges = stateChanges
+			},
+		}
+		sctp, _ := txproc.NewStateChangesTxProcessor(args)
+
+		returnCode, err := sctp.ProcessTransaction(tx)
+		require.Nil(t, err)
+		require.Equal(t, vmcommon.Ok, returnCode)
+		require.False(t, isTracking)
+
+		expectedTxHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+		require.Equal(t, expectedTxHash, recordedTxHash)
+		require.Equal(t, expectedStateChanges, recordedStateChanges)
+	})
+}
@@ -2,15 +2,19 @@ package data
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResults struct {
-	Status     transaction.TxStatus                           `json:"status,omitempty"`
-	FailReason string                                         `json:"failReason,omitempty"`
-	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
-	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
-	Hash       string                                         `json:"hash,omitempty"`
-	VMOutput   *vmcommon.VMOutput                             `json:"-"`
+	Status       transaction.TxStatus                           `json:"status,omitempty"`
+	FailReason   string                                         `json:"failReason,omitempty"`
+	ScResults    map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts     map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
+	Logs         *transaction.ApiLogs                           `json:"logs,omitempty"`
+	GasUsed      uint64                                         `json:"gasUsed,omitempty"`
+	StateChanges []*common.AccountStateChange                   `json:"stateChanges,omitempty"`
+	Hash         string                                         `json:"hash,omitempty"`
+	VMOutput     *vmcommon.VMOutput                             `json:"-"`
 }
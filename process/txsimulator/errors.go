@@ -25,3 +25,18 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrNilAccountHandler signals that a nil account handler has been provided
+var ErrNilAccountHandler = errors.New("nil account handler")
+
+// ErrNilTrie signals that a nil trie has been provided
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrNilSimulationAccountsHandler signals that a nil simulation accounts handler has been provided
+var ErrNilSimulationAccountsHandler = errors.New("nil simulation accounts handler")
+
+// ErrNoSimulationBatchInProgress signals that an operation requiring a batch in progress has been called outside a batch
+var ErrNoSimulationBatchInProgress = errors.New("no simulation batch in progress")
+
+// ErrEmptySimulationBatch signals that an empty batch of transactions has been provided for simulation
+var ErrEmptySimulationBatch = errors.New("empty simulation batch")
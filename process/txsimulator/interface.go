@@ -2,6 +2,8 @@ package txsimulator
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -11,3 +13,21 @@ type TransactionProcessor interface {
 	VerifyTransaction(transaction *transaction.Transaction) error
 	IsInterfaceNil() bool
 }
+
+// SimulationAccountsHandler defines the accounts wrapper able to keep the state changes of a batch of simulated transactions
+type SimulationAccountsHandler interface {
+	StartBatch() error
+	JournalLen() int
+	RevertToSnapshot(snapshot int) error
+	CollectStateChanges() ([]*common.AccountStateChange, error)
+	EndBatch()
+	IsInterfaceNil() bool
+}
+
+// StateChangesTracker defines the component able to compute the changes done on the accounts
+type StateChangesTracker interface {
+	TrackAccount(accounts state.AccountsAdapter, address []byte, account vmcommon.AccountHandler)
+	CollectStateChanges(accounts state.AccountsAdapter) []*common.AccountStateChange
+	Reset()
+	IsInterfaceNil() bool
+}
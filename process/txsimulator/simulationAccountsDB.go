@@ -0,0 +1,261 @@
+package txsimulator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	commonDisabled "github.com/ElrondNetwork/elrond-go/common/disabled"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/stateChanges"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ArgsSimulationAccountsDB holds the arguments required for creating a new simulation accounts db
+type ArgsSimulationAccountsDB struct {
+	AccountsDB      state.AccountsAdapter
+	PubkeyConverter core.PubkeyConverter
+	Hasher          hashing.Hasher
+	Marshalizer     marshal.Marshalizer
+}
+
+// simulationAccountsDB is a wrapper over an accounts db used by the transaction simulator. Outside a batch it works
+// read-only, exactly as the readOnlyAccountsDB. While a batch is in progress, all the operations are redirected
+// towards a throwaway accounts db created on top of the current state, so that each simulated transaction sees the
+// changes of the previous ones. The throwaway accounts db is never committed, so its changes are dropped at the end
+// of the batch.
+type simulationAccountsDB struct {
+	*readOnlyAccountsDB
+	hasher      hashing.Hasher
+	marshalizer marshal.Marshalizer
+
+	mutBatch      sync.RWMutex
+	batchAccounts state.AccountsAdapter
+	tracker       StateChangesTracker
+}
+
+// NewSimulationAccountsDB returns a new instance of simulationAccountsDB
+func NewSimulationAccountsDB(args ArgsSimulationAccountsDB) (*simulationAccountsDB, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	readOnlyAccounts, err := NewReadOnlyAccountsDB(args.AccountsDB)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker, err := stateChanges.NewStateChangesTracker(stateChanges.ArgsStateChangesTracker{
+		PubkeyConverter: args.PubkeyConverter,
+		Marshaller:      args.Marshalizer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &simulationAccountsDB{
+		readOnlyAccountsDB: readOnlyAccounts,
+		hasher:             args.Hasher,
+		marshalizer:        args.Marshalizer,
+		tracker:            tracker,
+	}, nil
+}
+
+// StartBatch creates the throwaway accounts db on top of the current state. All the operations done until EndBatch
+// is called will be executed on it
+func (sadb *simulationAccountsDB) StartBatch() error {
+	rootHash, err := sadb.getCurrentRootHash()
+	if err != nil {
+		return err
+	}
+
+	mainTrie, err := sadb.originalAccounts.GetTrie(rootHash)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(mainTrie) {
+		return ErrNilTrie
+	}
+
+	argsAccountsDB := state.ArgsAccountsDB{
+		Trie:                  mainTrie,
+		Hasher:                sadb.hasher,
+		Marshaller:            sadb.marshalizer,
+		AccountFactory:        factory.NewAccountCreator(),
+		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  commonDisabled.NewProcessStatusHandler(),
+	}
+	batchAccounts, err := state.NewAccountsDB(argsAccountsDB)
+	if err != nil {
+		return err
+	}
+
+	sadb.mutBatch.Lock()
+	sadb.batchAccounts = batchAccounts
+	sadb.tracker.Reset()
+	sadb.mutBatch.Unlock()
+
+	return nil
+}
+
+// getCurrentRootHash returns the root hash of the state the simulations run on. The API accounts adapter recreates
+// its trie lazily, so the root hash is fetched through a call that triggers the recreation, if needed
+func (sadb *simulationAccountsDB) getCurrentRootHash() ([]byte, error) {
+	accountsAPI, ok := sadb.originalAccounts.(state.AccountsAdapterAPI)
+	if !ok {
+		return sadb.originalAccounts.RootHash()
+	}
+
+	_, blockInfo, err := accountsAPI.GetCodeWithBlockInfo(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockInfo.GetRootHash(), nil
+}
+
+// CollectStateChanges returns the changes done on the accounts since the previous call and resets the tracking
+func (sadb *simulationAccountsDB) CollectStateChanges() ([]*common.AccountStateChange, error) {
+	sadb.mutBatch.Lock()
+	defer sadb.mutBatch.Unlock()
+
+	if check.IfNil(sadb.batchAccounts) {
+		return nil, ErrNoSimulationBatchInProgress
+	}
+
+	return sadb.tracker.CollectStateChanges(sadb.batchAccounts), nil
+}
+
+// EndBatch drops the throwaway accounts db, together with all the changes done on it
+func (sadb *simulationAccountsDB) EndBatch() {
+	sadb.mutBatch.Lock()
+	sadb.batchAccounts = nil
+	sadb.tracker.Reset()
+	sadb.mutBatch.Unlock()
+}
+
+func (sadb *simulationAccountsDB) getBatchAccounts() state.AccountsAdapter {
+	sadb.mutBatch.RLock()
+	defer sadb.mutBatch.RUnlock()
+
+	return sadb.batchAccounts
+}
+
+// GetCode returns the code for the given account
+func (sadb *simulationAccountsDB) GetCode(codeHash []byte) []byte {
+	batchAccounts := sadb.getBatchAccounts()
+	if check.IfNil(batchAccounts) {
+		return sadb.readOnlyAccountsDB.GetCode(codeHash)
+	}
+
+	return batchAccounts.GetCode(codeHash)
+}
+
+// GetExistingAccount returns the account from the batch state, if a batch is in progress, or from the original accounts
+func (sadb *simulationAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	batchAccounts := sadb.getBatchAccounts()
+	if check.IfNil(batchAccounts) {
+		return sadb.readOnlyAccountsDB.GetExistingAccount(address)
+	}
+
+	return batchAccounts.GetExistingAccount(address)
+}
+
+// GetAccountFromBytes returns the account from the provided bytes
+func (sadb *simulationAccountsDB) GetAccountFromBytes(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+	batchAccounts := sadb.getBatchAccounts()
+	if check.IfNil(batchAccounts) {
+		return sadb.readOnlyAccountsDB.GetAccountFromBytes(address, accountBytes)
+	}
+
+	return batchAccounts.GetAccountFromBytes(address, accountBytes)
+}
+
+// LoadAccount returns the account from the batch state, if a batch is in progress, or from the original accounts
+func (sadb *simulationAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	batchAccounts := sadb.getBatchAccounts()
+	if check.IfNil(batchAccounts) {
+		return sadb.readOnlyAccountsDB.LoadAccount(address)
+	}
+
+	return batchAccounts.LoadAccount(address)
+}
+
+// SaveAccount saves the account in the batch state, if a batch is in progress. Otherwise, it does nothing
+func (sadb *simulationAccountsDB) SaveAccount(account vmcommon.AccountHandler) error {
+	sadb.mutBatch.Lock()
+	defer sadb.mutBatch.Unlock()
+
+	if check.IfNil(sadb.batchAccounts) {
+		return nil
+	}
+	if check.IfNil(account) {
+		return ErrNilAccountHandler
+	}
+
+	sadb.tracker.TrackAccount(sadb.batchAccounts, account.AddressBytes(), account)
+
+	return sadb.batchAccounts.SaveAccount(account)
+}
+
+// RemoveAccount removes the account from the batch state, if a batch is in progress. Otherwise, it does nothing
+func (sadb *simulationAccountsDB) RemoveAccount(address []byte) error {
+	sadb.mutBatch.Lock()
+	defer sadb.mutBatch.Unlock()
+
+	if check.IfNil(sadb.batchAccounts) {
+		return nil
+	}
+
+	sadb.tracker.TrackAccount(sadb.batchAccounts, address, nil)
+
+	return sadb.batchAccounts.RemoveAccount(address)
+}
+
+// JournalLen returns the journal length of the batch state, if a batch is in progress, or of the original accounts
+func (sadb *simulationAccountsDB) JournalLen() int {
+	batchAccounts := sadb.getBatchAccounts()
+	if check.IfNil(batchAccounts) {
+		return sadb.readOnlyAccountsDB.JournalLen()
+	}
+
+	return batchAccounts.JournalLen()
+}
+
+// RevertToSnapshot reverts the batch state to the provided snapshot, if a batch is in progress. Otherwise, it does nothing
+func (sadb *simulationAccountsDB) RevertToSnapshot(snapshot int) error {
+	batchAccounts := sadb.getBatchAccounts()
+	if check.IfNil(batchAccounts) {
+		return nil
+	}
+
+	return batchAccounts.RevertToSnapshot(snapshot)
+}
+
+// RootHash returns the root hash of the original accounts, as the batch state is never committed
+func (sadb *simulationAccountsDB) RootHash() ([]byte, error) {
+	return sadb.readOnlyAccountsDB.RootHash()
+}
+
+// GetAllLeaves will call the original accounts' function with the same name
+func (sadb *simulationAccountsDB) GetAllLeaves(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error {
+	return sadb.readOnlyAccountsDB.GetAllLeaves(leavesChannel, ctx, rootHash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sadb *simulationAccountsDB) IsInterfaceNil() bool {
+	return sadb == nil
+}
@@ -0,0 +1,185 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func getSimulationAccountsDBArgs() ArgsSimulationAccountsDB {
+	return ArgsSimulationAccountsDB{
+		AccountsDB:      &stateMock.AccountsStub{},
+		PubkeyConverter: &mock.PubkeyConverterMock{},
+		Hasher:          &hashingMocks.HasherMock{},
+		Marshalizer:     &mock.MarshalizerMock{},
+	}
+}
+
+func createAccountsDBWithAccount(t *testing.T, address []byte, balance int64) state.AccountsAdapter {
+	args, _ := storage.GetStorageManagerArgsAndOptions()
+	trieStorage, _ := trie.NewTrieStorageManager(args)
+	tr, _ := trie.NewTrie(trieStorage, args.Marshalizer, args.Hasher, 5)
+
+	accountsDB, err := state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  tr,
+		Hasher:                args.Hasher,
+		Marshaller:            args.Marshalizer,
+		AccountFactory:        factory.NewAccountCreator(),
+		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+	})
+	require.Nil(t, err)
+
+	account, _ := accountsDB.LoadAccount(address)
+	_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(balance))
+	_ = accountsDB.SaveAccount(account)
+	_, err = accountsDB.Commit()
+	require.Nil(t, err)
+
+	return accountsDB
+}
+
+func TestNewSimulationAccountsDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts db should error", func(t *testing.T) {
+		args := getSimulationAccountsDBArgs()
+		args.AccountsDB = nil
+		sadb, err := NewSimulationAccountsDB(args)
+		require.True(t, check.IfNil(sadb))
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		args := getSimulationAccountsDBArgs()
+		args.PubkeyConverter = nil
+		sadb, err := NewSimulationAccountsDB(args)
+		require.True(t, check.IfNil(sadb))
+		require.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := getSimulationAccountsDBArgs()
+		args.Hasher = nil
+		sadb, err := NewSimulationAccountsDB(args)
+		require.True(t, check.IfNil(sadb))
+		require.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := getSimulationAccountsDBArgs()
+		args.Marshalizer = nil
+		sadb, err := NewSimulationAccountsDB(args)
+		require.True(t, check.IfNil(sadb))
+		require.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		sadb, err := NewSimulationAccountsDB(getSimulationAccountsDBArgs())
+		require.False(t, check.IfNil(sadb))
+		require.Nil(t, err)
+	})
+}
+
+func TestSimulationAccountsDB_OutsideBatchShouldBeReadOnly(t *testing.T) {
+	t.Parallel()
+
+	accountsDB := &stateMock.AccountsStub{
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	args := getSimulationAccountsDBArgs()
+	args.AccountsDB = accountsDB
+	sadb, _ := NewSimulationAccountsDB(args)
+
+	account, _ := state.NewUserAccount([]byte("address"))
+	require.Nil(t, sadb.SaveAccount(account))
+
+	stateChanges, err := sadb.CollectStateChanges()
+	require.Nil(t, stateChanges)
+	require.Equal(t, ErrNoSimulationBatchInProgress, err)
+}
+
+func TestSimulationAccountsDB_BatchShouldChainChangesAndDropThemAtTheEnd(t *testing.T) {
+	t.Parallel()
+
+	alice := []byte("alice")
+	bob := []byte("bob")
+	accountsDB := createAccountsDBWithAccount(t, alice, 100)
+	rootHash, _ := accountsDB.RootHash()
+
+	args := getSimulationAccountsDBArgs()
+	args.AccountsDB = accountsDB
+	sadb, _ := NewSimulationAccountsDB(args)
+
+	err := sadb.StartBatch()
+	require.Nil(t, err)
+
+	// first transaction: alice sends 30 to bob and writes a storage key
+	aliceAccount, _ := sadb.LoadAccount(alice)
+	aliceUserAccount := aliceAccount.(state.UserAccountHandler)
+	_ = aliceUserAccount.SubFromBalance(big.NewInt(30))
+	aliceUserAccount.IncreaseNonce(1)
+	_ = aliceUserAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	require.Nil(t, sadb.SaveAccount(aliceAccount))
+
+	bobAccount, _ := sadb.LoadAccount(bob)
+	_ = bobAccount.(state.UserAccountHandler).AddToBalance(big.NewInt(30))
+	require.Nil(t, sadb.SaveAccount(bobAccount))
+
+	stateChanges, err := sadb.CollectStateChanges()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(stateChanges))
+	require.Equal(t, hex.EncodeToString(alice), stateChanges[0].Address)
+	require.Equal(t, "100", stateChanges[0].BalanceBefore)
+	require.Equal(t, "70", stateChanges[0].BalanceAfter)
+	require.Equal(t, uint64(0), stateChanges[0].NonceBefore)
+	require.Equal(t, uint64(1), stateChanges[0].NonceAfter)
+	expectedStorageChanges := map[string]*common.ValueChange{
+		hex.EncodeToString([]byte("key")): {Before: "", After: hex.EncodeToString([]byte("value"))},
+	}
+	require.Equal(t, expectedStorageChanges, stateChanges[0].StorageChanges)
+	require.Nil(t, stateChanges[0].ESDTBalanceChanges)
+	require.Equal(t, hex.EncodeToString(bob), stateChanges[1].Address)
+	require.Equal(t, "0", stateChanges[1].BalanceBefore)
+	require.Equal(t, "30", stateChanges[1].BalanceAfter)
+
+	// second transaction sees the changes of the first one, then gets reverted
+	journalLen := sadb.JournalLen()
+	aliceAccount, _ = sadb.LoadAccount(alice)
+	aliceUserAccount = aliceAccount.(state.UserAccountHandler)
+	require.Equal(t, big.NewInt(70), aliceUserAccount.GetBalance())
+	value, _ := aliceUserAccount.DataTrieTracker().RetrieveValue([]byte("key"))
+	require.Equal(t, []byte("value"), value)
+
+	_ = aliceUserAccount.SubFromBalance(big.NewInt(70))
+	require.Nil(t, sadb.SaveAccount(aliceAccount))
+	require.Nil(t, sadb.RevertToSnapshot(journalLen))
+
+	stateChanges, err = sadb.CollectStateChanges()
+	require.Nil(t, err)
+	require.Equal(t, 0, len(stateChanges))
+
+	sadb.EndBatch()
+
+	aliceAccount, _ = sadb.LoadAccount(alice)
+	require.Equal(t, big.NewInt(100), aliceAccount.(state.UserAccountHandler).GetBalance())
+	_, err = sadb.GetExistingAccount(bob)
+	require.NotNil(t, err)
+
+	currentRootHash, _ := accountsDB.RootHash()
+	require.Equal(t, rootHash, currentRootHash)
+}
@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"errors"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -28,6 +29,7 @@ type ArgsTxSimulator struct {
 	VMOutputCacher            storage.Cacher
 	Hasher                    hashing.Hasher
 	Marshalizer               marshal.Marshalizer
+	AccountsHandler           SimulationAccountsHandler
 }
 
 type transactionSimulator struct {
@@ -39,6 +41,7 @@ type transactionSimulator struct {
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	accountsHandler        SimulationAccountsHandler
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -64,6 +67,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.AccountsHandler) {
+		return nil, ErrNilSimulationAccountsHandler
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -73,14 +79,72 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		accountsHandler:        args.AccountsHandler,
 	}, nil
 }
 
-// ProcessTx will process the transaction in a special environment, where state-writing is not allowed
+// ProcessTx will process the transaction in a special environment, where state-writing is not allowed. The
+// transaction is processed as a batch of one, so that the state changes it would produce are reported as well
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
 	ts.mutOperation.Lock()
 	defer ts.mutOperation.Unlock()
 
+	results, err := ts.processTxsBatch([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, err
+	}
+
+	return results[0], nil
+}
+
+// ProcessTxsBatch will process the transactions, in the provided order, on a shared throwaway state, so that each
+// transaction sees the changes done by the previous ones. The state changes are dropped at the end of the batch
+func (ts *transactionSimulator) ProcessTxsBatch(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptySimulationBatch
+	}
+
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	return ts.processTxsBatch(txs)
+}
+
+func (ts *transactionSimulator) processTxsBatch(txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error) {
+	err := ts.accountsHandler.StartBatch()
+	if err != nil {
+		return nil, err
+	}
+	defer ts.accountsHandler.EndBatch()
+
+	results := make([]*txSimData.SimulationResults, 0, len(txs))
+	for _, tx := range txs {
+		snapshot := ts.accountsHandler.JournalLen()
+		result, errProcess := ts.processTx(tx, snapshot)
+		if errProcess != nil {
+			return nil, errProcess
+		}
+
+		result.GasUsed = computeGasUsed(tx, result.VMOutput)
+		if result.VMOutput != nil && len(result.VMOutput.Logs) > 0 {
+			result.Logs = ts.adaptLogs(tx, result.VMOutput.Logs)
+		}
+
+		result.StateChanges, errProcess = ts.accountsHandler.CollectStateChanges()
+		if errProcess != nil {
+			return nil, errProcess
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// processTx processes the transaction on the current state. A transaction failing with an error other than
+// process.ErrFailedTransaction would not be included in a block, so its changes are reverted to the provided snapshot
+// and its intermediate results are dropped, as the block processing does, before the next transaction is processed
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction, snapshot int) (*txSimData.SimulationResults, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
@@ -99,6 +163,15 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 		FailReason: failReason,
 	}
 
+	isTxIncluded := err == nil || errors.Is(err, process.ErrFailedTransaction)
+	if !isTxIncluded {
+		// the VM output of the reverted transaction is only removed from the cacher
+		_, _ = ts.getVMOutputOfTx(tx)
+		ts.clearIntermediateResults()
+
+		return results, ts.accountsHandler.RevertToSnapshot(snapshot)
+	}
+
 	err = ts.addIntermediateTxsToResult(results)
 	if err != nil {
 		return nil, err
@@ -133,18 +206,20 @@ func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*v
 	return vmOutput, true
 }
 
-func (ts *transactionSimulator) addIntermediateTxsToResult(result *txSimData.SimulationResults) error {
-	defer func() {
-		processorsKeys := ts.intermProcContainer.Keys()
-		for _, procKey := range processorsKeys {
-			processor, errGetProc := ts.intermProcContainer.Get(procKey)
-			if errGetProc != nil || processor == nil {
-				continue
-			}
-
-			processor.CreateBlockStarted()
+func (ts *transactionSimulator) clearIntermediateResults() {
+	processorsKeys := ts.intermProcContainer.Keys()
+	for _, procKey := range processorsKeys {
+		processor, errGetProc := ts.intermProcContainer.Get(procKey)
+		if errGetProc != nil || processor == nil {
+			continue
 		}
-	}()
+
+		processor.CreateBlockStarted()
+	}
+}
+
+func (ts *transactionSimulator) addIntermediateTxsToResult(result *txSimData.SimulationResults) error {
+	defer ts.clearIntermediateResults()
 
 	scrForwarder, err := ts.intermProcContainer.Get(block.SmartContractResultBlock)
 	if err != nil {
@@ -220,6 +295,37 @@ func (ts *transactionSimulator) adaptReceipt(rcpt *receipt.Receipt) *transaction
 	}
 }
 
+func (ts *transactionSimulator) adaptLogs(tx *transaction.Transaction, logEntries []*vmcommon.LogEntry) *transaction.ApiLogs {
+	logAddress := tx.RcvAddr
+	if core.IsEmptyAddress(logAddress) {
+		logAddress = tx.SndAddr
+	}
+
+	events := make([]*transaction.Events, 0, len(logEntries))
+	for _, logEntry := range logEntries {
+		events = append(events, &transaction.Events{
+			Address:    ts.addressPubKeyConverter.Encode(logEntry.Address),
+			Identifier: string(logEntry.Identifier),
+			Topics:     logEntry.Topics,
+			Data:       logEntry.Data,
+		})
+	}
+
+	return &transaction.ApiLogs{
+		Address: ts.addressPubKeyConverter.Encode(logAddress),
+		Events:  events,
+	}
+}
+
+// computeGasUsed returns the gas consumed by a transaction. Transactions not reaching the VM consume all the provided gas
+func computeGasUsed(tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) uint64 {
+	if vmOutput == nil || vmOutput.GasRemaining > tx.GasLimit {
+		return tx.GasLimit
+	}
+
+	return tx.GasLimit - vmOutput.GasRemaining
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ts *transactionSimulator) IsInterfaceNil() bool {
 	return ts == nil
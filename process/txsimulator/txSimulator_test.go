@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -93,6 +94,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilAccountsHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.AccountsHandler = nil
+				return args
+			},
+			exError: ErrNilSimulationAccountsHandler,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -129,6 +139,34 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
 
+func TestTransactionSimulator_ProcessTxShouldReturnStateChanges(t *testing.T) {
+	t.Parallel()
+
+	startBatchCalled := false
+	endBatchCalled := false
+	expectedStateChanges := []*common.AccountStateChange{{Address: "address", NonceAfter: 1}}
+	args := getTxSimulatorArgs()
+	args.AccountsHandler = &mock.SimulationAccountsHandlerStub{
+		StartBatchCalled: func() error {
+			startBatchCalled = true
+			return nil
+		},
+		CollectStateChangesCalled: func() ([]*common.AccountStateChange, error) {
+			return expectedStateChanges, nil
+		},
+		EndBatchCalled: func() {
+			endBatchCalled = true
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	require.Nil(t, err)
+	require.Equal(t, expectedStateChanges, results.StateChanges)
+	require.True(t, startBatchCalled)
+	require.True(t, endBatchCalled)
+}
+
 func TestTransactionSimulator_getVMOutputComputeHashFails(t *testing.T) {
 	t.Parallel()
 
@@ -229,6 +267,7 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		VMOutputCacher:            txcache.NewDisabledCache(),
 		Marshalizer:               &mock.MarshalizerMock{},
 		Hasher:                    &hashingMocks.HasherMock{},
+		AccountsHandler:           &mock.SimulationAccountsHandlerStub{},
 	}
 }
 
@@ -262,3 +301,171 @@ func TestTransactionSimulator_ProcessTxConcurrentCalls(t *testing.T) {
 	wg.Wait()
 	assert.Equal(t, numCalls, numTransactionProcessorCalls)
 }
+
+func TestTransactionSimulator_ProcessTxsBatchEmptyBatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+	results, err := ts.ProcessTxsBatch(nil)
+	require.Nil(t, results)
+	require.Equal(t, ErrEmptySimulationBatch, err)
+}
+
+func TestTransactionSimulator_ProcessTxsBatchStartBatchErrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expErr := errors.New("start batch error")
+	args := getTxSimulatorArgs()
+	args.AccountsHandler = &mock.SimulationAccountsHandlerStub{
+		StartBatchCalled: func() error {
+			return expErr
+		},
+		EndBatchCalled: func() {
+			require.Fail(t, "should have not been called")
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxsBatch([]*transaction.Transaction{{Nonce: 37}})
+	require.Nil(t, results)
+	require.Equal(t, expErr, err)
+}
+
+func TestTransactionSimulator_ProcessTxsBatchShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher = testscommon.NewCacherMock()
+	processedNonces := make([]uint64, 0)
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			processedNonces = append(processedNonces, tx.Nonce)
+			if tx.Nonce == 38 {
+				return vmcommon.UserError, nil
+			}
+
+			return vmcommon.Ok, nil
+		},
+	}
+	batchStarted, batchEnded := false, false
+	numCollectCalls := 0
+	args.AccountsHandler = &mock.SimulationAccountsHandlerStub{
+		StartBatchCalled: func() error {
+			batchStarted = true
+			return nil
+		},
+		CollectStateChangesCalled: func() ([]*common.AccountStateChange, error) {
+			numCollectCalls++
+			return []*common.AccountStateChange{{NonceAfter: uint64(numCollectCalls)}}, nil
+		},
+		EndBatchCalled: func() {
+			batchEnded = true
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	scCall := &transaction.Transaction{Nonce: 37, GasLimit: 1000, RcvAddr: []byte("contract")}
+	scCallHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, scCall)
+	args.VMOutputCacher.Put(scCallHash, &vmcommon.VMOutput{
+		GasRemaining: 400,
+		Logs: []*vmcommon.LogEntry{
+			{Identifier: []byte("transfer"), Address: []byte("contract")},
+		},
+	}, 0)
+	moveBalance := &transaction.Transaction{Nonce: 38, GasLimit: 50000}
+
+	results, err := ts.ProcessTxsBatch([]*transaction.Transaction{scCall, moveBalance})
+	require.Nil(t, err)
+	require.True(t, batchStarted)
+	require.True(t, batchEnded)
+	require.Equal(t, []uint64{37, 38}, processedNonces)
+	require.Equal(t, 2, len(results))
+
+	require.Equal(t, transaction.TxStatusSuccess, results[0].Status)
+	require.Equal(t, uint64(600), results[0].GasUsed)
+	require.Equal(t, 1, len(results[0].Logs.Events))
+	require.Equal(t, "transfer", results[0].Logs.Events[0].Identifier)
+	require.Equal(t, uint64(1), results[0].StateChanges[0].NonceAfter)
+
+	require.Equal(t, transaction.TxStatusPending, results[1].Status)
+	require.Equal(t, uint64(50000), results[1].GasUsed)
+	require.Nil(t, results[1].Logs)
+	require.Equal(t, uint64(2), results[1].StateChanges[0].NonceAfter)
+}
+
+func TestTransactionSimulator_ProcessTxsBatchShouldRevertTxsNotIncludedInBlocks(t *testing.T) {
+	t.Parallel()
+
+	errNotExecutable := errors.New("not executable")
+	args := getTxSimulatorArgs()
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			switch tx.Nonce {
+			case 38:
+				return vmcommon.UserError, process.ErrFailedTransaction
+			case 39:
+				return vmcommon.UserError, errNotExecutable
+			default:
+				return vmcommon.Ok, nil
+			}
+		},
+	}
+	numClearCalls := 0
+	scrs := map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{RcvAddr: []byte("rcvr")},
+	}
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		KeysCalled: func() []block.Type {
+			return []block.Type{block.SmartContractResultBlock}
+		},
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{
+				GetAllCurrentFinishedTxsCalled: func() map[string]data.TransactionHandler {
+					return scrs
+				},
+				CreateBlockStartedCalled: func() {
+					numClearCalls++
+				},
+			}, nil
+		},
+	}
+	journalLen := 0
+	revertedSnapshots := make([]int, 0)
+	args.AccountsHandler = &mock.SimulationAccountsHandlerStub{
+		JournalLenCalled: func() int {
+			journalLen += 10
+			return journalLen
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			revertedSnapshots = append(revertedSnapshots, snapshot)
+			return nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxsBatch([]*transaction.Transaction{{Nonce: 37}, {Nonce: 38}, {Nonce: 39}, {Nonce: 40}})
+	require.Nil(t, err)
+	require.Equal(t, 4, len(results))
+	assert.Equal(t, []int{30}, revertedSnapshots)
+	assert.Equal(t, 4, numClearCalls)
+
+	assert.Equal(t, transaction.TxStatusFail, results[1].Status)
+	assert.Equal(t, 1, len(results[1].ScResults))
+	assert.Equal(t, transaction.TxStatusFail, results[2].Status)
+	assert.Equal(t, errNotExecutable.Error(), results[2].FailReason)
+	assert.Nil(t, results[2].ScResults)
+	assert.Equal(t, transaction.TxStatusSuccess, results[3].Status)
+
+	expectedErr := errors.New("revert error")
+	args.AccountsHandler = &mock.SimulationAccountsHandlerStub{
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return expectedErr
+		},
+	}
+	ts, _ = NewTransactionSimulator(args)
+
+	results, err = ts.ProcessTxsBatch([]*transaction.Transaction{{Nonce: 39}})
+	assert.Nil(t, results)
+	assert.Equal(t, expectedErr, err)
+}
@@ -0,0 +1,35 @@
+package txstatus
+
+// TxFinality is the finality of a transaction, as seen from the metachain notarization state
+type TxFinality string
+
+const (
+	// TxFinalityNotFinal signals that the transaction is not yet notarized in a final metachain block
+	TxFinalityNotFinal TxFinality = "not-final"
+	// TxFinalityFinalAtSource signals that the transaction is notarized at source in a final metachain block,
+	// but its execution at destination is not yet notarized in a final metachain block
+	TxFinalityFinalAtSource TxFinality = "final-at-source"
+	// TxFinalityFinal signals that both the source and the destination notarizations are in final metachain blocks
+	TxFinalityFinal TxFinality = "final"
+)
+
+// ComputeFinality computes the finality of a transaction given the metachain nonces notarizing its miniblock at
+// source and at destination (0 if not notarized yet) and the highest final metachain nonce
+func ComputeFinality(
+	notarizedAtSourceInMetaNonce uint64,
+	notarizedAtDestinationInMetaNonce uint64,
+	highestFinalMetaNonce uint64,
+) TxFinality {
+	if !isNotarizedInFinalMetaBlock(notarizedAtSourceInMetaNonce, highestFinalMetaNonce) {
+		return TxFinalityNotFinal
+	}
+	if !isNotarizedInFinalMetaBlock(notarizedAtDestinationInMetaNonce, highestFinalMetaNonce) {
+		return TxFinalityFinalAtSource
+	}
+
+	return TxFinalityFinal
+}
+
+func isNotarizedInFinalMetaBlock(notarizedInMetaNonce uint64, highestFinalMetaNonce uint64) bool {
+	return notarizedInMetaNonce > 0 && notarizedInMetaNonce <= highestFinalMetaNonce
+}
@@ -0,0 +1,24 @@
+package txstatus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeFinality(t *testing.T) {
+	t.Parallel()
+
+	// not notarized at all
+	require.Equal(t, TxFinalityNotFinal, ComputeFinality(0, 0, 100))
+	// notarized at source in a not final block
+	require.Equal(t, TxFinalityNotFinal, ComputeFinality(101, 0, 100))
+	require.Equal(t, TxFinalityNotFinal, ComputeFinality(101, 101, 100))
+	// notarized at source in a final block, not at destination
+	require.Equal(t, TxFinalityFinalAtSource, ComputeFinality(100, 0, 100))
+	// notarized at destination in a not final block
+	require.Equal(t, TxFinalityFinalAtSource, ComputeFinality(99, 101, 100))
+	// both notarizations are final
+	require.Equal(t, TxFinalityFinal, ComputeFinality(98, 100, 100))
+	require.Equal(t, TxFinalityFinal, ComputeFinality(98, 98, 100))
+}
@@ -0,0 +1,49 @@
+package remoteSigner
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+type signerClient struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewSignerClient returns a new typed client of the RemoteSigner gRPC service, working over the provided connection
+func NewSignerClient(conn grpc.ClientConnInterface) (*signerClient, error) {
+	if conn == nil {
+		return nil, ErrNilClientConnection
+	}
+
+	return &signerClient{
+		conn: conn,
+	}, nil
+}
+
+// Sign requests a signature from the remote signer
+func (c *signerClient) Sign(ctx context.Context, request *SignRequest) (*SignResponse, error) {
+	response := &SignResponse{}
+	err := c.conn.Invoke(ctx, fullMethodName("Sign"), request, response, grpc.ForceCodec(&gogoCodec{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetPublicKeys returns the public keys held by the remote signer
+func (c *signerClient) GetPublicKeys(ctx context.Context, request *PublicKeysRequest) (*PublicKeysResponse, error) {
+	response := &PublicKeysResponse{}
+	err := c.conn.Invoke(ctx, fullMethodName("GetPublicKeys"), request, response, grpc.ForceCodec(&gogoCodec{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *signerClient) IsInterfaceNil() bool {
+	return c == nil
+}
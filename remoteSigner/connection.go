@@ -0,0 +1,105 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// unixSocketPrefix marks the addresses of Unix sockets. The connections over Unix sockets are not encrypted, the access
+// being controlled by the file permissions of the socket
+const unixSocketPrefix = "unix://"
+
+// IsUnixSocketAddress returns true if the provided address points to a Unix socket
+func IsUnixSocketAddress(address string) bool {
+	return strings.HasPrefix(address, unixSocketPrefix)
+}
+
+// NewListener creates the listener of the remote signer service, either on a Unix socket or on a TCP address
+func NewListener(address string) (net.Listener, error) {
+	if len(address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if IsUnixSocketAddress(address) {
+		return net.Listen("unix", strings.TrimPrefix(address, unixSocketPrefix))
+	}
+
+	return net.Listen("tcp", address)
+}
+
+// NewServerTransportCredentials returns the mutual TLS credentials of the remote signer service: only the clients
+// presenting a certificate signed by the provided CA are accepted
+func NewServerTransportCredentials(certificateFile string, keyFile string, clientCACertificateFile string) (credentials.TransportCredentials, error) {
+	certificate, err := tls.LoadX509KeyPair(certificateFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCAs, err := loadCertificatePool(clientCACertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
+// NewClientConnection dials the remote signer described by the provided config. TCP addresses require mutual TLS
+func NewClientConnection(cfg config.RemoteSignerConfig) (*grpc.ClientConn, error) {
+	if len(cfg.Address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if IsUnixSocketAddress(cfg.Address) {
+		return grpc.Dial(cfg.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	transportCredentials, err := newClientTransportCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.Dial(cfg.Address, grpc.WithTransportCredentials(transportCredentials))
+}
+
+func newClientTransportCredentials(cfg config.RemoteSignerConfig) (credentials.TransportCredentials, error) {
+	certificate, err := tls.LoadX509KeyPair(cfg.CertificateFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	rootCAs, err := loadCertificatePool(cfg.CACertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
+func loadCertificatePool(caCertificateFile string) (*x509.CertPool, error) {
+	buff, err := ioutil.ReadFile(caCertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(buff) {
+		return nil, ErrInvalidCACertificate
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,61 @@
+package remoteSigner
+
+import "errors"
+
+// ErrNotAProtoMessage signals that a value which is not a protobuf message was provided to the codec
+var ErrNotAProtoMessage = errors.New("value is not a protobuf message")
+
+// ErrNilClientConnection signals that a nil gRPC client connection was provided
+var ErrNilClientConnection = errors.New("nil gRPC client connection")
+
+// ErrNilSignerClient signals that a nil remote signer client was provided
+var ErrNilSignerClient = errors.New("nil remote signer client")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPublicKey signals that a nil public key was provided
+var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrNilKeysHolder signals that a nil keys holder was provided
+var ErrNilKeysHolder = errors.New("nil keys holder")
+
+// ErrNilSlashingProtection signals that a nil slashing protection handler was provided
+var ErrNilSlashingProtection = errors.New("nil slashing protection handler")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrMessageNotAllowed signals that the message requested for an unprotected signature is neither a randomness seed
+// nor a peer ID
+var ErrMessageNotAllowed = errors.New("message not allowed for an unprotected signature")
+
+// ErrInvalidBlockHeader signals that the block header provided for a block header signature or a signature share is not valid
+var ErrInvalidBlockHeader = errors.New("invalid block header")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout was provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrPrivateKeyNotAvailable signals that the private key is held by the remote signer and can not be exported
+var ErrPrivateKeyNotAvailable = errors.New("private key is held by the remote signer")
+
+// ErrUnknownPublicKey signals that the remote signer does not hold the private key of the provided public key
+var ErrUnknownPublicKey = errors.New("unknown public key")
+
+// ErrInvalidSignatureType signals that an invalid signature type was requested
+var ErrInvalidSignatureType = errors.New("invalid signature type")
+
+// ErrDoubleSigningAttempt signals that the remote signer refused to sign different data for the same round and shard
+var ErrDoubleSigningAttempt = errors.New("double signing attempt")
+
+// ErrEmptyAddress signals that an empty remote signer address was provided
+var ErrEmptyAddress = errors.New("empty remote signer address")
+
+// ErrInvalidCACertificate signals that the provided CA certificate file does not contain any valid certificate
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
@@ -0,0 +1,25 @@
+package remoteSigner
+
+import (
+	"context"
+)
+
+// SignerServer defines the operations exposed by the remote signer service
+type SignerServer interface {
+	Sign(ctx context.Context, request *SignRequest) (*SignResponse, error)
+	GetPublicKeys(ctx context.Context, request *PublicKeysRequest) (*PublicKeysResponse, error)
+}
+
+// SignerClient defines the operations a node can request from the remote signer service
+type SignerClient interface {
+	Sign(ctx context.Context, request *SignRequest) (*SignResponse, error)
+	GetPublicKeys(ctx context.Context, request *PublicKeysRequest) (*PublicKeysResponse, error)
+	IsInterfaceNil() bool
+}
+
+// SlashingProtectionHandler defines the component which refuses to sign different data of the same kind, for the same
+// public key, round and shard
+type SlashingProtectionHandler interface {
+	CheckAndRecord(publicKey []byte, signatureType SignatureType, shardID uint32, round int64, digest []byte) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,94 @@
+package remoteSigner
+
+import (
+	"github.com/gogo/protobuf/proto"
+)
+
+// The messages below mirror the ones defined in remoteSigner.proto. They are marshalled by the gogo protobuf reflection
+// based marshaller, driven by the protobuf struct tags, so the field numbers must be kept in sync with the proto file
+
+// SignatureType defines the kind of data the remote signer is asked to sign
+type SignatureType int32
+
+const (
+	// MessageSignature is used for the data that is not subject to slashing, like the randomness seed or the peer ID
+	MessageSignature SignatureType = 0
+	// BlockHeaderSignature is used for the leader's signature on a proposed block header
+	BlockHeaderSignature SignatureType = 1
+	// SignatureShare is used for the consensus signature share on a block header hash, sent along with the header
+	SignatureShare SignatureType = 2
+)
+
+// String returns the human-readable name of the signature type
+func (st SignatureType) String() string {
+	switch st {
+	case MessageSignature:
+		return "message"
+	case BlockHeaderSignature:
+		return "block header"
+	case SignatureShare:
+		return "signature share"
+	default:
+		return "unknown"
+	}
+}
+
+// SignRequest holds the data to be signed with the private key of the provided public key
+type SignRequest struct {
+	PublicKey []byte `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Type      int32  `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Message   []byte `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	ShardID   uint32 `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Round     int64  `protobuf:"varint,5,opt,name=Round,proto3" json:"Round,omitempty"`
+	Header    []byte `protobuf:"bytes,6,opt,name=Header,proto3" json:"Header,omitempty"`
+}
+
+// SignResponse holds the produced signature
+type SignResponse struct {
+	Signature []byte `protobuf:"bytes,1,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+// PublicKeysRequest is the request for the public keys held by the remote signer
+type PublicKeysRequest struct {
+}
+
+// PublicKeysResponse holds the public keys held by the remote signer
+type PublicKeysResponse struct {
+	PublicKeys [][]byte `protobuf:"bytes,1,rep,name=PublicKeys,proto3" json:"PublicKeys,omitempty"`
+}
+
+// Reset resets the message to its zero value
+func (m *SignRequest) Reset() { *m = SignRequest{} }
+
+// String returns the compact text representation of the message
+func (m *SignRequest) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage marks the struct as a protobuf message
+func (*SignRequest) ProtoMessage() {}
+
+// Reset resets the message to its zero value
+func (m *SignResponse) Reset() { *m = SignResponse{} }
+
+// String returns the compact text representation of the message
+func (m *SignResponse) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage marks the struct as a protobuf message
+func (*SignResponse) ProtoMessage() {}
+
+// Reset resets the message to its zero value
+func (m *PublicKeysRequest) Reset() { *m = PublicKeysRequest{} }
+
+// String returns the compact text representation of the message
+func (m *PublicKeysRequest) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage marks the struct as a protobuf message
+func (*PublicKeysRequest) ProtoMessage() {}
+
+// Reset resets the message to its zero value
+func (m *PublicKeysResponse) Reset() { *m = PublicKeysResponse{} }
+
+// String returns the compact text representation of the message
+func (m *PublicKeysResponse) String() string { return proto.CompactTextString(m) }
+
+// ProtoMessage marks the struct as a protobuf message
+func (*PublicKeysResponse) ProtoMessage() {}
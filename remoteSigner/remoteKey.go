@@ -0,0 +1,54 @@
+package remoteSigner
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+type remoteKey struct {
+	publicKey      crypto.PublicKey
+	publicKeyBytes []byte
+}
+
+// NewRemoteKey creates a placeholder for a private key held by the remote signer. It only knows its public key, the
+// signing operations done with it being forwarded to the remote signer by the single signer of this package
+func NewRemoteKey(publicKey crypto.PublicKey) (*remoteKey, error) {
+	if check.IfNil(publicKey) {
+		return nil, ErrNilPublicKey
+	}
+
+	publicKeyBytes, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteKey{
+		publicKey:      publicKey,
+		publicKeyBytes: publicKeyBytes,
+	}, nil
+}
+
+// ToByteArray returns an error as the private key never leaves the remote signer
+func (rk *remoteKey) ToByteArray() ([]byte, error) {
+	return nil, ErrPrivateKeyNotAvailable
+}
+
+// GeneratePublic returns the public key of the remote key
+func (rk *remoteKey) GeneratePublic() crypto.PublicKey {
+	return rk.publicKey
+}
+
+// Suite returns the suite of the remote key
+func (rk *remoteKey) Suite() crypto.Suite {
+	return rk.publicKey.Suite()
+}
+
+// Scalar returns nil as the private key never leaves the remote signer
+func (rk *remoteKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rk *remoteKey) IsInterfaceNil() bool {
+	return rk == nil
+}
@@ -0,0 +1,258 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logger.GetOrCreate("remoteSigner")
+
+// randomnessSeedSize is the size of the previous block's randomness seed, which is itself a BLS signature
+const randomnessSeedSize = 48
+
+// ArgsSignerServer holds the arguments needed to create a new instance of signerServer
+type ArgsSignerServer struct {
+	KeysHolder         common.ManagedPeersHolder
+	SingleSigner       crypto.SingleSigner
+	SlashingProtection SlashingProtectionHandler
+	Hasher             hashing.Hasher
+	Marshaller         marshal.Marshalizer
+}
+
+type signerServer struct {
+	keysHolder         common.ManagedPeersHolder
+	singleSigner       crypto.SingleSigner
+	slashingProtection SlashingProtectionHandler
+	hasher             hashing.Hasher
+	marshaller         marshal.Marshalizer
+
+	mutServer  sync.Mutex
+	grpcServer *grpc.Server
+}
+
+// NewSignerServer returns a new instance of signerServer, which signs the requested data with the keys of the provided
+// holder, under the slashing protection rules
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	if check.IfNil(args.KeysHolder) {
+		return nil, ErrNilKeysHolder
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.SlashingProtection) {
+		return nil, ErrNilSlashingProtection
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+
+	return &signerServer{
+		keysHolder:         args.KeysHolder,
+		singleSigner:       args.SingleSigner,
+		slashingProtection: args.SlashingProtection,
+		hasher:             args.Hasher,
+		marshaller:         args.Marshaller,
+	}, nil
+}
+
+// Serve starts serving the signing requests on the provided listener
+func (s *signerServer) Serve(listener net.Listener, options ...grpc.ServerOption) {
+	s.mutServer.Lock()
+	defer s.mutServer.Unlock()
+
+	options = append([]grpc.ServerOption{grpc.ForceServerCodec(&gogoCodec{})}, options...)
+	s.grpcServer = grpc.NewServer(options...)
+	s.grpcServer.RegisterService(&remoteSignerServiceDesc, s)
+
+	go func(grpcServer *grpc.Server) {
+		errServe := grpcServer.Serve(listener)
+		if errServe != nil {
+			log.Error("remote signer server stopped", "error", errServe)
+		}
+	}(s.grpcServer)
+}
+
+// Sign signs the provided message with the private key of the provided public key. The block headers and the
+// signature shares, created on the hash of the provided block header, are first checked against the slashing
+// protection database, while the other messages are limited to the ones not subject to slashing
+func (s *signerServer) Sign(_ context.Context, request *SignRequest) (*SignResponse, error) {
+	signature, err := s.sign(request)
+	if errors.Is(err, ErrDoubleSigningAttempt) {
+		log.Warn("refused signing request", "error", err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, ErrUnknownPublicKey) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &SignResponse{
+		Signature: signature,
+	}, nil
+}
+
+func (s *signerServer) sign(request *SignRequest) ([]byte, error) {
+	if !s.keysHolder.IsKeyManagedByCurrentNode(request.PublicKey) {
+		return nil, ErrUnknownPublicKey
+	}
+	privateKey, err := s.keysHolder.GetPrivateKey(request.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureType := SignatureType(request.Type)
+	switch signatureType {
+	case MessageSignature:
+		err = checkUnprotectedMessage(request.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.singleSigner.Sign(privateKey, request.Message)
+	case BlockHeaderSignature:
+		header, errUnmarshal := s.unmarshalBlockHeader(request.Message, request.ShardID)
+		if errUnmarshal != nil {
+			return nil, errUnmarshal
+		}
+
+		digest := s.hasher.Compute(string(request.Message))
+		shardID, round := header.GetShardID(), int64(header.GetRound())
+		err = s.slashingProtection.CheckAndRecord(request.PublicKey, signatureType, shardID, round, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Debug("signing block header", "public key", request.PublicKey, "shard", shardID, "round", round)
+		return s.singleSigner.Sign(privateKey, request.Message)
+	case SignatureShare:
+		header, errUnmarshal := s.unmarshalBlockHeader(request.Header, request.ShardID)
+		if errUnmarshal != nil {
+			return nil, errUnmarshal
+		}
+
+		headerHash := s.hasher.Compute(string(request.Header))
+		if !bytes.Equal(headerHash, request.Message) {
+			return nil, fmt.Errorf("%w: the message is not the hash of the provided header", ErrInvalidBlockHeader)
+		}
+
+		shardID, round := header.GetShardID(), int64(header.GetRound())
+		err = s.slashingProtection.CheckAndRecord(request.PublicKey, signatureType, shardID, round, headerHash)
+		if err != nil {
+			return nil, err
+		}
+
+		multiSigner, errGet := s.keysHolder.GetMultiSigner(request.PublicKey)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		log.Debug("creating signature share", "public key", request.PublicKey, "shard", shardID, "round", round)
+		return multiSigner.CreateSignatureShare(headerHash, nil)
+	default:
+		return nil, ErrInvalidSignatureType
+	}
+}
+
+// checkUnprotectedMessage allows only the messages signed outside the consensus, the randomness seed and the peer ID,
+// so that a block header or a block header hash can not be signed without the slashing protection. The genesis
+// randomness seed, a root hash, is refused as well, as it can not be told apart from a block header hash
+func checkUnprotectedMessage(message []byte) error {
+	if len(message) == randomnessSeedSize {
+		return nil
+	}
+
+	pid, err := peer.IDFromBytes(message)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMessageNotAllowed, err)
+	}
+	_, err = pid.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMessageNotAllowed, err)
+	}
+
+	return nil
+}
+
+// unmarshalBlockHeader decodes the marshalled block header, so that it is protected under its own shard and round.
+// The header has to marshal back to the same bytes, so that no other data can be signed as a block header
+func (s *signerServer) unmarshalBlockHeader(message []byte, shardID uint32) (data.HeaderHandler, error) {
+	var header data.HeaderHandler
+	var err error
+	if shardID == core.MetachainShardId {
+		header = &block.MetaBlock{}
+		err = s.marshaller.Unmarshal(header, message)
+	} else {
+		header, err = process.UnmarshalShardHeader(s.marshaller, message)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBlockHeader, err)
+	}
+	if header.GetShardID() != shardID {
+		return nil, fmt.Errorf("%w: header shard %d, requested shard %d", ErrInvalidBlockHeader, header.GetShardID(), shardID)
+	}
+
+	marshalledHeader, err := s.marshaller.Marshal(header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBlockHeader, err)
+	}
+	if !bytes.Equal(marshalledHeader, message) {
+		return nil, fmt.Errorf("%w: non canonical encoding", ErrInvalidBlockHeader)
+	}
+
+	return header, nil
+}
+
+// GetPublicKeys returns the public keys held by the remote signer
+func (s *signerServer) GetPublicKeys(_ context.Context, _ *PublicKeysRequest) (*PublicKeysResponse, error) {
+	managedKeys := s.keysHolder.GetManagedKeysByCurrentNode()
+	response := &PublicKeysResponse{
+		PublicKeys: make([][]byte, 0, len(managedKeys)),
+	}
+	for pk := range managedKeys {
+		response.PublicKeys = append(response.PublicKeys, []byte(pk))
+	}
+
+	return response, nil
+}
+
+// Close stops the gRPC server, if started
+func (s *signerServer) Close() error {
+	s.mutServer.Lock()
+	defer s.mutServer.Unlock()
+
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+		s.grpcServer = nil
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *signerServer) IsInterfaceNil() bool {
+	return s == nil
+}
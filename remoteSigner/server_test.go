@@ -0,0 +1,357 @@
+package remoteSigner_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	mclMultiSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/multisig"
+	mclSig "github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const requestTimeout = time.Second
+
+type slashingProtectionStub struct{}
+
+// CheckAndRecord -
+func (stub *slashingProtectionStub) CheckAndRecord(_ []byte, _ remoteSigner.SignatureType, _ uint32, _ int64, _ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *slashingProtectionStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+type signerKey struct {
+	publicKey   crypto.PublicKey
+	pkBytes     []byte
+	multiSigner crypto.MultiSigner
+}
+
+func createMockArgsSignerServer() remoteSigner.ArgsSignerServer {
+	return remoteSigner.ArgsSignerServer{
+		KeysHolder:         &cryptoMocks.ManagedPeersHolderStub{},
+		SingleSigner:       &cryptoMocks.SingleSignerStub{},
+		SlashingProtection: &slashingProtectionStub{},
+		Hasher:             &hashingMocks.HasherMock{},
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+// startSignerServer starts a signer server holding a fresh BLS key and returns a client connected to it, along with
+// the key and a multi signer able to verify its signature shares
+func startSignerServer(t *testing.T) (remoteSigner.SignerClient, *signerKey) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	hasher, _ := blake2b.NewBlake2bWithSize(multisig.BlsHashSize)
+	createMultiSigner := func(privateKey crypto.PrivateKey, publicKeyBytes []byte) (crypto.MultiSigner, error) {
+		blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: hasher}
+		return multisig.NewBLSMultisig(blsSigner, []string{string(publicKeyBytes)}, privateKey, keyGen, 0)
+	}
+	holder, err := keysManagement.NewManagedPeersHolder(keysManagement.ArgsManagedPeersHolder{
+		KeyGenerator: keyGen,
+		MultiSignerCreator: &cryptoMocks.MultiSignerCreatorStub{
+			CreateMultiSignerCalled: createMultiSigner,
+		},
+	})
+	require.Nil(t, err)
+
+	sk, pk := keyGen.GeneratePair()
+	skBytes, _ := sk.ToByteArray()
+	pkBytes, _ := pk.ToByteArray()
+	err = holder.AddManagedPeer(skBytes)
+	require.Nil(t, err)
+
+	verifier, err := createMultiSigner(sk, pkBytes)
+	require.Nil(t, err)
+
+	slashingProtection, _ := remoteSigner.NewSlashingProtection(testscommon.CreateMemUnit())
+	server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+		KeysHolder:         holder,
+		SingleSigner:       &mclSig.BlsSingleSigner{},
+		SlashingProtection: slashingProtection,
+		Hasher:             blake2b.NewBlake2b(),
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+	})
+	require.Nil(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server.Serve(listener)
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufconn",
+		grpc.WithContextDialer(func(_ context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = server.Close()
+	})
+
+	client, err := remoteSigner.NewSignerClient(conn)
+	require.Nil(t, err)
+
+	return client, &signerKey{
+		publicKey:   pk,
+		pkBytes:     pkBytes,
+		multiSigner: verifier,
+	}
+}
+
+func TestNewSignerServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil keys holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer()
+		args.KeysHolder = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, remoteSigner.ErrNilKeysHolder, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer()
+		args.SingleSigner = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, remoteSigner.ErrNilSingleSigner, err)
+	})
+	t.Run("nil slashing protection should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer()
+		args.SlashingProtection = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, remoteSigner.ErrNilSlashingProtection, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer()
+		args.Hasher = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, remoteSigner.ErrNilHasher, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerServer()
+		args.Marshaller = nil
+		server, err := remoteSigner.NewSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, remoteSigner.ErrNilMarshaller, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := remoteSigner.NewSignerServer(createMockArgsSignerServer())
+		assert.False(t, check.IfNil(server))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSignerServer_GetPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	client, key := startSignerServer(t)
+
+	response, err := client.GetPublicKeys(context.Background(), &remoteSigner.PublicKeysRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{key.pkBytes}, response.PublicKeys)
+}
+
+func TestSignerServer_SignUnknownKeyShouldError(t *testing.T) {
+	t.Parallel()
+
+	client, _ := startSignerServer(t)
+
+	_, err := client.Sign(context.Background(), &remoteSigner.SignRequest{
+		PublicKey: []byte("unknown"),
+		Message:   []byte("message"),
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSignerServer_SignInvalidTypeShouldError(t *testing.T) {
+	t.Parallel()
+
+	client, key := startSignerServer(t)
+
+	_, err := client.Sign(context.Background(), &remoteSigner.SignRequest{
+		PublicKey: key.pkBytes,
+		Type:      100,
+		Message:   []byte("message"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSignerServer_SingleSignerWithRemoteKey(t *testing.T) {
+	t.Parallel()
+
+	client, key := startSignerServer(t)
+	blsSingleSigner := &mclSig.BlsSingleSigner{}
+	singleSigner, err := remoteSigner.NewSingleSigner(remoteSigner.ArgsSingleSigner{
+		Client:         client,
+		SingleSigner:   blsSingleSigner,
+		RequestTimeout: requestTimeout,
+	})
+	require.Nil(t, err)
+
+	privateKey, err := remoteSigner.NewRemoteKey(key.publicKey)
+	require.Nil(t, err)
+
+	randomnessSeed := bytes.Repeat([]byte{1}, 48)
+	signature, err := singleSigner.Sign(privateKey, randomnessSeed)
+	require.Nil(t, err)
+	assert.Nil(t, blsSingleSigner.Verify(key.publicKey, randomnessSeed, signature))
+
+	// messages are not protected, signing them again is allowed
+	_, err = singleSigner.Sign(privateKey, bytes.Repeat([]byte{2}, 48))
+	assert.Nil(t, err)
+
+	_, p2pPublicKey, err := libp2pCrypto.GenerateSecp256k1Key(rand.Reader)
+	require.Nil(t, err)
+	pid, err := peer.IDFromPublicKey(p2pPublicKey)
+	require.Nil(t, err)
+	signature, err = singleSigner.Sign(privateKey, []byte(pid))
+	require.Nil(t, err)
+	assert.Nil(t, blsSingleSigner.Verify(key.publicKey, []byte(pid), signature))
+
+	// a block header hash would be a signature share which bypasses the slashing protection
+	_, err = singleSigner.Sign(privateKey, bytes.Repeat([]byte{3}, 32))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = singleSigner.Sign(privateKey, []byte("marshalled header"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSignerServer_SigningHandlerBlockHeaderSignature(t *testing.T) {
+	t.Parallel()
+
+	client, key := startSignerServer(t)
+	handler, err := remoteSigner.NewSigningHandler(remoteSigner.ArgsSigningHandler{
+		Client:         client,
+		RequestTimeout: requestTimeout,
+	})
+	require.Nil(t, err)
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	header, _ := marshaller.Marshal(&block.Header{ShardID: 1, Round: 10, Nonce: 5})
+	signature, err := handler.CreateBlockSignatureForPublicKey(header, key.pkBytes, 1, 10)
+	require.Nil(t, err)
+	assert.Nil(t, (&mclSig.BlsSingleSigner{}).Verify(key.publicKey, header, signature))
+
+	retriedSignature, err := handler.CreateBlockSignatureForPublicKey(header, key.pkBytes, 1, 10)
+	require.Nil(t, err)
+	assert.Equal(t, signature, retriedSignature)
+
+	otherHeader, _ := marshaller.Marshal(&block.Header{ShardID: 1, Round: 10, Nonce: 6})
+	_, err = handler.CreateBlockSignatureForPublicKey(otherHeader, key.pkBytes, 1, 10)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the round is taken from the header, not from the request
+	_, err = handler.CreateBlockSignatureForPublicKey(otherHeader, key.pkBytes, 1, 11)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = handler.CreateBlockSignatureForPublicKey(otherHeader, key.pkBytes, 0, 10)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = handler.CreateBlockSignatureForPublicKey([]byte("not a header"), key.pkBytes, 1, 10)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	nextHeader, _ := marshaller.Marshal(&block.Header{ShardID: 1, Round: 11, Nonce: 6})
+	_, err = handler.CreateBlockSignatureForPublicKey(nextHeader, key.pkBytes, 1, 11)
+	assert.Nil(t, err)
+
+	metaBlock, _ := marshaller.Marshal(&block.MetaBlock{Round: 10, Nonce: 5})
+	_, err = handler.CreateBlockSignatureForPublicKey(metaBlock, key.pkBytes, core.MetachainShardId, 10)
+	assert.Nil(t, err)
+}
+
+func TestSignerServer_SigningHandlerSignatureShare(t *testing.T) {
+	t.Parallel()
+
+	client, key := startSignerServer(t)
+	handler, err := remoteSigner.NewSigningHandler(remoteSigner.ArgsSigningHandler{
+		Client:         client,
+		RequestTimeout: requestTimeout,
+	})
+	require.Nil(t, err)
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+	header, _ := marshaller.Marshal(&block.Header{ShardID: 1, Round: 10, Nonce: 5})
+	headerHash := hasher.Compute(string(header))
+	share, err := handler.CreateSignatureShareForPublicKey(headerHash, key.pkBytes, header, 1)
+	require.Nil(t, err)
+	assert.Nil(t, key.multiSigner.VerifySignatureShare(0, share, headerHash, nil))
+
+	retriedShare, err := handler.CreateSignatureShareForPublicKey(headerHash, key.pkBytes, header, 1)
+	require.Nil(t, err)
+	assert.Equal(t, share, retriedShare)
+
+	// the hash has to be the one of the provided header
+	_, err = handler.CreateSignatureShareForPublicKey([]byte("other header hash"), key.pkBytes, header, 1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = handler.CreateSignatureShareForPublicKey(headerHash, key.pkBytes, nil, 1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = handler.CreateSignatureShareForPublicKey(headerHash, key.pkBytes, header, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	otherHeader, _ := marshaller.Marshal(&block.Header{ShardID: 1, Round: 10, Nonce: 6})
+	otherHeaderHash := hasher.Compute(string(otherHeader))
+	_, err = handler.CreateSignatureShareForPublicKey(otherHeaderHash, key.pkBytes, otherHeader, 1)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the shard and the round are taken from the header, not from the request
+	_, err = client.Sign(context.Background(), &remoteSigner.SignRequest{
+		PublicKey: key.pkBytes,
+		Type:      int32(remoteSigner.SignatureShare),
+		Message:   otherHeaderHash,
+		ShardID:   1,
+		Round:     11,
+		Header:    otherHeader,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	nextHeader, _ := marshaller.Marshal(&block.Header{ShardID: 1, Round: 11, Nonce: 6})
+	_, err = handler.CreateSignatureShareForPublicKey(hasher.Compute(string(nextHeader)), key.pkBytes, nextHeader, 1)
+	assert.Nil(t, err)
+}
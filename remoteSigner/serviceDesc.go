@@ -0,0 +1,61 @@
+package remoteSigner
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+)
+
+const serviceName = "proto.RemoteSigner"
+
+type unaryCall func(srv SignerServer, ctx context.Context, request interface{}) (interface{}, error)
+
+// remoteSignerServiceDesc describes the RemoteSigner service, as defined in remoteSigner.proto
+var remoteSignerServiceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		newUnaryMethodDesc("Sign", func() interface{} { return &SignRequest{} },
+			func(srv SignerServer, ctx context.Context, request interface{}) (interface{}, error) {
+				return srv.Sign(ctx, request.(*SignRequest))
+			}),
+		newUnaryMethodDesc("GetPublicKeys", func() interface{} { return &PublicKeysRequest{} },
+			func(srv SignerServer, ctx context.Context, request interface{}) (interface{}, error) {
+				return srv.GetPublicKeys(ctx, request.(*PublicKeysRequest))
+			}),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "remoteSigner.proto",
+}
+
+func fullMethodName(methodName string) string {
+	return fmt.Sprintf("/%s/%s", serviceName, methodName)
+}
+
+func newUnaryMethodDesc(methodName string, newRequest func() interface{}, call unaryCall) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: methodName,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			request := newRequest()
+			err := dec(request)
+			if err != nil {
+				return nil, err
+			}
+
+			handler := func(ctx context.Context, request interface{}) (interface{}, error) {
+				return call(srv.(SignerServer), ctx, request)
+			}
+			if interceptor == nil {
+				return handler(ctx, request)
+			}
+
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: fullMethodName(methodName),
+			}
+
+			return interceptor(ctx, request, info, handler)
+		},
+	}
+}
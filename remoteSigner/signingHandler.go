@@ -0,0 +1,83 @@
+package remoteSigner
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// ArgsSigningHandler holds the arguments needed to create a new instance of signingHandler
+type ArgsSigningHandler struct {
+	Client         SignerClient
+	RequestTimeout time.Duration
+}
+
+type signingHandler struct {
+	client         SignerClient
+	requestTimeout time.Duration
+}
+
+// NewSigningHandler creates a consensus signing handler which requests all the signatures from the remote signer
+func NewSigningHandler(args ArgsSigningHandler) (*signingHandler, error) {
+	err := checkClientArgs(args.Client, args.RequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signingHandler{
+		client:         args.Client,
+		requestTimeout: args.RequestTimeout,
+	}, nil
+}
+
+func checkClientArgs(client SignerClient, requestTimeout time.Duration) error {
+	if check.IfNil(client) {
+		return ErrNilSignerClient
+	}
+	if requestTimeout <= 0 {
+		return ErrInvalidRequestTimeout
+	}
+
+	return nil
+}
+
+// CreateSignatureShareForPublicKey requests the signature share on the provided block header hash. The marshalled
+// header is sent as well, as the remote signer checks the hash against it
+func (sh *signingHandler) CreateSignatureShareForPublicKey(message []byte, publicKeyBytes []byte, marshalledHeader []byte, shardID uint32) ([]byte, error) {
+	return requestSignature(sh.client, sh.requestTimeout, &SignRequest{
+		PublicKey: publicKeyBytes,
+		Type:      int32(SignatureShare),
+		Message:   message,
+		ShardID:   shardID,
+		Header:    marshalledHeader,
+	})
+}
+
+// CreateBlockSignatureForPublicKey requests the leader's signature on the provided marshalled block header
+func (sh *signingHandler) CreateBlockSignatureForPublicKey(message []byte, publicKeyBytes []byte, shardID uint32, round int64) ([]byte, error) {
+	return requestSignature(sh.client, sh.requestTimeout, &SignRequest{
+		PublicKey: publicKeyBytes,
+		Type:      int32(BlockHeaderSignature),
+		Message:   message,
+		ShardID:   shardID,
+		Round:     round,
+	})
+}
+
+func requestSignature(client SignerClient, requestTimeout time.Duration, request *SignRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	response, err := client.Sign(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Signature, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *signingHandler) IsInterfaceNil() bool {
+	return sh == nil
+}
@@ -0,0 +1,63 @@
+package remoteSigner
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// ArgsSingleSigner holds the arguments needed to create a new instance of singleSigner
+type ArgsSingleSigner struct {
+	Client         SignerClient
+	SingleSigner   crypto.SingleSigner
+	RequestTimeout time.Duration
+}
+
+type singleSigner struct {
+	client         SignerClient
+	singleSigner   crypto.SingleSigner
+	requestTimeout time.Duration
+}
+
+// NewSingleSigner creates a single signer which forwards the signing operations done with remote keys to the remote
+// signer. The local keys and the signature verifications are handled by the provided single signer
+func NewSingleSigner(args ArgsSingleSigner) (*singleSigner, error) {
+	err := checkClientArgs(args.Client, args.RequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+
+	return &singleSigner{
+		client:         args.Client,
+		singleSigner:   args.SingleSigner,
+		requestTimeout: args.RequestTimeout,
+	}, nil
+}
+
+// Sign signs the provided message, remotely if the provided private key is held by the remote signer
+func (ss *singleSigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	key, isRemote := private.(*remoteKey)
+	if !isRemote {
+		return ss.singleSigner.Sign(private, msg)
+	}
+
+	return requestSignature(ss.client, ss.requestTimeout, &SignRequest{
+		PublicKey: key.publicKeyBytes,
+		Type:      int32(MessageSignature),
+		Message:   msg,
+	})
+}
+
+// Verify verifies the signature of the provided message
+func (ss *singleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	return ss.singleSigner.Verify(public, msg, sig)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *singleSigner) IsInterfaceNil() bool {
+	return ss == nil
+}
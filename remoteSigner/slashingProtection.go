@@ -0,0 +1,70 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+type slashingProtection struct {
+	mut    sync.Mutex
+	storer storage.Storer
+}
+
+// NewSlashingProtection creates a slashing protection database over the provided storer. It records the digest of the
+// data signed for each public key, signature type, shard and round and refuses to sign different data afterwards
+func NewSlashingProtection(storer storage.Storer) (*slashingProtection, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+
+	return &slashingProtection{
+		storer: storer,
+	}, nil
+}
+
+// CheckAndRecord returns an error if different data was already signed with the same public key, signature type,
+// shard and round. Otherwise, it records the provided digest and allows the signing. Signing the same data again is
+// allowed, so that the requests can be retried safely
+func (sp *slashingProtection) CheckAndRecord(publicKey []byte, signatureType SignatureType, shardID uint32, round int64, digest []byte) error {
+	key := createSlashingProtectionKey(publicKey, signatureType, shardID, round)
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	err := sp.storer.Has(key)
+	if err != nil {
+		return sp.storer.Put(key, digest)
+	}
+
+	recordedDigest, err := sp.storer.Get(key)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(recordedDigest, digest) {
+		return fmt.Errorf("%w: %s for public key %x, shard %d, round %d, signed %x, requested %x",
+			ErrDoubleSigningAttempt, signatureType, publicKey, shardID, round, recordedDigest, digest)
+	}
+
+	return nil
+}
+
+func createSlashingProtectionKey(publicKey []byte, signatureType SignatureType, shardID uint32, round int64) []byte {
+	key := make([]byte, len(publicKey)+1+4+8)
+	copy(key, publicKey)
+	offset := len(publicKey)
+	key[offset] = byte(signatureType)
+	binary.BigEndian.PutUint32(key[offset+1:], shardID)
+	binary.BigEndian.PutUint64(key[offset+5:], uint64(round))
+
+	return key
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProtection) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,72 @@
+package remoteSigner_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := remoteSigner.NewSlashingProtection(nil)
+		assert.True(t, check.IfNil(sp))
+		assert.Equal(t, remoteSigner.ErrNilStorer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := remoteSigner.NewSlashingProtection(testscommon.CreateMemUnit())
+		assert.False(t, check.IfNil(sp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSlashingProtection_CheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	pk := []byte("pk")
+	t.Run("same digest should be allowed", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := remoteSigner.NewSlashingProtection(testscommon.CreateMemUnit())
+		err := sp.CheckAndRecord(pk, remoteSigner.BlockHeaderSignature, 0, 10, []byte("header"))
+		assert.Nil(t, err)
+
+		err = sp.CheckAndRecord(pk, remoteSigner.BlockHeaderSignature, 0, 10, []byte("header"))
+		assert.Nil(t, err)
+	})
+	t.Run("different digest for the same round and shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := remoteSigner.NewSlashingProtection(testscommon.CreateMemUnit())
+		err := sp.CheckAndRecord(pk, remoteSigner.BlockHeaderSignature, 0, 10, []byte("header"))
+		assert.Nil(t, err)
+
+		err = sp.CheckAndRecord(pk, remoteSigner.BlockHeaderSignature, 0, 10, []byte("other header"))
+		assert.True(t, errors.Is(err, remoteSigner.ErrDoubleSigningAttempt))
+	})
+	t.Run("different round, shard, type or key should be allowed", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := remoteSigner.NewSlashingProtection(testscommon.CreateMemUnit())
+		err := sp.CheckAndRecord(pk, remoteSigner.BlockHeaderSignature, 0, 10, []byte("header"))
+		assert.Nil(t, err)
+
+		err = sp.CheckAndRecord(pk, remoteSigner.BlockHeaderSignature, 0, 11, []byte("other header"))
+		assert.Nil(t, err)
+		err = sp.CheckAndRecord(pk, remoteSigner.BlockHeaderSignature, 1, 10, []byte("other header"))
+		assert.Nil(t, err)
+		err = sp.CheckAndRecord(pk, remoteSigner.SignatureShare, 0, 10, []byte("other header"))
+		assert.Nil(t, err)
+		err = sp.CheckAndRecord([]byte("other pk"), remoteSigner.BlockHeaderSignature, 0, 10, []byte("other header"))
+		assert.Nil(t, err)
+	})
+}
@@ -72,5 +72,10 @@ func (psm *PeerShardMapper) FallbackPidShard() storage.Cacher {
 
 // UpdatePeerIDPublicKey -
 func (psm *PeerShardMapper) UpdatePeerIDPublicKey(pid core.PeerID, pk []byte) bool {
-	return psm.updatePeerIDPublicKey(pid, pk)
+	return psm.updatePeerIDPublicKey(pid, pk, nil)
+}
+
+// UpdatePeerIDPublicKeyFromBatch -
+func (psm *PeerShardMapper) UpdatePeerIDPublicKeyFromBatch(pid core.PeerID, pk []byte, batchID []byte) bool {
+	return psm.updatePeerIDPublicKey(pid, pk, batchID)
 }
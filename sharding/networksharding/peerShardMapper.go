@@ -24,6 +24,31 @@ const indexNotFound = -1
 var log = logger.GetOrCreate("sharding/networksharding")
 var peerLog = logger.GetOrCreate("sharding/networksharding/peerlog")
 
+// pidBatch holds the public keys associated with a peer ID from the same peer authentication batch
+type pidBatch struct {
+	id  []byte
+	pks [][]byte
+}
+
+func (batch *pidBatch) sizeInBytes() int {
+	size := len(batch.id)
+	for _, pk := range batch.pks {
+		size += len(pk)
+	}
+
+	return size
+}
+
+func containsPk(pks [][]byte, pk []byte) bool {
+	for _, existingPk := range pks {
+		if bytes.Equal(existingPk, pk) {
+			return true
+		}
+	}
+
+	return false
+}
+
 var _ p2p.NetworkShardingCollector = (*PeerShardMapper)(nil)
 var _ p2p.PeerShardResolver = (*PeerShardMapper)(nil)
 
@@ -41,6 +66,7 @@ type PeerShardMapper struct {
 	fallbackPkShardCache     storage.Cacher
 	fallbackPidShardCache    storage.Cacher
 	peerIdSubTypeCache       storage.Cacher
+	peerIdBatchCache         storage.Cacher
 	mutUpdatePeerIdPublicKey sync.RWMutex
 
 	nodesCoordinator     nodesCoordinator.NodesCoordinator
@@ -85,12 +111,18 @@ func NewPeerShardMapper(arg ArgPeerShardMapper) (*PeerShardMapper, error) {
 		return nil, err
 	}
 
+	peerIdBatchCache, err := lrucache.NewCache(arg.PeerIdPkCache.MaxSize())
+	if err != nil {
+		return nil, err
+	}
+
 	return &PeerShardMapper{
 		peerIdPkCache:         arg.PeerIdPkCache,
 		pkPeerIdCache:         pkPeerId,
 		fallbackPkShardCache:  arg.FallbackPkShardCache,
 		fallbackPidShardCache: arg.FallbackPidShardCache,
 		peerIdSubTypeCache:    peerIdSubTypeCache,
+		peerIdBatchCache:      peerIdBatchCache,
 		nodesCoordinator:      arg.NodesCoordinator,
 		preferredPeersHolder:  arg.PreferredPeersHolder,
 	}, nil
@@ -232,17 +264,27 @@ func (psm *PeerShardMapper) getPeerInfoSearchingPidInFallbackCache(pid core.Peer
 // It also uses the intermediate pkPeerId cache that will prevent having thousands of peer ID's with
 // the same Elrond PK that will make the node prone to an eclipse attack
 func (psm *PeerShardMapper) UpdatePeerIDPublicKeyPair(pid core.PeerID, pk []byte) {
-	isNew := psm.updatePeerIDPublicKey(pid, pk)
+	isNew := psm.updatePeerIDPublicKey(pid, pk, nil)
 	if isNew {
 		peerLog.Trace("new peer mapping", "pid", pid.Pretty(), "pk", pk)
 	}
 }
 
+// UpdatePeerIDPublicKeyPairFromBatch updates the public key - peer ID pair in the corresponding maps, keeping the
+// public keys received in the same batch all associated with the peer ID, as a node managing several keys sends
+// their peer authentication messages together. A public key from a new batch replaces the ones of the previous batch
+func (psm *PeerShardMapper) UpdatePeerIDPublicKeyPairFromBatch(pid core.PeerID, pk []byte, batchID []byte) {
+	isNew := psm.updatePeerIDPublicKey(pid, pk, batchID)
+	if isNew {
+		peerLog.Trace("new peer mapping", "pid", pid.Pretty(), "pk", pk, "batch", batchID)
+	}
+}
+
 // UpdatePeerIDInfo updates the public keys and the shard ID for the peer ID in the corresponding maps
 // It also uses the intermediate pkPeerId cache that will prevent having thousands of peer ID's with
 // the same Elrond PK that will make the node prone to an eclipse attack
 func (psm *PeerShardMapper) UpdatePeerIDInfo(pid core.PeerID, pk []byte, shardID uint32) {
-	isNew := psm.updatePeerIDPublicKey(pid, pk)
+	isNew := psm.updatePeerIDPublicKey(pid, pk, nil)
 	if isNew {
 		peerLog.Trace("new peer mapping", "pid", pid.Pretty(), "pk", pk)
 	}
@@ -265,12 +307,12 @@ func (psm *PeerShardMapper) PutPeerIdShardId(pid core.PeerID, shardId uint32) {
 }
 
 // updatePeerIDPublicKey will update the pid <-> pk mapping, returning true if the pair is a new known pair
-func (psm *PeerShardMapper) updatePeerIDPublicKey(pid core.PeerID, pk []byte) bool {
+func (psm *PeerShardMapper) updatePeerIDPublicKey(pid core.PeerID, pk []byte, batchID []byte) bool {
 	// mutUpdatePeerIdPublicKey is used as to consider this function a critical section
 	psm.mutUpdatePeerIdPublicKey.Lock()
 	defer psm.mutUpdatePeerIdPublicKey.Unlock()
 
-	isNew := !bytes.Equal(pk, psm.removePidAssociation(pid))
+	isNew := psm.updatePidBatch(pid, pk, batchID)
 
 	objPidsQueue, found := psm.pkPeerIdCache.Get(pk)
 	if !found {
@@ -302,6 +344,7 @@ func (psm *PeerShardMapper) updatePeerIDPublicKey(pid core.PeerID, pk []byte) bo
 		evictedPid := pq.Pop()
 
 		psm.peerIdPkCache.Remove([]byte(evictedPid))
+		psm.peerIdBatchCache.Remove([]byte(evictedPid))
 		psm.fallbackPidShardCache.Remove([]byte(evictedPid))
 	}
 	psm.pkPeerIdCache.Put(pk, pq, pq.DataSizeInBytes())
@@ -310,6 +353,73 @@ func (psm *PeerShardMapper) updatePeerIDPublicKey(pid core.PeerID, pk []byte) bo
 	return isNew
 }
 
+// updatePidBatch records the public key in the batch of the pid, returning true if the public key was not already
+// associated with the pid. A public key from another batch, or a public key out of the current batch received without
+// a batch, removes the associations between the pid and the public keys of the current batch
+func (psm *PeerShardMapper) updatePidBatch(pid core.PeerID, pk []byte, batchID []byte) bool {
+	batch := psm.getPidBatch(pid)
+	if batch != nil {
+		isKnownPkWithoutBatch := len(batchID) == 0 && containsPk(batch.pks, pk)
+		if isKnownPkWithoutBatch {
+			return false
+		}
+
+		isSameBatch := len(batchID) > 0 && bytes.Equal(batch.id, batchID)
+		if isSameBatch {
+			isNew := !containsPk(batch.pks, pk)
+			if isNew {
+				batch.pks = append(batch.pks, pk)
+			}
+			psm.peerIdBatchCache.Put([]byte(pid), batch, batch.sizeInBytes())
+
+			return isNew
+		}
+	}
+
+	oldPks := psm.removePidAssociations(pid, batch)
+	newBatch := &pidBatch{
+		id:  batchID,
+		pks: [][]byte{pk},
+	}
+	psm.peerIdBatchCache.Put([]byte(pid), newBatch, newBatch.sizeInBytes())
+
+	return !containsPk(oldPks, pk)
+}
+
+func (psm *PeerShardMapper) getPidBatch(pid core.PeerID) *pidBatch {
+	batchObj, found := psm.peerIdBatchCache.Get([]byte(pid))
+	if !found {
+		return nil
+	}
+
+	batch, ok := batchObj.(*pidBatch)
+	if !ok {
+		psm.peerIdBatchCache.Remove([]byte(pid))
+		return nil
+	}
+
+	return batch
+}
+
+// removePidAssociations removes the associations between the pid and the public keys of its batch, or its last public
+// key if it has no batch, returning the public keys of the removed associations
+func (psm *PeerShardMapper) removePidAssociations(pid core.PeerID, batch *pidBatch) [][]byte {
+	if batch == nil {
+		oldPk := psm.removePidAssociation(pid)
+		if len(oldPk) == 0 {
+			return nil
+		}
+
+		return [][]byte{oldPk}
+	}
+
+	for _, oldPk := range batch.pks {
+		psm.removePidFromPkQueue(pid, oldPk)
+	}
+
+	return batch.pks
+}
+
 // removePidAssociation removes the pid association between the pid and public key, returning old public key stored, if existing
 func (psm *PeerShardMapper) removePidAssociation(pid core.PeerID) []byte {
 	oldPk, found := psm.peerIdPkCache.Get([]byte(pid))
@@ -323,25 +433,30 @@ func (psm *PeerShardMapper) removePidAssociation(pid core.PeerID) []byte {
 		return nil
 	}
 
+	psm.removePidFromPkQueue(pid, oldPkBuff)
+
+	return oldPkBuff
+}
+
+func (psm *PeerShardMapper) removePidFromPkQueue(pid core.PeerID, oldPkBuff []byte) {
 	objPidsQueue, found := psm.pkPeerIdCache.Get(oldPkBuff)
 	if !found {
-		return oldPkBuff
+		return
 	}
 
 	pq, ok := objPidsQueue.(common.PidQueueHandler)
 	if !ok {
 		psm.pkPeerIdCache.Remove(oldPkBuff)
-		return oldPkBuff
+		return
 	}
 
 	pq.Remove(pid)
 	if pq.Len() == 0 {
 		psm.pkPeerIdCache.Remove(oldPkBuff)
-		return oldPkBuff
+		return
 	}
 
 	psm.pkPeerIdCache.Put(oldPkBuff, pq, pq.DataSizeInBytes())
-	return oldPkBuff
 }
 
 // PutPeerIdSubType puts the peerIdSubType search map containing peer IDs and peer subtypes
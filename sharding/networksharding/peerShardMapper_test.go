@@ -523,6 +523,71 @@ func TestPeerShardMapper_UpdatePeerIDPublicKey(t *testing.T) {
 	})
 }
 
+func TestPeerShardMapper_UpdatePeerIDPublicKeyFromBatch(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	pk1 := []byte("pk1")
+	pk2 := []byte("pk2")
+	pk3 := []byte("pk3")
+	batch1 := []byte("batch1")
+	batch2 := []byte("batch2")
+
+	t.Run("public keys of the same batch should all be kept", func(t *testing.T) {
+		t.Parallel()
+
+		psm := createPeerShardMapper()
+
+		assert.True(t, psm.UpdatePeerIDPublicKeyFromBatch(pid, pk1, batch1))
+		assert.True(t, psm.UpdatePeerIDPublicKeyFromBatch(pid, pk2, batch1))
+		assert.False(t, psm.UpdatePeerIDPublicKeyFromBatch(pid, pk1, batch1))
+
+		assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(pk1))
+		assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(pk2))
+	})
+	t.Run("public keys of a new batch should replace the ones of the previous batch", func(t *testing.T) {
+		t.Parallel()
+
+		psm := createPeerShardMapper()
+
+		_ = psm.UpdatePeerIDPublicKeyFromBatch(pid, pk1, batch1)
+		_ = psm.UpdatePeerIDPublicKeyFromBatch(pid, pk2, batch1)
+
+		assert.False(t, psm.UpdatePeerIDPublicKeyFromBatch(pid, pk2, batch2))
+		assert.True(t, psm.UpdatePeerIDPublicKeyFromBatch(pid, pk3, batch2))
+
+		assert.Nil(t, psm.GetFromPkPeerId(pk1))
+		assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(pk2))
+		assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(pk3))
+	})
+	t.Run("public key of the batch received without a batch should keep the batch", func(t *testing.T) {
+		t.Parallel()
+
+		psm := createPeerShardMapper()
+
+		_ = psm.UpdatePeerIDPublicKeyFromBatch(pid, pk1, batch1)
+		_ = psm.UpdatePeerIDPublicKeyFromBatch(pid, pk2, batch1)
+
+		assert.False(t, psm.UpdatePeerIDPublicKey(pid, pk1))
+		assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(pk1))
+		assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(pk2))
+		assert.Equal(t, pk1, psm.GetPkFromPidPk(pid))
+	})
+	t.Run("other public key received without a batch should replace the batch", func(t *testing.T) {
+		t.Parallel()
+
+		psm := createPeerShardMapper()
+
+		_ = psm.UpdatePeerIDPublicKeyFromBatch(pid, pk1, batch1)
+		_ = psm.UpdatePeerIDPublicKeyFromBatch(pid, pk2, batch1)
+
+		assert.True(t, psm.UpdatePeerIDPublicKey(pid, pk3))
+		assert.Nil(t, psm.GetFromPkPeerId(pk1))
+		assert.Nil(t, psm.GetFromPkPeerId(pk2))
+		assert.Equal(t, []core.PeerID{pid}, psm.GetFromPkPeerId(pk3))
+	})
+}
+
 func TestPeerShardMapper_PutPeerIdShardId(t *testing.T) {
 	t.Parallel()
 
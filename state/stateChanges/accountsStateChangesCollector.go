@@ -0,0 +1,104 @@
+package stateChanges
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ArgsAccountsStateChangesCollector holds the arguments needed to create a new accounts state changes collector
+type ArgsAccountsStateChangesCollector struct {
+	Accounts        state.AccountsAdapter
+	PubkeyConverter core.PubkeyConverter
+	Marshaller      marshal.Marshalizer
+}
+
+// accountsStateChangesCollector is a wrapper over an accounts adapter that records the changes done on the accounts
+// between the StartTracking and StopTracking calls. All the other operations are forwarded to the wrapped accounts
+type accountsStateChangesCollector struct {
+	state.AccountsAdapter
+	tracker *stateChangesTracker
+
+	mutTracking sync.RWMutex
+	isTracking  bool
+}
+
+// NewAccountsStateChangesCollector creates a new accounts state changes collector
+func NewAccountsStateChangesCollector(args ArgsAccountsStateChangesCollector) (*accountsStateChangesCollector, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	tracker, err := NewStateChangesTracker(ArgsStateChangesTracker{
+		PubkeyConverter: args.PubkeyConverter,
+		Marshaller:      args.Marshaller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountsStateChangesCollector{
+		AccountsAdapter: args.Accounts,
+		tracker:         tracker,
+	}, nil
+}
+
+// StartTracking starts recording the changes done on the accounts, dropping any previously recorded ones
+func (ascc *accountsStateChangesCollector) StartTracking() {
+	ascc.mutTracking.Lock()
+	ascc.tracker.Reset()
+	ascc.isTracking = true
+	ascc.mutTracking.Unlock()
+}
+
+// StopTracking stops recording the changes done on the accounts and returns the ones recorded since StartTracking
+func (ascc *accountsStateChangesCollector) StopTracking() []*common.AccountStateChange {
+	ascc.mutTracking.Lock()
+	defer ascc.mutTracking.Unlock()
+
+	if !ascc.isTracking {
+		return nil
+	}
+
+	ascc.isTracking = false
+
+	return ascc.tracker.CollectStateChanges(ascc.AccountsAdapter)
+}
+
+// SaveAccount records the account state before the change, if tracking, and saves the account in the wrapped accounts
+func (ascc *accountsStateChangesCollector) SaveAccount(account vmcommon.AccountHandler) error {
+	if check.IfNil(account) {
+		return ascc.AccountsAdapter.SaveAccount(account)
+	}
+
+	ascc.mutTracking.RLock()
+	defer ascc.mutTracking.RUnlock()
+
+	if ascc.isTracking {
+		ascc.tracker.TrackAccount(ascc.AccountsAdapter, account.AddressBytes(), account)
+	}
+
+	return ascc.AccountsAdapter.SaveAccount(account)
+}
+
+// RemoveAccount records the account state before the removal, if tracking, and removes the account from the wrapped accounts
+func (ascc *accountsStateChangesCollector) RemoveAccount(address []byte) error {
+	ascc.mutTracking.RLock()
+	defer ascc.mutTracking.RUnlock()
+
+	if ascc.isTracking {
+		ascc.tracker.TrackAccount(ascc.AccountsAdapter, address, nil)
+	}
+
+	return ascc.AccountsAdapter.RemoveAccount(address)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ascc *accountsStateChangesCollector) IsInterfaceNil() bool {
+	return ascc == nil
+}
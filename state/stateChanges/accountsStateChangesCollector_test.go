@@ -0,0 +1,181 @@
+package stateChanges
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func getAccountsStateChangesCollectorArgs() ArgsAccountsStateChangesCollector {
+	return ArgsAccountsStateChangesCollector{
+		Accounts:        &stateMock.AccountsStub{},
+		PubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+		Marshaller:      &testscommon.MarshalizerMock{},
+	}
+}
+
+func createAccountsDB(t *testing.T) state.AccountsAdapter {
+	args, _ := storage.GetStorageManagerArgsAndOptions()
+	trieStorage, _ := trie.NewTrieStorageManager(args)
+	tr, _ := trie.NewTrie(trieStorage, args.Marshalizer, args.Hasher, 5)
+
+	accountsDB, err := state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  tr,
+		Hasher:                args.Hasher,
+		Marshaller:            args.Marshalizer,
+		AccountFactory:        factory.NewAccountCreator(),
+		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+	})
+	require.Nil(t, err)
+
+	return accountsDB
+}
+
+func TestNewAccountsStateChangesCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		args := getAccountsStateChangesCollectorArgs()
+		args.Accounts = nil
+		collector, err := NewAccountsStateChangesCollector(args)
+		require.True(t, check.IfNil(collector))
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		args := getAccountsStateChangesCollectorArgs()
+		args.PubkeyConverter = nil
+		collector, err := NewAccountsStateChangesCollector(args)
+		require.True(t, check.IfNil(collector))
+		require.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		args := getAccountsStateChangesCollectorArgs()
+		args.Marshaller = nil
+		collector, err := NewAccountsStateChangesCollector(args)
+		require.True(t, check.IfNil(collector))
+		require.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		collector, err := NewAccountsStateChangesCollector(getAccountsStateChangesCollectorArgs())
+		require.False(t, check.IfNil(collector))
+		require.Nil(t, err)
+	})
+}
+
+func TestAccountsStateChangesCollector_ShouldNotTrackOutsideTheTrackingWindow(t *testing.T) {
+	t.Parallel()
+
+	numSaveCalls := 0
+	args := getAccountsStateChangesCollectorArgs()
+	args.Accounts = &stateMock.AccountsStub{
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			numSaveCalls++
+			return nil
+		},
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	collector, _ := NewAccountsStateChangesCollector(args)
+
+	account, _ := state.NewUserAccount([]byte("address"))
+	require.Nil(t, collector.SaveAccount(account))
+	require.Equal(t, 1, numSaveCalls)
+	require.Nil(t, collector.StopTracking())
+}
+
+func TestAccountsStateChangesCollector_ShouldCollectTheAccountChanges(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &testscommon.MarshalizerMock{}
+	alice := []byte("alice")
+	bob := []byte("bob")
+	args := getAccountsStateChangesCollectorArgs()
+	args.Accounts = createAccountsDB(t)
+	args.Marshaller = marshaller
+	collector, _ := NewAccountsStateChangesCollector(args)
+
+	fungibleKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-abcdef")
+	nftKey := append([]byte(core.ElrondProtectedKeyPrefix+core.ESDTKeyIdentifier+"NFT-123456"), 0x0a)
+	fungibleBefore, _ := marshaller.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(10)})
+	fungibleAfter, _ := marshaller.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(4)})
+	nftAfter, _ := marshaller.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(1)})
+
+	aliceAccount, _ := collector.LoadAccount(alice)
+	aliceUserAccount := aliceAccount.(state.UserAccountHandler)
+	_ = aliceUserAccount.AddToBalance(big.NewInt(100))
+	_ = aliceUserAccount.DataTrieTracker().SaveKeyValue(fungibleKey, fungibleBefore)
+	require.Nil(t, collector.SaveAccount(aliceAccount))
+	_, err := collector.Commit()
+	require.Nil(t, err)
+
+	collector.StartTracking()
+
+	aliceAccount, _ = collector.LoadAccount(alice)
+	aliceUserAccount = aliceAccount.(state.UserAccountHandler)
+	_ = aliceUserAccount.SubFromBalance(big.NewInt(30))
+	aliceUserAccount.IncreaseNonce(1)
+	aliceUserAccount.AddToDeveloperReward(big.NewInt(2))
+	aliceUserAccount.SetOwnerAddress(bob)
+	_ = aliceUserAccount.DataTrieTracker().SaveKeyValue(fungibleKey, fungibleAfter)
+	_ = aliceUserAccount.DataTrieTracker().SaveKeyValue(nftKey, nftAfter)
+	_ = aliceUserAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	require.Nil(t, collector.SaveAccount(aliceAccount))
+
+	// bob's changes are reverted, so bob will not be reported
+	journalLen := collector.JournalLen()
+	bobAccount, _ := collector.LoadAccount(bob)
+	_ = bobAccount.(state.UserAccountHandler).AddToBalance(big.NewInt(30))
+	require.Nil(t, collector.SaveAccount(bobAccount))
+	require.Nil(t, collector.RevertToSnapshot(journalLen))
+
+	stateChanges := collector.StopTracking()
+	require.Equal(t, 1, len(stateChanges))
+
+	expectedStateChange := &common.AccountStateChange{
+		Address:         hex.EncodeToString(alice),
+		BalanceBefore:   "100",
+		BalanceAfter:    "70",
+		NonceBefore:     0,
+		NonceAfter:      1,
+		Owner:           &common.ValueChange{Before: "", After: hex.EncodeToString(bob)},
+		DeveloperReward: &common.ValueChange{Before: "0", After: "2"},
+		ESDTBalanceChanges: map[string]*common.ValueChange{
+			"TKN-abcdef":    {Before: "10", After: "4"},
+			"NFT-123456-0a": {Before: "0", After: "1"},
+		},
+		StorageChanges: map[string]*common.ValueChange{
+			hex.EncodeToString([]byte("key")): {Before: "", After: hex.EncodeToString([]byte("value"))},
+		},
+	}
+	require.Equal(t, expectedStateChange, stateChanges[0])
+
+	// changes done after the tracking stopped are not reported
+	collector.StartTracking()
+	require.Equal(t, 0, len(collector.StopTracking()))
+}
+
+func TestGetTokenIdentifier(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "TKN-abcdef", getTokenIdentifier([]byte("TKN-abcdef")))
+	require.Equal(t, "NFT-abcdef-0102", getTokenIdentifier(append([]byte("NFT-abcdef"), 1, 2)))
+	require.Equal(t, "INVALID", getTokenIdentifier([]byte("INVALID")))
+}
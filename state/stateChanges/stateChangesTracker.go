@@ -0,0 +1,318 @@
+package stateChanges
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const esdtTokenRandomSequenceLength = 6
+
+var esdtKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+// ArgsStateChangesTracker holds the arguments needed to create a new state changes tracker
+type ArgsStateChangesTracker struct {
+	PubkeyConverter core.PubkeyConverter
+	Marshaller      marshal.Marshalizer
+}
+
+type accountSnapshot struct {
+	exists          bool
+	balance         *big.Int
+	nonce           uint64
+	codeHash        []byte
+	owner           []byte
+	developerReward *big.Int
+	storage         map[string][]byte
+}
+
+// stateChangesTracker remembers the state of the accounts before they are first saved, together with the previous
+// values of the changed data trie keys, and computes the differences against the current state, on request
+type stateChangesTracker struct {
+	pubkeyConverter core.PubkeyConverter
+	marshaller      marshal.Marshalizer
+
+	mutTracker      sync.Mutex
+	touchedAccounts map[string]*accountSnapshot
+}
+
+// NewStateChangesTracker creates a new state changes tracker
+func NewStateChangesTracker(args ArgsStateChangesTracker) (*stateChangesTracker, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+
+	return &stateChangesTracker{
+		pubkeyConverter: args.PubkeyConverter,
+		marshaller:      args.Marshaller,
+		touchedAccounts: make(map[string]*accountSnapshot),
+	}, nil
+}
+
+// TrackAccount remembers the state of the account before its first change, together with the values of the storage
+// keys it is about to change. It should be called before the account is saved in, or removed from, the accounts
+func (sct *stateChangesTracker) TrackAccount(accounts state.AccountsAdapter, address []byte, account vmcommon.AccountHandler) {
+	sct.mutTracker.Lock()
+	defer sct.mutTracker.Unlock()
+
+	before, found := sct.touchedAccounts[string(address)]
+	if !found {
+		before = getAccountSnapshot(accounts, address)
+		sct.touchedAccounts[string(address)] = before
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok || check.IfNil(userAccount.DataTrieTracker()) {
+		return
+	}
+
+	for key := range userAccount.DataTrieTracker().DirtyData() {
+		_, isTracked := before.storage[key]
+		if isTracked {
+			continue
+		}
+
+		before.storage[key] = retrieveValue(accounts, address, []byte(key))
+	}
+}
+
+// CollectStateChanges returns the changes done on the tracked accounts, sorted by address, and resets the tracking.
+// Accounts that ended up in their initial state are not reported
+func (sct *stateChangesTracker) CollectStateChanges(accounts state.AccountsAdapter) []*common.AccountStateChange {
+	sct.mutTracker.Lock()
+	defer sct.mutTracker.Unlock()
+
+	addresses := make([]string, 0, len(sct.touchedAccounts))
+	for address := range sct.touchedAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	stateChanges := make([]*common.AccountStateChange, 0, len(addresses))
+	for _, address := range addresses {
+		before := sct.touchedAccounts[address]
+		after := getAccountSnapshot(accounts, []byte(address))
+
+		stateChange := sct.computeStateChange(accounts, []byte(address), before, after)
+		if stateChange == nil {
+			continue
+		}
+
+		stateChanges = append(stateChanges, stateChange)
+	}
+
+	sct.touchedAccounts = make(map[string]*accountSnapshot)
+
+	return stateChanges
+}
+
+// Reset drops the tracked accounts
+func (sct *stateChangesTracker) Reset() {
+	sct.mutTracker.Lock()
+	sct.touchedAccounts = make(map[string]*accountSnapshot)
+	sct.mutTracker.Unlock()
+}
+
+func (sct *stateChangesTracker) computeStateChange(
+	accounts state.AccountsAdapter,
+	address []byte,
+	before *accountSnapshot,
+	after *accountSnapshot,
+) *common.AccountStateChange {
+	stateChange := &common.AccountStateChange{
+		Address:       sct.pubkeyConverter.Encode(address),
+		BalanceBefore: before.balance.String(),
+		BalanceAfter:  after.balance.String(),
+		NonceBefore:   before.nonce,
+		NonceAfter:    after.nonce,
+		IsRemoved:     before.exists && !after.exists,
+	}
+
+	hasChanges := stateChange.IsRemoved || before.balance.Cmp(after.balance) != 0 || before.nonce != after.nonce
+	if !bytes.Equal(before.codeHash, after.codeHash) {
+		stateChange.CodeHash = newHexValueChange(before.codeHash, after.codeHash)
+		hasChanges = true
+	}
+	if !bytes.Equal(before.owner, after.owner) {
+		stateChange.Owner = &common.ValueChange{
+			Before: sct.encodeAddress(before.owner),
+			After:  sct.encodeAddress(after.owner),
+		}
+		hasChanges = true
+	}
+	if before.developerReward.Cmp(after.developerReward) != 0 {
+		stateChange.DeveloperReward = &common.ValueChange{
+			Before: before.developerReward.String(),
+			After:  after.developerReward.String(),
+		}
+		hasChanges = true
+	}
+
+	for key, valueBefore := range before.storage {
+		valueAfter := retrieveValue(accounts, address, []byte(key))
+		if bytes.Equal(valueBefore, valueAfter) {
+			continue
+		}
+
+		hasChanges = true
+		isESDTBalanceChange := sct.addESDTBalanceChange(stateChange, []byte(key), valueBefore, valueAfter)
+		if isESDTBalanceChange {
+			continue
+		}
+
+		if stateChange.StorageChanges == nil {
+			stateChange.StorageChanges = make(map[string]*common.ValueChange)
+		}
+		stateChange.StorageChanges[hex.EncodeToString([]byte(key))] = newHexValueChange(valueBefore, valueAfter)
+	}
+
+	if !hasChanges {
+		return nil
+	}
+
+	return stateChange
+}
+
+// addESDTBalanceChange records the change of an ESDT key as a balance change, if the key holds an ESDT token and its
+// balance changed. Other changes of the ESDT keys (such as the NFT attributes) are reported as storage changes
+func (sct *stateChangesTracker) addESDTBalanceChange(stateChange *common.AccountStateChange, key []byte, valueBefore []byte, valueAfter []byte) bool {
+	if !bytes.HasPrefix(key, esdtKeyPrefix) {
+		return false
+	}
+
+	balanceBefore, err := sct.getESDTBalance(valueBefore)
+	if err != nil {
+		return false
+	}
+	balanceAfter, err := sct.getESDTBalance(valueAfter)
+	if err != nil {
+		return false
+	}
+	if balanceBefore.Cmp(balanceAfter) == 0 {
+		return false
+	}
+
+	if stateChange.ESDTBalanceChanges == nil {
+		stateChange.ESDTBalanceChanges = make(map[string]*common.ValueChange)
+	}
+	stateChange.ESDTBalanceChanges[getTokenIdentifier(key[len(esdtKeyPrefix):])] = &common.ValueChange{
+		Before: balanceBefore.String(),
+		After:  balanceAfter.String(),
+	}
+
+	return true
+}
+
+func (sct *stateChangesTracker) getESDTBalance(value []byte) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err := sct.marshaller.Unmarshal(esdtToken, value)
+	if err != nil {
+		return nil, err
+	}
+	if esdtToken.Value == nil {
+		return big.NewInt(0), nil
+	}
+
+	return esdtToken.Value, nil
+}
+
+func (sct *stateChangesTracker) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return sct.pubkeyConverter.Encode(address)
+}
+
+// getTokenIdentifier converts the ESDT key suffix into the token identifier. The suffix of the semi-fungible and
+// non-fungible tokens holds the nonce after the collection identifier (TICKER-abcdef), so it is appended in hex
+func getTokenIdentifier(keySuffix []byte) string {
+	suffix := string(keySuffix)
+	separatorIndex := strings.Index(suffix, "-")
+	collectionLength := separatorIndex + 1 + esdtTokenRandomSequenceLength
+	if separatorIndex < 0 || len(suffix) <= collectionLength {
+		return suffix
+	}
+
+	return suffix[:collectionLength] + "-" + hex.EncodeToString(keySuffix[collectionLength:])
+}
+
+func newHexValueChange(before []byte, after []byte) *common.ValueChange {
+	return &common.ValueChange{
+		Before: hex.EncodeToString(before),
+		After:  hex.EncodeToString(after),
+	}
+}
+
+func getAccountSnapshot(accounts state.AccountsAdapter, address []byte) *accountSnapshot {
+	snapshot := &accountSnapshot{
+		balance:         big.NewInt(0),
+		developerReward: big.NewInt(0),
+		storage:         make(map[string][]byte),
+	}
+
+	account, err := accounts.GetExistingAccount(address)
+	if err != nil {
+		return snapshot
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return snapshot
+	}
+
+	snapshot.exists = true
+	snapshot.nonce = userAccount.GetNonce()
+	snapshot.codeHash = userAccount.GetCodeHash()
+	snapshot.owner = userAccount.GetOwnerAddress()
+	if userAccount.GetBalance() != nil {
+		snapshot.balance.Set(userAccount.GetBalance())
+	}
+	if userAccount.GetDeveloperReward() != nil {
+		snapshot.developerReward.Set(userAccount.GetDeveloperReward())
+	}
+
+	return snapshot
+}
+
+func retrieveValue(accounts state.AccountsAdapter, address []byte, key []byte) []byte {
+	account, err := accounts.GetExistingAccount(address)
+	if err != nil {
+		return nil
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok || check.IfNil(userAccount.DataTrieTracker()) {
+		return nil
+	}
+
+	value, err := userAccount.DataTrieTracker().RetrieveValue(key)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sct *stateChangesTracker) IsInterfaceNil() bool {
+	return sct == nil
+}
@@ -0,0 +1,421 @@
+package badgerdb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+const mkdirAllFunction = "mkdirAll"
+const openBadgerDBFunction = "openBadgerDB"
+
+// valueLogFileSize is kept well below the badger default (1GB) as the node opens a lot of databases and each
+// value log file is memory mapped
+const valueLogFileSize = 128 << 20
+const valueLogGCInterval = time.Minute * 10
+const valueLogGCDiscardRatio = 0.5
+
+// the badger defaults (5 memtables of 64MB, level 0 kept in memory and a 1GB block cache) add up to GBs of RSS
+// as each storer opens its own database, so the memory is sized from the DB config instead
+const estimatedEntrySize = 512
+const minTableSize = 4 << 20
+const maxTableSize = 64 << 20
+const numMemtables = 2
+const numLevelZeroTables = 2
+const numLevelZeroTablesStall = 4
+const blockCacheSizePerOpenFile = 1 << 20
+
+// minOpenFiles is the number of files badger keeps open for an empty database: the manifest, the key registry,
+// one value log file and the level 0 tables written before the writes are stalled
+const minOpenFiles = 3 + numLevelZeroTablesStall
+
+var log = logger.GetOrCreate("storage/badgerdb")
+
+// DB holds a pointer to the badger database and the path to where it is stored.
+type DB struct {
+	mutDb             sync.RWMutex
+	db                *badger.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the badger persister
+// It creates the files in the location given as parameter. The memtables are sized to hold a full batch and
+// the block cache is sized from maxOpenFiles. As badger keeps all its table and value log files open, a
+// maxOpenFiles value below the number of files badger needs is rejected.
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (s *DB, err error) {
+	constructorName := "NewBadgerDB"
+
+	sw := core.NewStopWatch()
+	sw.Start(constructorName)
+
+	sw.Start(mkdirAllFunction)
+	err = os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+	sw.Stop(mkdirAllFunction)
+
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+	if maxOpenFiles < minOpenFiles {
+		return nil, fmt.Errorf("%w: badger needs at least %d open files, provided %d",
+			storage.ErrInvalidNumOpenFiles, minOpenFiles, maxOpenFiles)
+	}
+
+	options := badger.DefaultOptions(path).
+		WithLogger(&badgerLogger{}).
+		WithValueLogFileSize(valueLogFileSize).
+		WithMaxTableSize(computeTableSize(maxBatchSize)).
+		WithNumMemtables(numMemtables).
+		WithNumLevelZeroTables(numLevelZeroTables).
+		WithNumLevelZeroTablesStall(numLevelZeroTablesStall).
+		WithKeepL0InMemory(false).
+		WithMaxCacheSize(int64(maxOpenFiles) * blockCacheSizePerOpenFile).
+		// discard the partially written entries of the value log after an unclean shutdown
+		WithTruncate(true)
+
+	sw.Start(openBadgerDBFunction)
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+	sw.Stop(openBadgerDBFunction)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		batch:             NewBatch(),
+		cancel:            cancel,
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+	go dbStore.valueLogGCHandle(ctx)
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	sw.Stop(constructorName)
+
+	logArguments := []interface{}{"path", path, "created pointer", fmt.Sprintf("%p", db)}
+	logArguments = append(logArguments, sw.GetMeasurements()...)
+	log.Debug("opened badger db persister", logArguments...)
+
+	return dbStore, nil
+}
+
+// computeTableSize returns the memtable (and level 0 table) size needed to hold a full batch
+func computeTableSize(maxBatchSize int) int64 {
+	tableSize := int64(maxBatchSize) * estimatedEntrySize
+	if tableSize < minTableSize {
+		return minTableSize
+	}
+	if tableSize > maxTableSize {
+		return maxTableSize
+	}
+
+	return tableSize
+}
+
+func (s *DB) getDbPointer() *badger.DB {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	return s.db
+}
+
+func (s *DB) makeDbPointerNilReturningLast() *badger.DB {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	db := s.db
+	s.db = nil
+
+	return db
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			s.mutBatch.Lock()
+			err := s.putBatch(s.batch)
+			if err != nil {
+				log.Warn("badger putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// valueLogGCHandle periodically reclaims the space held by the stale entries of the value log
+func (s *DB) valueLogGCHandle(ctx context.Context) {
+	timer := time.NewTimer(valueLogGCInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(valueLogGCInterval)
+
+		select {
+		case <-timer.C:
+			s.runValueLogGC()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *DB) runValueLogGC() {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return
+	}
+
+	for {
+		// each successful call rewrites one value log file, so keep going until nothing is left to collect
+		err := s.db.RunValueLogGC(valueLogGCDiscardRatio)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("badger putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	db := s.getDbPointer()
+	if db == nil {
+		return nil, errors.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return data, nil
+	}
+
+	err := db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		data, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	db := s.getDbPointer()
+	if db == nil {
+		return errors.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return storage.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return nil
+	}
+
+	err := db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// putBatch writes the Batch data into the database
+func (s *DB) putBatch(b *batch) error {
+	db := s.getDbPointer()
+	if db == nil {
+		return errors.ErrDBIsClosed
+	}
+
+	wb := db.NewWriteBatch()
+	err := b.writeTo(wb)
+	if err != nil {
+		wb.Cancel()
+		return err
+	}
+
+	return wb.Flush()
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	db := s.getDbPointer()
+	if db == nil {
+		return
+	}
+
+	err := db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			val, errCopy := item.ValueCopy(nil)
+			if errCopy != nil {
+				return errCopy
+			}
+
+			shouldContinue := handler(item.KeyCopy(nil), val)
+			if !shouldContinue {
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("badger RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		return db.Close()
+	}
+
+	return nil
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
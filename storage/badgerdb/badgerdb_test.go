@@ -0,0 +1,240 @@
+package badgerdb_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBadgerDb(t *testing.T, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (p *badgerdb.DB) {
+	bdb, err := badgerdb.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize, maxOpenFiles)
+
+	assert.Nil(t, err, "Failed creating badger database file")
+	return bdb
+}
+
+func TestNewDB_InvalidMaxOpenFilesShouldError(t *testing.T) {
+	bdb, err := badgerdb.NewDB(t.TempDir(), 10, 1, 0)
+
+	assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+	assert.Nil(t, bdb)
+}
+
+func TestNewDB_TooFewOpenFilesForBadgerShouldError(t *testing.T) {
+	bdb, err := badgerdb.NewDB(t.TempDir(), 10, 1, 2)
+
+	assert.ErrorIs(t, err, storage.ErrInvalidNumOpenFiles)
+	assert.Nil(t, bdb)
+}
+
+func TestDB_DoubleOpenShouldError(t *testing.T) {
+	dir := t.TempDir()
+	bdb1, err := badgerdb.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+	}()
+
+	_, err = badgerdb.NewDB(dir, 10, 1, 10)
+	assert.NotNil(t, err)
+}
+
+func TestDB_ReopenShouldKeepTheData(t *testing.T) {
+	dir := t.TempDir()
+	bdb, err := badgerdb.NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+
+	key, val := []byte("key"), []byte("value")
+	// the batch is not full, it should be written on close
+	err = bdb.Put(key, val)
+	require.Nil(t, err)
+	_ = bdb.Close()
+
+	bdb, err = badgerdb.NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	recovered, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_GetErrorAfterPutBeforeTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100, 10)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	v, err := bdb.Get(key)
+	assert.Equal(t, val, v)
+	assert.Nil(t, err)
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100, 10)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 3)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_RemoveAfterTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100, 10)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 2)
+
+	_ = bdb.Remove(key)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_HasAndRemove(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 10, 1, 10)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	err := bdb.Has(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	err = bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	err = bdb.Has(key)
+	assert.Nil(t, err)
+
+	err = bdb.Remove(key)
+	assert.Nil(t, err)
+
+	err = bdb.Has(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_BatchShouldKeepTheOperationsOrder(t *testing.T) {
+	key := []byte("key")
+	bdb := createBadgerDb(t, 10, 4, 10)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	_ = bdb.Put(key, []byte("value1"))
+	_ = bdb.Remove(key)
+	_ = bdb.Put(key, []byte("value2"))
+	// the 4th operation writes the batch
+	_ = bdb.Put([]byte("other key"), []byte("other value"))
+
+	recovered, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), recovered)
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 1, 10)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+	}
+
+	for key, val := range keysVals {
+		_ = bdb.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	handler := func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	}
+
+	bdb.RangeKeys(handler)
+	assert.Equal(t, keysVals, recovered)
+
+	numCalls := 0
+	bdb.RangeKeys(func(key []byte, val []byte) bool {
+		numCalls++
+		return false
+	})
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestDB_MethodCallsAfterCloseOrDestroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when closing", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *badgerdb.DB) {
+			_ = db.Close()
+		})
+	})
+	t.Run("when destroying", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *badgerdb.DB) {
+			_ = db.Destroy()
+		})
+	})
+}
+
+func testDbAllMethodsShouldNotPanic(t *testing.T, closeHandler func(db *badgerdb.DB)) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panic %v", r))
+		}
+	}()
+
+	bdb := createBadgerDb(t, 1, 1, 10)
+	closeHandler(bdb)
+
+	err := bdb.Put([]byte("key1"), []byte("val1"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	_, err = bdb.Get([]byte("key2"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	err = bdb.Has([]byte("key3"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	bdb.RangeKeys(func(key []byte, value []byte) bool {
+		require.Fail(t, "should have not called range")
+		return false
+	})
+
+	err = bdb.Remove([]byte("key4"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+}
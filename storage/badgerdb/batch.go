@@ -0,0 +1,105 @@
+package badgerdb
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+type batchOperation struct {
+	key    []byte
+	val    []byte
+	remove bool
+}
+
+type batch struct {
+	operations  []*batchOperation
+	cachedData  map[string][]byte
+	removedData map[string]struct{}
+	mutBatch    sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		operations:  make([]*batchOperation, 0),
+		cachedData:  make(map[string][]byte),
+		removedData: make(map[string]struct{}),
+		mutBatch:    sync.RWMutex{},
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	b.operations = append(b.operations, &batchOperation{key: key, val: val})
+	b.cachedData[string(key)] = val
+	delete(b.removedData, string(key))
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	b.operations = append(b.operations, &batchOperation{key: key, remove: true})
+	b.removedData[string(key)] = struct{}{}
+	delete(b.cachedData, string(key))
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.operations = make([]*batchOperation, 0)
+	b.cachedData = make(map[string][]byte)
+	b.removedData = make(map[string]struct{})
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+// IsRemoved returns true if the key is marked for removal
+func (b *batch) IsRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	_, found := b.removedData[string(key)]
+
+	return found
+}
+
+// writeTo applies, in order, the batch operations on the provided badger write batch
+func (b *batch) writeTo(wb *badger.WriteBatch) error {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	for _, op := range b.operations {
+		var err error
+		if op.remove {
+			err = wb.Delete(op.key)
+		} else {
+			err = wb.Set(op.key, op.val)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
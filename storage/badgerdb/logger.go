@@ -0,0 +1,34 @@
+package badgerdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// badgerLogger redirects the badger internal logs towards the node's logger. The badger info messages are
+// rather verbose (compactions, flushes) so they are logged at debug level.
+type badgerLogger struct{}
+
+// Errorf logs the badger error messages
+func (bl *badgerLogger) Errorf(format string, args ...interface{}) {
+	log.Error(formatMessage(format, args...))
+}
+
+// Warningf logs the badger warning messages
+func (bl *badgerLogger) Warningf(format string, args ...interface{}) {
+	log.Warn(formatMessage(format, args...))
+}
+
+// Infof logs the badger info messages
+func (bl *badgerLogger) Infof(format string, args ...interface{}) {
+	log.Debug(formatMessage(format, args...))
+}
+
+// Debugf logs the badger debug messages
+func (bl *badgerLogger) Debugf(format string, args ...interface{}) {
+	log.Trace(formatMessage(format, args...))
+}
+
+func formatMessage(format string, args ...interface{}) string {
+	return strings.TrimSpace(fmt.Sprintf(format, args...))
+}
@@ -113,6 +113,10 @@ var ErrImmuneItemsCapacityReached = errors.New("capacity reached for immune item
 // ErrItemAlreadyInCache signals that an item is already in cache
 var ErrItemAlreadyInCache = errors.New("item already in cache")
 
+// ErrUnderpricedReplacement signals that a transaction meant to replace a pending one (same sender and nonce) does not
+// have a high enough gas price
+var ErrUnderpricedReplacement = errors.New("replacement transaction underpriced")
+
 // ErrCacheSizeInvalid signals that size of cache is less than 1
 var ErrCacheSizeInvalid = errors.New("cache size is less than 1")
 
@@ -162,3 +166,6 @@ func IsNotFoundInStorageErr(err error) bool {
 
 	return strings.Contains(err.Error(), "not found")
 }
+
+// ErrNilPoolJournal signals that a nil pool journal has been provided
+var ErrNilPoolJournal = errors.New("nil pool journal")
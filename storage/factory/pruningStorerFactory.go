@@ -289,6 +289,16 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		return nil, err
 	}
 
+	err = psf.setupOutportOutboxStorer(store)
+	if err != nil {
+		return nil, err
+	}
+
+	err = psf.setupEquivocationsStorer(store)
+	if err != nil {
+		return nil, err
+	}
+
 	err = psf.initOldDatabasesCleaningIfNeeded(store)
 	if err != nil {
 		return nil, err
@@ -485,6 +495,16 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	err = psf.setupOutportOutboxStorer(store)
+	if err != nil {
+		return nil, err
+	}
+
+	err = psf.setupEquivocationsStorer(store)
+	if err != nil {
+		return nil, err
+	}
+
 	err = psf.initOldDatabasesCleaningIfNeeded(store)
 	if err != nil {
 		return nil, err
@@ -515,6 +535,46 @@ func (psf *StorageServiceFactory) setupLogsAndEventsStorer(chainStorer *dataRetr
 	return nil
 }
 
+func (psf *StorageServiceFactory) setupOutportOutboxStorer(chainStorer *dataRetriever.ChainStorer) error {
+	if !psf.generalConfig.OutportOutbox.Enabled {
+		return nil
+	}
+
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+
+	// Create the outportOutbox (STATIC) storer
+	outportOutboxConfig := psf.generalConfig.OutportOutbox.Storage
+	outportOutboxDbConfig := GetDBFromConfig(outportOutboxConfig.DB)
+	outportOutboxDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, outportOutboxConfig.DB.FilePath)
+	outportOutboxCacherConfig := GetCacherFromConfig(outportOutboxConfig.Cache)
+	outportOutboxUnit, err := storageUnit.NewStorageUnitFromConf(outportOutboxCacherConfig, outportOutboxDbConfig)
+	if err != nil {
+		return err
+	}
+
+	chainStorer.AddStorer(dataRetriever.OutportOutboxUnit, outportOutboxUnit)
+
+	return nil
+}
+
+func (psf *StorageServiceFactory) setupEquivocationsStorer(chainStorer *dataRetriever.ChainStorer) error {
+	if !psf.generalConfig.EquivocationDetection.Enabled {
+		return nil
+	}
+
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+
+	// Create the equivocations (STATIC) storer
+	equivocationsUnit, err := psf.createStaticStorageUnit(psf.generalConfig.EquivocationDetection.Storage, shardID)
+	if err != nil {
+		return err
+	}
+
+	chainStorer.AddStorer(dataRetriever.EquivocationsUnit, equivocationsUnit)
+
+	return nil
+}
+
 func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetriever.ChainStorer) error {
 	if !psf.generalConfig.DbLookupExtensions.Enabled {
 		return nil
@@ -589,9 +649,47 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
 
+	if psf.generalConfig.DbLookupExtensions.AddressTransactionsIndexEnabled {
+		// Create the addressTransactions (STATIC) storer
+		addressTransactionsUnit, errCreate := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.AddressTransactionsStorageConfig, shardID)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		chainStorer.AddStorer(dataRetriever.AddressTransactionsUnit, addressTransactionsUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.StateChangesEnabled {
+		// Create the stateChanges (STATIC) storer
+		stateChangesUnit, errCreate := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.StateChangesStorageConfig, shardID)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		chainStorer.AddStorer(dataRetriever.StateChangesUnit, stateChangesUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.EventsIndexEnabled {
+		// Create the events (STATIC) storer
+		eventsUnit, errCreate := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.EventsStorageConfig, shardID)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		chainStorer.AddStorer(dataRetriever.EventsUnit, eventsUnit)
+	}
+
 	return nil
 }
 
+func (psf *StorageServiceFactory) createStaticStorageUnit(storageConfig config.StorageConfig, shardID string) (*storageUnit.Unit, error) {
+	dbConfig := GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = psf.pathManager.PathForStatic(shardID, storageConfig.DB.FilePath)
+	cacherConfig := GetCacherFromConfig(storageConfig.Cache)
+
+	return storageUnit.NewStorageUnitFromConf(cacherConfig, dbConfig)
+}
+
 func (psf *StorageServiceFactory) createPruningStorerArgs(
 	storageConfig config.StorageConfig,
 	customDatabaseRemover storage.CustomDatabaseRemoverHandler,
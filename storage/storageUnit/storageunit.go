@@ -16,6 +16,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -48,6 +49,7 @@ const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
 	MemoryDB    DBType = "MemoryDB"
+	BadgerDB    DBType = "BadgerDB"
 )
 
 const (
@@ -376,6 +378,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case MemoryDB:
 			db = memorydb.New()
+		case BadgerDB:
+			db, err = badgerdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		default:
 			return nil, storage.ErrNotSupportedDBType
 		}
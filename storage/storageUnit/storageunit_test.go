@@ -300,6 +300,22 @@ func TestCreateDBFromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestCreateDBFromConfBadgerDBOk(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.BadgerDB,
+		Path:              t.TempDir(),
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, err, "no error expected")
+	assert.NotNil(t, persister, "valid persister expected but got nil")
+
+	err = persister.Destroy()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
 func TestNewStorageUnit_FromConfWrongCacheSizeVsBatchSize(t *testing.T) {
 
 	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
@@ -17,6 +18,8 @@ const maxNumBytesPerSenderLowerBound = maxNumItemsPerSenderLowerBound * 1
 const maxNumBytesPerSenderUpperBound = 33_554_432 // 32 MB
 const numTxsToPreemptivelyEvictLowerBound = 1
 const numSendersToPreemptivelyEvictLowerBound = 1
+const minGasPriceBumpPercentageLowerBound = 1
+const minGasPriceBumpPercentageUpperBound = 1000
 
 // ConfigSourceMe holds cache configuration
 type ConfigSourceMe struct {
@@ -28,11 +31,22 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+	Replacement                   ReplacementConfig
+}
+
+// ReplacementConfig holds the configuration of the replace-by-fee policy. When enabled, a transaction replaces the
+// pending one having the same sender and nonce only if its gas price is higher by at least MinGasPriceBumpPercentage.
+// When disabled, the transactions having the same sender and nonce are kept side by side, ordered by gas price.
+type ReplacementConfig struct {
+	Enabled                   bool
+	MinGasPriceBumpPercentage uint32
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                 uint32
+	maxNumBytes               uint32
+	replacementEnabled        bool
+	minGasPriceBumpPercentage uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -60,17 +74,37 @@ func (config *ConfigSourceMe) verify() error {
 			return fmt.Errorf("%w: config.NumSendersToPreemptivelyEvict is invalid", storage.ErrInvalidConfig)
 		}
 	}
+	if config.Replacement.Enabled {
+		bump := config.Replacement.MinGasPriceBumpPercentage
+		if bump < minGasPriceBumpPercentageLowerBound || bump > minGasPriceBumpPercentageUpperBound {
+			return fmt.Errorf("%w: config.Replacement.MinGasPriceBumpPercentage is invalid", storage.ErrInvalidConfig)
+		}
+	}
 
 	return nil
 }
 
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:               config.NumBytesPerSenderThreshold,
+		maxNumTxs:                 config.CountPerSenderThreshold,
+		replacementEnabled:        config.Replacement.Enabled,
+		minGasPriceBumpPercentage: config.Replacement.MinGasPriceBumpPercentage,
 	}
 }
 
+// computeMinReplacementGasPrice returns the minimum gas price a transaction should have in order to replace a pending
+// transaction (with the same sender and nonce) having the provided gas price
+func (constraints *senderConstraints) computeMinReplacementGasPrice(gasPrice uint64) uint64 {
+	bumpedGasPrice := core.SafeMul(gasPrice, uint64(100+constraints.minGasPriceBumpPercentage)) / 100
+	if bumpedGasPrice <= gasPrice {
+		// the bump should be at least one unit, regardless of the rounding
+		return gasPrice + 1
+	}
+
+	return bumpedGasPrice
+}
+
 // String returns a readable representation of the object
 func (config *ConfigSourceMe) String() string {
 	bytes, err := json.Marshal(config)
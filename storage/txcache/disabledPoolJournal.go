@@ -0,0 +1,33 @@
+package txcache
+
+type disabledPoolJournal struct {
+}
+
+// NewDisabledPoolJournal creates a pool journal which does not record anything
+func NewDisabledPoolJournal() *disabledPoolJournal {
+	return &disabledPoolJournal{}
+}
+
+// Record does nothing
+func (journal *disabledPoolJournal) Record(_ *PoolJournalEntry) {
+}
+
+// GetEntriesForTx returns an empty slice
+func (journal *disabledPoolJournal) GetEntriesForTx(_ []byte) []*PoolJournalEntry {
+	return make([]*PoolJournalEntry, 0)
+}
+
+// GetLatestEvictions returns an empty slice
+func (journal *disabledPoolJournal) GetLatestEvictions(_ int) []*PoolJournalEntry {
+	return make([]*PoolJournalEntry, 0)
+}
+
+// IsEnabled returns false
+func (journal *disabledPoolJournal) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *disabledPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -95,7 +95,7 @@ func (cache *TxCache) evictSendersWhile(shouldContinue func() bool) (step uint32
 		batchEndBounded := core.MinUint32(batchEnd, snapshotLength)
 		batch := snapshot[batchStart:batchEndBounded]
 
-		numTxsEvictedInStep, numSendersEvictedInStep := cache.evictSendersAndTheirTxs(batch)
+		numTxsEvictedInStep, numSendersEvictedInStep := cache.evictSendersAndTheirTxs(batch, RemovalReasonEvicted)
 
 		numTxs += numTxsEvictedInStep
 		numSenders += numSendersEvictedInStep
@@ -115,13 +115,16 @@ func (cache *TxCache) evictSendersWhile(shouldContinue func() bool) (step uint32
 }
 
 // This is called concurrently by two goroutines: the eviction one and the sweeping one
-func (cache *TxCache) evictSendersAndTheirTxs(listsToEvict []*txListForSender) (uint32, uint32) {
+func (cache *TxCache) evictSendersAndTheirTxs(listsToEvict []*txListForSender, reason RemovalReason) (uint32, uint32) {
 	sendersToEvict := make([]string, 0, len(listsToEvict))
 	txsToEvict := make([][]byte, 0, approximatelyCountTxInLists(listsToEvict))
 
 	for _, txList := range listsToEvict {
+		txHashes := txList.getTxHashes()
+		cache.recordRemovals(txHashes, reason, txList.getLastComputedScore())
+
 		sendersToEvict = append(sendersToEvict, txList.sender)
-		txsToEvict = append(txsToEvict, txList.getTxHashes()...)
+		txsToEvict = append(txsToEvict, txHashes...)
 	}
 
 	return cache.doEvictItems(txsToEvict, sendersToEvict)
@@ -293,16 +293,50 @@ func Test_EvictSendersAndTheirTxs_Concurrently(t *testing.T) {
 
 		go func() {
 			snapshot := cache.txListBySender.getSnapshotAscending()
-			cache.evictSendersAndTheirTxs(snapshot)
+			cache.evictSendersAndTheirTxs(snapshot, RemovalReasonEvicted)
 			wg.Done()
 		}()
 
 		go func() {
 			snapshot := cache.txListBySender.getSnapshotAscending()
-			cache.evictSendersAndTheirTxs(snapshot)
+			cache.evictSendersAndTheirTxs(snapshot, RemovalReasonEvicted)
 			wg.Done()
 		}()
 	}
 
 	wg.Wait()
 }
+
+func TestEviction_EvictedTxsAreRecordedInPoolJournal(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                          "untitled",
+		NumChunks:                     16,
+		CountThreshold:                100,
+		CountPerSenderThreshold:       math.MaxUint32,
+		NumSendersToPreemptivelyEvict: 20,
+		NumBytesThreshold:             maxNumBytesUpperBound,
+		NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+	}
+
+	txGasHandler, _ := dummyParams()
+	cache, _ := NewTxCache(config, txGasHandler)
+	journal, _ := NewPoolJournal(1000)
+	_ = cache.SetPoolJournal(journal)
+
+	// 200 senders, each with 1 transaction
+	for index := 0; index < 200; index++ {
+		sender := string(createFakeSenderAddress(index))
+		cache.AddTx(createTx([]byte{byte(index)}, sender, uint64(1)))
+	}
+
+	cache.makeSnapshotOfSenders()
+	_, nTxs, _ := cache.evictSendersInLoop()
+
+	evictions := journal.GetLatestEvictions(1000)
+	require.Equal(t, int(nTxs), len(evictions))
+	for _, entry := range evictions {
+		require.Equal(t, RemovalReasonEvicted, entry.Reason)
+		require.Equal(t, "untitled", entry.CacheID)
+		require.False(t, cache.Has(entry.TxHash))
+	}
+}
@@ -21,3 +21,12 @@ type TxGasHandler interface {
 
 // ForEachTransaction is an iterator callback
 type ForEachTransaction func(txHash []byte, value *WrappedTransaction)
+
+// PoolJournalHandler defines the component which records the transactions leaving (or being rejected by) the pool
+type PoolJournalHandler interface {
+	Record(entry *PoolJournalEntry)
+	GetEntriesForTx(txHash []byte) []*PoolJournalEntry
+	GetLatestEvictions(maxNum int) []*PoolJournalEntry
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
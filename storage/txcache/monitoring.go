@@ -19,6 +19,11 @@ func (cache *TxCache) monitorEvictionWrtSenderLimit(sender []byte, evicted [][]b
 	}
 }
 
+func (cache *TxCache) monitorReplacement(tx *WrappedTransaction, replaced [][]byte) {
+	cache.numReplacedTxs.Add(int64(len(replaced)))
+	log.Trace("TxCache.AddTx() replaced transactions", "name", cache.name, "sender", tx.Tx.GetSndAddr(), "nonce", tx.Tx.GetNonce(), "tx", tx.TxHash, "num", len(replaced))
+}
+
 func (cache *TxCache) monitorEvictionStart() *core.StopWatch {
 	log.Debug("TxCache: eviction started", "name", cache.name, "numBytes", cache.NumBytes(), "txs", cache.CountTx(), "senders", cache.CountSenders())
 	cache.displaySendersHistogram()
@@ -154,6 +159,7 @@ func (cache *TxCache) diagnoseShallowly() {
 	log.Debug("TxCache.NumSenders:", "estimate", numSendersEstimate, "inChunks", numSendersInChunks, "inScoreChunks", numSendersInScoreChunks)
 	log.Debug("TxCache.NumSenders (continued):", "keys", len(sendersKeys), "keysSorted", len(sendersKeysSorted), "snapshot", len(sendersSnapshot))
 	log.Debug("TxCache.NumTxs:", "estimate", numTxsEstimate, "inChunks", numTxsInChunks, "keys", len(txsKeys))
+	log.Debug("TxCache.Replacements:", "replaced", cache.numReplacedTxs.Get(), "rejected", cache.numRejectedReplacements.Get())
 }
 
 func (cache *TxCache) diagnoseDeeply() {
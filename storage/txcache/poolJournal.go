@@ -0,0 +1,165 @@
+package txcache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// RemovalReason describes why a transaction has left (or never entered) the pool
+type RemovalReason string
+
+const (
+	// RemovalReasonIncluded signals that the transaction was removed after being included in a block
+	RemovalReasonIncluded RemovalReason = "included"
+	// RemovalReasonDiscarded signals that the transaction was found invalid at processing time (e.g. lower nonce, insufficient fee)
+	RemovalReasonDiscarded RemovalReason = "discarded"
+	// RemovalReasonExpired signals that the transaction was cleaned after not being processed for too many rounds
+	RemovalReasonExpired RemovalReason = "expired"
+	// RemovalReasonEvicted signals that the transaction was evicted because the pool capacity was exceeded
+	RemovalReasonEvicted RemovalReason = "evicted"
+	// RemovalReasonSwept signals that the transaction was swept because its sender had a persistent initial nonce gap
+	RemovalReasonSwept RemovalReason = "swept"
+	// RemovalReasonSenderLimit signals that the transaction was evicted because its sender exceeded the per-sender limits
+	RemovalReasonSenderLimit RemovalReason = "sender-limit"
+	// RemovalReasonRejected signals that the transaction was rejected by the interceptor, thus never entered the pool
+	RemovalReasonRejected RemovalReason = "rejected"
+	// RemovalReasonReplaced signals that the transaction was replaced by another one, having the same sender and nonce, but a higher gas price
+	RemovalReasonReplaced RemovalReason = "replaced"
+	// RemovalReasonUnderpriced signals that the transaction was meant to replace a pending one, but its gas price was too low, thus never entered the pool
+	RemovalReasonUnderpriced RemovalReason = "underpriced-replacement"
+)
+
+// PoolJournalEntry holds the details of a transaction removal from (or rejection by) the pool
+type PoolJournalEntry struct {
+	TxHash      []byte
+	Sender      []byte
+	Nonce       uint64
+	GasPrice    uint64
+	CacheID     string
+	Reason      RemovalReason
+	Details     string
+	Timestamp   int64
+	SenderScore uint32
+	PoolSize    uint64
+}
+
+// NewPoolJournalEntry creates a journal entry for the provided transaction, stamped with the current time
+func NewPoolJournalEntry(tx *WrappedTransaction, cacheID string, reason RemovalReason) *PoolJournalEntry {
+	return &PoolJournalEntry{
+		TxHash:    tx.TxHash,
+		Sender:    tx.Tx.GetSndAddr(),
+		Nonce:     tx.Tx.GetNonce(),
+		GasPrice:  tx.Tx.GetGasPrice(),
+		CacheID:   cacheID,
+		Reason:    reason,
+		Timestamp: time.Now().Unix(),
+	}
+}
+
+// IsEviction returns true if the transaction did not leave the pool due to its inclusion in a block
+func (entry *PoolJournalEntry) IsEviction() bool {
+	return entry.Reason != RemovalReasonIncluded
+}
+
+// poolJournal is a bounded, in-memory journal of pool removals and rejections.
+// Once the capacity is reached, the oldest entries are overwritten.
+type poolJournal struct {
+	mutex           sync.RWMutex
+	entries         []*PoolJournalEntry
+	nextIndex       int
+	numEntries      int
+	entriesByTxHash map[string][]*PoolJournalEntry
+}
+
+// NewPoolJournal creates a new pool journal able to hold at most "capacity" entries
+func NewPoolJournal(capacity uint32) (*poolJournal, error) {
+	if capacity == 0 {
+		return nil, fmt.Errorf("%w: pool journal capacity is invalid", storage.ErrInvalidConfig)
+	}
+
+	return &poolJournal{
+		entries:         make([]*PoolJournalEntry, capacity),
+		entriesByTxHash: make(map[string][]*PoolJournalEntry),
+	}, nil
+}
+
+// Record adds an entry in the journal, overwriting the oldest one if the capacity is reached
+func (journal *poolJournal) Record(entry *PoolJournalEntry) {
+	if entry == nil {
+		return
+	}
+
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	capacity := len(journal.entries)
+	if journal.numEntries == capacity {
+		journal.forgetEntry(journal.entries[journal.nextIndex])
+	} else {
+		journal.numEntries++
+	}
+
+	journal.entries[journal.nextIndex] = entry
+	journal.nextIndex = (journal.nextIndex + 1) % capacity
+
+	key := string(entry.TxHash)
+	journal.entriesByTxHash[key] = append(journal.entriesByTxHash[key], entry)
+}
+
+// forgetEntry should only be called in a critical section. The forgotten entry is always
+// the oldest one, thus the first one in the list of its transaction
+func (journal *poolJournal) forgetEntry(entry *PoolJournalEntry) {
+	key := string(entry.TxHash)
+	entriesForTx := journal.entriesByTxHash[key]
+	if len(entriesForTx) <= 1 {
+		delete(journal.entriesByTxHash, key)
+		return
+	}
+
+	journal.entriesByTxHash[key] = entriesForTx[1:]
+}
+
+// GetEntriesForTx returns the recorded entries for the given transaction hash, oldest first
+func (journal *poolJournal) GetEntriesForTx(txHash []byte) []*PoolJournalEntry {
+	journal.mutex.RLock()
+	defer journal.mutex.RUnlock()
+
+	entriesForTx := journal.entriesByTxHash[string(txHash)]
+	result := make([]*PoolJournalEntry, len(entriesForTx))
+	copy(result, entriesForTx)
+
+	return result
+}
+
+// GetLatestEvictions returns at most maxNum entries which do not signal an inclusion in a block, newest first
+func (journal *poolJournal) GetLatestEvictions(maxNum int) []*PoolJournalEntry {
+	journal.mutex.RLock()
+	defer journal.mutex.RUnlock()
+
+	result := make([]*PoolJournalEntry, 0)
+	capacity := len(journal.entries)
+	for i := 1; i <= journal.numEntries && len(result) < maxNum; i++ {
+		index := (journal.nextIndex - i + capacity) % capacity
+		entry := journal.entries[index]
+		if !entry.IsEviction() {
+			continue
+		}
+
+		result = append(result, entry)
+	}
+
+	return result
+}
+
+// IsEnabled returns true
+func (journal *poolJournal) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *poolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
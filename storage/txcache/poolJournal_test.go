@@ -0,0 +1,112 @@
+package txcache
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPoolJournal(t *testing.T) {
+	journal, err := NewPoolJournal(0)
+	require.Nil(t, journal)
+	require.True(t, errors.Is(err, storage.ErrInvalidConfig))
+
+	journal, err = NewPoolJournal(10)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(journal))
+	require.True(t, journal.IsEnabled())
+}
+
+func TestPoolJournal_RecordNilEntryDoesNothing(t *testing.T) {
+	journal, _ := NewPoolJournal(10)
+
+	journal.Record(nil)
+	require.Equal(t, 0, len(journal.GetLatestEvictions(10)))
+}
+
+func TestPoolJournal_GetEntriesForTx(t *testing.T) {
+	journal, _ := NewPoolJournal(10)
+
+	tx := createTx([]byte("hash-1"), "alice", 1)
+	journal.Record(NewPoolJournalEntry(tx, "0", RemovalReasonRejected))
+	journal.Record(NewPoolJournalEntry(tx, "0", RemovalReasonIncluded))
+	journal.Record(NewPoolJournalEntry(createTx([]byte("hash-2"), "bob", 1), "0", RemovalReasonEvicted))
+
+	entries := journal.GetEntriesForTx([]byte("hash-1"))
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, RemovalReasonRejected, entries[0].Reason)
+	require.Equal(t, RemovalReasonIncluded, entries[1].Reason)
+	require.Equal(t, []byte("alice"), entries[0].Sender)
+	require.Equal(t, uint64(1), entries[0].Nonce)
+	require.Equal(t, "0", entries[0].CacheID)
+
+	entries = journal.GetEntriesForTx([]byte("missing"))
+	require.Equal(t, 0, len(entries))
+}
+
+func TestPoolJournal_GetLatestEvictions(t *testing.T) {
+	journal, _ := NewPoolJournal(10)
+
+	journal.Record(NewPoolJournalEntry(createTx([]byte("hash-1"), "alice", 1), "0", RemovalReasonEvicted))
+	journal.Record(NewPoolJournalEntry(createTx([]byte("hash-2"), "alice", 2), "0", RemovalReasonIncluded))
+	journal.Record(NewPoolJournalEntry(createTx([]byte("hash-3"), "alice", 3), "0", RemovalReasonSwept))
+	journal.Record(NewPoolJournalEntry(createTx([]byte("hash-4"), "alice", 4), "0", RemovalReasonExpired))
+
+	evictions := journal.GetLatestEvictions(10)
+	require.Equal(t, 3, len(evictions))
+	require.Equal(t, []byte("hash-4"), evictions[0].TxHash)
+	require.Equal(t, []byte("hash-3"), evictions[1].TxHash)
+	require.Equal(t, []byte("hash-1"), evictions[2].TxHash)
+
+	evictions = journal.GetLatestEvictions(2)
+	require.Equal(t, 2, len(evictions))
+	require.Equal(t, []byte("hash-4"), evictions[0].TxHash)
+	require.Equal(t, []byte("hash-3"), evictions[1].TxHash)
+}
+
+func TestPoolJournal_OverwritesOldestEntries(t *testing.T) {
+	journal, _ := NewPoolJournal(3)
+
+	for i := 0; i < 5; i++ {
+		hash := []byte(fmt.Sprintf("hash-%d", i))
+		journal.Record(NewPoolJournalEntry(createTx(hash, "alice", uint64(i)), "0", RemovalReasonEvicted))
+	}
+
+	require.Equal(t, 0, len(journal.GetEntriesForTx([]byte("hash-0"))))
+	require.Equal(t, 0, len(journal.GetEntriesForTx([]byte("hash-1"))))
+	require.Equal(t, 1, len(journal.GetEntriesForTx([]byte("hash-2"))))
+
+	evictions := journal.GetLatestEvictions(10)
+	require.Equal(t, 3, len(evictions))
+	require.Equal(t, []byte("hash-4"), evictions[0].TxHash)
+	require.Equal(t, []byte("hash-2"), evictions[2].TxHash)
+	require.Equal(t, 3, len(journal.entriesByTxHash))
+}
+
+func TestPoolJournal_OverwritesOldestEntriesOfSameTx(t *testing.T) {
+	journal, _ := NewPoolJournal(2)
+
+	tx := createTx([]byte("hash-1"), "alice", 1)
+	journal.Record(NewPoolJournalEntry(tx, "0", RemovalReasonRejected))
+	journal.Record(NewPoolJournalEntry(tx, "0", RemovalReasonEvicted))
+	journal.Record(NewPoolJournalEntry(tx, "0", RemovalReasonIncluded))
+
+	entries := journal.GetEntriesForTx([]byte("hash-1"))
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, RemovalReasonEvicted, entries[0].Reason)
+	require.Equal(t, RemovalReasonIncluded, entries[1].Reason)
+}
+
+func TestDisabledPoolJournal_DoesNothing(t *testing.T) {
+	journal := NewDisabledPoolJournal()
+	require.False(t, check.IfNil(journal))
+	require.False(t, journal.IsEnabled())
+
+	journal.Record(NewPoolJournalEntry(createTx([]byte("hash-1"), "alice", 1), "0", RemovalReasonEvicted))
+	require.Equal(t, 0, len(journal.GetEntriesForTx([]byte("hash-1"))))
+	require.Equal(t, 0, len(journal.GetLatestEvictions(10)))
+}
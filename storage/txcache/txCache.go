@@ -10,6 +10,12 @@ import (
 
 var _ storage.Cacher = (*TxCache)(nil)
 
+// ReplacementStatistics holds the counters of the replace-by-fee policy
+type ReplacementStatistics struct {
+	NumReplacedTxs          uint64
+	NumRejectedReplacements uint64
+}
+
 // TxCache represents a cache-like structure (it has a fixed capacity and implements an eviction mechanism) for holding transactions
 type TxCache struct {
 	name                      string
@@ -24,9 +30,13 @@ type TxCache struct {
 	numSendersWithInitialGap  atomic.Counter
 	numSendersWithMiddleGap   atomic.Counter
 	numSendersInGracePeriod   atomic.Counter
+	numReplacedTxs            atomic.Counter
+	numRejectedReplacements   atomic.Counter
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
 	mutTxOperation            sync.Mutex
+	mutPoolJournal            sync.RWMutex
+	poolJournal               PoolJournalHandler
 }
 
 // NewTxCache creates a new transaction cache
@@ -54,6 +64,7 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 		txByHash:        newTxByHashMap(numChunks),
 		config:          config,
 		evictionJournal: evictionJournal{},
+		poolJournal:     NewDisabledPoolJournal(),
 	}
 
 	txCache.initSweepable()
@@ -62,6 +73,8 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 
 // AddTx adds a transaction in the cache
 // Eviction happens if maximum capacity is reached
+// If the replace-by-fee policy is enabled, the pending transactions having the same sender and nonce are replaced,
+// while the underpriced replacements are rejected (not added)
 func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	if tx == nil || check.IfNil(tx.Tx) {
 		return false, false
@@ -73,8 +86,17 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 
 	cache.mutTxOperation.Lock()
 	addedInByHash := cache.txByHash.addTx(tx)
-	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+	outcome, err := cache.txListBySender.addTx(tx)
+	if err != nil && addedInByHash {
+		_, _ = cache.txByHash.removeTx(string(tx.TxHash))
+	}
 	cache.mutTxOperation.Unlock()
+	if err != nil {
+		cache.onRejectedReplacement(tx, err)
+		return true, false
+	}
+
+	addedInBySender := outcome.added
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
 		// - A adds to "txByHash"
@@ -84,8 +106,16 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		log.Trace("TxCache.AddTx(): slight inconsistency detected:", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "addedInByHash", addedInByHash, "addedInBySender", addedInBySender)
 	}
 
+	if len(outcome.replaced) > 0 {
+		cache.monitorReplacement(tx, outcome.replaced)
+		cache.recordRemovals(outcome.replaced, RemovalReasonReplaced, cache.getSenderScore(tx.Tx.GetSndAddr()))
+		cache.txByHash.RemoveTxsBulk(outcome.replaced)
+	}
+
+	evicted := outcome.evicted
 	if len(evicted) > 0 {
 		cache.monitorEvictionWrtSenderLimit(tx.Tx.GetSndAddr(), evicted)
+		cache.recordRemovals(evicted, RemovalReasonSenderLimit, cache.getSenderScore(tx.Tx.GetSndAddr()))
 		cache.txByHash.RemoveTxsBulk(evicted)
 	}
 
@@ -94,6 +124,63 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	return true, addedInByHash || addedInBySender
 }
 
+func (cache *TxCache) onRejectedReplacement(tx *WrappedTransaction, err error) {
+	cache.numRejectedReplacements.Increment()
+	log.Trace("TxCache.AddTx(): underpriced replacement", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "err", err)
+
+	journal := cache.getPoolJournal()
+	if !journal.IsEnabled() {
+		return
+	}
+
+	entry := cache.newPoolJournalEntry(tx, RemovalReasonUnderpriced, cache.getSenderScore(tx.Tx.GetSndAddr()))
+	entry.Details = err.Error()
+	journal.Record(entry)
+}
+
+// CheckTxReplacement verifies whether the transaction would be rejected by the replace-by-fee policy, returning
+// ErrUnderpricedReplacement if so. The cache is not altered.
+func (cache *TxCache) CheckTxReplacement(tx *WrappedTransaction) error {
+	if tx == nil || check.IfNil(tx.Tx) {
+		return nil
+	}
+
+	return cache.txListBySender.checkReplacement(tx)
+}
+
+// RemoveTxsReplacedBy removes the pending transactions having the same sender and nonce as the provided one, which is
+// added in another cache. If the provided transaction is underpriced, ErrUnderpricedReplacement is returned and nothing
+// is removed.
+func (cache *TxCache) RemoveTxsReplacedBy(tx *WrappedTransaction) error {
+	if tx == nil || check.IfNil(tx.Tx) {
+		return nil
+	}
+
+	cache.mutTxOperation.Lock()
+	replaced, err := cache.txListBySender.removeTxsReplacedBy(tx)
+	cache.mutTxOperation.Unlock()
+	if err != nil {
+		cache.onRejectedReplacement(tx, err)
+		return err
+	}
+
+	if len(replaced) > 0 {
+		cache.monitorReplacement(tx, replaced)
+		cache.recordRemovals(replaced, RemovalReasonReplaced, cache.getSenderScore(tx.Tx.GetSndAddr()))
+		cache.txByHash.RemoveTxsBulk(replaced)
+	}
+
+	return nil
+}
+
+// GetReplacementStatistics returns the counters of the replace-by-fee policy
+func (cache *TxCache) GetReplacementStatistics() ReplacementStatistics {
+	return ReplacementStatistics{
+		NumReplacedTxs:          cache.numReplacedTxs.GetUint64(),
+		NumRejectedReplacements: cache.numRejectedReplacements.GetUint64(),
+	}
+}
+
 // GetByTxHash gets the transaction by hash
 func (cache *TxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	tx, ok := cache.txByHash.getTx(string(txHash))
@@ -167,9 +254,15 @@ func (cache *TxCache) RemoveTxByHash(txHash []byte) bool {
 	cache.mutTxOperation.Lock()
 	defer cache.mutTxOperation.Unlock()
 
+	_, found := cache.removeTxByHash(txHash)
+	return found
+}
+
+// This function should only be called in a critical section managed by "mutTxOperation"
+func (cache *TxCache) removeTxByHash(txHash []byte) (*WrappedTransaction, bool) {
 	tx, foundInByHash := cache.txByHash.removeTx(string(txHash))
 	if !foundInByHash {
-		return false
+		return nil, false
 	}
 
 	foundInBySender := cache.txListBySender.removeTx(tx)
@@ -185,7 +278,7 @@ func (cache *TxCache) RemoveTxByHash(txHash []byte) bool {
 		log.Trace("TxCache.RemoveTxByHash(): slight inconsistency detected: !foundInBySender", "name", cache.name, "tx", txHash)
 	}
 
-	return true
+	return tx, true
 }
 
 // NumBytes gets the approximate number of bytes stored in the cache
@@ -249,7 +342,7 @@ func (cache *TxCache) Put(_ []byte, _ interface{}, _ int) (evicted bool) {
 }
 
 // Get gets a transaction (unwrapped) by hash
-// Implemented for compatibility reasons (see txPoolsCleaner.go).
+// Implemented for compatibility reasons (see process/block/poolsCleaner/txsPoolsCleaner.go).
 func (cache *TxCache) Get(key []byte) (value interface{}, ok bool) {
 	tx, ok := cache.GetByTxHash(key)
 	if ok {
@@ -281,8 +374,15 @@ func (cache *TxCache) HasOrAdd(_ []byte, _ interface{}, _ int) (has, added bool)
 }
 
 // Remove removes tx by hash
+// Implemented for compatibility reasons (see process/block/poolsCleaner/txsPoolsCleaner.go), thus the removal is journaled as an expiration.
 func (cache *TxCache) Remove(key []byte) {
-	_ = cache.RemoveTxByHash(key)
+	cache.mutTxOperation.Lock()
+	tx, found := cache.removeTxByHash(key)
+	cache.mutTxOperation.Unlock()
+
+	if found {
+		cache.recordRemoval(tx, RemovalReasonExpired)
+	}
 }
 
 // Keys returns the tx hashes in the cache
@@ -316,6 +416,69 @@ func (cache *TxCache) NotifyAccountNonce(accountKey []byte, nonce uint64) {
 func (cache *TxCache) ImmunizeTxsAgainstEviction(_ [][]byte) {
 }
 
+// SetPoolJournal sets the journal in which the removals of transactions are recorded
+func (cache *TxCache) SetPoolJournal(journal PoolJournalHandler) error {
+	if check.IfNil(journal) {
+		return storage.ErrNilPoolJournal
+	}
+
+	cache.mutPoolJournal.Lock()
+	cache.poolJournal = journal
+	cache.mutPoolJournal.Unlock()
+
+	return nil
+}
+
+func (cache *TxCache) getPoolJournal() PoolJournalHandler {
+	cache.mutPoolJournal.RLock()
+	defer cache.mutPoolJournal.RUnlock()
+
+	return cache.poolJournal
+}
+
+func (cache *TxCache) recordRemoval(tx *WrappedTransaction, reason RemovalReason) {
+	journal := cache.getPoolJournal()
+	if !journal.IsEnabled() {
+		return
+	}
+
+	journal.Record(cache.newPoolJournalEntry(tx, reason, cache.getSenderScore(tx.Tx.GetSndAddr())))
+}
+
+// recordRemovals should be called before the transactions are actually removed from "txByHash"
+func (cache *TxCache) recordRemovals(txHashes [][]byte, reason RemovalReason, senderScore uint32) {
+	journal := cache.getPoolJournal()
+	if !journal.IsEnabled() {
+		return
+	}
+
+	for _, txHash := range txHashes {
+		tx, ok := cache.txByHash.getTx(string(txHash))
+		if !ok {
+			continue
+		}
+
+		journal.Record(cache.newPoolJournalEntry(tx, reason, senderScore))
+	}
+}
+
+func (cache *TxCache) newPoolJournalEntry(tx *WrappedTransaction, reason RemovalReason, senderScore uint32) *PoolJournalEntry {
+	entry := NewPoolJournalEntry(tx, cache.name, reason)
+	entry.SenderScore = senderScore
+	entry.PoolSize = cache.CountTx()
+
+	return entry
+}
+
+func (cache *TxCache) getSenderScore(sender []byte) uint32 {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return 0
+	}
+
+	return listForSender.getLastComputedScore()
+}
+
 // Close does nothing for this cacher implementation
 func (cache *TxCache) Close() error {
 	return nil
@@ -72,6 +72,14 @@ func Test_NewTxCache(t *testing.T) {
 	badConfig = withEvictionConfig
 	badConfig.NumSendersToPreemptivelyEvict = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.NumSendersToPreemptivelyEvict", txGasHandler)
+
+	badConfig = config
+	badConfig.Replacement = ReplacementConfig{Enabled: true, MinGasPriceBumpPercentage: 0}
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.Replacement.MinGasPriceBumpPercentage", txGasHandler)
+
+	badConfig = config
+	badConfig.Replacement = ReplacementConfig{Enabled: true, MinGasPriceBumpPercentage: minGasPriceBumpPercentageUpperBound + 1}
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.Replacement.MinGasPriceBumpPercentage", txGasHandler)
 }
 
 func requireErrorOnNewTxCache(t *testing.T, config ConfigSourceMe, errExpected error, errPartialMessage string, txGasHandler TxGasHandler) {
@@ -654,3 +662,117 @@ func newCacheToTest(numBytesPerSenderThreshold uint32, countPerSenderThreshold u
 
 	return cache
 }
+
+func TestTxCache_SetPoolJournal(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	err := cache.SetPoolJournal(nil)
+	require.Equal(t, storage.ErrNilPoolJournal, err)
+
+	journal, _ := NewPoolJournal(10)
+	err = cache.SetPoolJournal(journal)
+	require.Nil(t, err)
+	require.True(t, cache.getPoolJournal() == journal)
+}
+
+func TestTxCache_RemovalsAreRecordedInPoolJournal(t *testing.T) {
+	cache := newCacheToTest(maxNumBytesPerSenderUpperBound, 3)
+	journal, _ := NewPoolJournal(10)
+	_ = cache.SetPoolJournal(journal)
+
+	cache.AddTx(createTx([]byte("tx-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("tx-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("tx-alice-4"), "alice", 4))
+	cache.AddTx(createTx([]byte("tx-alice-3"), "alice", 3))
+
+	entries := journal.GetEntriesForTx([]byte("tx-alice-4"))
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, RemovalReasonSenderLimit, entries[0].Reason)
+	require.Equal(t, "test", entries[0].CacheID)
+
+	cache.Remove([]byte("tx-alice-1"))
+	entries = journal.GetEntriesForTx([]byte("tx-alice-1"))
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, RemovalReasonExpired, entries[0].Reason)
+	require.Equal(t, uint64(2), entries[0].PoolSize)
+
+	cache.Remove([]byte("missing"))
+	require.Equal(t, 0, len(journal.GetEntriesForTx([]byte("missing"))))
+}
+
+func TestTxCache_AddTxWithReplacementPolicy(t *testing.T) {
+	txGasHandler, _ := dummyParams()
+	cache, _ := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		Replacement: ReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+		},
+	}, txGasHandler)
+	journal, _ := NewPoolJournal(10)
+	_ = cache.SetPoolJournal(journal)
+
+	ok, added := cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 50000, oneBillion))
+	require.True(t, ok)
+	require.True(t, added)
+	ok, added = cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 128, 50000, oneBillion))
+	require.True(t, ok)
+	require.True(t, added)
+
+	underpricedTx := createTxWithParams([]byte("tx-alice-2-underpriced"), "alice", 2, 128, 50000, oneBillion+1)
+	require.True(t, errors.Is(cache.CheckTxReplacement(underpricedTx), storage.ErrUnderpricedReplacement))
+	ok, added = cache.AddTx(underpricedTx)
+	require.True(t, ok)
+	require.False(t, added)
+	require.False(t, cache.Has([]byte("tx-alice-2-underpriced")))
+	entries := journal.GetEntriesForTx([]byte("tx-alice-2-underpriced"))
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, RemovalReasonUnderpriced, entries[0].Reason)
+
+	replacementTx := createTxWithParams([]byte("tx-alice-2-replacement"), "alice", 2, 128, 50000, 2*oneBillion)
+	require.Nil(t, cache.CheckTxReplacement(replacementTx))
+	ok, added = cache.AddTx(replacementTx)
+	require.True(t, ok)
+	require.True(t, added)
+	require.True(t, cache.Has([]byte("tx-alice-2-replacement")))
+	require.False(t, cache.Has([]byte("tx-alice-2")))
+	require.Equal(t, uint64(2), cache.CountTx())
+	entries = journal.GetEntriesForTx([]byte("tx-alice-2"))
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, RemovalReasonReplaced, entries[0].Reason)
+
+	require.Equal(t, ReplacementStatistics{NumReplacedTxs: 1, NumRejectedReplacements: 1}, cache.GetReplacementStatistics())
+	cache.Diagnose(true)
+}
+
+func TestTxCache_RemoveTxsReplacedBy(t *testing.T) {
+	txGasHandler, _ := dummyParams()
+	cache, _ := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		Replacement: ReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+		},
+	}, txGasHandler)
+
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 50000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 128, 50000, oneBillion))
+
+	err := cache.RemoveTxsReplacedBy(createTxWithParams([]byte("tx-alice-2-underpriced"), "alice", 2, 128, 50000, oneBillion+1))
+	require.True(t, errors.Is(err, storage.ErrUnderpricedReplacement))
+	require.True(t, cache.Has([]byte("tx-alice-2")))
+
+	err = cache.RemoveTxsReplacedBy(createTxWithParams([]byte("tx-alice-2-replacement"), "alice", 2, 128, 50000, 2*oneBillion))
+	require.Nil(t, err)
+	require.False(t, cache.Has([]byte("tx-alice-2")))
+	require.False(t, cache.Has([]byte("tx-alice-2-replacement")))
+	require.True(t, cache.Has([]byte("tx-alice-1")))
+	require.Equal(t, uint64(1), cache.CountTx())
+	require.Equal(t, ReplacementStatistics{NumReplacedTxs: 1, NumRejectedReplacements: 1}, cache.GetReplacementStatistics())
+}
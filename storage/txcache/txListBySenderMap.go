@@ -40,12 +40,38 @@ func newTxListBySenderMap(
 }
 
 // addTx adds a transaction in the map, in the corresponding list (selected by its sender)
-func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (bool, [][]byte) {
+func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (addTxOutcome, error) {
 	sender := string(tx.Tx.GetSndAddr())
 	listForSender := txMap.getOrAddListForSender(sender)
 	return listForSender.AddTx(tx, txMap.txGasHandler, txMap.txFeeHelper)
 }
 
+// checkReplacement verifies whether the transaction can be added with respect to the replace-by-fee policy
+func (txMap *txListBySenderMap) checkReplacement(tx *WrappedTransaction) error {
+	listForSender, ok := txMap.getListForSender(string(tx.Tx.GetSndAddr()))
+	if !ok {
+		return nil
+	}
+
+	return listForSender.checkReplacement(tx)
+}
+
+// removeTxsReplacedBy removes the transactions of the sender having the same nonce as the provided one
+func (txMap *txListBySenderMap) removeTxsReplacedBy(tx *WrappedTransaction) ([][]byte, error) {
+	sender := string(tx.Tx.GetSndAddr())
+	listForSender, ok := txMap.getListForSender(sender)
+	if !ok {
+		return nil, nil
+	}
+
+	replaced, err := listForSender.removeTxsReplacedBy(tx)
+	if listForSender.IsEmpty() {
+		txMap.removeSender(sender)
+	}
+
+	return replaced, err
+}
+
 // getOrAddListForSender gets or lazily creates a list (using double-checked locking pattern)
 func (txMap *txListBySenderMap) getOrAddListForSender(sender string) *txListForSender {
 	listForSender, ok := txMap.getListForSender(sender)
@@ -3,8 +3,10 @@ package txcache
 import (
 	"bytes"
 	"container/list"
+	"fmt"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache/maps"
@@ -47,16 +49,38 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 	}
 }
 
+// addTxOutcome holds the effects of adding a transaction in a sender's list
+type addTxOutcome struct {
+	added    bool
+	evicted  [][]byte
+	replaced [][]byte
+}
+
 // AddTx adds a transaction in sender's list
 // This is a "sorted" insert
-func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte) {
+// If the replace-by-fee policy is enabled, the transactions having the same nonce as the incoming one are replaced,
+// provided that the incoming transaction has a high enough gas price. Otherwise, ErrUnderpricedReplacement is returned.
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (addTxOutcome, error) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
+	var replaced [][]byte
+	if listForSender.constraints.replacementEnabled {
+		toReplace, err := listForSender.findTxsToReplace(tx)
+		if err == storage.ErrItemAlreadyInCache {
+			return addTxOutcome{}, nil
+		}
+		if err != nil {
+			return addTxOutcome{}, err
+		}
+
+		replaced = listForSender.removeListElements(toReplace)
+	}
+
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
-		return false, nil
+		return addTxOutcome{}, nil
 	}
 
 	if insertionPlace == nil {
@@ -68,7 +92,111 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler T
 	listForSender.onAddedTransaction(tx, gasHandler, txFeeHelper)
 	evicted := listForSender.applySizeConstraints()
 	listForSender.triggerScoreChange()
-	return true, evicted
+
+	return addTxOutcome{
+		added:    true,
+		evicted:  evicted,
+		replaced: replaced,
+	}, nil
+}
+
+// findTxsToReplace returns the transactions having the same nonce as the incoming one, if the incoming transaction
+// has a high enough gas price to replace all of them
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) findTxsToReplace(incomingTx *WrappedTransaction) ([]*list.Element, error) {
+	incomingNonce := incomingTx.Tx.GetNonce()
+	highestGasPrice := uint64(0)
+	sameNonceElements := make([]*list.Element, 0)
+
+	for element := listForSender.items.Back(); element != nil; element = element.Prev() {
+		currentTx := element.Value.(*WrappedTransaction)
+		currentTxNonce := currentTx.Tx.GetNonce()
+
+		if currentTxNonce < incomingNonce {
+			// Optimization: stop search at this point, since the list is sorted by nonce
+			break
+		}
+		if currentTxNonce > incomingNonce {
+			continue
+		}
+		if incomingTx.sameAs(currentTx) {
+			return nil, storage.ErrItemAlreadyInCache
+		}
+
+		highestGasPrice = core.MaxUint64(highestGasPrice, currentTx.Tx.GetGasPrice())
+		sameNonceElements = append(sameNonceElements, element)
+	}
+
+	if len(sameNonceElements) == 0 {
+		return nil, nil
+	}
+
+	minGasPrice := listForSender.constraints.computeMinReplacementGasPrice(highestGasPrice)
+	if incomingTx.Tx.GetGasPrice() < minGasPrice {
+		return nil, fmt.Errorf("%w: nonce %d, gas price %d, minimum gas price for replacement %d",
+			storage.ErrUnderpricedReplacement, incomingNonce, incomingTx.Tx.GetGasPrice(), minGasPrice)
+	}
+
+	return sameNonceElements, nil
+}
+
+// checkReplacement verifies, without altering the list, whether the transaction would be rejected as an underpriced replacement
+func (listForSender *txListForSender) checkReplacement(tx *WrappedTransaction) error {
+	if !listForSender.constraints.replacementEnabled {
+		return nil
+	}
+
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	_, err := listForSender.findTxsToReplace(tx)
+	if err == storage.ErrItemAlreadyInCache {
+		return nil
+	}
+
+	return err
+}
+
+// removeTxsReplacedBy removes the transactions having the same nonce as the provided one, which was added elsewhere,
+// provided that it has a high enough gas price. Otherwise, ErrUnderpricedReplacement is returned and nothing is removed.
+func (listForSender *txListForSender) removeTxsReplacedBy(tx *WrappedTransaction) ([][]byte, error) {
+	if !listForSender.constraints.replacementEnabled {
+		return nil, nil
+	}
+
+	listForSender.mutex.Lock()
+	defer listForSender.mutex.Unlock()
+
+	toReplace, err := listForSender.findTxsToReplace(tx)
+	if err == storage.ErrItemAlreadyInCache {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(toReplace) == 0 {
+		return nil, nil
+	}
+
+	replaced := listForSender.removeListElements(toReplace)
+	listForSender.triggerScoreChange()
+
+	return replaced, nil
+}
+
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) removeListElements(elements []*list.Element) [][]byte {
+	removedTxHashes := make([][]byte, 0, len(elements))
+
+	for _, element := range elements {
+		listForSender.items.Remove(element)
+		listForSender.onRemovedListElement(element)
+
+		value := element.Value.(*WrappedTransaction)
+		removedTxHashes = append(removedTxHashes, value.TxHash)
+	}
+
+	return removedTxHashes
 }
 
 // This function should only be used in critical section (listForSender.mutex)
@@ -1,10 +1,12 @@
 package txcache
 
 import (
+	"errors"
 	"math"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -54,14 +56,14 @@ func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	list := newUnconstrainedListToTest()
 	txGasHandler, txFeeHelper := dummyParams()
 
-	added, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
-	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
-	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
-	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
-	require.False(t, added)
+	outcome, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
+	require.True(t, outcome.added)
+	outcome, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	require.True(t, outcome.added)
+	outcome, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	require.True(t, outcome.added)
+	outcome, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	require.False(t, outcome.added)
 }
 
 func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing.T) {
@@ -74,19 +76,19 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing
 	list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx4"}, list.getTxHashesAsStrings())
 
-	_, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	outcome, _ := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
+	require.Equal(t, []string{"tx4"}, hashesAsStrings(outcome.evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx3" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
+	outcome, _ = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
+	require.Equal(t, []string{"tx3"}, hashesAsStrings(outcome.evicted))
 
 	// Though Undesirably to some extent, "tx3++"" is added, then evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
+	outcome, _ = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx3++"}, hashesAsStrings(evicted))
+	require.Equal(t, []string{"tx3++"}, hashesAsStrings(outcome.evicted))
 }
 
 func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
@@ -96,22 +98,79 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
 	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 512, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 42), txGasHandler, txFeeHelper)
-	_, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
+	outcome, _ := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx5"}, hashesAsStrings(evicted))
+	require.Equal(t, []string{"tx5"}, hashesAsStrings(outcome.evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	outcome, _ = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx5--"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{}, hashesAsStrings(evicted))
+	require.Equal(t, []string{}, hashesAsStrings(outcome.evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	outcome, _ = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx5--"}, hashesAsStrings(evicted))
+	require.Equal(t, []string{"tx5--"}, hashesAsStrings(outcome.evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx4" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
+	outcome, _ = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3++", "tx3"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
+	require.Equal(t, []string{"tx4"}, hashesAsStrings(outcome.evicted))
+}
+
+func TestListForSender_AddTx_ReplacesSameNonceTxWhenGasPriceIsBumpedEnough(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("b"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("c"), ".", 3, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	outcome, err := list.AddTx(createTxWithParams([]byte("b++"), ".", 2, 128, 42, 110), txGasHandler, txFeeHelper)
+	require.Nil(t, err)
+	require.True(t, outcome.added)
+	require.Equal(t, []string{"b"}, hashesAsStrings(outcome.replaced))
+	require.Equal(t, []string{"a", "b++", "c"}, list.getTxHashesAsStrings())
+	require.Equal(t, int64(3*128), list.totalBytes.Get())
+
+	// the replacement of the replacement should be bumped with respect to the latest gas price
+	outcome, err = list.AddTx(createTxWithParams([]byte("b+++"), ".", 2, 128, 42, 120), txGasHandler, txFeeHelper)
+	require.True(t, errors.Is(err, storage.ErrUnderpricedReplacement))
+	require.False(t, outcome.added)
+	require.Equal(t, []string{"a", "b++", "c"}, list.getTxHashesAsStrings())
+}
+
+func TestListForSender_AddTx_RejectsUnderpricedReplacement(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	outcome, err := list.AddTx(createTxWithParams([]byte("a+"), ".", 1, 128, 42, 109), txGasHandler, txFeeHelper)
+	require.True(t, errors.Is(err, storage.ErrUnderpricedReplacement))
+	require.False(t, outcome.added)
+	require.Equal(t, []string{"a"}, list.getTxHashesAsStrings())
+
+	outcome, err = list.AddTx(createTxWithParams([]byte("a-"), ".", 1, 128, 42, 90), txGasHandler, txFeeHelper)
+	require.True(t, errors.Is(err, storage.ErrUnderpricedReplacement))
+	require.False(t, outcome.added)
+
+	// duplicates are ignored, not rejected
+	outcome, err = list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	require.Nil(t, err)
+	require.False(t, outcome.added)
+
+	require.True(t, errors.Is(list.checkReplacement(createTxWithParams([]byte("a+"), ".", 1, 128, 42, 109)), storage.ErrUnderpricedReplacement))
+	require.Nil(t, list.checkReplacement(createTxWithParams([]byte("a++"), ".", 1, 128, 42, 110)))
+	require.Nil(t, list.checkReplacement(createTxWithParams([]byte("b"), ".", 2, 128, 42, 1)))
+	require.Equal(t, []string{"a"}, list.getTxHashesAsStrings())
+}
+
+func TestSenderConstraints_computeMinReplacementGasPrice(t *testing.T) {
+	constraints := &senderConstraints{replacementEnabled: true, minGasPriceBumpPercentage: 10}
+
+	require.Equal(t, uint64(1_100_000_000), constraints.computeMinReplacementGasPrice(1_000_000_000))
+	require.Equal(t, uint64(11), constraints.computeMinReplacementGasPrice(10))
+	require.Equal(t, uint64(6), constraints.computeMinReplacementGasPrice(5))
+	require.Equal(t, uint64(1), constraints.computeMinReplacementGasPrice(0))
 }
 
 func TestListForSender_findTx(t *testing.T) {
@@ -435,6 +494,15 @@ func newUnconstrainedListToTest() *txListForSender {
 	}, func(_ *txListForSender, _ senderScoreParams) {})
 }
 
+func newListWithReplacementToTest(minGasPriceBumpPercentage uint32) *txListForSender {
+	return newTxListForSender(".", &senderConstraints{
+		maxNumBytes:               math.MaxUint32,
+		maxNumTxs:                 math.MaxUint32,
+		replacementEnabled:        true,
+		minGasPriceBumpPercentage: minGasPriceBumpPercentage,
+	}, func(_ *txListForSender, _ senderScoreParams) {})
+}
+
 func newListToTest(maxNumBytes uint32, maxNumTxs uint32) *txListForSender {
 	return newTxListForSender(".", &senderConstraints{
 		maxNumBytes: maxNumBytes,
@@ -0,0 +1,23 @@
+package consensus
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	ProcessMessageCalled func(cnsMsg *consensus.Message, message p2p.MessageP2P)
+}
+
+// ProcessMessage -
+func (stub *EquivocationDetectorStub) ProcessMessage(cnsMsg *consensus.Message, message p2p.MessageP2P) {
+	if stub.ProcessMessageCalled != nil {
+		stub.ProcessMessageCalled(cnsMsg, message)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
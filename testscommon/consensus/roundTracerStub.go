@@ -0,0 +1,93 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// RoundTracerStub -
+type RoundTracerStub struct {
+	SubroundStartedCalled        func(round int64, subroundName string)
+	SubroundEndedCalled          func(round int64, subroundName string, isFinished bool, isCanceled bool)
+	ConsensusGroupSelectedCalled func(round int64, leader []byte, consensusGroupSize int)
+	MessageReceivedCalled        func(cnsMsg *consensus.Message)
+	SignaturesCollectedCalled    func(round int64, numSignatures int, threshold int)
+	BlockProcessedCalled         func(round int64, duration time.Duration, err error)
+	BlockCommittedCalled         func(round int64)
+	GetRoundCalled               func(round int64) (*common.ConsensusRoundTrace, error)
+	GetRoundsCalled              func(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error)
+}
+
+// SubroundStarted -
+func (stub *RoundTracerStub) SubroundStarted(round int64, subroundName string) {
+	if stub.SubroundStartedCalled != nil {
+		stub.SubroundStartedCalled(round, subroundName)
+	}
+}
+
+// SubroundEnded -
+func (stub *RoundTracerStub) SubroundEnded(round int64, subroundName string, isFinished bool, isCanceled bool) {
+	if stub.SubroundEndedCalled != nil {
+		stub.SubroundEndedCalled(round, subroundName, isFinished, isCanceled)
+	}
+}
+
+// ConsensusGroupSelected -
+func (stub *RoundTracerStub) ConsensusGroupSelected(round int64, leader []byte, consensusGroupSize int) {
+	if stub.ConsensusGroupSelectedCalled != nil {
+		stub.ConsensusGroupSelectedCalled(round, leader, consensusGroupSize)
+	}
+}
+
+// MessageReceived -
+func (stub *RoundTracerStub) MessageReceived(cnsMsg *consensus.Message) {
+	if stub.MessageReceivedCalled != nil {
+		stub.MessageReceivedCalled(cnsMsg)
+	}
+}
+
+// SignaturesCollected -
+func (stub *RoundTracerStub) SignaturesCollected(round int64, numSignatures int, threshold int) {
+	if stub.SignaturesCollectedCalled != nil {
+		stub.SignaturesCollectedCalled(round, numSignatures, threshold)
+	}
+}
+
+// BlockProcessed -
+func (stub *RoundTracerStub) BlockProcessed(round int64, duration time.Duration, err error) {
+	if stub.BlockProcessedCalled != nil {
+		stub.BlockProcessedCalled(round, duration, err)
+	}
+}
+
+// BlockCommitted -
+func (stub *RoundTracerStub) BlockCommitted(round int64) {
+	if stub.BlockCommittedCalled != nil {
+		stub.BlockCommittedCalled(round)
+	}
+}
+
+// GetRound -
+func (stub *RoundTracerStub) GetRound(round int64) (*common.ConsensusRoundTrace, error) {
+	if stub.GetRoundCalled != nil {
+		return stub.GetRoundCalled(round)
+	}
+
+	return &common.ConsensusRoundTrace{}, nil
+}
+
+// GetRounds -
+func (stub *RoundTracerStub) GetRounds(startRound int64, endRound int64) ([]*common.ConsensusRoundTrace, error) {
+	if stub.GetRoundsCalled != nil {
+		return stub.GetRoundsCalled(startRound, endRound)
+	}
+
+	return make([]*common.ConsensusRoundTrace, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *RoundTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,30 @@
+package consensus
+
+// SigningHandlerStub -
+type SigningHandlerStub struct {
+	CreateSignatureShareForPublicKeyCalled func(message []byte, publicKeyBytes []byte, marshalledHeader []byte, shardID uint32) ([]byte, error)
+	CreateBlockSignatureForPublicKeyCalled func(message []byte, publicKeyBytes []byte, shardID uint32, round int64) ([]byte, error)
+}
+
+// CreateSignatureShareForPublicKey -
+func (stub *SigningHandlerStub) CreateSignatureShareForPublicKey(message []byte, publicKeyBytes []byte, marshalledHeader []byte, shardID uint32) ([]byte, error) {
+	if stub.CreateSignatureShareForPublicKeyCalled != nil {
+		return stub.CreateSignatureShareForPublicKeyCalled(message, publicKeyBytes, marshalledHeader, shardID)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// CreateBlockSignatureForPublicKey -
+func (stub *SigningHandlerStub) CreateBlockSignatureForPublicKey(message []byte, publicKeyBytes []byte, shardID uint32, round int64) ([]byte, error) {
+	if stub.CreateBlockSignatureForPublicKeyCalled != nil {
+		return stub.CreateBlockSignatureForPublicKeyCalled(message, publicKeyBytes, shardID, round)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *SigningHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,74 @@
+package cryptoMocks
+
+import (
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// ManagedPeersHolderStub -
+type ManagedPeersHolderStub struct {
+	AddManagedPeerCalled              func(privateKeyBytes []byte) error
+	GetPrivateKeyCalled               func(pkBytes []byte) (crypto.PrivateKey, error)
+	GetMultiSignerCalled              func(pkBytes []byte) (crypto.MultiSigner, error)
+	GetManagedKeysByCurrentNodeCalled func() map[string]crypto.PrivateKey
+	IsKeyManagedByCurrentNodeCalled   func(pkBytes []byte) bool
+	IsMultiKeyModeCalled              func() bool
+}
+
+// AddManagedPeer -
+func (stub *ManagedPeersHolderStub) AddManagedPeer(privateKeyBytes []byte) error {
+	if stub.AddManagedPeerCalled != nil {
+		return stub.AddManagedPeerCalled(privateKeyBytes)
+	}
+
+	return nil
+}
+
+// GetPrivateKey -
+func (stub *ManagedPeersHolderStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if stub.GetPrivateKeyCalled != nil {
+		return stub.GetPrivateKeyCalled(pkBytes)
+	}
+
+	return nil, nil
+}
+
+// GetMultiSigner -
+func (stub *ManagedPeersHolderStub) GetMultiSigner(pkBytes []byte) (crypto.MultiSigner, error) {
+	if stub.GetMultiSignerCalled != nil {
+		return stub.GetMultiSignerCalled(pkBytes)
+	}
+
+	return nil, nil
+}
+
+// GetManagedKeysByCurrentNode -
+func (stub *ManagedPeersHolderStub) GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey {
+	if stub.GetManagedKeysByCurrentNodeCalled != nil {
+		return stub.GetManagedKeysByCurrentNodeCalled()
+	}
+
+	return make(map[string]crypto.PrivateKey)
+}
+
+// IsKeyManagedByCurrentNode -
+func (stub *ManagedPeersHolderStub) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	if stub.IsKeyManagedByCurrentNodeCalled != nil {
+		return stub.IsKeyManagedByCurrentNodeCalled(pkBytes)
+	}
+
+	return false
+}
+
+// IsMultiKeyMode -
+func (stub *ManagedPeersHolderStub) IsMultiKeyMode() bool {
+	if stub.IsMultiKeyModeCalled != nil {
+		return stub.IsMultiKeyModeCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *ManagedPeersHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
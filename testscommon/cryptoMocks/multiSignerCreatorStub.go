@@ -0,0 +1,24 @@
+package cryptoMocks
+
+import (
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+)
+
+// MultiSignerCreatorStub -
+type MultiSignerCreatorStub struct {
+	CreateMultiSignerCalled func(privateKey crypto.PrivateKey, publicKeyBytes []byte) (crypto.MultiSigner, error)
+}
+
+// CreateMultiSigner -
+func (stub *MultiSignerCreatorStub) CreateMultiSigner(privateKey crypto.PrivateKey, publicKeyBytes []byte) (crypto.MultiSigner, error) {
+	if stub.CreateMultiSignerCalled != nil {
+		return stub.CreateMultiSignerCalled(privateKey, publicKeyBytes)
+	}
+
+	return &MultisignerStub{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *MultiSignerCreatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,36 @@
+package cryptoMocks
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/remoteSigner"
+)
+
+// SignerClientStub -
+type SignerClientStub struct {
+	SignCalled          func(ctx context.Context, request *remoteSigner.SignRequest) (*remoteSigner.SignResponse, error)
+	GetPublicKeysCalled func(ctx context.Context, request *remoteSigner.PublicKeysRequest) (*remoteSigner.PublicKeysResponse, error)
+}
+
+// Sign -
+func (stub *SignerClientStub) Sign(ctx context.Context, request *remoteSigner.SignRequest) (*remoteSigner.SignResponse, error) {
+	if stub.SignCalled != nil {
+		return stub.SignCalled(ctx, request)
+	}
+
+	return &remoteSigner.SignResponse{}, nil
+}
+
+// GetPublicKeys -
+func (stub *SignerClientStub) GetPublicKeys(ctx context.Context, request *remoteSigner.PublicKeysRequest) (*remoteSigner.PublicKeysResponse, error) {
+	if stub.GetPublicKeysCalled != nil {
+		return stub.GetPublicKeysCalled(ctx, request)
+	}
+
+	return &remoteSigner.PublicKeysResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *SignerClientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
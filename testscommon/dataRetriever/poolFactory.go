@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageCacherAdapter"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/ElrondNetwork/elrond-go/trie/factory"
 )
@@ -39,6 +40,7 @@ func CreateTxPool(numShards uint32, selfShard uint32) (dataRetriever.ShardedData
 				SizeInBytesPerSender: 33_554_432,
 				Shards:               16,
 			},
+			PoolJournal:    txcache.NewDisabledPoolJournal(),
 			NumberOfShards: numShards,
 			SelfShardID:    selfShard,
 			TxGasHandler: &txcachemocks.TxGasHandlerMock{
@@ -140,6 +142,7 @@ func CreatePoolsHolder(numShards uint32, selfShard uint32) dataRetriever.PoolsHo
 		SmartContracts:           smartContracts,
 		PeerAuthentications:      peerAuthPool,
 		Heartbeats:               heartbeatPool,
+		PoolJournal:              txcache.NewDisabledPoolJournal(),
 	}
 	holder, err := dataPool.NewDataPool(dataPoolArgs)
 	panicIfError("CreatePoolsHolder", err)
@@ -214,6 +217,7 @@ func CreatePoolsHolderWithTxPool(txPool dataRetriever.ShardedDataCacherNotifier)
 		SmartContracts:           smartContracts,
 		PeerAuthentications:      peerAuthPool,
 		Heartbeats:               heartbeatPool,
+		PoolJournal:              txcache.NewDisabledPoolJournal(),
 	}
 	holder, err := dataPool.NewDataPool(dataPoolArgs)
 	panicIfError("CreatePoolsHolderWithTxPool", err)
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 )
 
@@ -30,12 +31,15 @@ type PoolsHolderMock struct {
 	currBlockTxs         dataRetriever.TransactionCacher
 	peerAuthentications  storage.Cacher
 	heartbeats           storage.Cacher
+	poolJournal          txcache.PoolJournalHandler
 }
 
 // NewPoolsHolderMock -
 func NewPoolsHolderMock() *PoolsHolderMock {
 	var err error
-	holder := &PoolsHolderMock{}
+	holder := &PoolsHolderMock{
+		poolJournal: txcache.NewDisabledPoolJournal(),
+	}
 
 	holder.transactions, err = txpool.NewShardedTxPool(
 		txpool.ArgShardedTxPool{
@@ -51,6 +55,7 @@ func NewPoolsHolderMock() *PoolsHolderMock {
 				MinimumGasPrice:      200000000000,
 				GasProcessingDivisor: 100,
 			},
+			PoolJournal:    holder.poolJournal,
 			NumberOfShards: 1,
 		},
 	)
@@ -172,6 +177,11 @@ func (holder *PoolsHolderMock) Heartbeats() storage.Cacher {
 	return holder.heartbeats
 }
 
+// PoolJournal -
+func (holder *PoolsHolderMock) PoolJournal() txcache.PoolJournalHandler {
+	return holder.poolJournal
+}
+
 // Close -
 func (holder *PoolsHolderMock) Close() error {
 	var lastError error
@@ -3,6 +3,7 @@ package dataRetriever
 import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 )
 
@@ -21,6 +22,7 @@ type PoolsHolderStub struct {
 	SmartContractsCalled       func() storage.Cacher
 	PeerAuthenticationsCalled  func() storage.Cacher
 	HeartbeatsCalled           func() storage.Cacher
+	PoolJournalCalled          func() txcache.PoolJournalHandler
 	CloseCalled                func() error
 }
 
@@ -146,6 +148,15 @@ func (holder *PoolsHolderStub) Heartbeats() storage.Cacher {
 	return testscommon.NewCacherStub()
 }
 
+// PoolJournal -
+func (holder *PoolsHolderStub) PoolJournal() txcache.PoolJournalHandler {
+	if holder.PoolJournalCalled != nil {
+		return holder.PoolJournalCalled()
+	}
+
+	return txcache.NewDisabledPoolJournal()
+}
+
 // Close -
 func (holder *PoolsHolderStub) Close() error {
 	if holder.CloseCalled != nil {
@@ -5,19 +5,24 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 )
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, createdIntraMiniBlocks []*block.MiniBlock, logs []*data.LogData) error
-	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
-	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
-	GetEpochByHashCalled               func(hash []byte) (uint32, error)
-	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
-	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
-	IsEnabledCalled                    func() bool
+	RecordBlockCalled                    func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, createdIntraMiniBlocks []*block.MiniBlock, logs []*data.LogData) error
+	OnNotarizedBlocksCalled              func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
+	GetMiniblockMetadataByTxHashCalled   func(hash []byte) (*dblookupext.MiniblockMetadata, error)
+	GetEpochByHashCalled                 func(hash []byte) (uint32, error)
+	GetEventsHashesByTxHashCalled        func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetESDTSupplyCalled                  func(token string) (*esdtSupply.SupplyESDT, error)
+	IsEnabledCalled                      func() bool
+	GetTransactionsHashesByAddressCalled func(address []byte, from uint64, size uint64) ([][]byte, uint64, error)
+	RecordStateChangesCalled             func(txHash []byte, stateChanges []*common.AccountStateChange)
+	GetStateChangesByTxHashCalled        func(txHash []byte) ([]*common.AccountStateChange, error)
+	GetEventsCalled                      func(query *dblookupext.EventsQuery) ([]*dblookupext.IndexedEvent, bool, error)
 }
 
 // RecordBlock -
@@ -25,13 +30,14 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 	createdIntraMiniBlocks []*block.MiniBlock,
 	logs []*data.LogData,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, createdIntraMiniBlocks, logs)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, receipts, createdIntraMiniBlocks, logs)
 	}
 	return nil
 }
@@ -89,6 +95,40 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetTransactionsHashesByAddress -
+func (hp *HistoryRepositoryStub) GetTransactionsHashesByAddress(address []byte, from uint64, size uint64) ([][]byte, uint64, error) {
+	if hp.GetTransactionsHashesByAddressCalled != nil {
+		return hp.GetTransactionsHashesByAddressCalled(address, from, size)
+	}
+
+	return nil, 0, nil
+}
+
+// RecordStateChanges -
+func (hp *HistoryRepositoryStub) RecordStateChanges(txHash []byte, stateChanges []*common.AccountStateChange) {
+	if hp.RecordStateChangesCalled != nil {
+		hp.RecordStateChangesCalled(txHash, stateChanges)
+	}
+}
+
+// GetStateChangesByTxHash -
+func (hp *HistoryRepositoryStub) GetStateChangesByTxHash(txHash []byte) ([]*common.AccountStateChange, error) {
+	if hp.GetStateChangesByTxHashCalled != nil {
+		return hp.GetStateChangesByTxHashCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (hp *HistoryRepositoryStub) GetEvents(query *dblookupext.EventsQuery) ([]*dblookupext.IndexedEvent, bool, error) {
+	if hp.GetEventsCalled != nil {
+		return hp.GetEventsCalled(query)
+	}
+
+	return nil, false, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
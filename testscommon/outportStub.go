@@ -3,6 +3,7 @@ package testscommon
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport"
 )
 
@@ -12,6 +13,7 @@ type OutportStub struct {
 	SaveValidatorsRatingCalled  func(index string, validatorsInfo []*indexer.ValidatorRatingInfo)
 	SaveValidatorsPubKeysCalled func(shardPubKeys map[uint32][][]byte, epoch uint32)
 	HasDriversCalled            func() bool
+	SaveEquivocationCalled      func(evidence *common.EquivocationEvidence)
 }
 
 // SaveBlock -
@@ -76,3 +78,10 @@ func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 // FinalizedBlock -
 func (as *OutportStub) FinalizedBlock(_ []byte) {
 }
+
+// SaveEquivocation -
+func (as *OutportStub) SaveEquivocation(evidence *common.EquivocationEvidence) {
+	if as.SaveEquivocationCalled != nil {
+		as.SaveEquivocationCalled(evidence)
+	}
+}
@@ -12,6 +12,8 @@ type NetworkShardingCollectorStub struct {
 	PutPeerIdSubTypeCalled          func(pid core.PeerID, peerSubType core.P2PPeerSubType)
 	GetLastKnownPeerIDCalled        func(pk []byte) (core.PeerID, bool)
 	GetPeerInfoCalled               func(pid core.PeerID) core.P2PPeerInfo
+
+	UpdatePeerIDPublicKeyPairFromBatchCalled func(pid core.PeerID, pk []byte, batchID []byte)
 }
 
 // UpdatePeerIDPublicKeyPair -
@@ -21,6 +23,13 @@ func (nscs *NetworkShardingCollectorStub) UpdatePeerIDPublicKeyPair(pid core.Pee
 	}
 }
 
+// UpdatePeerIDPublicKeyPairFromBatch -
+func (nscs *NetworkShardingCollectorStub) UpdatePeerIDPublicKeyPairFromBatch(pid core.PeerID, pk []byte, batchID []byte) {
+	if nscs.UpdatePeerIDPublicKeyPairFromBatchCalled != nil {
+		nscs.UpdatePeerIDPublicKeyPairFromBatchCalled(pid, pk, batchID)
+	}
+}
+
 // PutPeerIdShardId -
 func (nscs *NetworkShardingCollectorStub) PutPeerIdShardId(pid core.PeerID, shardID uint32) {
 	if nscs.PutPeerIdShardIdCalled != nil {
@@ -0,0 +1,30 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// StateChangesCollectorStub -
+type StateChangesCollectorStub struct {
+	StartTrackingCalled func()
+	StopTrackingCalled  func() []*common.AccountStateChange
+}
+
+// StartTracking -
+func (stub *StateChangesCollectorStub) StartTracking() {
+	if stub.StartTrackingCalled != nil {
+		stub.StartTrackingCalled()
+	}
+}
+
+// StopTracking -
+func (stub *StateChangesCollectorStub) StopTracking() []*common.AccountStateChange {
+	if stub.StopTrackingCalled != nil {
+		return stub.StopTrackingCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *StateChangesCollectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
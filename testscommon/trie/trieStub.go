@@ -24,6 +24,7 @@ type TrieStub struct {
 	GetSerializedNodesCalled          func([]byte, uint64) ([][]byte, uint64, error)
 	GetAllHashesCalled                func() ([][]byte, error)
 	GetAllLeavesOnChannelCalled       func(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error
+	GetLeavesOnChannelFromKeyCalled   func(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte, startKey []byte) error
 	GetProofCalled                    func(key []byte) ([][]byte, []byte, error)
 	VerifyProofCalled                 func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 	GetStorageManagerCalled           func() common.StorageManager
@@ -70,6 +71,15 @@ func (ts *TrieStub) GetAllLeavesOnChannel(leavesChannel chan core.KeyValueHolder
 	return nil
 }
 
+// GetLeavesOnChannelFromKey -
+func (ts *TrieStub) GetLeavesOnChannelFromKey(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte, startKey []byte) error {
+	if ts.GetLeavesOnChannelFromKeyCalled != nil {
+		return ts.GetLeavesOnChannelFromKeyCalled(leavesChannel, ctx, rootHash, startKey)
+	}
+
+	return nil
+}
+
 // Get -
 func (ts *TrieStub) Get(key []byte) ([]byte, error) {
 	if ts.GetCalled != nil {
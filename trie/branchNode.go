@@ -814,6 +814,61 @@ func (bn *branchNode) getAllLeavesOnChannel(
 	return nil
 }
 
+// getLeavesOnChannelFromPath adds to the channel the leaves whose hex path is not lower than the provided one. The
+// children placed before the start path are skipped without being resolved
+func (bn *branchNode) getLeavesOnChannelFromPath(
+	leavesChannel chan core.KeyValueHolder,
+	key []byte,
+	startPath []byte,
+	db common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	chanClose chan struct{},
+	ctx context.Context,
+) error {
+	if len(startPath) == 0 {
+		return bn.getAllLeavesOnChannel(leavesChannel, key, db, marshalizer, chanClose, ctx)
+	}
+
+	err := bn.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("getLeavesOnChannelFromPath error: %w", err)
+	}
+
+	for i := int(startPath[0]); i < len(bn.children); i++ {
+		select {
+		case <-chanClose:
+			log.Trace("branchNode.getLeavesOnChannelFromPath interrupted")
+			return nil
+		case <-ctx.Done():
+			log.Trace("branchNode.getLeavesOnChannelFromPath context done")
+			return nil
+		default:
+			err = resolveIfCollapsed(bn, byte(i), db)
+			if err != nil {
+				return err
+			}
+
+			if bn.children[i] == nil {
+				continue
+			}
+
+			childKey := append(key, byte(i))
+			if i == int(startPath[0]) {
+				err = bn.children[i].getLeavesOnChannelFromPath(leavesChannel, childKey, startPath[1:], db, marshalizer, chanClose, ctx)
+			} else {
+				err = bn.children[i].getAllLeavesOnChannel(leavesChannel, childKey, db, marshalizer, chanClose, ctx)
+			}
+			if err != nil {
+				return err
+			}
+
+			bn.children[i] = nil
+		}
+	}
+
+	return nil
+}
+
 func (bn *branchNode) getAllHashes(db common.DBWriteCacher) ([][]byte, error) {
 	err := bn.isEmptyOrNil()
 	if err != nil {
@@ -674,6 +674,62 @@ func (en *extensionNode) getAllLeavesOnChannel(
 	return nil
 }
 
+// getLeavesOnChannelFromPath adds to the channel the leaves whose hex path is not lower than the provided one
+func (en *extensionNode) getLeavesOnChannelFromPath(
+	leavesChannel chan core.KeyValueHolder,
+	key []byte,
+	startPath []byte,
+	db common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	chanClose chan struct{},
+	ctx context.Context,
+) error {
+	if len(startPath) == 0 {
+		return en.getAllLeavesOnChannel(leavesChannel, key, db, marshalizer, chanClose, ctx)
+	}
+
+	err := en.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("getLeavesOnChannelFromPath error: %w", err)
+	}
+
+	commonLen := len(en.Key)
+	if len(startPath) < commonLen {
+		commonLen = len(startPath)
+	}
+
+	switch bytes.Compare(en.Key[:commonLen], startPath[:commonLen]) {
+	case -1:
+		return nil
+	case 1:
+		return en.getAllLeavesOnChannel(leavesChannel, key, db, marshalizer, chanClose, ctx)
+	}
+
+	select {
+	case <-chanClose:
+		log.Trace("extensionNode.getLeavesOnChannelFromPath interrupted")
+		return nil
+	case <-ctx.Done():
+		log.Trace("extensionNode.getLeavesOnChannelFromPath: context done")
+		return nil
+	default:
+		err = resolveIfCollapsed(en, 0, db)
+		if err != nil {
+			return err
+		}
+
+		childKey := append(key, en.Key...)
+		err = en.child.getLeavesOnChannelFromPath(leavesChannel, childKey, startPath[commonLen:], db, marshalizer, chanClose, ctx)
+		if err != nil {
+			return err
+		}
+
+		en.child = nil
+	}
+
+	return nil
+}
+
 func (en *extensionNode) getAllHashes(db common.DBWriteCacher) ([][]byte, error) {
 	err := en.isEmptyOrNil()
 	if err != nil {
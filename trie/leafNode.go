@@ -463,6 +463,28 @@ func (ln *leafNode) getAllLeavesOnChannel(
 	}
 }
 
+// getLeavesOnChannelFromPath adds the leaf to the channel if its hex path is not lower than the provided one
+func (ln *leafNode) getLeavesOnChannelFromPath(
+	leavesChannel chan core.KeyValueHolder,
+	key []byte,
+	startPath []byte,
+	db common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	chanClose chan struct{},
+	ctx context.Context,
+) error {
+	err := ln.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("getLeavesOnChannelFromPath error: %w", err)
+	}
+
+	if bytes.Compare(ln.Key, startPath) < 0 {
+		return nil
+	}
+
+	return ln.getAllLeavesOnChannel(leavesChannel, key, db, marshalizer, chanClose, ctx)
+}
+
 func (ln *leafNode) getAllHashes(_ common.DBWriteCacher) ([][]byte, error) {
 	err := ln.isEmptyOrNil()
 	if err != nil {
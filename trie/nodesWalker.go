@@ -0,0 +1,74 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// WalkTrieNodes calls the handler for every node reachable from the provided root hash, loading the nodes one by one
+// from the provided storer. The handler receives the node hash, the node as it is saved in the storer and, for the
+// leaf nodes, the leaf value. Unlike the trie iterator, the visited nodes are not kept in memory.
+func WalkTrieNodes(
+	db common.DBWriteCacher,
+	rootHash []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler func(hash []byte, encodedNode []byte, leafValue []byte) error,
+) error {
+	if check.IfNil(db) {
+		return ErrNilDatabase
+	}
+	if check.IfNil(marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return ErrNilHasher
+	}
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return nil
+	}
+
+	hashesToVisit := [][]byte{rootHash}
+	for len(hashesToVisit) > 0 {
+		lastIndex := len(hashesToVisit) - 1
+		hash := hashesToVisit[lastIndex]
+		hashesToVisit = hashesToVisit[:lastIndex]
+
+		encodedNode, err := db.Get(hash)
+		if err != nil {
+			return fmt.Errorf(common.GetNodeFromDBErrorString+" %w for key %v", err, hex.EncodeToString(hash))
+		}
+
+		n, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil {
+			return err
+		}
+
+		var leafValue []byte
+		switch typedNode := n.(type) {
+		case *branchNode:
+			for _, childHash := range typedNode.EncodedChildren {
+				if len(childHash) != 0 {
+					hashesToVisit = append(hashesToVisit, childHash)
+				}
+			}
+		case *extensionNode:
+			hashesToVisit = append(hashesToVisit, typedNode.EncodedChild)
+		case *leafNode:
+			leafValue = typedNode.Value
+		}
+
+		err = handler(hash, encodedNode, leafValue)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package trie_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtobufMarshalizerMock{}
+	hasher := &testscommon.KeccakMock{}
+
+	t.Run("nil database should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := trie.WalkTrieNodes(nil, []byte("root"), marshalizer, hasher, nil)
+		assert.Equal(t, trie.ErrNilDatabase, err)
+	})
+	t.Run("empty root hash should not call the handler", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		err := trie.WalkTrieNodes(tr.GetStorageManager(), trie.EmptyTrieHash, marshalizer, hasher, func(_ []byte, _ []byte, _ []byte) error {
+			assert.Fail(t, "should not have been called")
+			return nil
+		})
+		assert.Nil(t, err)
+	})
+	t.Run("should visit all nodes", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		require.Nil(t, tr.Commit())
+		rootHash, _ := tr.RootHash()
+		expectedHashes, _ := tr.GetAllHashes()
+
+		visitedHashes := make([][]byte, 0)
+		leafValues := make([]string, 0)
+		err := trie.WalkTrieNodes(tr.GetStorageManager(), rootHash, marshalizer, hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+			assert.Equal(t, hash, hasher.Compute(string(encodedNode)))
+			visitedHashes = append(visitedHashes, hash)
+			if len(leafValue) > 0 {
+				leafValues = append(leafValues, string(leafValue))
+			}
+
+			return nil
+		})
+		require.Nil(t, err)
+
+		assert.ElementsMatch(t, expectedHashes, visitedHashes)
+		sort.Strings(leafValues)
+		assert.Equal(t, []string{"cat", "puppy", "reindeer"}, leafValues)
+	})
+	t.Run("handler error should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		require.Nil(t, tr.Commit())
+		rootHash, _ := tr.RootHash()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := trie.WalkTrieNodes(tr.GetStorageManager(), rootHash, marshalizer, hasher, func(_ []byte, _ []byte, _ []byte) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		err := trie.WalkTrieNodes(tr.GetStorageManager(), []byte("missing root hash"), marshalizer, hasher, func(_ []byte, _ []byte, _ []byte) error {
+			return nil
+		})
+		assert.NotNil(t, err)
+	})
+}
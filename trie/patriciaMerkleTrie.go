@@ -451,6 +451,32 @@ func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(
 	leavesChannel chan core.KeyValueHolder,
 	ctx context.Context,
 	rootHash []byte,
+) error {
+	return tr.getLeavesOnChannel(leavesChannel, ctx, rootHash, nil)
+}
+
+// GetLeavesOnChannelFromKey adds to the given channel the trie leaves which, in the trie order, are not placed before
+// the provided key. An empty start key adds all the leaves. The trie order is not the lexicographic order of the keys,
+// but it is stable for a root hash, so that an iteration can be resumed from the key of the first leaf not yet processed
+func (tr *patriciaMerkleTrie) GetLeavesOnChannelFromKey(
+	leavesChannel chan core.KeyValueHolder,
+	ctx context.Context,
+	rootHash []byte,
+	startKey []byte,
+) error {
+	var startPath []byte
+	if len(startKey) > 0 {
+		startPath = keyBytesToHex(startKey)
+	}
+
+	return tr.getLeavesOnChannel(leavesChannel, ctx, rootHash, startPath)
+}
+
+func (tr *patriciaMerkleTrie) getLeavesOnChannel(
+	leavesChannel chan core.KeyValueHolder,
+	ctx context.Context,
+	rootHash []byte,
+	startPath []byte,
 ) error {
 	tr.mutOperation.RLock()
 	newTrie, err := tr.recreate(rootHash, tr.trieStorage)
@@ -470,9 +496,10 @@ func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(
 	tr.mutOperation.RUnlock()
 
 	go func() {
-		err = newTrie.root.getAllLeavesOnChannel(
+		err = newTrie.root.getLeavesOnChannelFromPath(
 			leavesChannel,
 			[]byte{},
+			startPath,
 			tr.trieStorage,
 			tr.marshalizer,
 			tr.chanClose,
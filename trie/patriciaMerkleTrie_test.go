@@ -576,6 +576,51 @@ func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	assert.Equal(t, leaves, recovered)
 }
 
+func TestPatriciaMerkleTrie_GetLeavesOnChannelFromKey(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	for i := 0; i < 200; i++ {
+		_ = tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	getKeysFrom := func(startKey []byte) []string {
+		leavesChannel := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+		err := tr.GetLeavesOnChannelFromKey(leavesChannel, context.Background(), rootHash, startKey)
+		require.Nil(t, err)
+
+		keys := make([]string, 0)
+		for leaf := range leavesChannel {
+			keys = append(keys, string(leaf.Key()))
+		}
+
+		return keys
+	}
+
+	allKeys := getKeysFrom(nil)
+	require.Equal(t, 200, len(allKeys))
+
+	leavesChannel := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	_ = tr.GetAllLeavesOnChannel(leavesChannel, context.Background(), rootHash)
+	keysInTrieOrder := make([]string, 0)
+	for leaf := range leavesChannel {
+		keysInTrieOrder = append(keysInTrieOrder, string(leaf.Key()))
+	}
+	assert.Equal(t, keysInTrieOrder, allKeys)
+
+	// resuming from any key should return the key and all the keys placed after it
+	for i, key := range allKeys {
+		assert.Equal(t, allKeys[i:], getKeysFrom([]byte(key)))
+	}
+
+	// resuming from a missing key should return the keys placed after it
+	keys := getKeysFrom([]byte("missing key"))
+	assert.True(t, len(keys) < len(allKeys))
+	assert.Equal(t, allKeys[len(allKeys)-len(keys):], keys)
+}
+
 func TestPatriciaMerkleTree_Prove(t *testing.T) {
 	t.Parallel()
 